@@ -142,6 +142,7 @@ import (
 	v10 "github.com/evmos/evmos/v12/app/upgrades/v10"
 	v11 "github.com/evmos/evmos/v12/app/upgrades/v11"
 	v12 "github.com/evmos/evmos/v12/app/upgrades/v12"
+	v13 "github.com/evmos/evmos/v12/app/upgrades/v13"
 	v8 "github.com/evmos/evmos/v12/app/upgrades/v8"
 	v81 "github.com/evmos/evmos/v12/app/upgrades/v8_1"
 	v82 "github.com/evmos/evmos/v12/app/upgrades/v8_2"
@@ -164,6 +165,9 @@ import (
 	"github.com/evmos/evmos/v12/x/inflation"
 	inflationkeeper "github.com/evmos/evmos/v12/x/inflation/keeper"
 	inflationtypes "github.com/evmos/evmos/v12/x/inflation/types"
+	"github.com/evmos/evmos/v12/x/ratelimit"
+	ratelimitkeeper "github.com/evmos/evmos/v12/x/ratelimit/keeper"
+	ratelimittypes "github.com/evmos/evmos/v12/x/ratelimit/types"
 	"github.com/evmos/evmos/v12/x/recovery"
 	recoverykeeper "github.com/evmos/evmos/v12/x/recovery/keeper"
 	recoverytypes "github.com/evmos/evmos/v12/x/recovery/types"
@@ -175,6 +179,14 @@ import (
 	vestingtypes "github.com/evmos/evmos/v12/x/vesting/types"
 
 	// NOTE: override ICS20 keeper to support IBC transfers of ERC20 tokens
+	"github.com/evmos/evmos/v12/x/ibc/callbacks"
+	callbackskeeper "github.com/evmos/evmos/v12/x/ibc/callbacks/keeper"
+	callbackstypes "github.com/evmos/evmos/v12/x/ibc/callbacks/types"
+	"github.com/evmos/evmos/v12/x/ibc/evmhooks"
+	evmhookskeeper "github.com/evmos/evmos/v12/x/ibc/evmhooks/keeper"
+	"github.com/evmos/evmos/v12/x/ibc/forward"
+	forwardkeeper "github.com/evmos/evmos/v12/x/ibc/forward/keeper"
+	forwardtypes "github.com/evmos/evmos/v12/x/ibc/forward/types"
 	"github.com/evmos/evmos/v12/x/ibc/transfer"
 	transferkeeper "github.com/evmos/evmos/v12/x/ibc/transfer/keeper"
 
@@ -245,23 +257,27 @@ var (
 		epochs.AppModuleBasic{},
 		claims.AppModuleBasic{},
 		recovery.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
 		revenue.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		icatypes.ModuleName:            nil,
-		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		inflationtypes.ModuleName:      {authtypes.Minter},
-		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
-		claimstypes.ModuleName:         nil,
-		incentivestypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:            nil,
+		distrtypes.ModuleName:                 nil,
+		stakingtypes.BondedPoolName:           {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:        {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                   {authtypes.Burner},
+		ibctransfertypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:                   nil,
+		evmtypes.ModuleName:                   {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		inflationtypes.ModuleName:             {authtypes.Minter},
+		erc20types.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		claimstypes.ModuleName:                nil,
+		claimstypes.CampaignEscrowName:        nil,
+		incentivestypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
+		incentivestypes.SponsorshipEscrowName: nil,
+		revenuetypes.ModuleName:               nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -328,6 +344,10 @@ type Evmos struct {
 	VestingKeeper    vestingkeeper.Keeper
 	RecoveryKeeper   *recoverykeeper.Keeper
 	RevenueKeeper    revenuekeeper.Keeper
+	EVMHooksKeeper   evmhookskeeper.Keeper
+	CallbacksKeeper  *callbackskeeper.Keeper
+	ForwardKeeper    *forwardkeeper.Keeper
+	RateLimitKeeper  *ratelimitkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -377,7 +397,7 @@ func NewEvmos(
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
 		feegrant.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibchost.StoreKey, ibctransfertypes.StoreKey,
+		ibchost.StoreKey, ibctransfertypes.StoreKey, callbackstypes.StoreKey, forwardtypes.StoreKey,
 		// ica keys
 		icahosttypes.StoreKey,
 		// ethermint keys
@@ -385,7 +405,7 @@ func NewEvmos(
 		// evmos keys
 		inflationtypes.StoreKey, erc20types.StoreKey, incentivestypes.StoreKey,
 		epochstypes.StoreKey, claimstypes.StoreKey, vestingtypes.StoreKey,
-		revenuetypes.StoreKey, recoverytypes.StoreKey,
+		revenuetypes.StoreKey, recoverytypes.StoreKey, ratelimittypes.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -491,10 +511,14 @@ func NewEvmos(
 		&stakingKeeper, govRouter, app.MsgServiceRouter(), govConfig,
 	)
 
+	epochsKeeper := epochskeeper.NewKeeper(
+		appCodec, keys[epochstypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+
 	// Evmos Keeper
 	app.InflationKeeper = inflationkeeper.NewKeeper(
 		keys[inflationtypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, &stakingKeeper,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, &stakingKeeper, epochsKeeper,
 		authtypes.FeeCollectorName,
 	)
 
@@ -524,9 +548,14 @@ func NewEvmos(
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper,
 	)
 
+	// register the token pair conversion hooks before the keeper is copied
+	// into the modules that depend on it
+	app.Erc20Keeper.SetHooks(app.ClaimsKeeper.Hooks())
+
 	app.IncentivesKeeper = incentiveskeeper.NewKeeper(
 		keys[incentivestypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.InflationKeeper, app.StakingKeeper, app.EvmKeeper,
+		app.Erc20Keeper, epochsKeeper,
 	)
 
 	app.RevenueKeeper = revenuekeeper.NewKeeper(
@@ -534,13 +563,28 @@ func NewEvmos(
 		app.BankKeeper, app.EvmKeeper,
 		authtypes.FeeCollectorName,
 	)
+	app.RevenueKeeper.SetHooks(app.ClaimsKeeper.Hooks())
+
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		keys[ratelimittypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper, app.IBCKeeper.ChannelKeeper,
+	)
 
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
 			app.IncentivesKeeper.Hooks(),
 			app.InflationKeeper.Hooks(),
+			app.Erc20Keeper.Hooks(),
+			app.RateLimitKeeper.Hooks(),
+		),
+	).SetScheduleHooks(
+		epochskeeper.NewMultiEpochScheduleHooks(
+			// insert epoch schedule hooks receivers here
+			app.IncentivesKeeper.Hooks(),
+			app.InflationKeeper.Hooks(),
+			app.Erc20Keeper.Hooks(),
+			app.RateLimitKeeper.Hooks(),
 		),
 	)
 
@@ -576,12 +620,30 @@ func NewEvmos(
 		app.IBCKeeper.ChannelKeeper,
 		app.TransferKeeper,
 		app.ClaimsKeeper,
+		app.Erc20Keeper,
+	)
+
+	app.CallbacksKeeper = callbackskeeper.NewKeeper(
+		keys[callbackstypes.StoreKey],
+		appCodec,
+		app.EvmKeeper,
+	)
+
+	app.ForwardKeeper = forwardkeeper.NewKeeper(
+		keys[forwardtypes.StoreKey],
+		appCodec,
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.BankKeeper,
 	)
 
 	// NOTE: app.Erc20Keeper is already initialized elsewhere
 
 	// Set the ICS4 wrappers for custom module middlewares
-	app.RecoveryKeeper.SetICS4Wrapper(app.IBCKeeper.ChannelKeeper)
+	app.RateLimitKeeper.SetICS4Wrapper(app.IBCKeeper.ChannelKeeper)
+	app.CallbacksKeeper.SetICS4Wrapper(app.RateLimitKeeper)
+	app.ForwardKeeper.SetICS4Wrapper(app.CallbacksKeeper)
+	app.RecoveryKeeper.SetICS4Wrapper(app.ForwardKeeper)
 	app.ClaimsKeeper.SetICS4Wrapper(app.RecoveryKeeper)
 
 	// Override the ICS20 app module
@@ -606,18 +668,26 @@ func NewEvmos(
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- Rate Limit Middleware
+			- Packet Callbacks Middleware
+			- Packet Forward Middleware
+			- EVM Hooks Middleware
 			- ERC-20 Middleware
 		 	- Recovery Middleware
 		 	- Airdrop Claims Middleware
 			- IBC Transfer
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> forward.SendPacket -> callbacks.SendPacket -> ratelimit.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> ratelimit.OnRecvPacket -> callbacks.OnRecvPacket -> forward.OnRecvPacket -> evmhooks.OnRecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
+	app.EVMHooksKeeper = evmhookskeeper.NewKeeper(
+		app.AccountKeeper, app.BankKeeper, app.Erc20Keeper, app.EvmKeeper,
+	)
+
 	// create IBC module from top to bottom of stack
 	var transferStack porttypes.IBCModule
 
@@ -625,6 +695,11 @@ func NewEvmos(
 	transferStack = claims.NewIBCMiddleware(*app.ClaimsKeeper, transferStack)
 	transferStack = recovery.NewIBCMiddleware(*app.RecoveryKeeper, transferStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = evmhooks.NewIBCMiddleware(app.EVMHooksKeeper, transferStack)
+	// forwarded packets are handled by the bare transfer application
+	transferStack = forward.NewIBCMiddleware(*app.ForwardKeeper, transferStack, transfer.NewIBCModule(app.TransferKeeper))
+	transferStack = callbacks.NewIBCMiddleware(*app.CallbacksKeeper, transferStack)
+	transferStack = ratelimit.NewIBCMiddleware(*app.RateLimitKeeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -689,6 +764,7 @@ func NewEvmos(
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		recovery.NewAppModule(*app.RecoveryKeeper,
 			app.GetSubspace(recoverytypes.ModuleName)),
+		ratelimit.NewAppModule(*app.RateLimitKeeper),
 		revenue.NewAppModule(app.RevenueKeeper, app.AccountKeeper,
 			app.GetSubspace(revenuetypes.ModuleName)),
 	)
@@ -728,6 +804,7 @@ func NewEvmos(
 		claimstypes.ModuleName,
 		incentivestypes.ModuleName,
 		recoverytypes.ModuleName,
+		ratelimittypes.ModuleName,
 		revenuetypes.ModuleName,
 	)
 
@@ -762,6 +839,7 @@ func NewEvmos(
 		erc20types.ModuleName,
 		incentivestypes.ModuleName,
 		recoverytypes.ModuleName,
+		ratelimittypes.ModuleName,
 		revenuetypes.ModuleName,
 	)
 
@@ -803,6 +881,7 @@ func NewEvmos(
 		incentivestypes.ModuleName,
 		epochstypes.ModuleName,
 		recoverytypes.ModuleName,
+		ratelimittypes.ModuleName,
 		revenuetypes.ModuleName,
 		// NOTE: crisis module must go at the end to check for invariants on each module
 		crisistypes.ModuleName,
@@ -1221,6 +1300,14 @@ func (app *Evmos) setupUpgradeHandlers() {
 		),
 	)
 
+	// v13 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v13.UpgradeName,
+		v13.CreateUpgradeHandler(
+			app.mm, app.configurator,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1263,6 +1350,11 @@ func (app *Evmos) setupUpgradeHandlers() {
 		}
 	case v12.UpgradeName:
 		// no store upgrades
+	case v13.UpgradeName:
+		// add the packet callbacks, rate limit and packet forward stores
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{callbackstypes.StoreKey, ratelimittypes.StoreKey, forwardtypes.StoreKey},
+		}
 	}
 
 	if storeUpgrades != nil {
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.0;
+
+/**
+ * @dev Interface that contracts must implement to receive the acknowledgement
+ * and timeout callbacks of the IBC packets they registered as source callback.
+ * The callbacks are executed by the callbacks module account.
+ */
+interface IBCCallbacks {
+    /**
+     * @dev Returns true if the contract accepts the callbacks of the packets
+     * sent by the given account. It is called with a static call when a packet
+     * sent by an account other than the contract registers it as source callback.
+     */
+    function isCallbackAuthorized(address sender) external view returns (bool);
+
+    /**
+     * @dev Called when the packet has been acknowledged by the counterparty chain.
+     */
+    function onPacketAcknowledgement(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data,
+        bytes calldata acknowledgement
+    ) external;
+
+    /**
+     * @dev Called when the packet has timed out.
+     */
+    function onPacketTimeout(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data
+    ) external;
+}
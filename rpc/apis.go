@@ -32,6 +32,7 @@ import (
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/personal"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/web3"
+	"github.com/evmos/evmos/v12/rpc/namespaces/evmos"
 	"github.com/evmos/evmos/v12/types"
 
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
@@ -53,6 +54,10 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// Evmos namespaces
+
+	EvmosNamespace = "evmos"
+
 	apiVersion = "1.0"
 )
 
@@ -170,6 +175,22 @@ func init() {
 				},
 			}
 		},
+		EvmosNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EvmosNamespace,
+					Version:   apiVersion,
+					Service:   evmos.NewPublicAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
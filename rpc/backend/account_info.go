@@ -16,6 +16,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/big"
@@ -30,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/evmos/v12/rpc/types"
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	vestingtypes "github.com/evmos/evmos/v12/x/vesting/types"
 	"github.com/pkg/errors"
 )
 
@@ -171,7 +173,18 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
-	res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+
+	// report only the spendable balance for clawback vesting accounts if
+	// enabled on the node. Any other account falls back to the full balance.
+	if b.cfg.JSONRPC.SpendableBalanceOnly {
+		balances, evmDenom, err := b.queryVestingBalances(ctx, address)
+		if err == nil {
+			return (*hexutil.Big)(balances.Spendable.AmountOf(evmDenom).BigInt()), nil
+		}
+	}
+
+	res, err := b.queryClient.Balance(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +202,52 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 	return (*hexutil.Big)(val.BigInt()), nil
 }
 
+// GetVestingBalances returns the locked, unvested, vested and spendable
+// balances of the provided clawback vesting account up to the provided block
+// number.
+func (b *Backend) GetVestingBalances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.VestingBalances, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	res, evmDenom, err := b.queryVestingBalances(rpctypes.ContextWithHeight(blockNum.Int64()), address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.VestingBalances{
+		Address:   address,
+		Locked:    (*hexutil.Big)(res.Locked.AmountOf(evmDenom).BigInt()),
+		Unvested:  (*hexutil.Big)(res.Unvested.AmountOf(evmDenom).BigInt()),
+		Vested:    (*hexutil.Big)(res.Vested.AmountOf(evmDenom).BigInt()),
+		Spendable: (*hexutil.Big)(res.Spendable.AmountOf(evmDenom).BigInt()),
+	}, nil
+}
+
+// queryVestingBalances queries the vesting balances of the given address
+// together with the EVM denomination, using the height set on the context.
+func (b *Backend) queryVestingBalances(ctx context.Context, address common.Address) (*vestingtypes.QueryBalancesResponse, string, error) {
+	res, err := b.queryClient.Vesting.Balances(ctx, &vestingtypes.QueryBalancesRequest{
+		Address: sdk.AccAddress(address.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	params, err := b.queryClient.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res, params.Params.EvmDenom, nil
+}
+
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
 func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error) {
 	n := hexutil.Uint64(0)
@@ -332,6 +332,40 @@ func (suite *BackendTestSuite) TestGetBalance() {
 			true,
 			(*hexutil.Big)(big.NewInt(1)),
 		},
+		{
+			"pass - spendable balance only, vesting account",
+			utiltx.GenerateAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				suite.backend.cfg.JSONRPC.SpendableBalanceOnly = true
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, bn.Int64(), nil)
+				suite.Require().NoError(err)
+				vestingClient := suite.backend.queryClient.Vesting.(*mocks.VestingQueryClient)
+				RegisterVestingBalances(vestingClient, addr, bn.Int64())
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParamsWithoutHeader(queryClient, bn.Int64())
+			},
+			true,
+			(*hexutil.Big)(big.NewInt(1)),
+		},
+		{
+			"pass - spendable balance only, non vesting account",
+			utiltx.GenerateAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				suite.backend.cfg.JSONRPC.SpendableBalanceOnly = true
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, bn.Int64(), nil)
+				suite.Require().NoError(err)
+				vestingClient := suite.backend.queryClient.Vesting.(*mocks.VestingQueryClient)
+				RegisterVestingBalancesError(vestingClient, addr, bn.Int64())
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBalance(queryClient, addr, bn.Int64())
+			},
+			true,
+			(*hexutil.Big)(big.NewInt(1)),
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -353,6 +387,77 @@ func (suite *BackendTestSuite) TestGetBalance() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetVestingBalances() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+
+	testCases := []struct {
+		name          string
+		addr          common.Address
+		blockNrOrHash rpctypes.BlockNumberOrHash
+		registerMock  func(rpctypes.BlockNumber, common.Address)
+		expPass       bool
+	}{
+		{
+			"fail - tendermint client failed to get block",
+			utiltx.GenerateAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, bn.Int64())
+			},
+			false,
+		},
+		{
+			"fail - not a vesting account",
+			utiltx.GenerateAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, bn.Int64(), nil)
+				suite.Require().NoError(err)
+				vestingClient := suite.backend.queryClient.Vesting.(*mocks.VestingQueryClient)
+				RegisterVestingBalancesError(vestingClient, addr, bn.Int64())
+			},
+			false,
+		},
+		{
+			"pass",
+			utiltx.GenerateAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, bn.Int64(), nil)
+				suite.Require().NoError(err)
+				vestingClient := suite.backend.queryClient.Vesting.(*mocks.VestingQueryClient)
+				RegisterVestingBalances(vestingClient, addr, bn.Int64())
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParamsWithoutHeader(queryClient, bn.Int64())
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock(*tc.blockNrOrHash.BlockNumber, tc.addr)
+
+			balances, err := suite.backend.GetVestingBalances(tc.addr, tc.blockNrOrHash)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&rpctypes.VestingBalances{
+					Address:   tc.addr,
+					Locked:    (*hexutil.Big)(big.NewInt(4)),
+					Unvested:  (*hexutil.Big)(big.NewInt(3)),
+					Vested:    (*hexutil.Big)(big.NewInt(2)),
+					Spendable: (*hexutil.Big)(big.NewInt(1)),
+				}, balances)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetTransactionCount() {
 	testCases := []struct {
 		name         string
@@ -0,0 +1,30 @@
+package backend
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/rpc/backend/mocks"
+	rpc "github.com/evmos/evmos/v12/rpc/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	vestingtypes "github.com/evmos/evmos/v12/x/vesting/types"
+)
+
+var _ vestingtypes.QueryClient = &mocks.VestingQueryClient{}
+
+// Balances
+func RegisterVestingBalances(vestingClient *mocks.VestingQueryClient, addr common.Address, height int64) {
+	vestingClient.On("Balances", rpc.ContextWithHeight(height), &vestingtypes.QueryBalancesRequest{Address: sdk.AccAddress(addr.Bytes()).String()}).
+		Return(&vestingtypes.QueryBalancesResponse{
+			Locked:    sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 4)),
+			Unvested:  sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 3)),
+			Vested:    sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 2)),
+			Spendable: sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1)),
+		}, nil)
+}
+
+func RegisterVestingBalancesError(vestingClient *mocks.VestingQueryClient, addr common.Address, height int64) {
+	vestingClient.On("Balances", rpc.ContextWithHeight(height), &vestingtypes.QueryBalancesRequest{Address: sdk.AccAddress(addr.Bytes()).String()}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
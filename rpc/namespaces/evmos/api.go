@@ -0,0 +1,48 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package evmos
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+)
+
+// PublicAPI offers Evmos specific endpoints that expose chain data which has
+// no equivalent in the Ethereum JSON-RPC specification.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates a new Evmos JSON-RPC service.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "evmos"),
+		backend: backend,
+	}
+}
+
+// GetVestingBalances returns the locked, unvested, vested and spendable
+// balances of a clawback vesting account up to the provided block number.
+func (api *PublicAPI) GetVestingBalances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.VestingBalances, error) {
+	api.logger.Debug("evmos_getVestingBalances", "address", address.String(), "block number or hash", blockNrOrHash)
+	return api.backend.GetVestingBalances(address, blockNrOrHash)
+}
@@ -27,16 +27,19 @@ import (
 
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v12/x/feemarket/types"
+	vestingtypes "github.com/evmos/evmos/v12/x/vesting/types"
 )
 
 // QueryClient defines a gRPC Client used for:
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - Vesting module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	Vesting   vestingtypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
@@ -45,6 +48,7 @@ func NewQueryClient(clientCtx client.Context) *QueryClient {
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		Vesting:       vestingtypes.NewQueryClient(clientCtx),
 	}
 }
 
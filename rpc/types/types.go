@@ -44,6 +44,16 @@ type StorageResult struct {
 	Proof []string     `json:"proof"`
 }
 
+// VestingBalances defines the format returned by evmos_getVestingBalances. All
+// amounts are denominated in the EVM denomination.
+type VestingBalances struct {
+	Address   common.Address `json:"address"`
+	Locked    *hexutil.Big   `json:"locked"`
+	Unvested  *hexutil.Big   `json:"unvested"`
+	Vested    *hexutil.Big   `json:"vested"`
+	Spendable *hexutil.Big   `json:"spendable"`
+}
+
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
 	BlockHash        *common.Hash         `json:"blockHash"`
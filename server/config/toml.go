@@ -95,6 +95,10 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# SpendableBalanceOnly makes eth_getBalance return only the spendable balance for clawback vesting
+# accounts, i.e. excluding locked tokens. The full balance is returned for any other account.
+spendable-balance-only = {{ .JSONRPC.SpendableBalanceOnly }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -19,6 +19,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -44,6 +45,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryClaimsRecords(),
 		GetCmdQueryClaimsRecord(),
+		GetCmdQueryCampaigns(),
+		GetCmdQueryCampaign(),
+		GetCmdQueryCampaignClaimed(),
 	)
 
 	return claimQueryCmd
@@ -175,3 +179,117 @@ func GetCmdQueryClaimsRecord() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryCampaigns implements the query campaigns command.
+func GetCmdQueryCampaigns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "campaigns",
+		Args:    cobra.NoArgs,
+		Short:   "Query all the Merkle-root airdrop campaigns",
+		Long:    "Query the list of all the Merkle-root airdrop campaigns",
+		Example: fmt.Sprintf("%s query claims campaigns", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCampaignsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Campaigns(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "campaigns")
+	return cmd
+}
+
+// GetCmdQueryCampaign implements the query campaign command.
+func GetCmdQueryCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "campaign CAMPAIGN_ID",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query a Merkle-root airdrop campaign",
+		Long:    "Query the Merkle root, escrowed amounts and timeline of a Merkle-root airdrop campaign",
+		Example: fmt.Sprintf("%s query claims campaign 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id %s: %w", args[0], err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Campaign(context.Background(), &types.QueryCampaignRequest{CampaignId: campaignID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCampaignClaimed implements the query campaign claimed command.
+func GetCmdQueryCampaignClaimed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "campaign-claimed CAMPAIGN_ID INDEX",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Query if the allocation of a campaign recipient has been claimed",
+		Long:    "Query if the allocation of the Merkle tree leaf with the given index has been claimed",
+		Example: fmt.Sprintf("%s query claims campaign-claimed 1 42", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id %s: %w", args[0], err)
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid leaf index %s: %w", args[1], err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCampaignClaimedRequest{
+				CampaignId: campaignID,
+				Index:      index,
+			}
+
+			res, err := queryClient.CampaignClaimed(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,209 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/evmos/evmos/v12/x/claims/types"
+)
+
+// FlagStartTime defines the flag for the start time of a campaign
+const FlagStartTime = "start-time"
+
+// NewTxCmd returns a root CLI command handler for claims transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateCampaignCmd(),
+		NewClaimCampaignCmd(),
+	)
+	return txCmd
+}
+
+// NewCreateCampaignCmd returns a CLI command handler for creating a
+// Merkle-root airdrop campaign
+func NewCreateCampaignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-campaign MERKLE_ROOT NUM_RECIPIENTS AMOUNT DURATION_UNTIL_DECAY DURATION_OF_DECAY",
+		Short: "Create a Merkle-root airdrop campaign funded by the sender",
+		Long: `Create a Merkle-root airdrop campaign funded by the sender.
+Each leaf of the Merkle tree is keccak256(0x00 ‖ uint64(index) ‖ address ‖ uint256(amount) ‖ uint8(action)...),
+and the nodes are keccak256(0x01 ‖ left ‖ right) of sorted pairs. The campaign starts at the current block time unless --start-time is set.`,
+		Example: fmt.Sprintf(
+			"%s tx %s create-campaign 0x... 4000000 1000000000000aevmos 720h 2160h --from=<key_or_address>",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			numRecipients, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid number of recipients %s: %w", args[1], err)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			durationUntilDecay, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid duration until decay %s: %w", args[3], err)
+			}
+
+			durationOfDecay, err := time.ParseDuration(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid duration of decay %s: %w", args[4], err)
+			}
+
+			var startTime time.Time
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			if startTimeStr != "" {
+				startTime, err = time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return fmt.Errorf("invalid start time %s: %w", startTimeStr, err)
+				}
+			}
+
+			msg := &types.MsgCreateCampaign{
+				Creator:            cliCtx.GetFromAddress().String(),
+				MerkleRoot:         args[0],
+				NumRecipients:      numRecipients,
+				Amount:             amount,
+				StartTime:          startTime,
+				DurationUntilDecay: durationUntilDecay,
+				DurationOfDecay:    durationOfDecay,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "start time of the campaign in RFC3339 format")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimCampaignCmd returns a CLI command handler for claiming the
+// allocation of a campaign recipient
+func NewClaimCampaignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-campaign CAMPAIGN_ID INDEX AMOUNT ACTIONS [PROOF_NODE...]",
+		Short: "Claim the allocation of a Merkle-root airdrop campaign",
+		Long: `Claim the allocation of a Merkle-root airdrop campaign for the sender.
+ACTIONS is a comma separated list of the actions of the leaf (vote, delegate, evm, ibc_transfer),
+and the proof nodes are the hex encoded sibling hashes from the leaf to the root.`,
+		Example: fmt.Sprintf(
+			"%s tx %s claim-campaign 1 42 1000000000 vote,delegate 0x... 0x... --from=<key_or_address>",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id %s: %w", args[0], err)
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid leaf index %s: %w", args[1], err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[2])
+			}
+
+			actions, err := parseActions(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimCampaign{
+				Claimer:    cliCtx.GetFromAddress().String(),
+				CampaignId: campaignID,
+				Index:      index,
+				Amount:     amount,
+				Actions:    actions,
+				Proof:      args[4:],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseActions parses a comma separated list of claim actions, with or without
+// the ACTION_ prefix
+func parseActions(s string) ([]types.Action, error) {
+	var actions []types.Action
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if !strings.HasPrefix(name, "ACTION_") {
+			name = "ACTION_" + name
+		}
+
+		action, ok := types.Action_value[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid action %s", name)
+		}
+		actions = append(actions, types.Action(action))
+	}
+	return actions, nil
+}
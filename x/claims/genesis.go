@@ -32,7 +32,6 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	totalEscrowed := sdk.ZeroInt()
 	sumUnclaimed := sdk.ZeroInt()
-	numActions := sdk.NewInt(4)
 
 	// ensure claim module account is set on genesis
 	if acc := k.GetModuleAccount(ctx); acc == nil {
@@ -61,15 +60,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 			ActionsCompleted:       claimsRecord.ActionsCompleted,
 		}
 
-		if len(cr.ActionsCompleted) != len(types.Action_name)-1 {
-			panic(fmt.Errorf("invalid actions completed length for address %s", claimsRecord.Address))
+		if err := cr.Validate(); err != nil {
+			panic(fmt.Errorf("invalid claims record for address %s: %w", claimsRecord.Address, err))
 		}
 
-		initialClaimablePerAction := claimsRecord.InitialClaimableAmount.Quo(numActions)
-
-		for _, actionCompleted := range cr.ActionsCompleted {
-			if !actionCompleted {
+		for _, action := range data.Params.Actions() {
+			if !cr.HasClaimedAction(action) {
 				// NOTE: only add the initial claimable amount per action for the ones that haven't been claimed
+				initialClaimablePerAction := data.Params.InitialClaimableForAction(claimsRecord.InitialClaimableAmount, action)
 				sumUnclaimed = sumUnclaimed.Add(initialClaimablePerAction)
 			}
 		}
@@ -77,6 +75,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		k.SetClaimsRecord(ctx, addr, cr)
 	}
 
+	var nextCampaignID uint64 = 1
+	for _, campaign := range data.Campaigns {
+		k.SetCampaign(ctx, campaign)
+		if campaign.Id >= nextCampaignID {
+			nextCampaignID = campaign.Id + 1
+		}
+	}
+	k.SetNextCampaignID(ctx, nextCampaignID)
+
+	for _, bitmap := range data.CampaignClaimBitmaps {
+		k.SetCampaignClaimBitmap(ctx, bitmap)
+	}
+
 	// check for equal only for unclaimed actions
 	if !sumUnclaimed.Equal(totalEscrowed) {
 		panic(
@@ -91,7 +102,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 // ExportGenesis returns the claim module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:        k.GetParams(ctx),
-		ClaimsRecords: k.GetClaimsRecords(ctx),
+		Params:               k.GetParams(ctx),
+		ClaimsRecords:        k.GetClaimsRecords(ctx),
+		Campaigns:            k.GetCampaigns(ctx),
+		CampaignClaimBitmaps: k.GetCampaignClaimBitmaps(ctx),
 	}
 }
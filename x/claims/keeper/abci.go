@@ -30,6 +30,10 @@ import (
 // EndBlocker checks if the airdrop claiming period has ended in order to
 // process the clawback of unclaimed tokens
 func (k Keeper) EndBlocker(ctx sdk.Context) {
+	if err := k.EndExpiredCampaigns(ctx); err != nil {
+		panic(err)
+	}
+
 	params := k.GetParams(ctx)
 
 	// NOTE: ignore end of airdrop period check if claiming is disabled
@@ -48,6 +52,46 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 	}
 }
 
+// EndExpiredCampaigns transfers the unclaimed tokens of the campaigns whose
+// claim period has ended to the community pool and removes the campaigns and
+// their claim bitmaps from state.
+func (k Keeper) EndExpiredCampaigns(ctx sdk.Context) error {
+	var expired []types.Campaign
+	k.IterateCampaigns(ctx, func(campaign types.Campaign) (stop bool) {
+		if ctx.BlockTime().After(campaign.EndTime()) {
+			expired = append(expired, campaign)
+		}
+		return false
+	})
+
+	escrowAddr := k.GetCampaignEscrowAddress()
+	for _, campaign := range expired {
+		if campaign.RemainingAmount.IsPositive() {
+			if err := k.distrKeeper.FundCommunityPool(ctx, sdk.Coins{campaign.RemainingAmount}, escrowAddr); err != nil {
+				return errorsmod.Wrapf(err, "failed to transfer unclaimed tokens of campaign %d", campaign.Id)
+			}
+		}
+
+		k.DeleteCampaign(ctx, campaign.Id)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEndCampaign,
+				sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyFundCommunityPoolCoins, campaign.RemainingAmount.String()),
+			),
+		)
+
+		k.Logger(ctx).Info(
+			"ended campaign",
+			"id", campaign.Id,
+			"unclaimed", campaign.RemainingAmount.String(),
+		)
+	}
+
+	return nil
+}
+
 // EndAirdrop transfers the unclaimed tokens from the airdrop to the community
 // pool, removes all claims records from state and disables the claims.
 func (k Keeper) EndAirdrop(ctx sdk.Context, params types.Params) error {
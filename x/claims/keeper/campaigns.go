@@ -0,0 +1,165 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v12/x/claims/types"
+)
+
+// GetCampaign returns the campaign for the given id
+func (k Keeper) GetCampaign(ctx sdk.Context, id uint64) (types.Campaign, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if len(bz) == 0 {
+		return types.Campaign{}, false
+	}
+
+	var campaign types.Campaign
+	k.cdc.MustUnmarshal(bz, &campaign)
+
+	return campaign, true
+}
+
+// SetCampaign stores a campaign
+func (k Keeper) SetCampaign(ctx sdk.Context, campaign types.Campaign) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+	bz := k.cdc.MustMarshal(&campaign)
+	store.Set(sdk.Uint64ToBigEndian(campaign.Id), bz)
+}
+
+// DeleteCampaign deletes a campaign and its claim bitmap from the store
+func (k Keeper) DeleteCampaign(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+
+	bitmapStore := prefix.NewStore(ctx.KVStore(k.storeKey), campaignBitmapPrefix(id))
+	iterator := bitmapStore.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		bitmapStore.Delete(key)
+	}
+}
+
+// IterateCampaigns iterates over all campaigns and performs a callback.
+func (k Keeper) IterateCampaigns(ctx sdk.Context, handlerFn func(campaign types.Campaign) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCampaigns)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var campaign types.Campaign
+		k.cdc.MustUnmarshal(iterator.Value(), &campaign)
+
+		if handlerFn(campaign) {
+			break
+		}
+	}
+}
+
+// GetCampaigns returns all campaigns
+func (k Keeper) GetCampaigns(ctx sdk.Context) []types.Campaign {
+	campaigns := []types.Campaign{}
+	k.IterateCampaigns(ctx, func(campaign types.Campaign) (stop bool) {
+		campaigns = append(campaigns, campaign)
+		return false
+	})
+
+	return campaigns
+}
+
+// GetNextCampaignID returns the id to assign to the next campaign
+func (k Keeper) GetNextCampaignID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNextCampaignID)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextCampaignID sets the id to assign to the next campaign
+func (k Keeper) SetNextCampaignID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextCampaignID, sdk.Uint64ToBigEndian(id))
+}
+
+// IsCampaignLeafClaimed returns true if the leaf of the campaign has been
+// claimed
+func (k Keeper) IsCampaignLeafClaimed(ctx sdk.Context, id, index uint64) bool {
+	word, bit := types.CampaignBitmapPosition(index)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), campaignBitmapPrefix(id))
+	return types.IsBitSet(store.Get(sdk.Uint64ToBigEndian(word)), bit)
+}
+
+// SetCampaignLeafClaimed marks the leaf of the campaign as claimed
+func (k Keeper) SetCampaignLeafClaimed(ctx sdk.Context, id, index uint64) {
+	word, bit := types.CampaignBitmapPosition(index)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), campaignBitmapPrefix(id))
+	key := sdk.Uint64ToBigEndian(word)
+	store.Set(key, types.SetBit(store.Get(key), bit))
+}
+
+// SetCampaignClaimBitmap sets a word of the claim bitmap of a campaign
+func (k Keeper) SetCampaignClaimBitmap(ctx sdk.Context, bitmap types.CampaignClaimBitmap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), campaignBitmapPrefix(bitmap.CampaignId))
+	store.Set(sdk.Uint64ToBigEndian(bitmap.Word), bitmap.Bits)
+}
+
+// GetCampaignClaimBitmaps returns the claim bitmap words of all campaigns for
+// genesis export
+func (k Keeper) GetCampaignClaimBitmaps(ctx sdk.Context) []types.CampaignClaimBitmap {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCampaignClaimBitmaps)
+	defer iterator.Close()
+
+	bitmaps := []types.CampaignClaimBitmap{}
+	for ; iterator.Valid(); iterator.Next() {
+		// key = prefix (1) | campaign id (8) | word (8)
+		key := iterator.Key()[len(types.KeyPrefixCampaignClaimBitmaps):]
+		bitmaps = append(bitmaps, types.CampaignClaimBitmap{
+			CampaignId: binary.BigEndian.Uint64(key[:8]),
+			Word:       binary.BigEndian.Uint64(key[8:]),
+			Bits:       iterator.Value(),
+		})
+	}
+
+	return bitmaps
+}
+
+// GetCampaignEscrowAddress returns the address of the module account that
+// holds the campaign tokens
+func (k Keeper) GetCampaignEscrowAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.CampaignEscrowName)
+}
+
+// campaignBitmapPrefix returns the store prefix of the claim bitmap of a
+// campaign
+func campaignBitmapPrefix(id uint64) []byte {
+	return append(append([]byte{}, types.KeyPrefixCampaignClaimBitmaps...), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/claims/types"
+)
+
+func (suite *KeeperTestSuite) TestCampaigns() {
+	const (
+		durationUntilDecay = time.Hour
+		durationOfDecay    = 2 * time.Hour
+	)
+
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	denom := suite.app.ClaimsKeeper.GetParams(suite.ctx).ClaimsDenom
+	startTime := suite.ctx.BlockTime()
+
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 4000)))
+	suite.Require().NoError(err)
+
+	recipients := []sdk.AccAddress{
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+	}
+	amounts := []math.Int{sdk.NewInt(1000), sdk.NewInt(2000)}
+	actions := [][]types.Action{
+		{types.ActionVote, types.ActionDelegate},
+		{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer},
+	}
+
+	leaves := [][]byte{
+		types.CampaignLeafHash(0, recipients[0], amounts[0], actions[0]),
+		types.CampaignLeafHash(1, recipients[1], amounts[1], actions[1]),
+	}
+	root := hexutil.Encode(types.CampaignNodeHash(leaves[0], leaves[1]))
+	proofs := [][]string{
+		{hexutil.Encode(leaves[1])},
+		{hexutil.Encode(leaves[0])},
+	}
+
+	claimMsg := func(i int) *types.MsgClaimCampaign {
+		return &types.MsgClaimCampaign{
+			Claimer:    recipients[i].String(),
+			CampaignId: 1,
+			Index:      uint64(i),
+			Amount:     amounts[i],
+			Actions:    actions[i],
+			Proof:      proofs[i],
+		}
+	}
+
+	createMsg := &types.MsgCreateCampaign{
+		Creator:            creator.String(),
+		MerkleRoot:         root,
+		NumRecipients:      2,
+		Amount:             sdk.NewInt64Coin(denom, 4000),
+		DurationUntilDecay: durationUntilDecay,
+		DurationOfDecay:    durationOfDecay,
+	}
+
+	// fail - creator without funds
+	createMsg.Creator = recipients[0].String()
+	_, err = suite.app.ClaimsKeeper.CreateCampaign(ctx, createMsg)
+	suite.Require().Error(err)
+
+	// create campaign
+	createMsg.Creator = creator.String()
+	res, err := suite.app.ClaimsKeeper.CreateCampaign(ctx, createMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.CampaignId)
+
+	campaign, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(startTime, campaign.StartTime)
+	suite.Require().Equal(uint64(2), suite.app.ClaimsKeeper.GetNextCampaignID(suite.ctx))
+
+	escrowAddr := suite.app.ClaimsKeeper.GetCampaignEscrowAddress()
+	suite.Require().Equal(int64(4000), suite.app.BankKeeper.GetBalance(suite.ctx, escrowAddr, denom).Amount.Int64())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, creator, denom).IsZero())
+
+	// fail - unknown campaign
+	msg := claimMsg(0)
+	msg.CampaignId = 2
+	_, err = suite.app.ClaimsKeeper.ClaimCampaign(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrCampaignNotFound)
+
+	// fail - leaf out of range
+	msg = claimMsg(0)
+	msg.Index = 2
+	_, err = suite.app.ClaimsKeeper.ClaimCampaign(ctx, msg)
+	suite.Require().Error(err)
+
+	// fail - amount not committed to by the root
+	msg = claimMsg(0)
+	msg.Amount = amounts[1]
+	_, err = suite.app.ClaimsKeeper.ClaimCampaign(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	// fail - claimer is not the recipient of the leaf
+	msg = claimMsg(0)
+	msg.Claimer = recipients[1].String()
+	_, err = suite.app.ClaimsKeeper.ClaimCampaign(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	// pass - claim before decay, 2 out of 4 actions
+	claimRes, err := suite.app.ClaimsKeeper.ClaimCampaign(ctx, claimMsg(0))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 500), claimRes.Claimed)
+	suite.Require().Equal(int64(500), suite.app.BankKeeper.GetBalance(suite.ctx, recipients[0], denom).Amount.Int64())
+	suite.Require().True(suite.app.ClaimsKeeper.IsCampaignLeafClaimed(suite.ctx, 1, 0))
+	suite.Require().False(suite.app.ClaimsKeeper.IsCampaignLeafClaimed(suite.ctx, 1, 1))
+
+	// fail - already claimed
+	_, err = suite.app.ClaimsKeeper.ClaimCampaign(ctx, claimMsg(0))
+	suite.Require().ErrorIs(err, types.ErrCampaignClaimed)
+
+	// pass - claim halfway through the decay
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(durationUntilDecay).Add(durationOfDecay / 2))
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+
+	claimRes, err = suite.app.ClaimsKeeper.ClaimCampaign(ctx, claimMsg(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 1000), claimRes.Claimed)
+	suite.Require().Equal(
+		communityPool.Add(sdk.NewDec(1000)),
+		suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom),
+	)
+
+	campaign, _ = suite.app.ClaimsKeeper.GetCampaign(suite.ctx, 1)
+	suite.Require().Equal(int64(1500), campaign.RemainingAmount.Amount.Int64())
+	suite.Require().Equal(int64(1500), suite.app.BankKeeper.GetBalance(suite.ctx, escrowAddr, denom).Amount.Int64())
+
+	// queries
+	queryRes, err := suite.app.ClaimsKeeper.CampaignClaimed(ctx, &types.QueryCampaignClaimedRequest{CampaignId: 1, Index: 1})
+	suite.Require().NoError(err)
+	suite.Require().True(queryRes.Claimed)
+	suite.Require().Len(suite.app.ClaimsKeeper.GetCampaignClaimBitmaps(suite.ctx), 1)
+
+	_, broken := suite.app.ClaimsKeeper.CampaignsInvariant()(suite.ctx)
+	suite.Require().False(broken)
+
+	// the campaign isn't ended before its end time
+	suite.ctx = suite.ctx.WithBlockTime(campaign.EndTime())
+	err = suite.app.ClaimsKeeper.EndExpiredCampaigns(suite.ctx)
+	suite.Require().NoError(err)
+	_, found = suite.app.ClaimsKeeper.GetCampaign(suite.ctx, 1)
+	suite.Require().True(found)
+
+	// end the campaign
+	suite.ctx = suite.ctx.WithBlockTime(campaign.EndTime().Add(time.Second))
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	communityPool = suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+
+	_, err = suite.app.ClaimsKeeper.ClaimCampaign(ctx, claimMsg(1))
+	suite.Require().ErrorIs(err, types.ErrCampaignInactive)
+
+	err = suite.app.ClaimsKeeper.EndExpiredCampaigns(suite.ctx)
+	suite.Require().NoError(err)
+
+	_, found = suite.app.ClaimsKeeper.GetCampaign(suite.ctx, 1)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetCampaignClaimBitmaps(suite.ctx))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, escrowAddr, denom).IsZero())
+	suite.Require().Equal(
+		communityPool.Add(sdk.NewDec(1500)),
+		suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom),
+	)
+}
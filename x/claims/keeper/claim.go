@@ -34,7 +34,7 @@ func (k Keeper) ClaimCoinsForAction(
 	action types.Action,
 	params types.Params,
 ) (math.Int, error) {
-	if action == types.ActionUnspecified || action > types.ActionIBCTransfer {
+	if !params.IsValidAction(action) {
 		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidAction, "%d", action)
 	}
 
@@ -77,7 +77,7 @@ func (k Keeper) ClaimCoinsForAction(
 			types.EventTypeClaim,
 			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, claimedCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyActionType, action.String()),
+			sdk.NewAttribute(types.AttributeKeyActionType, params.ActionName(action)),
 		),
 	})
 
@@ -86,7 +86,7 @@ func (k Keeper) ClaimCoinsForAction(
 	k.Logger(ctx).Info(
 		"claimed action",
 		"address", addr.String(),
-		"action", action.String(),
+		"action", params.ActionName(action),
 	)
 
 	return claimableAmount, nil
@@ -98,6 +98,7 @@ func (k Keeper) ClaimCoinsForAction(
 
 // This method additionally:
 //   - Always claims the IBC action, assuming both record haven't claimed it.
+//   - Iterates over the built-in and the custom actions of the registry.
 //   - Marks an action as claimed for the new instance by performing an XOR operation between the 2 provided records: `merged completed action = sender completed action XOR recipient completed action`
 func (k Keeper) MergeClaimsRecords(
 	ctx sdk.Context,
@@ -115,8 +116,7 @@ func (k Keeper) MergeClaimsRecords(
 
 	// iterate over all the available actions and claim the amount if
 	// the recipient or sender has completed an action but the other hasn't
-	actions := []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer}
-	for _, action := range actions {
+	for _, action := range params.Actions() {
 
 		// Safety check: the sender record cannot have any claimed actions, as
 		//  - the sender is not an evmos address and can't claim vote, delegation or evm actions
@@ -218,9 +218,12 @@ func (k Keeper) ClaimableAmountForAction(
 		return sdk.ZeroInt(), sdk.ZeroInt()
 	}
 
-	// NOTE: use len(actions)-1 as we don't consider the Unspecified Action
-	actionsCount := int64(len(types.Action_name) - 1)
-	initialClaimablePerAction := claimsRecord.InitialClaimableAmount.QuoRaw(actionsCount)
+	// the share of each action is its weight over the total weight of the
+	// built-in and custom actions
+	initialClaimablePerAction := params.InitialClaimableForAction(claimsRecord.InitialClaimableAmount, action)
+	if initialClaimablePerAction.IsZero() {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
 
 	// return full claim amount if the elapsed time <= decay start time
 	decayStartTime := params.DecayStartTime()
@@ -243,3 +246,28 @@ func (k Keeper) ClaimableAmountForAction(
 	remainder = initialClaimablePerAction.Sub(claimableCoins)
 	return claimableCoins, remainder
 }
+
+// campaignClaimableAmount returns the claimable amount and the decayed
+// remainder of the given actions for a campaign recipient. The amount is the
+// recipient allocation over all the claim actions.
+func (k Keeper) campaignClaimableAmount(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	amount math.Int,
+	actions []types.Action,
+) (claimableAmt, remainderAmt math.Int) {
+	claimsRecord := types.NewClaimsRecord(amount)
+	params := campaign.ClaimsParams()
+
+	claimableAmt = sdk.ZeroInt()
+	remainderAmt = sdk.ZeroInt()
+
+	for _, action := range actions {
+		claimable, remainder := k.GetClaimableAmountForAction(ctx, claimsRecord, action, params)
+		claimableAmt = claimableAmt.Add(claimable)
+		remainderAmt = remainderAmt.Add(remainder)
+		claimsRecord.MarkClaimed(action)
+	}
+
+	return claimableAmt, remainderAmt
+}
@@ -123,7 +123,7 @@ func (k Keeper) ClaimsRecord(
 	}
 
 	params := k.GetParams(ctx)
-	actions := []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer}
+	actions := params.Actions()
 
 	claims := make([]types.Claim, len(actions))
 	for i, action := range actions {
@@ -141,3 +141,87 @@ func (k Keeper) ClaimsRecord(
 		Claims:                 claims,
 	}, nil
 }
+
+// Campaigns returns all Merkle-root airdrop campaigns
+func (k Keeper) Campaigns(
+	c context.Context,
+	req *types.QueryCampaignsRequest,
+) (*types.QueryCampaignsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+
+	campaigns := []types.Campaign{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_, value []byte) error {
+			var campaign types.Campaign
+			if err := k.cdc.Unmarshal(value, &campaign); err != nil {
+				return err
+			}
+
+			campaigns = append(campaigns, campaign)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCampaignsResponse{
+		Campaigns:  campaigns,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Campaign returns the Merkle-root airdrop campaign for a given id
+func (k Keeper) Campaign(
+	c context.Context,
+	req *types.QueryCampaignRequest,
+) (*types.QueryCampaignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.GetCampaign(ctx, req.CampaignId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "campaign %d", req.CampaignId)
+	}
+
+	return &types.QueryCampaignResponse{Campaign: campaign}, nil
+}
+
+// CampaignClaimed returns whether the leaf of a campaign has been claimed
+func (k Keeper) CampaignClaimed(
+	c context.Context,
+	req *types.QueryCampaignClaimedRequest,
+) (*types.QueryCampaignClaimedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.GetCampaign(ctx, req.CampaignId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "campaign %d", req.CampaignId)
+	}
+
+	if req.Index >= campaign.NumRecipients {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"leaf index %d out of range, campaign has %d recipients", req.Index, campaign.NumRecipients,
+		)
+	}
+
+	return &types.QueryCampaignClaimedResponse{
+		Claimed: k.IsCampaignLeafClaimed(ctx, req.CampaignId, req.Index),
+	}, nil
+}
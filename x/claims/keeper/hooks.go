@@ -23,10 +23,13 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	revenuetypes "github.com/evmos/evmos/v12/x/revenue/v1/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
@@ -38,6 +41,8 @@ var (
 	_ evmtypes.EvmHooks         = Hooks{}
 	_ govtypes.GovHooks         = Hooks{}
 	_ stakingtypes.StakingHooks = Hooks{}
+	_ erc20types.ERC20Hooks     = Hooks{}
+	_ revenuetypes.RevenueHooks = Hooks{}
 )
 
 // Hooks wrapper struct for the claim keeper
@@ -116,8 +121,8 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 
 // PostTxProcessing implements the ethermint evm PostTxProcessing hook.
 // After a EVM state transition is successfully processed, the claimable amount
-// for the users's claims record evm action is claimed and transferred to the
-// user address.
+// for the users's claims record evm action and for the custom actions that
+// match the EVM call is claimed and transferred to the user address.
 func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, _ *ethtypes.Receipt) error {
 	params := k.GetParams(ctx)
 	fromAddr := sdk.AccAddress(msg.From().Bytes())
@@ -136,9 +141,82 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, _ *ethtypes.
 		)
 	}
 
+	k.claimCustomActions(ctx, fromAddr, params, func(matcher types.ActionMatcher) bool {
+		return matcher.MatchesEVMCall(msg.To(), msg.Data())
+	})
+
+	return nil
+}
+
+// AfterTokenConversion is a wrapper for calling the ERC20 AfterTokenConversion
+// hook on the module keeper
+func (h Hooks) AfterTokenConversion(ctx sdk.Context, sender sdk.AccAddress, pair erc20types.TokenPair) error {
+	h.k.AfterTokenConversion(ctx, sender, pair)
+	return nil
+}
+
+// AfterTokenConversion is called after the coins or tokens of a token pair are
+// converted. The claimable amount for the custom actions that match the
+// conversion is claimed and transferred to the sender address.
+func (k Keeper) AfterTokenConversion(ctx sdk.Context, sender sdk.AccAddress, pair erc20types.TokenPair) {
+	params := k.GetParams(ctx)
+	contract := pair.GetERC20Contract()
+
+	k.claimCustomActions(ctx, sender, params, func(matcher types.ActionMatcher) bool {
+		return matcher.MatchesERC20Conversion(contract, pair.Denom)
+	})
+}
+
+// AfterRevenueRegistered is a wrapper for calling the Revenue
+// AfterRevenueRegistered hook on the module keeper
+func (h Hooks) AfterRevenueRegistered(ctx sdk.Context, deployer sdk.AccAddress, contract common.Address) error {
+	h.k.AfterRevenueRegistered(ctx, deployer, contract)
 	return nil
 }
 
+// AfterRevenueRegistered is called after a contract is registered for fee
+// revenue. The claimable amount for the custom actions that match the
+// registration is claimed and transferred to the deployer address.
+func (k Keeper) AfterRevenueRegistered(ctx sdk.Context, deployer sdk.AccAddress, contract common.Address) {
+	params := k.GetParams(ctx)
+
+	k.claimCustomActions(ctx, deployer, params, func(matcher types.ActionMatcher) bool {
+		return matcher.MatchesRevenueRegistration(contract)
+	})
+}
+
+// claimCustomActions claims the custom actions of the registry whose matcher
+// matches the behaviour of the user. Errors are logged so that the user
+// transaction is not reverted.
+func (k Keeper) claimCustomActions(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	params types.Params,
+	match func(matcher types.ActionMatcher) bool,
+) {
+	for _, customAction := range params.CustomActions {
+		if !match(customAction.Matcher) {
+			continue
+		}
+
+		// fetch the record for each action as claiming updates it
+		claimsRecord, found := k.GetClaimsRecord(ctx, addr)
+		if !found {
+			return
+		}
+
+		_, err := k.ClaimCoinsForAction(ctx, addr, claimsRecord, customAction.Action(), params)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to claim custom action",
+				"address", addr.String(),
+				"action", customAction.Name,
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
 // ________________________________________________________________________________________
 
 // governance hooks
@@ -6,10 +6,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v12/testutil"
 	utiltx "github.com/evmos/evmos/v12/testutil/tx"
 	"github.com/evmos/evmos/v12/x/claims/types"
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
 )
 
 func (suite *KeeperTestSuite) TestAfterProposalVote() {
@@ -398,3 +400,139 @@ func (suite *KeeperTestSuite) TestAfterEVMStateTransition() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCustomActions() {
+	from := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	addr := sdk.AccAddress(from.Bytes())
+
+	evmCall := types.NewActionDefinition(5, "swap", 2, types.ActionMatcher{
+		Type:           types.MatcherTypeEVMCall,
+		Contract:       contract.Hex(),
+		MethodSelector: "0xa9059cbb",
+	})
+	conversion := types.NewActionDefinition(6, "convert", 1, types.ActionMatcher{
+		Type:  types.MatcherTypeERC20Conversion,
+		Denom: "acoin",
+	})
+	registration := types.NewActionDefinition(7, "register", 1, types.ActionMatcher{
+		Type:     types.MatcherTypeRevenueRegistration,
+		Contract: contract.Hex(),
+	})
+
+	testCases := []struct {
+		name       string
+		trigger    func()
+		expAction  types.Action
+		expClaimed bool
+		expBalance int64
+	}{
+		{
+			"no match - EVM call with other method",
+			func() {
+				msg := ethtypes.NewMessage(from, &contract, 0, nil, 0, nil, nil, nil, []byte{1, 2, 3, 4}, nil, false)
+				err := suite.app.ClaimsKeeper.PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{})
+				suite.Require().NoError(err)
+			},
+			evmCall.Action(),
+			false,
+			// only the built-in EVM action is claimed
+			100,
+		},
+		{
+			"match - EVM call",
+			func() {
+				data := append(common.FromHex("0xa9059cbb"), 1, 2)
+				msg := ethtypes.NewMessage(from, &contract, 0, nil, 0, nil, nil, nil, data, nil, false)
+				err := suite.app.ClaimsKeeper.PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{})
+				suite.Require().NoError(err)
+			},
+			evmCall.Action(),
+			true,
+			// the built-in EVM action is claimed as well
+			200 + 100,
+		},
+		{
+			"no match - conversion of other denom",
+			func() {
+				pair := erc20types.NewTokenPair(contract, "bcoin", erc20types.OWNER_MODULE)
+				suite.Require().NoError(suite.app.ClaimsKeeper.Hooks().AfterTokenConversion(suite.ctx, addr, pair))
+			},
+			conversion.Action(),
+			false,
+			0,
+		},
+		{
+			"match - conversion",
+			func() {
+				pair := erc20types.NewTokenPair(contract, "acoin", erc20types.OWNER_MODULE)
+				suite.Require().NoError(suite.app.ClaimsKeeper.Hooks().AfterTokenConversion(suite.ctx, addr, pair))
+			},
+			conversion.Action(),
+			true,
+			100,
+		},
+		{
+			"no match - registration of other contract",
+			func() {
+				err := suite.app.ClaimsKeeper.Hooks().AfterRevenueRegistered(suite.ctx, addr, utiltx.GenerateAddress())
+				suite.Require().NoError(err)
+			},
+			registration.Action(),
+			false,
+			0,
+		},
+		{
+			"match - registration",
+			func() {
+				err := suite.app.ClaimsKeeper.Hooks().AfterRevenueRegistered(suite.ctx, addr, contract)
+				suite.Require().NoError(err)
+			},
+			registration.Action(),
+			true,
+			100,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr, nil, 0, 0))
+
+			params := types.Params{
+				EnableClaims:       true,
+				AirdropStartTime:   suite.ctx.BlockTime().Add(-time.Hour),
+				DurationUntilDecay: 2 * time.Hour,
+				DurationOfDecay:    time.Hour,
+				ClaimsDenom:        types.DefaultClaimsDenom,
+				CustomActions:      []types.ActionDefinition{evmCall, conversion, registration},
+			}
+			suite.Require().NoError(params.Validate())
+			suite.Require().NoError(suite.app.ClaimsKeeper.SetParams(suite.ctx, params))
+
+			// 4 built-in actions with weight 1 and custom actions with total weight 4
+			claimsRecord := types.NewClaimsRecord(sdk.NewInt(800))
+			suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, addr, claimsRecord)
+
+			coins := sdk.Coins{sdk.NewCoin(params.ClaimsDenom, sdk.NewInt(800))}
+			err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
+			suite.Require().NoError(err)
+
+			_, broken := suite.app.ClaimsKeeper.ClaimsInvariant()(suite.ctx)
+			suite.Require().False(broken)
+
+			tc.trigger()
+
+			newClaimsRecord, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, addr)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expClaimed, newClaimsRecord.HasClaimedAction(tc.expAction))
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, addr, params.ClaimsDenom)
+			suite.Require().Equal(sdk.NewInt(tc.expBalance), balance.Amount)
+
+			_, broken = suite.app.ClaimsKeeper.ClaimsInvariant()(suite.ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 // RegisterInvariants registers the claims module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "claims-invariant", k.ClaimsInvariant())
+	ir.RegisterRoute(types.ModuleName, "campaigns-invariant", k.CampaignsInvariant())
 }
 
 // ClaimsInvariant checks that the total amount of all unclaimed coins held in
@@ -34,8 +35,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 func (k Keeper) ClaimsInvariant() sdk.Invariant {
 	return func(ctx sdk.Context) (msg string, broken bool) {
 		expectedUnclaimed := sdk.ZeroDec()
-		numActions := sdk.NewDec(4)
 		params := k.GetParams(ctx)
+		totalWeight := sdk.NewDec(int64(params.TotalActionWeight()))
 
 		if !params.IsClaimsActive(ctx.BlockTime()) {
 			return "", false
@@ -44,11 +45,12 @@ func (k Keeper) ClaimsInvariant() sdk.Invariant {
 		// iterate over all the claim records and sum the unclaimed amounts
 		k.IterateClaimsRecords(ctx, func(_ sdk.AccAddress, cr types.ClaimsRecord) bool {
 			// IMPORTANT: use Dec to prevent truncation errors
-			initialClaimablePerAction := sdk.NewDecFromInt(cr.InitialClaimableAmount).Quo(numActions)
-			for _, actionCompleted := range cr.ActionsCompleted {
-				if !actionCompleted {
+			initialClaimable := sdk.NewDecFromInt(cr.InitialClaimableAmount)
+			for _, action := range params.Actions() {
+				if !cr.HasClaimedAction(action) {
 					// NOTE: only add the initial claimable amount per action for the ones that haven't been claimed
-					expectedUnclaimed = expectedUnclaimed.Add(initialClaimablePerAction)
+					weight := sdk.NewDec(int64(params.ActionWeight(action)))
+					expectedUnclaimed = expectedUnclaimed.Add(initialClaimable.Mul(weight).Quo(totalWeight))
 				}
 			}
 			return false
@@ -71,3 +73,31 @@ func (k Keeper) ClaimsInvariant() sdk.Invariant {
 		return msg, isInvariantBroken
 	}
 }
+
+// CampaignsInvariant checks that the campaign escrow account holds at least the
+// remaining amount of all the campaigns
+func (k Keeper) CampaignsInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (msg string, broken bool) {
+		expectedRemaining := sdk.Coins{}
+
+		k.IterateCampaigns(ctx, func(campaign types.Campaign) bool {
+			expectedRemaining = expectedRemaining.Add(campaign.RemainingAmount)
+			return false
+		})
+
+		balances := k.bankKeeper.GetAllBalances(ctx, k.GetCampaignEscrowAddress())
+
+		isInvariantBroken := !balances.IsAllGTE(expectedRemaining)
+		msg = sdk.FormatInvariant(
+			types.ModuleName,
+			"campaigns",
+			fmt.Sprintf(
+				"\tsum of remaining campaign amounts: %s\n"+
+					"\tcampaign escrow balance: %s\n",
+				expectedRemaining, balances,
+			),
+		)
+
+		return msg, isInvariantBroken
+	}
+}
@@ -19,9 +19,11 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
@@ -55,6 +57,13 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 		}
 	}
 
+	// Changing the share of the actions during the airdrop would break the
+	// accounting of the escrowed claimable amounts
+	params := k.GetParams(ctx)
+	if params.IsClaimsActive(ctx.BlockTime()) && !params.HasEqualActionWeights(req.Params) {
+		return nil, types.ErrActionRegistryLocked
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -62,6 +71,164 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// CreateCampaign escrows the tokens of a Merkle-root airdrop campaign from the
+// creator. The campaign recipients claim their allocation with a Merkle proof
+// against the root, so no per address state is stored until a claim is made.
+func (k *Keeper) CreateCampaign(goCtx context.Context, msg *types.MsgCreateCampaign) (*types.MsgCreateCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	creator := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	// set the start time to the current block time by default
+	startTime := msg.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+
+	id := k.GetNextCampaignID(ctx)
+	campaign := types.NewCampaign(
+		id,
+		creator,
+		msg.MerkleRoot,
+		msg.NumRecipients,
+		msg.Amount,
+		startTime,
+		msg.DurationUntilDecay,
+		msg.DurationOfDecay,
+	)
+
+	if !campaign.EndTime().After(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(
+			types.ErrCampaignInactive,
+			"campaign ends at %s, before the current block time %s", campaign.EndTime(), ctx.BlockTime(),
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.CampaignEscrowName, sdk.Coins{msg.Amount}); err != nil {
+		return nil, err
+	}
+
+	k.SetCampaign(ctx, campaign)
+	k.SetNextCampaignID(ctx, id+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateCampaign,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMerkleRoot, msg.MerkleRoot),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgCreateCampaignResponse{CampaignId: id}, nil
+}
+
+// ClaimCampaign verifies the Merkle proof of a campaign recipient and transfers
+// the claimable amount of each of the recipient actions to the claimer. The
+// claimable amounts decay over the campaign timeline in the same way as the
+// claims records, and the decayed remainder is sent to the community pool.
+func (k *Keeper) ClaimCampaign(goCtx context.Context, msg *types.MsgClaimCampaign) (*types.MsgClaimCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	claimer := sdk.MustAccAddressFromBech32(msg.Claimer)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCampaignNotFound, "campaign %d", msg.CampaignId)
+	}
+
+	if !campaign.IsActive(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(
+			types.ErrCampaignInactive,
+			"campaign %d can be claimed from %s until %s", campaign.Id, campaign.StartTime, campaign.EndTime(),
+		)
+	}
+
+	if msg.Index >= campaign.NumRecipients {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"leaf index %d out of range, campaign has %d recipients", msg.Index, campaign.NumRecipients,
+		)
+	}
+
+	if k.IsCampaignLeafClaimed(ctx, campaign.Id, msg.Index) {
+		return nil, errorsmod.Wrapf(types.ErrCampaignClaimed, "campaign %d, leaf %d", campaign.Id, msg.Index)
+	}
+
+	if err := verifyCampaignProof(campaign, claimer, msg); err != nil {
+		return nil, err
+	}
+
+	claimedAmt, remainderAmt := k.campaignClaimableAmount(ctx, campaign, msg.Amount, msg.Actions)
+
+	spentAmt := claimedAmt.Add(remainderAmt)
+	if spentAmt.GT(campaign.RemainingAmount.Amount) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"campaign %d remaining amount %s is lower than the allocation %s", campaign.Id, campaign.RemainingAmount.Amount, spentAmt,
+		)
+	}
+
+	claimedCoins := sdk.Coins{sdk.Coin{Denom: campaign.TotalAmount.Denom, Amount: claimedAmt}}
+	remainderCoins := sdk.Coins{sdk.Coin{Denom: campaign.TotalAmount.Denom, Amount: remainderAmt}}
+
+	if claimedAmt.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CampaignEscrowName, claimer, claimedCoins); err != nil {
+			return nil, err
+		}
+	}
+
+	// fund community pool if remainder is not 0
+	if remainderAmt.IsPositive() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, remainderCoins, k.GetCampaignEscrowAddress()); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetCampaignLeafClaimed(ctx, campaign.Id, msg.Index)
+
+	campaign.RemainingAmount.Amount = campaign.RemainingAmount.Amount.Sub(spentAmt)
+	k.SetCampaign(ctx, campaign)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimCampaign,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Claimer),
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyLeafIndex, strconv.FormatUint(msg.Index, 10)),
+			sdk.NewAttribute(types.AttributeKeyClaimedCoins, claimedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyFundCommunityPoolCoins, remainderCoins.String()),
+		),
+	})
+
+	return &types.MsgClaimCampaignResponse{
+		Claimed: sdk.Coin{Denom: campaign.TotalAmount.Denom, Amount: claimedAmt},
+	}, nil
+}
+
+// verifyCampaignProof checks that the leaf of the claimer is included in the
+// Merkle tree of the campaign
+func verifyCampaignProof(campaign types.Campaign, claimer sdk.AccAddress, msg *types.MsgClaimCampaign) error {
+	root, err := types.DecodeMerkleHash(campaign.MerkleRoot)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidMerkleProof, "invalid campaign merkle root: %s", err)
+	}
+
+	proof := make([][]byte, len(msg.Proof))
+	for i, node := range msg.Proof {
+		proof[i], err = types.DecodeMerkleHash(node)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidMerkleProof, "node %d: %s", i, err)
+		}
+	}
+
+	leaf := types.CampaignLeafHash(msg.Index, claimer, msg.Amount, msg.Actions)
+	if !types.VerifyMerkleProof(root, leaf, proof) {
+		return errorsmod.Wrapf(types.ErrInvalidMerkleProof, "leaf %d of campaign %d", msg.Index, campaign.Id)
+	}
+
+	return nil
+}
+
 // checkIfChannelOpen checks if an IBC channel with the given channel id is registered
 // in the channel keeper and is in the OPEN state. It also requires the channel id to
 // be in a valid format.
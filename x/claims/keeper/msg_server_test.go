@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -9,6 +10,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
 	"github.com/evmos/evmos/v12/x/claims/types"
 )
 
@@ -111,3 +113,72 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateParamsCustomActions() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	customAction := types.NewActionDefinition(5, "swap", 1, types.ActionMatcher{
+		Type:     types.MatcherTypeEVMCall,
+		Contract: utiltx.GenerateAddress().Hex(),
+	})
+
+	testCases := []struct {
+		name        string
+		active      bool
+		updateName  bool
+		expectErr   bool
+		errContains string
+	}{
+		{
+			name:      "pass - register actions before the airdrop",
+			active:    false,
+			expectErr: false,
+		},
+		{
+			name:        "fail - register actions during the airdrop",
+			active:      true,
+			expectErr:   true,
+			errContains: types.ErrActionRegistryLocked.Error(),
+		},
+		{
+			name:       "pass - rename action during the airdrop",
+			active:     true,
+			updateName: true,
+			expectErr:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("MsgUpdateParams - %s", tc.name), func() {
+			suite.SetupTest()
+
+			params := types.DefaultParams()
+			params.AuthorizedChannels = nil
+			params.EVMChannels = nil
+			params.AirdropStartTime = suite.ctx.BlockTime().Add(time.Hour)
+			if tc.active {
+				params.AirdropStartTime = suite.ctx.BlockTime().Add(-time.Hour)
+			}
+			if tc.updateName {
+				params.CustomActions = []types.ActionDefinition{customAction}
+			}
+			suite.Require().NoError(suite.app.ClaimsKeeper.SetParams(suite.ctx, params))
+
+			updated := customAction
+			if tc.updateName {
+				updated.Name = "swap-v2"
+			}
+			params.CustomActions = []types.ActionDefinition{updated}
+
+			_, err := suite.app.ClaimsKeeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    params,
+			})
+			if tc.expectErr {
+				suite.Require().ErrorContains(err, tc.errContains)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.app.ClaimsKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
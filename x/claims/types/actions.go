@@ -0,0 +1,195 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+const (
+	// BuiltinActionWeight is the weight of each of the built-in claim actions
+	BuiltinActionWeight = 1
+	// MaxActionID is the maximum identifier of a custom claim action. It bounds
+	// the length of the completed actions of a claims record.
+	MaxActionID = 64
+	// MaxActionNameLength is the maximum length of the name of a custom claim
+	// action
+	MaxActionNameLength = 64
+	// MethodSelectorLength is the length in bytes of an EVM method selector
+	MethodSelectorLength = 4
+)
+
+// BuiltinActions returns the claim actions that are completed through the
+// governance, staking, EVM and IBC hooks of the module.
+func BuiltinActions() []Action {
+	return []Action{ActionVote, ActionDelegate, ActionEVM, ActionIBCTransfer}
+}
+
+// IsBuiltinAction returns true if the action is one of the built-in claim
+// actions
+func IsBuiltinAction(action Action) bool {
+	return action > ActionUnspecified && action <= ActionIBCTransfer
+}
+
+// NewActionDefinition creates a new custom claim action instance
+func NewActionDefinition(id uint32, name string, weight uint32, matcher ActionMatcher) ActionDefinition {
+	return ActionDefinition{
+		ID:      id,
+		Name:    name,
+		Weight:  weight,
+		Matcher: matcher,
+	}
+}
+
+// Action returns the Action value of the custom claim action
+func (ad ActionDefinition) Action() Action {
+	return Action(ad.ID)
+}
+
+// Validate performs a stateless validation of the fields
+func (ad ActionDefinition) Validate() error {
+	if ad.ID <= uint32(ActionIBCTransfer) || ad.ID > MaxActionID {
+		return fmt.Errorf("action id must be between %d and %d, got %d", ActionIBCTransfer+1, MaxActionID, ad.ID)
+	}
+	if strings.TrimSpace(ad.Name) == "" {
+		return fmt.Errorf("action %d name cannot be blank", ad.ID)
+	}
+	if len(ad.Name) > MaxActionNameLength {
+		return fmt.Errorf("action %d name cannot be longer than %d characters", ad.ID, MaxActionNameLength)
+	}
+	if _, ok := Action_value[ad.Name]; ok {
+		return fmt.Errorf("action %d name %s is reserved for a built-in action", ad.ID, ad.Name)
+	}
+	if ad.Weight == 0 {
+		return fmt.Errorf("action %d weight must be positive", ad.ID)
+	}
+	if err := ad.Matcher.Validate(); err != nil {
+		return fmt.Errorf("action %d: %w", ad.ID, err)
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the fields
+func (m ActionMatcher) Validate() error {
+	switch m.Type {
+	case MatcherTypeEVMCall:
+		if err := evmostypes.ValidateNonZeroAddress(m.Contract); err != nil {
+			return fmt.Errorf("invalid matcher contract: %w", err)
+		}
+		if m.MethodSelector != "" {
+			selector, err := hexutil.Decode(m.MethodSelector)
+			if err != nil {
+				return fmt.Errorf("invalid matcher method selector: %w", err)
+			}
+			if len(selector) != MethodSelectorLength {
+				return fmt.Errorf("matcher method selector must be %d bytes long, got %d", MethodSelectorLength, len(selector))
+			}
+		}
+	case MatcherTypeERC20Conversion, MatcherTypeRevenueRegistration:
+		if m.Contract != "" {
+			if err := evmostypes.ValidateNonZeroAddress(m.Contract); err != nil {
+				return fmt.Errorf("invalid matcher contract: %w", err)
+			}
+		}
+		if m.MethodSelector != "" {
+			return fmt.Errorf("matcher method selector is only supported for %s", MatcherTypeEVMCall)
+		}
+	default:
+		return fmt.Errorf("invalid matcher type %s", m.Type)
+	}
+
+	if m.Denom != "" {
+		if m.Type != MatcherTypeERC20Conversion {
+			return fmt.Errorf("matcher denom is only supported for %s", MatcherTypeERC20Conversion)
+		}
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return fmt.Errorf("invalid matcher denom: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// MatchesEVMCall returns true if the matcher matches an EVM transaction sent
+// to the given recipient with the given input data. The recipient is nil for
+// contract deployments.
+func (m ActionMatcher) MatchesEVMCall(to *common.Address, data []byte) bool {
+	if m.Type != MatcherTypeEVMCall || to == nil || !m.matchesContract(*to) {
+		return false
+	}
+	if m.MethodSelector == "" {
+		return true
+	}
+
+	selector, err := hexutil.Decode(m.MethodSelector)
+	if err != nil {
+		return false
+	}
+	return len(data) >= MethodSelectorLength && bytes.Equal(data[:MethodSelectorLength], selector)
+}
+
+// MatchesERC20Conversion returns true if the matcher matches the conversion of
+// the token pair with the given ERC20 contract and coin denomination
+func (m ActionMatcher) MatchesERC20Conversion(contract common.Address, denom string) bool {
+	if m.Type != MatcherTypeERC20Conversion || !m.matchesContract(contract) {
+		return false
+	}
+	return m.Denom == "" || m.Denom == denom
+}
+
+// MatchesRevenueRegistration returns true if the matcher matches the revenue
+// registration of the given contract
+func (m ActionMatcher) MatchesRevenueRegistration(contract common.Address) bool {
+	return m.Type == MatcherTypeRevenueRegistration && m.matchesContract(contract)
+}
+
+// matchesContract returns true if the matcher contract is empty or equal to
+// the given address
+func (m ActionMatcher) matchesContract(contract common.Address) bool {
+	return m.Contract == "" || common.HexToAddress(m.Contract) == contract
+}
+
+// validateCustomActions validates the custom claim actions and checks that
+// their identifiers and names are unique
+func validateCustomActions(actions []ActionDefinition) error {
+	seenIDs := make(map[uint32]bool)
+	seenNames := make(map[string]bool)
+
+	for _, action := range actions {
+		if err := action.Validate(); err != nil {
+			return err
+		}
+		if seenIDs[action.ID] {
+			return fmt.Errorf("duplicated action id %d", action.ID)
+		}
+		if seenNames[action.Name] {
+			return fmt.Errorf("duplicated action name %s", action.Name)
+		}
+		seenIDs[action.ID] = true
+		seenNames[action.Name] = true
+	}
+
+	return nil
+}
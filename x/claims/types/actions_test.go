@@ -0,0 +1,175 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/claims/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionDefinitionValidate(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	evmCall := types.ActionMatcher{Type: types.MatcherTypeEVMCall, Contract: contract.Hex()}
+
+	testCases := []struct {
+		name     string
+		action   types.ActionDefinition
+		expError bool
+	}{
+		{
+			"fail - built-in action id",
+			types.NewActionDefinition(uint32(types.ActionIBCTransfer), "swap", 1, evmCall),
+			true,
+		},
+		{
+			"fail - id above max",
+			types.NewActionDefinition(types.MaxActionID+1, "swap", 1, evmCall),
+			true,
+		},
+		{
+			"fail - blank name",
+			types.NewActionDefinition(5, " ", 1, evmCall),
+			true,
+		},
+		{
+			"fail - built-in name",
+			types.NewActionDefinition(5, types.ActionVote.String(), 1, evmCall),
+			true,
+		},
+		{
+			"fail - zero weight",
+			types.NewActionDefinition(5, "swap", 0, evmCall),
+			true,
+		},
+		{
+			"fail - unspecified matcher",
+			types.NewActionDefinition(5, "swap", 1, types.ActionMatcher{}),
+			true,
+		},
+		{
+			"fail - evm call without contract",
+			types.NewActionDefinition(5, "swap", 1, types.ActionMatcher{Type: types.MatcherTypeEVMCall}),
+			true,
+		},
+		{
+			"fail - invalid method selector",
+			types.NewActionDefinition(5, "swap", 1, types.ActionMatcher{
+				Type: types.MatcherTypeEVMCall, Contract: contract.Hex(), MethodSelector: "0x1234",
+			}),
+			true,
+		},
+		{
+			"fail - method selector on conversion",
+			types.NewActionDefinition(5, "convert", 1, types.ActionMatcher{
+				Type: types.MatcherTypeERC20Conversion, MethodSelector: "0xa9059cbb",
+			}),
+			true,
+		},
+		{
+			"fail - denom on revenue registration",
+			types.NewActionDefinition(5, "register", 1, types.ActionMatcher{
+				Type: types.MatcherTypeRevenueRegistration, Denom: "aevmos",
+			}),
+			true,
+		},
+		{
+			"fail - invalid conversion denom",
+			types.NewActionDefinition(5, "convert", 1, types.ActionMatcher{
+				Type: types.MatcherTypeERC20Conversion, Denom: "1",
+			}),
+			true,
+		},
+		{
+			"success - evm call with method selector",
+			types.NewActionDefinition(5, "swap", 2, types.ActionMatcher{
+				Type: types.MatcherTypeEVMCall, Contract: contract.Hex(), MethodSelector: "0xa9059cbb",
+			}),
+			false,
+		},
+		{
+			"success - any conversion",
+			types.NewActionDefinition(6, "convert", 1, types.ActionMatcher{Type: types.MatcherTypeERC20Conversion}),
+			false,
+		},
+		{
+			"success - revenue registration of contract",
+			types.NewActionDefinition(types.MaxActionID, "register", 1, types.ActionMatcher{
+				Type: types.MatcherTypeRevenueRegistration, Contract: contract.Hex(),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.action.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestActionMatcherMatches(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+	selector := common.FromHex("0xa9059cbb")
+
+	evmCall := types.ActionMatcher{Type: types.MatcherTypeEVMCall, Contract: contract.Hex(), MethodSelector: "0xa9059cbb"}
+	require.True(t, evmCall.MatchesEVMCall(&contract, append(selector, 1, 2, 3)))
+	require.False(t, evmCall.MatchesEVMCall(&contract, []byte{1, 2, 3, 4}))
+	require.False(t, evmCall.MatchesEVMCall(&contract, selector[:2]))
+	require.False(t, evmCall.MatchesEVMCall(&other, selector))
+	require.False(t, evmCall.MatchesEVMCall(nil, selector))
+	require.False(t, evmCall.MatchesERC20Conversion(contract, "aevmos"))
+
+	conversion := types.ActionMatcher{Type: types.MatcherTypeERC20Conversion, Denom: "aevmos"}
+	require.True(t, conversion.MatchesERC20Conversion(contract, "aevmos"))
+	require.True(t, conversion.MatchesERC20Conversion(other, "aevmos"))
+	require.False(t, conversion.MatchesERC20Conversion(contract, "uatom"))
+	require.False(t, conversion.MatchesRevenueRegistration(contract))
+
+	registration := types.ActionMatcher{Type: types.MatcherTypeRevenueRegistration, Contract: contract.Hex()}
+	require.True(t, registration.MatchesRevenueRegistration(contract))
+	require.False(t, registration.MatchesRevenueRegistration(other))
+	require.False(t, registration.MatchesEVMCall(&contract, nil))
+}
+
+func TestParamsCustomActions(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, types.BuiltinActions(), params.Actions())
+	require.Equal(t, uint64(4), params.TotalActionWeight())
+	require.Equal(t, sdk.NewInt(25), params.InitialClaimableForAction(sdk.NewInt(100), types.ActionVote))
+
+	swap := types.NewActionDefinition(5, "swap", 2, types.ActionMatcher{
+		Type: types.MatcherTypeEVMCall, Contract: utiltx.GenerateAddress().Hex(),
+	})
+	updated := params
+	updated.CustomActions = []types.ActionDefinition{swap}
+	require.NoError(t, updated.Validate())
+	require.False(t, params.HasEqualActionWeights(updated))
+	require.True(t, updated.HasEqualActionWeights(updated))
+
+	require.Equal(t, append(types.BuiltinActions(), swap.Action()), updated.Actions())
+	require.True(t, updated.IsValidAction(swap.Action()))
+	require.False(t, params.IsValidAction(swap.Action()))
+	require.Equal(t, "swap", updated.ActionName(swap.Action()))
+	require.Equal(t, types.ActionEVM.String(), updated.ActionName(types.ActionEVM))
+	require.Equal(t, uint64(6), updated.TotalActionWeight())
+	require.Equal(t, sdk.NewInt(100), updated.InitialClaimableForAction(sdk.NewInt(600), types.ActionVote))
+	require.Equal(t, sdk.NewInt(200), updated.InitialClaimableForAction(sdk.NewInt(600), swap.Action()))
+	require.True(t, updated.InitialClaimableForAction(sdk.NewInt(600), types.Action(6)).IsZero())
+
+	duplicated := swap
+	duplicated.Name = "swap2"
+	updated.CustomActions = []types.ActionDefinition{swap, duplicated}
+	require.Error(t, updated.Validate())
+
+	duplicated = swap
+	duplicated.ID = 6
+	updated.CustomActions = []types.ActionDefinition{swap, duplicated}
+	require.Error(t, updated.Validate())
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// CampaignBitmapWordSize is the number of leaves tracked by a single word of
+// the campaign claim bitmap
+const CampaignBitmapWordSize = 256
+
+// NewCampaign creates a new campaign instance
+func NewCampaign(
+	id uint64,
+	creator sdk.AccAddress,
+	merkleRoot string,
+	numRecipients uint64,
+	amount sdk.Coin,
+	startTime time.Time,
+	durationUntilDecay, durationOfDecay time.Duration,
+) Campaign {
+	return Campaign{
+		Id:                 id,
+		Creator:            creator.String(),
+		MerkleRoot:         merkleRoot,
+		NumRecipients:      numRecipients,
+		TotalAmount:        amount,
+		RemainingAmount:    amount,
+		StartTime:          startTime,
+		DurationUntilDecay: durationUntilDecay,
+		DurationOfDecay:    durationOfDecay,
+	}
+}
+
+// Validate performs a stateless validation of the fields
+func (c Campaign) Validate() error {
+	if c.Id == 0 {
+		return errors.New("campaign id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Creator); err != nil {
+		return err
+	}
+	if _, err := DecodeMerkleHash(c.MerkleRoot); err != nil {
+		return fmt.Errorf("invalid merkle root: %w", err)
+	}
+	if c.NumRecipients == 0 {
+		return errors.New("number of recipients cannot be zero")
+	}
+	if !c.TotalAmount.IsValid() || !c.TotalAmount.IsPositive() {
+		return fmt.Errorf("invalid total amount: %s", c.TotalAmount)
+	}
+	if !c.RemainingAmount.IsValid() || c.RemainingAmount.Denom != c.TotalAmount.Denom {
+		return fmt.Errorf("invalid remaining amount: %s", c.RemainingAmount)
+	}
+	if c.RemainingAmount.Amount.GT(c.TotalAmount.Amount) {
+		return fmt.Errorf("remaining amount %s is greater than total amount %s", c.RemainingAmount, c.TotalAmount)
+	}
+	return ValidateCampaignDurations(c.DurationUntilDecay, c.DurationOfDecay)
+}
+
+// ValidateCampaignDurations checks that the decay durations of a campaign are
+// positive
+func ValidateCampaignDurations(durationUntilDecay, durationOfDecay time.Duration) error {
+	if durationOfDecay <= 0 {
+		return fmt.Errorf("duration of decay must be positive: %d", durationOfDecay)
+	}
+	if durationUntilDecay <= 0 {
+		return fmt.Errorf("duration until decay must be positive: %d", durationUntilDecay)
+	}
+	return nil
+}
+
+// EndTime returns the time at which no further claims of the campaign will be
+// processed
+func (c Campaign) EndTime() time.Time {
+	return c.StartTime.Add(c.DurationUntilDecay).Add(c.DurationOfDecay)
+}
+
+// IsActive returns true if the campaign can be claimed at the given block time
+func (c Campaign) IsActive(blockTime time.Time) bool {
+	return !blockTime.Before(c.StartTime) && !blockTime.After(c.EndTime())
+}
+
+// ClaimsParams returns the claims params that apply the campaign timeline to
+// the action-based decay of the claimable amounts
+func (c Campaign) ClaimsParams() Params {
+	return Params{
+		EnableClaims:       true,
+		AirdropStartTime:   c.StartTime,
+		DurationUntilDecay: c.DurationUntilDecay,
+		DurationOfDecay:    c.DurationOfDecay,
+		ClaimsDenom:        c.TotalAmount.Denom,
+	}
+}
+
+// DecodeMerkleHash decodes a hex encoded 32 byte hash, with or without the 0x
+// prefix
+func DecodeMerkleHash(s string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != common.HashLength {
+		return nil, fmt.Errorf("expected %d bytes, got %d", common.HashLength, len(bz))
+	}
+	return bz, nil
+}
+
+// Domain separation prefixes of the campaign Merkle tree hashes, so that an
+// internal node can't be passed off as a leaf
+const (
+	merkleLeafPrefix byte = 0x00
+	merkleNodePrefix byte = 0x01
+)
+
+// CampaignLeafHash returns the Merkle tree leaf of a campaign recipient:
+//
+//	keccak256(0x00 ‖ uint64(index) ‖ address ‖ uint256(amount) ‖ uint8(action)...)
+//
+// with all the integers encoded in big endian.
+func CampaignLeafHash(index uint64, addr sdk.AccAddress, amount math.Int, actions []Action) []byte {
+	indexBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBz, index)
+
+	actionsBz := make([]byte, len(actions))
+	for i, action := range actions {
+		actionsBz[i] = byte(action)
+	}
+
+	return crypto.Keccak256(
+		[]byte{merkleLeafPrefix},
+		indexBz,
+		addr.Bytes(),
+		common.LeftPadBytes(amount.BigInt().Bytes(), 32),
+		actionsBz,
+	)
+}
+
+// CampaignNodeHash returns the internal Merkle tree node of two children:
+//
+//	keccak256(0x01 ‖ min(a, b) ‖ max(a, b))
+//
+// The pair is sorted before hashing, so proofs don't need to encode the
+// position of the leaf.
+func CampaignNodeHash(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256([]byte{merkleNodePrefix}, a, b)
+}
+
+// VerifyMerkleProof returns true if the proof connects the leaf to the root.
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	computed := leaf
+	for _, node := range proof {
+		computed = CampaignNodeHash(computed, node)
+	}
+	return bytes.Equal(computed, root)
+}
+
+// CampaignBitmapPosition returns the word of the claim bitmap and the bit
+// within the word that track the given leaf index
+func CampaignBitmapPosition(index uint64) (word uint64, bit int) {
+	return index / CampaignBitmapWordSize, int(index % CampaignBitmapWordSize)
+}
+
+// IsBitSet returns true if the bit of the big endian encoded bitmap word is set
+func IsBitSet(bits []byte, bit int) bool {
+	return new(big.Int).SetBytes(bits).Bit(bit) == 1
+}
+
+// SetBit returns the big endian encoding of the bitmap word with the given bit
+// set
+func SetBit(bits []byte, bit int) []byte {
+	word := new(big.Int).SetBytes(bits)
+	word.SetBit(word, bit, 1)
+	return word.FillBytes(make([]byte, CampaignBitmapWordSize/8))
+}
@@ -0,0 +1,180 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/claims/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyMerkleProof(t *testing.T) {
+	actions := []types.Action{types.ActionVote, types.ActionDelegate}
+	leaves := make([][]byte, 4)
+	for i := range leaves {
+		leaves[i] = types.CampaignLeafHash(uint64(i), utiltx.GenerateAddress().Bytes(), sdk.NewInt(int64(100*(i+1))), actions)
+	}
+
+	n01 := types.CampaignNodeHash(leaves[0], leaves[1])
+	n23 := types.CampaignNodeHash(leaves[2], leaves[3])
+	root := types.CampaignNodeHash(n01, n23)
+
+	testCases := []struct {
+		name  string
+		leaf  []byte
+		proof [][]byte
+		expOK bool
+	}{
+		{"pass - first leaf", leaves[0], [][]byte{leaves[1], n23}, true},
+		{"pass - last leaf", leaves[3], [][]byte{leaves[2], n01}, true},
+		{"fail - wrong sibling", leaves[0], [][]byte{leaves[2], n23}, false},
+		{"fail - missing node", leaves[0], [][]byte{leaves[1]}, false},
+		{"fail - empty proof", leaves[0], nil, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expOK, types.VerifyMerkleProof(root, tc.leaf, tc.proof), tc.name)
+	}
+}
+
+func TestCampaignLeafInternalNodeRejected(t *testing.T) {
+	// with 4 actions the leaf preimage is 64 bytes long, as the preimage of an
+	// internal node
+	actions := []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer}
+	leaves := make([][]byte, 4)
+	for i := range leaves {
+		leaves[i] = types.CampaignLeafHash(uint64(i), utiltx.GenerateAddress().Bytes(), sdk.NewInt(int64(100*(i+1))), actions)
+	}
+
+	n01 := types.CampaignNodeHash(leaves[0], leaves[1])
+	n23 := types.CampaignNodeHash(leaves[2], leaves[3])
+	root := types.CampaignNodeHash(n01, n23)
+
+	// claim fields decoded from the sorted children of the internal node n01
+	preimage := append(append([]byte{}, leaves[0]...), leaves[1]...)
+	if bytes.Compare(leaves[0], leaves[1]) > 0 {
+		preimage = append(append([]byte{}, leaves[1]...), leaves[0]...)
+	}
+	index := binary.BigEndian.Uint64(preimage[:8])
+	addr := sdk.AccAddress(preimage[8:28])
+	amount := sdk.NewIntFromBigInt(new(big.Int).SetBytes(preimage[28:60]))
+	forged := make([]types.Action, 4)
+	for i, b := range preimage[60:] {
+		forged[i] = types.Action(b)
+	}
+
+	leaf := types.CampaignLeafHash(index, addr, amount, forged)
+	require.NotEqual(t, n01, leaf)
+	require.False(t, types.VerifyMerkleProof(root, leaf, [][]byte{n23}))
+	// the internal node itself is still part of a valid path to the root
+	require.True(t, types.VerifyMerkleProof(root, n01, [][]byte{n23}))
+}
+
+func TestCampaignLeafHash(t *testing.T) {
+	addr := utiltx.GenerateAddress().Bytes()
+	actions := []types.Action{types.ActionVote, types.ActionEVM}
+	leaf := types.CampaignLeafHash(1, addr, sdk.NewInt(1000), actions)
+
+	require.Len(t, leaf, 32)
+	require.NotEqual(t, leaf, types.CampaignLeafHash(2, addr, sdk.NewInt(1000), actions))
+	require.NotEqual(t, leaf, types.CampaignLeafHash(1, addr, sdk.NewInt(1001), actions))
+	require.NotEqual(t, leaf, types.CampaignLeafHash(1, addr, sdk.NewInt(1000), actions[:1]))
+	require.NotEqual(t, leaf, types.CampaignLeafHash(1, utiltx.GenerateAddress().Bytes(), sdk.NewInt(1000), actions))
+}
+
+func TestCampaignBitmap(t *testing.T) {
+	word, bit := types.CampaignBitmapPosition(0)
+	require.Equal(t, uint64(0), word)
+	require.Equal(t, 0, bit)
+
+	word, bit = types.CampaignBitmapPosition(513)
+	require.Equal(t, uint64(2), word)
+	require.Equal(t, 1, bit)
+
+	require.False(t, types.IsBitSet(nil, 5))
+
+	bits := types.SetBit(nil, 5)
+	require.Len(t, bits, 32)
+	require.True(t, types.IsBitSet(bits, 5))
+	require.False(t, types.IsBitSet(bits, 4))
+
+	bits = types.SetBit(bits, 255)
+	require.Len(t, bits, 32)
+	require.True(t, types.IsBitSet(bits, 5))
+	require.True(t, types.IsBitSet(bits, 255))
+}
+
+func TestCampaignValidate(t *testing.T) {
+	root := hexutil.Encode(crypto.Keccak256([]byte("root")))
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	amount := sdk.NewInt64Coin("aevmos", 1000)
+	startTime := time.Now().UTC()
+
+	testCases := []struct {
+		name     string
+		campaign types.Campaign
+		expError bool
+	}{
+		{
+			"fail - empty",
+			types.Campaign{},
+			true,
+		},
+		{
+			"fail - invalid merkle root",
+			types.NewCampaign(1, creator, "0x1234", 10, amount, startTime, time.Hour, time.Hour),
+			true,
+		},
+		{
+			"fail - zero recipients",
+			types.NewCampaign(1, creator, root, 0, amount, startTime, time.Hour, time.Hour),
+			true,
+		},
+		{
+			"fail - zero amount",
+			types.NewCampaign(1, creator, root, 10, sdk.NewInt64Coin("aevmos", 0), startTime, time.Hour, time.Hour),
+			true,
+		},
+		{
+			"fail - zero duration of decay",
+			types.NewCampaign(1, creator, root, 10, amount, startTime, time.Hour, 0),
+			true,
+		},
+		{
+			"fail - remaining greater than total",
+			types.Campaign{
+				Id:                 1,
+				Creator:            creator.String(),
+				MerkleRoot:         root,
+				NumRecipients:      10,
+				TotalAmount:        amount,
+				RemainingAmount:    amount.AddAmount(sdk.OneInt()),
+				StartTime:          startTime,
+				DurationUntilDecay: time.Hour,
+				DurationOfDecay:    time.Hour,
+			},
+			true,
+		},
+		{
+			"pass - valid campaign",
+			types.NewCampaign(1, creator, root, 10, amount, startTime, time.Hour, time.Hour),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.campaign.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
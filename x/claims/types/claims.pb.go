@@ -6,17 +6,23 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -64,6 +70,200 @@ func (Action) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a7153f2307523893, []int{0}
 }
 
+// MatcherType defines the kind of user behaviour that completes a custom claim
+// action.
+type MatcherType int32
+
+const (
+	// MATCHER_TYPE_UNSPECIFIED defines an invalid matcher.
+	MatcherTypeUnspecified MatcherType = 0
+	// MATCHER_TYPE_EVM_CALL matches an EVM transaction sent to a given contract.
+	MatcherTypeEVMCall MatcherType = 1
+	// MATCHER_TYPE_ERC20_CONVERSION matches a conversion between a Cosmos coin
+	// and its ERC20 token representation.
+	MatcherTypeERC20Conversion MatcherType = 2
+	// MATCHER_TYPE_REVENUE_REGISTRATION matches the registration of a contract
+	// for fee revenue.
+	MatcherTypeRevenueRegistration MatcherType = 3
+)
+
+var MatcherType_name = map[int32]string{
+	0: "MATCHER_TYPE_UNSPECIFIED",
+	1: "MATCHER_TYPE_EVM_CALL",
+	2: "MATCHER_TYPE_ERC20_CONVERSION",
+	3: "MATCHER_TYPE_REVENUE_REGISTRATION",
+}
+
+var MatcherType_value = map[string]int32{
+	"MATCHER_TYPE_UNSPECIFIED":          0,
+	"MATCHER_TYPE_EVM_CALL":             1,
+	"MATCHER_TYPE_ERC20_CONVERSION":     2,
+	"MATCHER_TYPE_REVENUE_REGISTRATION": 3,
+}
+
+func (x MatcherType) String() string {
+	return proto.EnumName(MatcherType_name, int32(x))
+}
+
+func (MatcherType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{1}
+}
+
+// ActionMatcher defines the conditions that the behaviour of a user must meet
+// to complete a custom claim action. Empty optional fields match any value.
+type ActionMatcher struct {
+	// type of the behaviour that is matched
+	Type MatcherType `protobuf:"varint,1,opt,name=type,proto3,enum=evmos.claims.v1.MatcherType" json:"type,omitempty"`
+	// contract is the hex address of the called contract for EVM calls, of the
+	// ERC20 token for conversions or of the registered contract for revenue
+	// registrations. It is required for EVM calls and optional otherwise.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// method_selector is the optional hex encoded 4-byte selector of the called
+	// method. Only used for EVM calls.
+	MethodSelector string `protobuf:"bytes,3,opt,name=method_selector,json=methodSelector,proto3" json:"method_selector,omitempty"`
+	// denom is the optional Cosmos coin denomination of the converted token pair.
+	// Only used for ERC20 conversions.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *ActionMatcher) Reset()         { *m = ActionMatcher{} }
+func (m *ActionMatcher) String() string { return proto.CompactTextString(m) }
+func (*ActionMatcher) ProtoMessage()    {}
+func (*ActionMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{0}
+}
+func (m *ActionMatcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionMatcher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionMatcher.Merge(m, src)
+}
+func (m *ActionMatcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionMatcher proto.InternalMessageInfo
+
+func (m *ActionMatcher) GetType() MatcherType {
+	if m != nil {
+		return m.Type
+	}
+	return MatcherTypeUnspecified
+}
+
+func (m *ActionMatcher) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ActionMatcher) GetMethodSelector() string {
+	if m != nil {
+		return m.MethodSelector
+	}
+	return ""
+}
+
+func (m *ActionMatcher) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ActionDefinition defines a custom claim action registered in the module
+// parameters.
+type ActionDefinition struct {
+	// id of the action. It must be greater than the identifiers of the built-in
+	// actions and is used as the Action value of the custom action.
+	ID uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the human readable identifier of the action
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// weight of the action. The share of the initial claimable amount of an
+	// action is its weight over the sum of the weights of all the actions, where
+	// each built-in action has a weight of 1.
+	Weight uint32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// matcher defines the behaviour that completes the action
+	Matcher ActionMatcher `protobuf:"bytes,4,opt,name=matcher,proto3" json:"matcher"`
+}
+
+func (m *ActionDefinition) Reset()         { *m = ActionDefinition{} }
+func (m *ActionDefinition) String() string { return proto.CompactTextString(m) }
+func (*ActionDefinition) ProtoMessage()    {}
+func (*ActionDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{1}
+}
+func (m *ActionDefinition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionDefinition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionDefinition.Merge(m, src)
+}
+func (m *ActionDefinition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionDefinition proto.InternalMessageInfo
+
+func (m *ActionDefinition) GetID() uint32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ActionDefinition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ActionDefinition) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ActionDefinition) GetMatcher() ActionMatcher {
+	if m != nil {
+		return m.Matcher
+	}
+	return ActionMatcher{}
+}
+
 // Claim defines the action, completed flag and the remaining claimable amount
 // for a given user. This is only used during client queries.
 type Claim struct {
@@ -79,7 +279,7 @@ func (m *Claim) Reset()         { *m = Claim{} }
 func (m *Claim) String() string { return proto.CompactTextString(m) }
 func (*Claim) ProtoMessage()    {}
 func (*Claim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7153f2307523893, []int{0}
+	return fileDescriptor_a7153f2307523893, []int{2}
 }
 func (m *Claim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +337,7 @@ func (m *ClaimsRecordAddress) Reset()         { *m = ClaimsRecordAddress{} }
 func (m *ClaimsRecordAddress) String() string { return proto.CompactTextString(m) }
 func (*ClaimsRecordAddress) ProtoMessage()    {}
 func (*ClaimsRecordAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7153f2307523893, []int{1}
+	return fileDescriptor_a7153f2307523893, []int{3}
 }
 func (m *ClaimsRecordAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,7 +393,7 @@ func (m *ClaimsRecord) Reset()         { *m = ClaimsRecord{} }
 func (m *ClaimsRecord) String() string { return proto.CompactTextString(m) }
 func (*ClaimsRecord) ProtoMessage()    {}
 func (*ClaimsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7153f2307523893, []int{2}
+	return fileDescriptor_a7153f2307523893, []int{4}
 }
 func (m *ClaimsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,52 +429,280 @@ func (m *ClaimsRecord) GetActionsCompleted() []bool {
 	return nil
 }
 
+// Campaign defines an airdrop whose recipients are committed to by the root of
+// a Merkle tree instead of claims records stored per address. Each leaf of the
+// tree commits to the (index, address, amount, actions) of a recipient. Leaves
+// and internal nodes are hashed with distinct prefixes (0x00 and 0x01).
+type Campaign struct {
+	// id is the unique identifier of the campaign
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// creator is the address of the account that funded the campaign
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// merkle_root is the hex encoded root of the recipients Merkle tree
+	MerkleRoot string `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// num_recipients is the number of leaves of the Merkle tree
+	NumRecipients uint64 `protobuf:"varint,4,opt,name=num_recipients,json=numRecipients,proto3" json:"num_recipients,omitempty"`
+	// total_amount of tokens escrowed for the campaign
+	TotalAmount types.Coin `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount"`
+	// remaining_amount of tokens that haven't been claimed yet
+	RemainingAmount types.Coin `protobuf:"bytes,6,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount"`
+	// start_time defines the timestamp from which the campaign can be claimed
+	StartTime time.Time `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// duration_until_decay of claimable tokens begin
+	DurationUntilDecay time.Duration `protobuf:"bytes,8,opt,name=duration_until_decay,json=durationUntilDecay,proto3,stdduration" json:"duration_until_decay"`
+	// duration_of_decay for token claim decay period
+	DurationOfDecay time.Duration `protobuf:"bytes,9,opt,name=duration_of_decay,json=durationOfDecay,proto3,stdduration" json:"duration_of_decay"`
+}
+
+func (m *Campaign) Reset()         { *m = Campaign{} }
+func (m *Campaign) String() string { return proto.CompactTextString(m) }
+func (*Campaign) ProtoMessage()    {}
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{5}
+}
+func (m *Campaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Campaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Campaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Campaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Campaign.Merge(m, src)
+}
+func (m *Campaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *Campaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_Campaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Campaign proto.InternalMessageInfo
+
+func (m *Campaign) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Campaign) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Campaign) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *Campaign) GetNumRecipients() uint64 {
+	if m != nil {
+		return m.NumRecipients
+	}
+	return 0
+}
+
+func (m *Campaign) GetTotalAmount() types.Coin {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return types.Coin{}
+}
+
+func (m *Campaign) GetRemainingAmount() types.Coin {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return types.Coin{}
+}
+
+func (m *Campaign) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Campaign) GetDurationUntilDecay() time.Duration {
+	if m != nil {
+		return m.DurationUntilDecay
+	}
+	return 0
+}
+
+func (m *Campaign) GetDurationOfDecay() time.Duration {
+	if m != nil {
+		return m.DurationOfDecay
+	}
+	return 0
+}
+
+// CampaignClaimBitmap defines a word of the bitmap that tracks the claimed
+// leaves of a campaign. Bit i of word w is set if leaf w*256+i was claimed.
+type CampaignClaimBitmap struct {
+	// campaign_id is the identifier of the campaign
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// word is the index of the 256-bit word in the bitmap
+	Word uint64 `protobuf:"varint,2,opt,name=word,proto3" json:"word,omitempty"`
+	// bits is the big endian encoding of the word
+	Bits []byte `protobuf:"bytes,3,opt,name=bits,proto3" json:"bits,omitempty"`
+}
+
+func (m *CampaignClaimBitmap) Reset()         { *m = CampaignClaimBitmap{} }
+func (m *CampaignClaimBitmap) String() string { return proto.CompactTextString(m) }
+func (*CampaignClaimBitmap) ProtoMessage()    {}
+func (*CampaignClaimBitmap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{6}
+}
+func (m *CampaignClaimBitmap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CampaignClaimBitmap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CampaignClaimBitmap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CampaignClaimBitmap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CampaignClaimBitmap.Merge(m, src)
+}
+func (m *CampaignClaimBitmap) XXX_Size() int {
+	return m.Size()
+}
+func (m *CampaignClaimBitmap) XXX_DiscardUnknown() {
+	xxx_messageInfo_CampaignClaimBitmap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CampaignClaimBitmap proto.InternalMessageInfo
+
+func (m *CampaignClaimBitmap) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *CampaignClaimBitmap) GetWord() uint64 {
+	if m != nil {
+		return m.Word
+	}
+	return 0
+}
+
+func (m *CampaignClaimBitmap) GetBits() []byte {
+	if m != nil {
+		return m.Bits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("evmos.claims.v1.Action", Action_name, Action_value)
+	proto.RegisterEnum("evmos.claims.v1.MatcherType", MatcherType_name, MatcherType_value)
+	proto.RegisterType((*ActionMatcher)(nil), "evmos.claims.v1.ActionMatcher")
+	proto.RegisterType((*ActionDefinition)(nil), "evmos.claims.v1.ActionDefinition")
 	proto.RegisterType((*Claim)(nil), "evmos.claims.v1.Claim")
 	proto.RegisterType((*ClaimsRecordAddress)(nil), "evmos.claims.v1.ClaimsRecordAddress")
 	proto.RegisterType((*ClaimsRecord)(nil), "evmos.claims.v1.ClaimsRecord")
+	proto.RegisterType((*Campaign)(nil), "evmos.claims.v1.Campaign")
+	proto.RegisterType((*CampaignClaimBitmap)(nil), "evmos.claims.v1.CampaignClaimBitmap")
 }
 
 func init() { proto.RegisterFile("evmos/claims/v1/claims.proto", fileDescriptor_a7153f2307523893) }
 
 var fileDescriptor_a7153f2307523893 = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4d, 0x6e, 0xd3, 0x40,
-	0x14, 0xc7, 0x3d, 0x49, 0x09, 0xcd, 0x00, 0x89, 0x3b, 0xe5, 0x23, 0xb2, 0x8a, 0x63, 0x65, 0x01,
-	0x01, 0x54, 0x5b, 0x29, 0x27, 0x70, 0x1c, 0x17, 0x59, 0xa2, 0x29, 0x72, 0xdd, 0x48, 0xb0, 0xb1,
-	0x1c, 0x7b, 0x9a, 0x5a, 0xd8, 0x9e, 0xc8, 0x33, 0x8d, 0xe0, 0x06, 0x28, 0x2b, 0x2e, 0x90, 0x15,
-	0xe2, 0x00, 0x5c, 0x02, 0x75, 0xd9, 0x15, 0x42, 0x2c, 0x2a, 0x94, 0x5c, 0x04, 0x75, 0xc6, 0xa1,
-	0xe1, 0x43, 0x2c, 0x90, 0xba, 0xb1, 0xdf, 0xbc, 0xff, 0x6f, 0x9e, 0xfe, 0xef, 0x8d, 0x1e, 0xdc,
-	0xc2, 0x93, 0x94, 0x50, 0x23, 0x4c, 0x82, 0x38, 0xa5, 0xc6, 0xa4, 0x53, 0x44, 0xfa, 0x38, 0x27,
-	0x8c, 0xa0, 0x3a, 0x57, 0xf5, 0x22, 0x37, 0xe9, 0x28, 0xb7, 0x47, 0x64, 0x44, 0xb8, 0x66, 0x5c,
-	0x44, 0x02, 0x6b, 0x7d, 0x02, 0xf0, 0x9a, 0x75, 0xc1, 0x20, 0x03, 0x56, 0x82, 0x90, 0xc5, 0x24,
-	0x6b, 0x00, 0x0d, 0xb4, 0x6b, 0x3b, 0xf7, 0xf4, 0xdf, 0x2a, 0xe8, 0x26, 0x97, 0xdd, 0x02, 0x43,
-	0x5b, 0xb0, 0x1a, 0x92, 0x74, 0x9c, 0x60, 0x86, 0xa3, 0x46, 0x49, 0x03, 0xed, 0x75, 0xf7, 0x32,
-	0x81, 0x5e, 0x42, 0x99, 0xdf, 0x0c, 0x86, 0x09, 0xf6, 0x83, 0x94, 0x9c, 0x64, 0xac, 0x51, 0xd6,
-	0x40, 0xbb, 0xda, 0xd5, 0x4f, 0xcf, 0x9b, 0xd2, 0xb7, 0xf3, 0xe6, 0x83, 0x51, 0xcc, 0x8e, 0x4f,
-	0x86, 0x7a, 0x48, 0x52, 0x23, 0x24, 0x94, 0xf7, 0xc2, 0x7f, 0xdb, 0x34, 0x7a, 0x6d, 0xb0, 0xb7,
-	0x63, 0x4c, 0x75, 0x27, 0x63, 0x6e, 0xfd, 0x67, 0x1d, 0x93, 0x97, 0x69, 0x7d, 0x06, 0x70, 0x93,
-	0x7b, 0xa6, 0x2e, 0x0e, 0x49, 0x1e, 0x99, 0x51, 0x94, 0x63, 0x4a, 0x51, 0x03, 0x5e, 0x0f, 0x44,
-	0xc8, 0x5b, 0xa8, 0xba, 0xcb, 0x23, 0x3a, 0x86, 0x8d, 0x38, 0x8b, 0x59, 0x1c, 0x24, 0xfe, 0x1f,
-	0xa6, 0x4a, 0xff, 0x65, 0xea, 0x6e, 0x51, 0xcf, 0xfa, 0xd5, 0x1b, 0x7a, 0x02, 0x37, 0xc4, 0x78,
-	0xa8, 0x7f, 0x39, 0x9c, 0xb2, 0x56, 0x6e, 0xaf, 0xbb, 0x72, 0x21, 0x58, 0xcb, 0x7c, 0xeb, 0x23,
-	0x80, 0x37, 0x57, 0x1b, 0xf9, 0xa7, 0x4f, 0x70, 0xf5, 0x3e, 0x4b, 0x7f, 0xf7, 0xf9, 0xf8, 0x0b,
-	0x80, 0x15, 0xf1, 0xf8, 0x68, 0x1b, 0x22, 0xd3, 0xf2, 0x9c, 0xfd, 0xbe, 0x7f, 0xd8, 0x3f, 0x78,
-	0x61, 0x5b, 0xce, 0xae, 0x63, 0xf7, 0x64, 0x49, 0xb9, 0x33, 0x9d, 0x69, 0x1b, 0x82, 0x39, 0xcc,
-	0xe8, 0x18, 0x87, 0xf1, 0x51, 0x8c, 0x23, 0xd4, 0x84, 0x37, 0x0a, 0x7c, 0xb0, 0xef, 0xd9, 0x32,
-	0x50, 0x6a, 0xd3, 0x99, 0x06, 0x05, 0x37, 0x20, 0x0c, 0xa3, 0x87, 0xb0, 0x5e, 0x00, 0x3d, 0xfb,
-	0xb9, 0xfd, 0xcc, 0xf4, 0x6c, 0xb9, 0xa4, 0xa0, 0xe9, 0x4c, 0xab, 0x09, 0xa8, 0x87, 0x13, 0x3c,
-	0x0a, 0x18, 0x46, 0xf7, 0x21, 0x2c, 0x40, 0x7b, 0xb0, 0x27, 0x97, 0x95, 0x5b, 0xd3, 0x99, 0x56,
-	0x15, 0x8c, 0x3d, 0xd8, 0x43, 0x3a, 0xdc, 0x2c, 0x64, 0xa7, 0x6b, 0xf9, 0x9e, 0x6b, 0xf6, 0x0f,
-	0x76, 0x6d, 0x57, 0x5e, 0x5b, 0x35, 0xe6, 0x74, 0x2d, 0x2f, 0x0f, 0x32, 0x7a, 0x84, 0x73, 0x65,
-	0xed, 0xdd, 0x07, 0x55, 0xea, 0x5a, 0xa7, 0x73, 0x15, 0x9c, 0xcd, 0x55, 0xf0, 0x7d, 0xae, 0x82,
-	0xf7, 0x0b, 0x55, 0x3a, 0x5b, 0xa8, 0xd2, 0xd7, 0x85, 0x2a, 0xbd, 0x7a, 0xb4, 0x32, 0x5f, 0xb1,
-	0x67, 0xe2, 0x3b, 0xe9, 0xec, 0x18, 0x6f, 0x96, 0x3b, 0xc7, 0xc7, 0x3c, 0xac, 0xf0, 0x4d, 0x7a,
-	0xfa, 0x23, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x4b, 0x48, 0x8e, 0x90, 0x03, 0x00, 0x00,
+	// 1102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xc1, 0x6e, 0xdb, 0xc6,
+	0x16, 0x15, 0x25, 0x45, 0x96, 0xc6, 0xb1, 0xcc, 0x4c, 0x12, 0x3f, 0x3d, 0xc2, 0xa1, 0x54, 0x01,
+	0x6d, 0xdd, 0x14, 0xa1, 0x22, 0x77, 0xd3, 0x55, 0x01, 0x89, 0x62, 0x52, 0x15, 0x96, 0x14, 0x8c,
+	0x65, 0x01, 0xe9, 0xa2, 0xc4, 0x88, 0x1c, 0xc9, 0x44, 0x48, 0x8e, 0x40, 0x8e, 0x94, 0xfa, 0x0f,
+	0x0a, 0x2d, 0x8a, 0xac, 0x8a, 0x2e, 0xaa, 0x55, 0xd1, 0x0f, 0xe8, 0x4f, 0x14, 0x59, 0x66, 0x55,
+	0x14, 0x5d, 0xb8, 0x85, 0xfd, 0x15, 0xdd, 0x15, 0x9c, 0x19, 0x2a, 0xb2, 0x83, 0x14, 0x41, 0x81,
+	0x6e, 0xac, 0x99, 0x3b, 0xe7, 0x1c, 0x9e, 0x7b, 0xe7, 0xfa, 0x92, 0x60, 0x9f, 0x2c, 0x02, 0x1a,
+	0x37, 0x1c, 0x1f, 0x7b, 0x41, 0xdc, 0x58, 0x34, 0xe5, 0xca, 0x98, 0x45, 0x94, 0x51, 0xb8, 0xcb,
+	0x4f, 0x0d, 0x19, 0x5b, 0x34, 0x35, 0xdd, 0xa1, 0x71, 0x82, 0x1f, 0xe3, 0x98, 0x34, 0x16, 0xcd,
+	0x31, 0x61, 0xb8, 0xd9, 0x70, 0xa8, 0x17, 0x0a, 0x82, 0x76, 0x67, 0x4a, 0xa7, 0x94, 0x2f, 0x1b,
+	0xc9, 0x4a, 0x46, 0xf5, 0x29, 0xa5, 0x53, 0x9f, 0x34, 0xf8, 0x6e, 0x3c, 0x9f, 0x34, 0xdc, 0x79,
+	0x84, 0x99, 0x47, 0x53, 0x56, 0xf5, 0xfa, 0x39, 0xf3, 0x02, 0x12, 0x33, 0x1c, 0xcc, 0x04, 0xa0,
+	0xfe, 0x83, 0x02, 0x76, 0x5a, 0x4e, 0xc2, 0xe8, 0x61, 0xe6, 0x9c, 0x92, 0x08, 0x3e, 0x04, 0x79,
+	0x76, 0x36, 0x23, 0x15, 0xa5, 0xa6, 0x1c, 0x94, 0x0f, 0xf7, 0x8d, 0x6b, 0x46, 0x0d, 0x89, 0x1b,
+	0x9e, 0xcd, 0x08, 0xe2, 0x48, 0xa8, 0x81, 0xa2, 0x43, 0x43, 0x16, 0x61, 0x87, 0x55, 0xb2, 0x35,
+	0xe5, 0xa0, 0x84, 0xd6, 0x7b, 0xf8, 0x21, 0xd8, 0x0d, 0x08, 0x3b, 0xa5, 0xae, 0x1d, 0x13, 0x9f,
+	0x38, 0x8c, 0x46, 0x95, 0x1c, 0x87, 0x94, 0x45, 0xf8, 0x58, 0x46, 0xe1, 0x1d, 0x70, 0xc3, 0x25,
+	0x21, 0x0d, 0x2a, 0x79, 0x7e, 0x2c, 0x36, 0xf5, 0xef, 0x14, 0xa0, 0x0a, 0x7b, 0x1d, 0x32, 0xf1,
+	0x42, 0x2f, 0x59, 0xc1, 0x3d, 0x90, 0xf5, 0x5c, 0xee, 0x6f, 0xa7, 0x5d, 0xb8, 0x38, 0xaf, 0x66,
+	0xbb, 0x1d, 0x94, 0xf5, 0x5c, 0x08, 0x41, 0x3e, 0xc4, 0x01, 0x91, 0x1e, 0xf8, 0x1a, 0xee, 0x81,
+	0xc2, 0x73, 0xe2, 0x4d, 0x4f, 0x19, 0x7f, 0xec, 0x0e, 0x92, 0x3b, 0xf8, 0x19, 0xd8, 0x0a, 0x44,
+	0x22, 0xfc, 0x81, 0xdb, 0x87, 0xfa, 0x1b, 0x89, 0x5e, 0x29, 0x4b, 0x3b, 0xff, 0xf2, 0xbc, 0x9a,
+	0x41, 0x29, 0xa9, 0xfe, 0xb3, 0x02, 0x6e, 0x98, 0x09, 0x14, 0x36, 0x40, 0x01, 0x73, 0xa4, 0xac,
+	0xd8, 0xff, 0xde, 0x22, 0x84, 0x24, 0x0c, 0xee, 0x83, 0x92, 0x43, 0x83, 0x99, 0x4f, 0x18, 0x71,
+	0xb9, 0xd7, 0x22, 0x7a, 0x1d, 0x80, 0x4f, 0x81, 0xca, 0x99, 0x78, 0xec, 0x13, 0x1b, 0x07, 0x74,
+	0x1e, 0x0a, 0xeb, 0xa5, 0xb6, 0x91, 0x38, 0xf8, 0xfd, 0xbc, 0xfa, 0xc1, 0xd4, 0x63, 0xa7, 0xf3,
+	0xb1, 0xe1, 0xd0, 0xa0, 0x21, 0x9b, 0x46, 0xfc, 0x3c, 0x88, 0xdd, 0x67, 0x8d, 0xe4, 0x46, 0x62,
+	0xa3, 0x1b, 0x32, 0xb4, 0xbb, 0xd6, 0x69, 0x71, 0x99, 0xfa, 0x2f, 0x0a, 0xb8, 0xcd, 0x3d, 0xc7,
+	0x88, 0x38, 0x34, 0x72, 0x5b, 0xae, 0x1b, 0x91, 0x38, 0x86, 0x15, 0xb0, 0x85, 0xc5, 0x92, 0xa7,
+	0x50, 0x42, 0xe9, 0x16, 0x9e, 0x82, 0x0a, 0x2f, 0x3a, 0xf6, 0xed, 0x37, 0x4c, 0x65, 0xff, 0x95,
+	0xa9, 0x3d, 0xa9, 0x67, 0x5e, 0xf5, 0x06, 0x3f, 0x06, 0xb7, 0x44, 0x79, 0x62, 0xfb, 0x75, 0x71,
+	0x72, 0xb5, 0xdc, 0x41, 0x11, 0xa9, 0xf2, 0xc0, 0x4c, 0xe3, 0xf5, 0x9f, 0x14, 0x70, 0x73, 0x33,
+	0x91, 0x7f, 0xf4, 0xa9, 0xfc, 0xf7, 0x3e, 0xb3, 0x6f, 0xf1, 0xf9, 0x57, 0x0e, 0x14, 0x4d, 0x1c,
+	0xcc, 0xb0, 0x37, 0x0d, 0x61, 0x79, 0xdd, 0xb5, 0x79, 0xde, 0xad, 0x15, 0xb0, 0xe5, 0x44, 0x04,
+	0x27, 0xff, 0x11, 0xa2, 0x61, 0xd3, 0x2d, 0xac, 0x82, 0xed, 0x80, 0x44, 0xcf, 0x7c, 0x62, 0x47,
+	0x94, 0xca, 0xdb, 0x47, 0x40, 0x84, 0x10, 0xa5, 0x0c, 0xbe, 0x0f, 0xca, 0xe1, 0x3c, 0xb0, 0x23,
+	0xe2, 0x78, 0x33, 0x8f, 0x84, 0x2c, 0xe6, 0x3d, 0x9c, 0x47, 0x3b, 0xe1, 0x3c, 0x40, 0xeb, 0x20,
+	0x6c, 0x83, 0x9b, 0x8c, 0x32, 0xec, 0xa7, 0x95, 0xb8, 0xc1, 0x1b, 0xfd, 0xff, 0x86, 0x48, 0xd8,
+	0x48, 0x26, 0x8d, 0x21, 0x27, 0x8d, 0x61, 0x52, 0x2f, 0x94, 0x3d, 0xbe, 0xcd, 0x49, 0x32, 0xdf,
+	0x2f, 0x80, 0x1a, 0x91, 0x00, 0x7b, 0xa1, 0x17, 0x4e, 0x53, 0x9d, 0xc2, 0xbb, 0xe9, 0xec, 0xae,
+	0x89, 0x52, 0xcb, 0x04, 0x20, 0x66, 0x38, 0x62, 0x76, 0x32, 0x84, 0x2a, 0x5b, 0x5c, 0x45, 0x33,
+	0xc4, 0x84, 0x32, 0xd2, 0x09, 0x65, 0x0c, 0xd3, 0x09, 0xd5, 0x2e, 0x26, 0x32, 0x2f, 0xfe, 0xa8,
+	0x2a, 0xa8, 0xc4, 0x79, 0xc9, 0x09, 0x3c, 0x01, 0x77, 0xd2, 0x19, 0x67, 0xcf, 0x43, 0xe6, 0xf9,
+	0xb6, 0x4b, 0x1c, 0x7c, 0x56, 0x29, 0x4a, 0x53, 0xd7, 0xe5, 0x3a, 0x12, 0x2c, 0xd4, 0xbe, 0x4f,
+	0xd4, 0x60, 0x2a, 0x70, 0x92, 0xf0, 0x3b, 0x09, 0x1d, 0x0e, 0xc0, 0xad, 0xb5, 0x2c, 0x9d, 0x48,
+	0xcd, 0xd2, 0xbb, 0x6b, 0xee, 0xa6, 0xec, 0xc1, 0x84, 0x0b, 0xd6, 0xbf, 0x02, 0xb7, 0xd3, 0xab,
+	0xe7, 0x3d, 0xd4, 0xf6, 0x58, 0x80, 0x67, 0xc9, 0xdd, 0x3a, 0x32, 0x6c, 0xaf, 0xdb, 0x01, 0xa4,
+	0xa1, 0x2e, 0x1f, 0x62, 0xcf, 0x69, 0x24, 0x06, 0x43, 0x1e, 0xf1, 0x75, 0x12, 0x1b, 0x7b, 0x2c,
+	0xe6, 0x9d, 0x70, 0x13, 0xf1, 0xf5, 0xfd, 0x5f, 0x15, 0x50, 0x10, 0x83, 0x05, 0x3e, 0x00, 0xb0,
+	0x65, 0x0e, 0xbb, 0x83, 0xbe, 0x7d, 0xd2, 0x3f, 0x7e, 0x62, 0x99, 0xdd, 0x47, 0x5d, 0xab, 0xa3,
+	0x66, 0xb4, 0xbb, 0xcb, 0x55, 0xed, 0x96, 0xc0, 0x9c, 0x84, 0xf1, 0x8c, 0x38, 0xde, 0xc4, 0x23,
+	0x6e, 0x62, 0x41, 0xc2, 0x47, 0x83, 0xa1, 0xa5, 0x2a, 0x5a, 0x79, 0xb9, 0xaa, 0x01, 0x81, 0x1b,
+	0x51, 0x46, 0x92, 0x99, 0x2d, 0x01, 0x1d, 0xeb, 0xc8, 0x7a, 0xdc, 0x1a, 0x5a, 0x6a, 0x56, 0x83,
+	0xcb, 0x55, 0xad, 0x9c, 0x8e, 0x62, 0x9f, 0x4c, 0x31, 0x23, 0xf0, 0x1e, 0x00, 0x12, 0x68, 0x8d,
+	0x7a, 0x6a, 0x4e, 0xdb, 0x59, 0xae, 0x6a, 0x25, 0x81, 0xb1, 0x46, 0x3d, 0x68, 0x80, 0xdb, 0xf2,
+	0xb8, 0xdb, 0x36, 0xed, 0x21, 0x6a, 0xf5, 0x8f, 0x1f, 0x59, 0x48, 0xcd, 0x6f, 0x1a, 0xeb, 0xb6,
+	0xcd, 0x61, 0x84, 0xc3, 0x78, 0x42, 0x22, 0x2d, 0xff, 0xcd, 0x8f, 0x7a, 0xe6, 0xfe, 0xb7, 0x59,
+	0xb0, 0xbd, 0xf1, 0x8e, 0x81, 0x9f, 0x82, 0x4a, 0xaf, 0x35, 0x34, 0x3f, 0xb7, 0x90, 0x3d, 0x7c,
+	0xfa, 0xc4, 0xba, 0x96, 0xa3, 0xb6, 0x5c, 0xd5, 0xf6, 0x36, 0xe0, 0x9b, 0x89, 0x36, 0xc1, 0xdd,
+	0x2b, 0x4c, 0x6b, 0xd4, 0xb3, 0xcd, 0xd6, 0xd1, 0x91, 0xaa, 0x68, 0x7b, 0xcb, 0x55, 0x0d, 0x6e,
+	0xd0, 0xac, 0x51, 0xcf, 0xc4, 0xbe, 0x0f, 0x5b, 0xe0, 0xde, 0x55, 0x0a, 0x32, 0x0f, 0x1f, 0xda,
+	0xe6, 0xa0, 0x3f, 0xb2, 0xd0, 0x71, 0x77, 0xd0, 0x57, 0xb3, 0x9a, 0xbe, 0x5c, 0xd5, 0xb4, 0x4d,
+	0x6a, 0x02, 0x31, 0x69, 0xb8, 0x20, 0x51, 0x9c, 0xdc, 0x46, 0x17, 0xbc, 0x77, 0x45, 0x02, 0x59,
+	0x23, 0xab, 0x7f, 0x92, 0xfc, 0x3e, 0xee, 0x1e, 0x0f, 0x51, 0x2b, 0x29, 0x88, 0x9a, 0xd3, 0xea,
+	0xcb, 0x55, 0x4d, 0xdf, 0x7c, 0x97, 0x92, 0x05, 0x09, 0xe7, 0x04, 0x91, 0xa9, 0x17, 0x33, 0xd1,
+	0x4a, 0xa2, 0x20, 0x6d, 0xf3, 0xe5, 0x85, 0xae, 0xbc, 0xba, 0xd0, 0x95, 0x3f, 0x2f, 0x74, 0xe5,
+	0xc5, 0xa5, 0x9e, 0x79, 0x75, 0xa9, 0x67, 0x7e, 0xbb, 0xd4, 0x33, 0x5f, 0x7e, 0xb4, 0x31, 0xcc,
+	0xc4, 0xd7, 0x86, 0xf8, 0xbb, 0x68, 0x1e, 0x36, 0xbe, 0x4e, 0xbf, 0x3c, 0xf8, 0x4c, 0x1b, 0x17,
+	0x78, 0xf3, 0x7e, 0xf2, 0xf7, 0x00, 0x68, 0x9c, 0x22, 0x7a, 0x96, 0x08, 0x00, 0x00,
 }
 
-func (m *Claim) Marshal() (dAtA []byte, err error) {
+func (m *ActionMatcher) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -284,45 +712,46 @@ func (m *Claim) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Claim) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActionMatcher) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActionMatcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ClaimableAmount.Size()
-		i -= size
-		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintClaims(dAtA, i, uint64(size))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Completed {
+	if len(m.MethodSelector) > 0 {
+		i -= len(m.MethodSelector)
+		copy(dAtA[i:], m.MethodSelector)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.MethodSelector)))
 		i--
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Contract)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Action != 0 {
-		i = encodeVarintClaims(dAtA, i, uint64(m.Action))
+	if m.Type != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClaimsRecordAddress) Marshal() (dAtA []byte, err error) {
+func (m *ActionDefinition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -332,50 +761,47 @@ func (m *ClaimsRecordAddress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClaimsRecordAddress) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActionDefinition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimsRecordAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActionDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ActionsCompleted) > 0 {
-		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.ActionsCompleted[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintClaims(dAtA, i, uint64(len(m.ActionsCompleted)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	{
-		size := m.InitialClaimableAmount.Size()
-		i -= size
-		if _, err := m.InitialClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Matcher.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintClaims(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintClaims(dAtA, i, uint64(len(m.Address)))
+	dAtA[i] = 0x22
+	if m.Weight != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Weight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClaimsRecord) Marshal() (dAtA []byte, err error) {
+func (m *Claim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -385,18 +811,119 @@ func (m *ClaimsRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClaimsRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *Claim) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ActionsCompleted) > 0 {
-		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.ClaimableAmount.Size()
+		i -= size
+		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Action != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimsRecordAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimsRecordAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimsRecordAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActionsCompleted) > 0 {
+		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ActionsCompleted[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.ActionsCompleted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.InitialClaimableAmount.Size()
+		i -= size
+		if _, err := m.InitialClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActionsCompleted) > 0 {
+		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
 			i--
 			if m.ActionsCompleted[iNdEx] {
 				dAtA[i] = 1
@@ -421,6 +948,137 @@ func (m *ClaimsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Campaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Campaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Campaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintClaims(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintClaims(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintClaims(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.RemainingAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TotalAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NumRecipients != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.NumRecipients))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CampaignClaimBitmap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CampaignClaimBitmap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CampaignClaimBitmap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bits) > 0 {
+		i -= len(m.Bits)
+		copy(dAtA[i:], m.Bits)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Bits)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Word != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Word))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClaims(v)
 	base := offset
@@ -432,6 +1090,51 @@ func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *ActionMatcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovClaims(uint64(m.Type))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.MethodSelector)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	return n
+}
+
+func (m *ActionDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovClaims(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovClaims(uint64(m.Weight))
+	}
+	l = m.Matcher.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
 func (m *Claim) Size() (n int) {
 	if m == nil {
 		return 0
@@ -481,13 +1184,65 @@ func (m *ClaimsRecord) Size() (n int) {
 	return n
 }
 
+func (m *Campaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovClaims(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if m.NumRecipients != 0 {
+		n += 1 + sovClaims(uint64(m.NumRecipients))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovClaims(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay)
+	n += 1 + l + sovClaims(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay)
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *CampaignClaimBitmap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovClaims(uint64(m.CampaignId))
+	}
+	if m.Word != 0 {
+		n += 1 + sovClaims(uint64(m.Word))
+	}
+	l = len(m.Bits)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	return n
+}
+
 func sovClaims(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozClaims(x uint64) (n int) {
 	return sovClaims(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Claim) Unmarshal(dAtA []byte) error {
+func (m *ActionMatcher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -510,17 +1265,335 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActionMatcher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActionMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Action = 0
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MatcherType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matcher", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Matcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Claim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -536,10 +1609,473 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimsRecordAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimsRecordAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
+					m.ActionsCompleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
+					m.ActionsCompleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Campaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Campaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Campaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -549,15 +2085,27 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Completed = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -569,81 +2117,147 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumRecipients", wireType)
+			}
+			m.NumRecipients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumRecipients |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClaims
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthClaims
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipClaims(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthClaims
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClaims
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimsRecordAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimsRecordAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationUntilDecay", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -653,29 +2267,30 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClaims
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthClaims
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationUntilDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationOfDecay", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -685,96 +2300,25 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClaims
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthClaims
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationOfDecay, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClaims
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthClaims
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
-					m.ActionsCompleted = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClaims
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaims(dAtA[iNdEx:])
@@ -796,7 +2340,7 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
+func (m *CampaignClaimBitmap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -819,17 +2363,55 @@ func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: CampaignClaimBitmap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CampaignClaimBitmap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Word", wireType)
+			}
+			m.Word = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Word |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bits", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -839,96 +2421,26 @@ func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthClaims
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthClaims
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Bits = append(m.Bits[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bits == nil {
+				m.Bits = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClaims
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthClaims
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
-					m.ActionsCompleted = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClaims
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaims(dAtA[iNdEx:])
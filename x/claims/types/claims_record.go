@@ -48,34 +48,35 @@ func (cr ClaimsRecord) Validate() error {
 	if !cr.InitialClaimableAmount.IsPositive() {
 		return fmt.Errorf("initial claimable amount is not positive, %s", cr.InitialClaimableAmount)
 	}
-	if len(cr.ActionsCompleted) == 0 || len(Action_value)-1 != len(cr.ActionsCompleted) {
-		return fmt.Errorf("action length mismatch, expected %d, got %d", len(Action_value)-1, len(cr.ActionsCompleted))
-	}
-
-	return nil
+	return validateActionsCompleted(cr.ActionsCompleted)
 }
 
-// MarkClaimed marks the given action as completed (i.e claimed). It performs a no-op if the
-// action is invalid or if the ActionsCompleted slice has an invalid length.
+// MarkClaimed marks the given action as completed (i.e claimed). The
+// ActionsCompleted slice is extended for custom actions whose identifier is
+// beyond its length. It performs a no-op if the action is invalid or if the
+// ActionsCompleted slice is shorter than the built-in actions.
 func (cr *ClaimsRecord) MarkClaimed(action Action) {
 	switch {
-	case len(cr.ActionsCompleted) != len(Action_value)-1:
+	case len(cr.ActionsCompleted) < len(BuiltinActions()):
 		return
-	case action == ActionUnspecified || int(action) > len(Action_value)-1:
+	case action <= ActionUnspecified || action > MaxActionID:
 		return
-	default:
-		cr.ActionsCompleted[action-1] = true
 	}
+
+	for len(cr.ActionsCompleted) < int(action) {
+		cr.ActionsCompleted = append(cr.ActionsCompleted, false)
+	}
+	cr.ActionsCompleted[action-1] = true
 }
 
 // HasClaimedAction checks if the user has claimed a given action. It also
-// returns false if the action is invalid or if the ActionsCompleted slice has
-// an invalid length.
+// returns false if the action is invalid or if the ActionsCompleted slice is
+// shorter than the built-in actions.
 func (cr ClaimsRecord) HasClaimedAction(action Action) bool {
 	switch {
-	case len(cr.ActionsCompleted) != len(Action_value)-1:
+	case len(cr.ActionsCompleted) < len(BuiltinActions()):
 		return false
-	case action == 0 || int(action) > len(Action_value)-1:
+	case action <= ActionUnspecified || int(action) > len(cr.ActionsCompleted):
 		return false
 	default:
 		return cr.ActionsCompleted[action-1]
@@ -130,9 +131,17 @@ func (cra ClaimsRecordAddress) Validate() error {
 		return fmt.Errorf("initial claimable amount is not positive, %s", cra.InitialClaimableAmount)
 	}
 
-	if len(Action_value)-1 != len(cra.ActionsCompleted) {
-		return fmt.Errorf("action length mismatch, expected %d, got %d", len(Action_value)-1, len(cra.ActionsCompleted))
-	}
+	return validateActionsCompleted(cra.ActionsCompleted)
+}
 
+// validateActionsCompleted checks that the completed actions cover at least
+// the built-in actions and at most the maximum custom action identifier
+func validateActionsCompleted(actionsCompleted []bool) error {
+	if len(actionsCompleted) < len(BuiltinActions()) || len(actionsCompleted) > MaxActionID {
+		return fmt.Errorf(
+			"action length mismatch, expected between %d and %d, got %d",
+			len(BuiltinActions()), MaxActionID, len(actionsCompleted),
+		)
+	}
 	return nil
 }
@@ -81,7 +81,7 @@ func TestClaimAction(t *testing.T) {
 		{
 			"fail - invalid action",
 			types.NewClaimsRecord(sdk.OneInt()),
-			types.Action(10),
+			types.Action(types.MaxActionID + 1),
 			false,
 		},
 		{
@@ -90,6 +90,12 @@ func TestClaimAction(t *testing.T) {
 			types.ActionEVM,
 			true,
 		},
+		{
+			"success - custom action extends the completed actions",
+			types.NewClaimsRecord(sdk.OneInt()),
+			types.Action(10),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -35,7 +35,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "evmos/claims/MsgUpdateParams"
+	updateParamsName   = "evmos/claims/MsgUpdateParams"
+	createCampaignName = "evmos/claims/MsgCreateCampaign"
+	claimCampaignName  = "evmos/claims/MsgClaimCampaign"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -49,6 +51,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgCreateCampaign{},
+		&MsgClaimCampaign{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -56,4 +60,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgCreateCampaign{}, createCampaignName, nil)
+	cdc.RegisterConcrete(&MsgClaimCampaign{}, claimCampaignName, nil)
 }
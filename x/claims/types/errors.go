@@ -25,4 +25,9 @@ var (
 	ErrClaimsRecordNotFound = errorsmod.Register(ModuleName, 2, "claims record not found")
 	ErrInvalidAction        = errorsmod.Register(ModuleName, 3, "invalid claim action type")
 	ErrKeyTypeNotSupported  = errorsmod.Register(ModuleName, 4, "key type 'secp256k1' not supported")
+	ErrCampaignNotFound     = errorsmod.Register(ModuleName, 5, "campaign not found")
+	ErrCampaignInactive     = errorsmod.Register(ModuleName, 6, "campaign is not active")
+	ErrCampaignClaimed      = errorsmod.Register(ModuleName, 7, "campaign allocation already claimed")
+	ErrInvalidMerkleProof   = errorsmod.Register(ModuleName, 8, "invalid merkle proof")
+	ErrActionRegistryLocked = errorsmod.Register(ModuleName, 9, "claim action weights cannot be modified while claims are active")
 )
@@ -20,9 +20,15 @@ package types
 const (
 	EventTypeClaim              = "claim"
 	EventTypeMergeClaimsRecords = "merge_claims_records"
+	EventTypeCreateCampaign     = "create_campaign"
+	EventTypeClaimCampaign      = "claim_campaign"
+	EventTypeEndCampaign        = "end_campaign"
 
 	AttributeKeyActionType             = "action"
 	AttributeKeyRecipient              = "recipient"
 	AttributeKeyClaimedCoins           = "claimed_coins"
 	AttributeKeyFundCommunityPoolCoins = "fund_community_pool_coins"
+	AttributeKeyCampaignID             = "campaign_id"
+	AttributeKeyMerkleRoot             = "merkle_root"
+	AttributeKeyLeafIndex              = "leaf_index"
 )
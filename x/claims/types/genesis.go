@@ -26,8 +26,10 @@ import (
 // DefaultGenesis returns the default claims module genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		ClaimsRecords: []ClaimsRecordAddress{},
+		Params:               DefaultParams(),
+		ClaimsRecords:        []ClaimsRecordAddress{},
+		Campaigns:            []Campaign{},
+		CampaignClaimBitmaps: []CampaignClaimBitmap{},
 	}
 }
 
@@ -58,5 +60,31 @@ func (gs GenesisState) Validate() error {
 		seenClaims[claimsRecord.Address] = true
 	}
 
+	seenCampaigns := make(map[uint64]bool)
+	for _, campaign := range gs.Campaigns {
+		if seenCampaigns[campaign.Id] {
+			return fmt.Errorf("duplicated campaign %d", campaign.Id)
+		}
+		if err := campaign.Validate(); err != nil {
+			return err
+		}
+		seenCampaigns[campaign.Id] = true
+	}
+
+	seenWords := make(map[string]bool)
+	for _, bitmap := range gs.CampaignClaimBitmaps {
+		if !seenCampaigns[bitmap.CampaignId] {
+			return fmt.Errorf("claim bitmap for unknown campaign %d", bitmap.CampaignId)
+		}
+		if len(bitmap.Bits) != CampaignBitmapWordSize/8 {
+			return fmt.Errorf("invalid claim bitmap length for campaign %d, expected %d, got %d", bitmap.CampaignId, CampaignBitmapWordSize/8, len(bitmap.Bits))
+		}
+		key := fmt.Sprintf("%d/%d", bitmap.CampaignId, bitmap.Word)
+		if seenWords[key] {
+			return fmt.Errorf("duplicated claim bitmap word %d for campaign %d", bitmap.Word, bitmap.CampaignId)
+		}
+		seenWords[key] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -34,6 +34,11 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// claims_records is a list of claim records with the corresponding airdrop recipient
 	ClaimsRecords []ClaimsRecordAddress `protobuf:"bytes,2,rep,name=claims_records,json=claimsRecords,proto3" json:"claims_records"`
+	// campaigns is the list of Merkle-root airdrop campaigns
+	Campaigns []Campaign `protobuf:"bytes,3,rep,name=campaigns,proto3" json:"campaigns"`
+	// campaign_claim_bitmaps is the list of the non-empty claim bitmap words of
+	// the campaigns
+	CampaignClaimBitmaps []CampaignClaimBitmap `protobuf:"bytes,4,rep,name=campaign_claim_bitmaps,json=campaignClaimBitmaps,proto3" json:"campaign_claim_bitmaps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,6 +88,20 @@ func (m *GenesisState) GetClaimsRecords() []ClaimsRecordAddress {
 	return nil
 }
 
+func (m *GenesisState) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCampaignClaimBitmaps() []CampaignClaimBitmap {
+	if m != nil {
+		return m.CampaignClaimBitmaps
+	}
+	return nil
+}
+
 // Params defines the claims module's parameters.
 type Params struct {
 	// enable_claims is the parameter to enable the claiming process
@@ -100,6 +119,9 @@ type Params struct {
 	AuthorizedChannels []string `protobuf:"bytes,6,rep,name=authorized_channels,json=authorizedChannels,proto3" json:"authorized_channels,omitempty"`
 	// evm_channels is the list of channel identifiers from EVM compatible chains
 	EVMChannels []string `protobuf:"bytes,7,rep,name=evm_channels,json=evmChannels,proto3" json:"evm_channels,omitempty"`
+	// custom_actions is the registry of claim actions that are completed in
+	// addition to the built-in actions
+	CustomActions []ActionDefinition `protobuf:"bytes,8,rep,name=custom_actions,json=customActions,proto3" json:"custom_actions"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,6 +206,13 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetCustomActions() []ActionDefinition {
+	if m != nil {
+		return m.CustomActions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.claims.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.claims.v1.Params")
@@ -192,38 +221,43 @@ func init() {
 func init() { proto.RegisterFile("evmos/claims/v1/genesis.proto", fileDescriptor_f2f8f1d6f18af278) }
 
 var fileDescriptor_f2f8f1d6f18af278 = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
-	0x14, 0xc6, 0xe3, 0xa6, 0x84, 0x76, 0x9c, 0x12, 0x18, 0x2a, 0x61, 0x22, 0x70, 0x42, 0x61, 0x11,
-	0x36, 0xb6, 0x12, 0xc4, 0x01, 0x48, 0x82, 0x58, 0xa1, 0x82, 0x4b, 0x59, 0xb0, 0xb1, 0x26, 0xf6,
-	0xc4, 0xb1, 0xe4, 0xf1, 0x58, 0x33, 0x63, 0x8b, 0x72, 0x8a, 0x2e, 0x7b, 0x0d, 0x6e, 0xd1, 0x65,
-	0x97, 0xac, 0x0a, 0x4a, 0x2e, 0x82, 0xe6, 0x1f, 0x45, 0xe9, 0xa6, 0x1b, 0x6b, 0xfc, 0xbe, 0xdf,
-	0xf7, 0xcd, 0x7b, 0x4f, 0x03, 0x9e, 0xe3, 0x86, 0x50, 0x1e, 0x26, 0x05, 0xca, 0x09, 0x0f, 0x9b,
-	0x71, 0x98, 0xe1, 0x12, 0xf3, 0x9c, 0x07, 0x15, 0xa3, 0x82, 0xc2, 0x9e, 0x92, 0x03, 0x2d, 0x07,
-	0xcd, 0xb8, 0xff, 0x6c, 0x9b, 0x37, 0x92, 0xc2, 0xfb, 0x87, 0x19, 0xcd, 0xa8, 0x3a, 0x86, 0xf2,
-	0x64, 0xaa, 0x7e, 0x46, 0x69, 0x56, 0xe0, 0x50, 0xfd, 0x2d, 0xea, 0x65, 0x98, 0xd6, 0x0c, 0x89,
-	0x9c, 0x96, 0x46, 0x1f, 0x6c, 0xeb, 0x22, 0x27, 0x98, 0x0b, 0x44, 0x2a, 0x0d, 0x1c, 0x5d, 0x38,
-	0xa0, 0xfb, 0x41, 0xf7, 0x75, 0x22, 0x90, 0xc0, 0xf0, 0x2d, 0xe8, 0x54, 0x88, 0x21, 0xc2, 0x3d,
-	0x67, 0xe8, 0x8c, 0xdc, 0xc9, 0x93, 0x60, 0xab, 0xcf, 0xe0, 0x93, 0x92, 0xa7, 0xbb, 0x97, 0xd7,
-	0x83, 0x56, 0x64, 0x60, 0xf8, 0x19, 0x3c, 0xd0, 0x44, 0xcc, 0x70, 0x42, 0x59, 0xca, 0xbd, 0x9d,
-	0x61, 0x7b, 0xe4, 0x4e, 0x5e, 0xdd, 0xb2, 0xcf, 0xd4, 0x29, 0x52, 0xd4, 0xbb, 0x34, 0x65, 0x98,
-	0xdb, 0xac, 0x83, 0xe4, 0x3f, 0x89, 0x1f, 0xfd, 0x6c, 0x83, 0x8e, 0xbe, 0x0b, 0xbe, 0x04, 0x07,
-	0xb8, 0x44, 0x8b, 0x02, 0xc7, 0x1a, 0x51, 0xbd, 0xed, 0x45, 0x5d, 0x5d, 0xd4, 0x89, 0x30, 0x02,
-	0x10, 0xe5, 0x2c, 0x65, 0xb4, 0x8a, 0xb9, 0x40, 0x4c, 0xc4, 0x72, 0x56, 0x6f, 0x47, 0x4d, 0xd1,
-	0x0f, 0xf4, 0x22, 0x02, 0xbb, 0x88, 0xe0, 0x8b, 0x5d, 0xc4, 0x74, 0x4f, 0x5e, 0x7e, 0xfe, 0x7b,
-	0xe0, 0x44, 0x0f, 0x8d, 0xff, 0x44, 0xda, 0x25, 0x00, 0x4f, 0xc1, 0xa1, 0xdd, 0x68, 0x5c, 0x97,
-	0x22, 0x2f, 0xe2, 0x14, 0x27, 0xe8, 0xcc, 0x6b, 0xab, 0xd4, 0xa7, 0xb7, 0x52, 0xe7, 0x06, 0xd6,
-	0xa1, 0x17, 0x32, 0x14, 0xda, 0x80, 0x53, 0xe9, 0x9f, 0x4b, 0x3b, 0x3c, 0x06, 0x8f, 0xfe, 0xc5,
-	0xd2, 0xa5, 0xc9, 0xdc, 0xbd, 0x7b, 0x66, 0xcf, 0xba, 0x8f, 0x97, 0x3a, 0xf0, 0x05, 0xe8, 0x9a,
-	0xf5, 0xa7, 0xb8, 0xa4, 0xc4, 0xbb, 0x37, 0x74, 0x46, 0xfb, 0x91, 0xab, 0x6b, 0x73, 0x59, 0x82,
-	0x21, 0x78, 0x8c, 0x6a, 0xb1, 0xa2, 0x2c, 0xff, 0x81, 0xd3, 0x38, 0x59, 0xa1, 0xb2, 0xc4, 0x05,
-	0xf7, 0x3a, 0xc3, 0xf6, 0x68, 0x3f, 0x82, 0x37, 0xd2, 0xcc, 0x28, 0x70, 0x02, 0xba, 0xb8, 0x21,
-	0x37, 0xe4, 0x7d, 0x49, 0x4e, 0x7b, 0xeb, 0xeb, 0x81, 0xfb, 0xfe, 0xeb, 0x47, 0x8b, 0x45, 0x2e,
-	0x6e, 0x88, 0xfd, 0x99, 0xce, 0x2e, 0xd7, 0xbe, 0x73, 0xb5, 0xf6, 0x9d, 0x3f, 0x6b, 0xdf, 0x39,
-	0xdf, 0xf8, 0xad, 0xab, 0x8d, 0xdf, 0xfa, 0xb5, 0xf1, 0x5b, 0xdf, 0x5e, 0x67, 0xb9, 0x58, 0xd5,
-	0x8b, 0x20, 0xa1, 0x24, 0xd4, 0x0f, 0x5d, 0x7f, 0x9b, 0xf1, 0x24, 0xfc, 0x6e, 0x1f, 0xbd, 0x38,
-	0xab, 0x30, 0x5f, 0x74, 0xd4, 0xe8, 0x6f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x18, 0xe5, 0xf2,
-	0x61, 0x41, 0x03, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x72, 0xd2, 0x40,
+	0x18, 0xc7, 0x09, 0x20, 0xc2, 0x42, 0x8b, 0xae, 0x8c, 0xa6, 0x8c, 0x06, 0x5a, 0x3d, 0xe0, 0x25,
+	0x19, 0x70, 0x3c, 0x7a, 0x28, 0xe0, 0x78, 0xd2, 0x6a, 0x6a, 0x3d, 0x78, 0x89, 0x4b, 0xb2, 0x84,
+	0x9d, 0x61, 0xb3, 0x99, 0xec, 0x26, 0x63, 0xbd, 0xf9, 0x06, 0x3d, 0xfa, 0x2a, 0xbe, 0x41, 0x8f,
+	0x3d, 0x7a, 0xaa, 0x0e, 0xbc, 0x88, 0x93, 0xdd, 0x4d, 0x71, 0x60, 0x9c, 0xe9, 0x85, 0xd9, 0xec,
+	0xff, 0xf7, 0xff, 0xf3, 0xe5, 0xfb, 0xbe, 0x80, 0x27, 0x38, 0xa3, 0x8c, 0x3b, 0xfe, 0x12, 0x11,
+	0xca, 0x9d, 0x6c, 0xe8, 0x84, 0x38, 0xc2, 0x9c, 0x70, 0x3b, 0x4e, 0x98, 0x60, 0xb0, 0x2d, 0x65,
+	0x5b, 0xc9, 0x76, 0x36, 0xec, 0x3e, 0xde, 0xe6, 0xb5, 0x24, 0xf1, 0x6e, 0x27, 0x64, 0x21, 0x93,
+	0x47, 0x27, 0x3f, 0xe9, 0x5b, 0x2b, 0x64, 0x2c, 0x5c, 0x62, 0x47, 0x3e, 0xcd, 0xd2, 0xb9, 0x13,
+	0xa4, 0x09, 0x12, 0x84, 0x45, 0x5a, 0xef, 0x6d, 0xeb, 0x82, 0x50, 0xcc, 0x05, 0xa2, 0xb1, 0x02,
+	0x8e, 0x7e, 0x96, 0x41, 0xeb, 0x8d, 0xaa, 0xeb, 0x54, 0x20, 0x81, 0xe1, 0x4b, 0x50, 0x8b, 0x51,
+	0x82, 0x28, 0x37, 0x8d, 0xbe, 0x31, 0x68, 0x8e, 0x1e, 0xd9, 0x5b, 0x75, 0xda, 0xef, 0xa5, 0x3c,
+	0xae, 0x5e, 0x5e, 0xf7, 0x4a, 0xae, 0x86, 0xe1, 0x07, 0xb0, 0xaf, 0x08, 0x2f, 0xc1, 0x3e, 0x4b,
+	0x02, 0x6e, 0x96, 0xfb, 0x95, 0x41, 0x73, 0xf4, 0x6c, 0xc7, 0x3e, 0x91, 0x27, 0x57, 0x52, 0xc7,
+	0x41, 0x90, 0x60, 0x5e, 0x64, 0xed, 0xf9, 0xff, 0x48, 0x1c, 0xbe, 0x02, 0x0d, 0x1f, 0xd1, 0x18,
+	0x91, 0x30, 0xe2, 0x66, 0x45, 0xa6, 0x1d, 0xec, 0xa6, 0x69, 0x42, 0x47, 0x6c, 0x1c, 0xf0, 0x0b,
+	0x78, 0x58, 0x3c, 0x78, 0x92, 0xf7, 0x66, 0x44, 0x50, 0x14, 0x73, 0xb3, 0xfa, 0xbf, 0xca, 0x34,
+	0x2e, 0x2b, 0x1c, 0x4b, 0x58, 0xc7, 0x76, 0xfc, 0x5d, 0x89, 0x1f, 0x7d, 0xaf, 0x82, 0x9a, 0x6a,
+	0x06, 0x7c, 0x0a, 0xf6, 0x70, 0x84, 0x66, 0x4b, 0xac, 0xfe, 0x4a, 0x35, 0xaf, 0xee, 0xb6, 0xd4,
+	0xa5, 0x7a, 0x65, 0xe8, 0x02, 0x88, 0x48, 0x12, 0x24, 0x2c, 0xf6, 0xb8, 0x40, 0x89, 0xf0, 0xf2,
+	0x61, 0x98, 0x65, 0xd9, 0xe6, 0xae, 0xad, 0x26, 0x65, 0x17, 0x93, 0xb2, 0x3f, 0x16, 0x93, 0x1a,
+	0xd7, 0xf3, 0x1a, 0x2e, 0x7e, 0xf7, 0x0c, 0xf7, 0x9e, 0xf6, 0x9f, 0xe6, 0xf6, 0x1c, 0x80, 0x67,
+	0xa0, 0x53, 0x8c, 0xdc, 0x4b, 0x23, 0x41, 0x96, 0x5e, 0x80, 0x7d, 0x74, 0x6e, 0x56, 0x64, 0xea,
+	0xc1, 0x4e, 0xea, 0x54, 0xc3, 0x2a, 0xf4, 0x47, 0x1e, 0x0a, 0x8b, 0x80, 0xb3, 0xdc, 0x3f, 0xcd,
+	0xed, 0xf0, 0x04, 0xdc, 0xbf, 0x89, 0x65, 0x73, 0x9d, 0x59, 0xbd, 0x7d, 0x66, 0xbb, 0x70, 0x9f,
+	0xcc, 0x55, 0xe0, 0x21, 0x68, 0xe9, 0xfd, 0x08, 0x70, 0xc4, 0xa8, 0x79, 0xa7, 0x6f, 0x0c, 0x1a,
+	0x6e, 0x53, 0xdd, 0x4d, 0xf3, 0x2b, 0xe8, 0x80, 0x07, 0x28, 0x15, 0x0b, 0x96, 0x90, 0x6f, 0x38,
+	0xf0, 0xfc, 0x05, 0x8a, 0x22, 0xbc, 0xe4, 0x66, 0xad, 0x5f, 0x19, 0x34, 0x5c, 0xb8, 0x91, 0x26,
+	0x5a, 0x81, 0x23, 0xd0, 0xc2, 0x19, 0xdd, 0x90, 0x77, 0x73, 0x72, 0xdc, 0x5e, 0x5d, 0xf7, 0x9a,
+	0xaf, 0x3f, 0xbd, 0x2d, 0x30, 0xb7, 0x89, 0x33, 0x7a, 0xe3, 0x79, 0x07, 0xf6, 0xfd, 0x94, 0x0b,
+	0x46, 0x3d, 0xe4, 0xe7, 0xf5, 0x71, 0xb3, 0x2e, 0xb7, 0xe1, 0x70, 0x67, 0x1b, 0x8e, 0xa5, 0x3e,
+	0xc5, 0x73, 0x12, 0x11, 0xf9, 0x76, 0xc5, 0x92, 0x4a, 0xbb, 0x52, 0xf9, 0x78, 0x72, 0xb9, 0xb2,
+	0x8c, 0xab, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc5, 0xda, 0x2a, 0x5d, 0xad, 0xad, 0xd2, 0xaf,
+	0xb5, 0x55, 0xfa, 0xfc, 0x3c, 0x24, 0x62, 0x91, 0xce, 0x6c, 0x9f, 0x51, 0x47, 0x7d, 0xd9, 0xea,
+	0x37, 0x1b, 0x8e, 0x9c, 0xaf, 0xc5, 0x57, 0x2e, 0xce, 0x63, 0xcc, 0x67, 0x35, 0xd9, 0xca, 0x17,
+	0x7f, 0x07, 0x00, 0xda, 0x35, 0x7e, 0x34, 0x32, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +280,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CampaignClaimBitmaps) > 0 {
+		for iNdEx := len(m.CampaignClaimBitmaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CampaignClaimBitmaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ClaimsRecords) > 0 {
 		for iNdEx := len(m.ClaimsRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -293,6 +355,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CustomActions) > 0 {
+		for iNdEx := len(m.CustomActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.EVMChannels) > 0 {
 		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EVMChannels[iNdEx])
@@ -380,6 +456,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CampaignClaimBitmaps) > 0 {
+		for _, e := range m.CampaignClaimBitmaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -414,6 +502,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CustomActions) > 0 {
+		for _, e := range m.CustomActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -519,6 +613,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignClaimBitmaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CampaignClaimBitmaps = append(m.CampaignClaimBitmaps, CampaignClaimBitmap{})
+			if err := m.CampaignClaimBitmaps[len(m.CampaignClaimBitmaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -784,6 +946,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomActions = append(m.CustomActions, ActionDefinition{})
+			if err := m.CustomActions[len(m.CustomActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -25,14 +25,24 @@ const (
 
 	// RouterKey is the message route for claims
 	RouterKey = ModuleName
+
+	// CampaignEscrowName is the name of the module account that holds the
+	// tokens of the Merkle-root airdrop campaigns
+	CampaignEscrowName = "claims_campaigns"
 )
 
 // prefix bytes for the claims module's persistent store
 const (
 	prefixClaimsRecords = iota + 1
+	prefixCampaigns
+	prefixCampaignClaimBitmaps
+	prefixNextCampaignID
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixClaimsRecords = []byte{prefixClaimsRecords}
+	KeyPrefixClaimsRecords        = []byte{prefixClaimsRecords}
+	KeyPrefixCampaigns            = []byte{prefixCampaigns}
+	KeyPrefixCampaignClaimBitmaps = []byte{prefixCampaignClaimBitmaps}
+	KeyNextCampaignID             = []byte{prefixNextCampaignID}
 )
@@ -17,11 +17,18 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCreateCampaign{}
+	_ sdk.Msg = &MsgClaimCampaign{}
+)
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -42,3 +49,84 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgCreateCampaign message.
+func (m *MsgCreateCampaign) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	if _, err := DecodeMerkleHash(m.MerkleRoot); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid merkle root: %s", err)
+	}
+
+	if m.NumRecipients == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "number of recipients cannot be zero")
+	}
+
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount: %s", m.Amount)
+	}
+
+	return ValidateCampaignDurations(m.DurationUntilDecay, m.DurationOfDecay)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateCampaign) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgClaimCampaign message.
+func (m *MsgClaimCampaign) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Claimer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgClaimCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Claimer); err != nil {
+		return errorsmod.Wrap(err, "invalid claimer address")
+	}
+
+	if m.CampaignId == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "campaign id cannot be zero")
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "amount must be positive: %s", m.Amount)
+	}
+
+	if len(m.Actions) == 0 {
+		return errorsmod.Wrap(ErrInvalidAction, "actions cannot be empty")
+	}
+
+	seenActions := make(map[Action]bool)
+	for _, action := range m.Actions {
+		if action == ActionUnspecified || action > ActionIBCTransfer {
+			return errorsmod.Wrapf(ErrInvalidAction, "%d", action)
+		}
+		if seenActions[action] {
+			return errorsmod.Wrapf(ErrInvalidAction, "duplicated action %s", action)
+		}
+		seenActions[action] = true
+	}
+
+	for i, node := range m.Proof {
+		if _, err := DecodeMerkleHash(node); err != nil {
+			return errorsmod.Wrap(ErrInvalidMerkleProof, fmt.Sprintf("node %d: %s", i, err))
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgClaimCampaign) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
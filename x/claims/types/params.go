@@ -21,6 +21,7 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
@@ -117,7 +118,10 @@ func (p Params) Validate() error {
 	if err := ValidateChannels(p.AuthorizedChannels); err != nil {
 		return err
 	}
-	return ValidateChannels(p.EVMChannels)
+	if err := ValidateChannels(p.EVMChannels); err != nil {
+		return err
+	}
+	return validateCustomActions(p.CustomActions)
 }
 
 // DecayStartTime returns the time at which the Decay period starts
@@ -164,3 +168,86 @@ func (p Params) IsEVMChannel(channel string) bool {
 
 	return false
 }
+
+// Actions returns the built-in claim actions followed by the custom actions of
+// the registry
+func (p Params) Actions() []Action {
+	actions := BuiltinActions()
+	for _, action := range p.CustomActions {
+		actions = append(actions, action.Action())
+	}
+	return actions
+}
+
+// GetCustomAction returns the custom claim action definition for the given
+// action
+func (p Params) GetCustomAction(action Action) (ActionDefinition, bool) {
+	for _, customAction := range p.CustomActions {
+		if customAction.Action() == action {
+			return customAction, true
+		}
+	}
+	return ActionDefinition{}, false
+}
+
+// IsValidAction returns true if the action is a built-in action or a custom
+// action of the registry
+func (p Params) IsValidAction(action Action) bool {
+	_, found := p.GetCustomAction(action)
+	return IsBuiltinAction(action) || found
+}
+
+// ActionName returns the name of a built-in or custom claim action
+func (p Params) ActionName(action Action) string {
+	if customAction, found := p.GetCustomAction(action); found {
+		return customAction.Name
+	}
+	return action.String()
+}
+
+// ActionWeight returns the weight of a claim action. It returns zero if the
+// action is not registered.
+func (p Params) ActionWeight(action Action) uint64 {
+	if IsBuiltinAction(action) {
+		return BuiltinActionWeight
+	}
+	if customAction, found := p.GetCustomAction(action); found {
+		return uint64(customAction.Weight)
+	}
+	return 0
+}
+
+// TotalActionWeight returns the sum of the weights of all the claim actions
+func (p Params) TotalActionWeight() uint64 {
+	total := uint64(len(BuiltinActions()) * BuiltinActionWeight)
+	for _, customAction := range p.CustomActions {
+		total += uint64(customAction.Weight)
+	}
+	return total
+}
+
+// HasEqualActionWeights returns true if both params define the same claim
+// actions with the same weights, i.e the share of each action is unchanged
+func (p Params) HasEqualActionWeights(other Params) bool {
+	if len(p.CustomActions) != len(other.CustomActions) {
+		return false
+	}
+	for _, action := range p.Actions() {
+		if p.ActionWeight(action) != other.ActionWeight(action) {
+			return false
+		}
+	}
+	return true
+}
+
+// InitialClaimableForAction returns the share of the initial claimable amount
+// that corresponds to the given action, i.e the amount multiplied by the
+// action weight over the total weight of the actions
+func (p Params) InitialClaimableForAction(initialClaimableAmt math.Int, action Action) math.Int {
+	weight := p.ActionWeight(action)
+	if weight == 0 {
+		return math.ZeroInt()
+	}
+
+	return initialClaimableAmt.Mul(math.NewIntFromUint64(weight)).Quo(math.NewIntFromUint64(p.TotalActionWeight()))
+}
@@ -399,6 +399,303 @@ func (m *QueryClaimsRecordResponse) GetClaims() []Claim {
 	return nil
 }
 
+// QueryCampaignsRequest is the request type for the Query/Campaigns RPC method.
+type QueryCampaignsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCampaignsRequest) Reset()         { *m = QueryCampaignsRequest{} }
+func (m *QueryCampaignsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsRequest) ProtoMessage()    {}
+func (*QueryCampaignsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{8}
+}
+func (m *QueryCampaignsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsRequest.Merge(m, src)
+}
+func (m *QueryCampaignsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignsRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCampaignsResponse is the response type for the Query/Campaigns RPC
+// method.
+type QueryCampaignsResponse struct {
+	// campaigns defines all Merkle-root airdrop campaigns
+	Campaigns []Campaign `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCampaignsResponse) Reset()         { *m = QueryCampaignsResponse{} }
+func (m *QueryCampaignsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsResponse) ProtoMessage()    {}
+func (*QueryCampaignsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{9}
+}
+func (m *QueryCampaignsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsResponse.Merge(m, src)
+}
+func (m *QueryCampaignsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignsResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignsResponse) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+func (m *QueryCampaignsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCampaignRequest is the request type for the Query/Campaign RPC method.
+type QueryCampaignRequest struct {
+	// campaign_id is the identifier of the campaign
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (m *QueryCampaignRequest) Reset()         { *m = QueryCampaignRequest{} }
+func (m *QueryCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignRequest) ProtoMessage()    {}
+func (*QueryCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{10}
+}
+func (m *QueryCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignRequest.Merge(m, src)
+}
+func (m *QueryCampaignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignRequest) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// QueryCampaignResponse is the response type for the Query/Campaign RPC method.
+type QueryCampaignResponse struct {
+	// campaign for the given id
+	Campaign Campaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign"`
+}
+
+func (m *QueryCampaignResponse) Reset()         { *m = QueryCampaignResponse{} }
+func (m *QueryCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignResponse) ProtoMessage()    {}
+func (*QueryCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{11}
+}
+func (m *QueryCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignResponse.Merge(m, src)
+}
+func (m *QueryCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignResponse) GetCampaign() Campaign {
+	if m != nil {
+		return m.Campaign
+	}
+	return Campaign{}
+}
+
+// QueryCampaignClaimedRequest is the request type for the Query/CampaignClaimed
+// RPC method.
+type QueryCampaignClaimedRequest struct {
+	// campaign_id is the identifier of the campaign
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// index of the recipient leaf in the Merkle tree
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryCampaignClaimedRequest) Reset()         { *m = QueryCampaignClaimedRequest{} }
+func (m *QueryCampaignClaimedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignClaimedRequest) ProtoMessage()    {}
+func (*QueryCampaignClaimedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{12}
+}
+func (m *QueryCampaignClaimedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignClaimedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignClaimedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignClaimedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignClaimedRequest.Merge(m, src)
+}
+func (m *QueryCampaignClaimedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignClaimedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignClaimedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignClaimedRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignClaimedRequest) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *QueryCampaignClaimedRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// QueryCampaignClaimedResponse is the response type for the
+// Query/CampaignClaimed RPC method.
+type QueryCampaignClaimedResponse struct {
+	// claimed is true if the leaf has been claimed
+	Claimed bool `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
+}
+
+func (m *QueryCampaignClaimedResponse) Reset()         { *m = QueryCampaignClaimedResponse{} }
+func (m *QueryCampaignClaimedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignClaimedResponse) ProtoMessage()    {}
+func (*QueryCampaignClaimedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{13}
+}
+func (m *QueryCampaignClaimedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignClaimedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignClaimedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignClaimedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignClaimedResponse.Merge(m, src)
+}
+func (m *QueryCampaignClaimedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignClaimedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignClaimedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignClaimedResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignClaimedResponse) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryTotalUnclaimedRequest)(nil), "evmos.claims.v1.QueryTotalUnclaimedRequest")
 	proto.RegisterType((*QueryTotalUnclaimedResponse)(nil), "evmos.claims.v1.QueryTotalUnclaimedResponse")
@@ -408,54 +705,74 @@ func init() {
 	proto.RegisterType((*QueryClaimsRecordsResponse)(nil), "evmos.claims.v1.QueryClaimsRecordsResponse")
 	proto.RegisterType((*QueryClaimsRecordRequest)(nil), "evmos.claims.v1.QueryClaimsRecordRequest")
 	proto.RegisterType((*QueryClaimsRecordResponse)(nil), "evmos.claims.v1.QueryClaimsRecordResponse")
+	proto.RegisterType((*QueryCampaignsRequest)(nil), "evmos.claims.v1.QueryCampaignsRequest")
+	proto.RegisterType((*QueryCampaignsResponse)(nil), "evmos.claims.v1.QueryCampaignsResponse")
+	proto.RegisterType((*QueryCampaignRequest)(nil), "evmos.claims.v1.QueryCampaignRequest")
+	proto.RegisterType((*QueryCampaignResponse)(nil), "evmos.claims.v1.QueryCampaignResponse")
+	proto.RegisterType((*QueryCampaignClaimedRequest)(nil), "evmos.claims.v1.QueryCampaignClaimedRequest")
+	proto.RegisterType((*QueryCampaignClaimedResponse)(nil), "evmos.claims.v1.QueryCampaignClaimedResponse")
 }
 
 func init() { proto.RegisterFile("evmos/claims/v1/query.proto", fileDescriptor_f3bf523ec58a3aba) }
 
 var fileDescriptor_f3bf523ec58a3aba = []byte{
-	// 669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x86, 0x33, 0xfd, 0xda, 0x7c, 0x62, 0xca, 0x8f, 0x34, 0x54, 0x6d, 0x9a, 0x16, 0xa7, 0x98,
-	0xaa, 0x4d, 0x5b, 0xf0, 0xe0, 0x50, 0x2e, 0xa0, 0x89, 0x04, 0x42, 0x62, 0x51, 0x2c, 0xd8, 0xb0,
-	0x89, 0x26, 0xce, 0xc8, 0xb5, 0x48, 0x3c, 0xae, 0x67, 0x12, 0x51, 0x21, 0x24, 0xc4, 0x15, 0x80,
-	0x10, 0x12, 0xb7, 0x00, 0xb7, 0xc0, 0x96, 0x45, 0x97, 0x95, 0xd8, 0x20, 0x16, 0x05, 0xb5, 0x5c,
-	0x08, 0xf2, 0xfc, 0x84, 0x38, 0x31, 0x24, 0x9b, 0xc4, 0xf6, 0x39, 0xe7, 0x3d, 0xcf, 0x79, 0x7d,
-	0xc6, 0x70, 0x85, 0xf6, 0xbb, 0x8c, 0x63, 0xbf, 0x43, 0xc2, 0x2e, 0xc7, 0x7d, 0x17, 0x1f, 0xf6,
-	0x68, 0x72, 0xe4, 0xc4, 0x09, 0x13, 0x0c, 0x5d, 0x91, 0x41, 0x47, 0x05, 0x9d, 0xbe, 0x5b, 0xde,
-	0xf6, 0x19, 0x4f, 0xd3, 0x5b, 0x84, 0x53, 0x95, 0x89, 0xfb, 0x6e, 0x8b, 0x0a, 0xe2, 0xe2, 0x98,
-	0x04, 0x61, 0x44, 0x44, 0xc8, 0x22, 0x55, 0x5c, 0xb6, 0x86, 0x73, 0x4d, 0x96, 0xcf, 0x42, 0x13,
-	0x5f, 0x1d, 0xed, 0xac, 0xdb, 0xa8, 0xe8, 0xb5, 0xd1, 0x68, 0x40, 0x23, 0xca, 0x43, 0x13, 0x5e,
-	0x08, 0x58, 0xc0, 0xe4, 0x25, 0x4e, 0xaf, 0x8c, 0x64, 0xc0, 0x58, 0xd0, 0xa1, 0x98, 0xc4, 0x21,
-	0x26, 0x51, 0xc4, 0x84, 0xe4, 0xd1, 0x35, 0xf6, 0x2a, 0x2c, 0x3f, 0x4a, 0x91, 0x1f, 0x33, 0x41,
-	0x3a, 0x4f, 0x22, 0x29, 0x4d, 0xdb, 0x1e, 0x3d, 0xec, 0x51, 0x2e, 0xec, 0x57, 0x00, 0xae, 0xe4,
-	0x86, 0x79, 0xcc, 0x22, 0x4e, 0x11, 0x81, 0x73, 0x29, 0x3c, 0x2f, 0x81, 0xb5, 0xff, 0xaa, 0xf3,
-	0xb5, 0x65, 0x47, 0x8d, 0xe7, 0xa4, 0xe3, 0x39, 0x7a, 0x3c, 0xa7, 0xc1, 0xc2, 0xa8, 0x7e, 0xfb,
-	0xf8, 0xb4, 0x52, 0xf8, 0xf4, 0xa3, 0x52, 0x0d, 0x42, 0x71, 0xd0, 0x6b, 0x39, 0x3e, 0xeb, 0x62,
-	0xed, 0x85, 0xfa, 0xbb, 0xc5, 0xdb, 0xcf, 0xb0, 0x38, 0x8a, 0x29, 0x97, 0x05, 0xdc, 0x53, 0xca,
-	0xf6, 0x02, 0x44, 0x92, 0x60, 0x9f, 0x24, 0xa4, 0xcb, 0x0d, 0xd8, 0x43, 0x78, 0x35, 0xf3, 0x54,
-	0xf3, 0xdc, 0x85, 0xc5, 0x58, 0x3e, 0x29, 0x81, 0x35, 0x50, 0x9d, 0xaf, 0x2d, 0x39, 0x23, 0x2f,
-	0xcb, 0x51, 0x05, 0xf5, 0xd9, 0x14, 0xc7, 0xd3, 0xc9, 0xb6, 0x0f, 0x97, 0xa5, 0x5a, 0x43, 0xa6,
-	0x79, 0xd4, 0x67, 0x49, 0xdb, 0xb4, 0x42, 0xf7, 0x20, 0xfc, 0xf3, 0x1a, 0xb5, 0xee, 0x46, 0x66,
-	0x50, 0xb5, 0x1d, 0x66, 0xdc, 0x7d, 0x12, 0x50, 0x5d, 0xeb, 0x0d, 0x55, 0xda, 0x1f, 0x81, 0xb6,
-	0x7a, 0xa4, 0x8b, 0x46, 0xaf, 0xc3, 0xa2, 0xa2, 0xd4, 0x5e, 0xae, 0x8f, 0xa1, 0x0f, 0xd7, 0xed,
-	0xb5, 0xdb, 0x09, 0xe5, 0x83, 0x39, 0x54, 0x12, 0xba, 0x9f, 0x41, 0x9d, 0x91, 0xa8, 0x9b, 0x13,
-	0x51, 0x15, 0x40, 0x86, 0x75, 0x17, 0x96, 0xc6, 0x50, 0x8d, 0x1f, 0x25, 0xf8, 0x3f, 0x51, 0xdd,
-	0xa5, 0x19, 0x17, 0x3c, 0x73, 0x6b, 0x7f, 0x06, 0x39, 0x3e, 0x0e, 0x06, 0x3c, 0x80, 0xa5, 0x30,
-	0x0a, 0x45, 0x48, 0x3a, 0x4d, 0x89, 0x4b, 0x5a, 0x1d, 0xda, 0x24, 0x5d, 0xd6, 0x8b, 0x84, 0x12,
-	0xaa, 0x3b, 0xe9, 0x30, 0xdf, 0x4f, 0x2b, 0x1b, 0x53, 0xec, 0xc8, 0x83, 0x48, 0x78, 0x8b, 0x5a,
-	0xaf, 0x61, 0xe4, 0xf6, 0xa4, 0x1a, 0xda, 0x1d, 0x58, 0x39, 0x23, 0xad, 0x5c, 0xcc, 0xb7, 0x32,
-	0x6b, 0x5e, 0xed, 0xcb, 0x2c, 0x9c, 0x93, 0xf4, 0xe8, 0x3d, 0x80, 0x97, 0xb3, 0x0b, 0x8f, 0x76,
-	0xc6, 0x24, 0xfe, 0x7e, 0x6a, 0xca, 0x37, 0xa7, 0x4b, 0x56, 0xbe, 0xd8, 0xd5, 0xd7, 0x5f, 0x7f,
-	0xbd, 0x9b, 0xb1, 0xd1, 0x1a, 0x1e, 0x3d, 0xdd, 0x22, 0x2d, 0x68, 0xf6, 0x06, 0x10, 0x02, 0x16,
-	0xd5, 0xfa, 0xa2, 0x1b, 0xf9, 0x1d, 0x32, 0x67, 0xa4, 0xbc, 0xfe, 0xef, 0x24, 0xdd, 0xbe, 0x22,
-	0xdb, 0x2f, 0xa3, 0xa5, 0xb1, 0xf6, 0xea, 0x70, 0xa0, 0xb7, 0x00, 0x5e, 0xca, 0xac, 0x2c, 0xda,
-	0xce, 0x17, 0xce, 0x3b, 0x3d, 0xe5, 0x9d, 0xa9, 0x72, 0x35, 0xcb, 0xa6, 0x64, 0xb9, 0x8e, 0x2a,
-	0x38, 0xff, 0x33, 0xd8, 0x4c, 0x34, 0xc1, 0x07, 0x00, 0x2f, 0x0e, 0x4b, 0xa0, 0xad, 0xc9, 0x6d,
-	0x0c, 0xd1, 0xf6, 0x34, 0xa9, 0x1a, 0xc8, 0x95, 0x40, 0x3b, 0x68, 0x6b, 0x02, 0x10, 0x7e, 0xa1,
-	0xcf, 0xc0, 0xcb, 0x7a, 0xe3, 0xf8, 0xcc, 0x02, 0x27, 0x67, 0x16, 0xf8, 0x79, 0x66, 0x81, 0x37,
-	0xe7, 0x56, 0xe1, 0xe4, 0xdc, 0x2a, 0x7c, 0x3b, 0xb7, 0x0a, 0x4f, 0xb7, 0x86, 0xd6, 0x5a, 0xc9,
-	0xa9, 0xdf, 0xbe, 0x5b, 0xc3, 0xcf, 0x8d, 0xb4, 0xdc, 0xee, 0x56, 0x51, 0x7e, 0x9c, 0xef, 0xfc,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x85, 0x91, 0x6b, 0xab, 0x89, 0x06, 0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x33, 0xa5, 0xcd, 0xa6, 0xaf, 0xc0, 0x4a, 0x43, 0xe8, 0xa6, 0xde, 0x90, 0x14, 0xb3,
+	0xa4, 0x69, 0xba, 0xeb, 0xd9, 0x84, 0x45, 0xac, 0x40, 0x48, 0x6c, 0x22, 0x81, 0x56, 0xe2, 0xb0,
+	0x58, 0xe5, 0xc2, 0x25, 0x9a, 0xc4, 0x23, 0xaf, 0x45, 0xe2, 0xc9, 0xc6, 0x4e, 0xb4, 0x55, 0x55,
+	0xa9, 0xe2, 0x84, 0xc4, 0x05, 0x84, 0x90, 0x38, 0x72, 0x85, 0x13, 0x77, 0xfe, 0x81, 0x1e, 0x2b,
+	0x71, 0x41, 0x1c, 0x0a, 0x6a, 0xf9, 0x43, 0x90, 0xe7, 0x87, 0x1b, 0x3b, 0x6e, 0x93, 0x43, 0x2f,
+	0xad, 0xed, 0xf9, 0xbe, 0xf7, 0xfd, 0xbc, 0x67, 0xcf, 0x9b, 0xc0, 0x5d, 0x36, 0x1d, 0xf2, 0x80,
+	0xf4, 0x07, 0xd4, 0x1b, 0x06, 0x64, 0xda, 0x24, 0x2f, 0x26, 0x6c, 0x7c, 0x60, 0x8d, 0xc6, 0x3c,
+	0xe4, 0xf8, 0xb6, 0x58, 0xb4, 0xe4, 0xa2, 0x35, 0x6d, 0x1a, 0x8d, 0x3e, 0x0f, 0x22, 0x79, 0x8f,
+	0x06, 0x4c, 0x2a, 0xc9, 0xb4, 0xd9, 0x63, 0x21, 0x6d, 0x92, 0x11, 0x75, 0x3d, 0x9f, 0x86, 0x1e,
+	0xf7, 0x65, 0xb0, 0x51, 0x99, 0xd5, 0x6a, 0x55, 0x9f, 0x7b, 0x7a, 0xbd, 0x9c, 0x76, 0x56, 0x36,
+	0x72, 0xf5, 0xad, 0xf4, 0xaa, 0xcb, 0x7c, 0x16, 0x78, 0x7a, 0xb9, 0xe8, 0x72, 0x97, 0x8b, 0x4b,
+	0x12, 0x5d, 0xe9, 0x94, 0x2e, 0xe7, 0xee, 0x80, 0x11, 0x3a, 0xf2, 0x08, 0xf5, 0x7d, 0x1e, 0x0a,
+	0x1e, 0x15, 0x63, 0x96, 0xc1, 0xf8, 0x22, 0x42, 0xde, 0xe7, 0x21, 0x1d, 0x7c, 0xe9, 0x8b, 0xd4,
+	0xcc, 0xb1, 0xd9, 0x8b, 0x09, 0x0b, 0x42, 0xf3, 0x18, 0xc1, 0xdd, 0xcc, 0xe5, 0x60, 0xc4, 0xfd,
+	0x80, 0x61, 0x0a, 0x6b, 0x11, 0x7c, 0x50, 0x42, 0xdb, 0xaf, 0xd4, 0x37, 0x5a, 0x5b, 0x96, 0x2c,
+	0xcf, 0x8a, 0xca, 0xb3, 0x54, 0x79, 0x56, 0x87, 0x7b, 0x7e, 0xfb, 0xe1, 0xc9, 0x59, 0x35, 0xf7,
+	0xdb, 0x3f, 0xd5, 0xba, 0xeb, 0x85, 0xcf, 0x27, 0x3d, 0xab, 0xcf, 0x87, 0x44, 0xf5, 0x42, 0xfe,
+	0x7b, 0x10, 0x38, 0x5f, 0x93, 0xf0, 0x60, 0xc4, 0x02, 0x11, 0x10, 0xd8, 0x32, 0xb3, 0x59, 0x04,
+	0x2c, 0x08, 0x9e, 0xd1, 0x31, 0x1d, 0x06, 0x1a, 0xec, 0x73, 0x78, 0x23, 0xf1, 0x54, 0xf1, 0xbc,
+	0x0f, 0xf9, 0x91, 0x78, 0x52, 0x42, 0xdb, 0xa8, 0xbe, 0xd1, 0xba, 0x63, 0xa5, 0x5e, 0x96, 0x25,
+	0x03, 0xda, 0xab, 0x11, 0x8e, 0xad, 0xc4, 0x66, 0x1f, 0xb6, 0x44, 0xb6, 0x8e, 0x90, 0xd9, 0xac,
+	0xcf, 0xc7, 0x8e, 0xb6, 0xc2, 0x9f, 0x02, 0x5c, 0xbe, 0x46, 0x95, 0xb7, 0x96, 0x28, 0x54, 0x7e,
+	0x1d, 0xba, 0xdc, 0x67, 0xd4, 0x65, 0x2a, 0xd6, 0x9e, 0x89, 0x34, 0x7f, 0x45, 0x60, 0x64, 0xb9,
+	0x28, 0xf4, 0x36, 0xe4, 0x25, 0xa5, 0xea, 0xe5, 0xbd, 0x39, 0xf4, 0xd9, 0xb8, 0x27, 0x8e, 0x33,
+	0x66, 0x41, 0x5c, 0x87, 0x14, 0xe1, 0xcf, 0x12, 0xa8, 0x2b, 0x02, 0x75, 0x67, 0x21, 0xaa, 0x04,
+	0x48, 0xb0, 0x3e, 0x82, 0xd2, 0x1c, 0xaa, 0xee, 0x47, 0x09, 0x6e, 0x51, 0xe9, 0x2e, 0x9a, 0xb1,
+	0x6e, 0xeb, 0x5b, 0xf3, 0x0f, 0x94, 0xd1, 0xc7, 0xb8, 0xc0, 0xe7, 0x50, 0xf2, 0x7c, 0x2f, 0xf4,
+	0xe8, 0xa0, 0x2b, 0x70, 0x69, 0x6f, 0xc0, 0xba, 0x74, 0xc8, 0x27, 0x7e, 0x28, 0x13, 0xb5, 0xad,
+	0xa8, 0x98, 0xbf, 0xcf, 0xaa, 0xb5, 0x25, 0xbe, 0x91, 0xa7, 0x7e, 0x68, 0x6f, 0xaa, 0x7c, 0x1d,
+	0x9d, 0xee, 0x89, 0xc8, 0x86, 0x1f, 0xc5, 0xad, 0x5c, 0x11, 0xad, 0xdc, 0xcc, 0x6e, 0x65, 0xb2,
+	0x79, 0x66, 0x17, 0xde, 0x94, 0xf0, 0x74, 0x38, 0xa2, 0x9e, 0xeb, 0xdf, 0xf8, 0x07, 0xf0, 0x0b,
+	0x82, 0xcd, 0xb4, 0x83, 0xea, 0xcd, 0xc7, 0xb0, 0xde, 0xd7, 0x0f, 0xe3, 0xbd, 0x34, 0x07, 0xad,
+	0x14, 0x8a, 0xfb, 0x32, 0xe2, 0xe6, 0xde, 0xfb, 0x07, 0x50, 0x4c, 0x10, 0xea, 0x16, 0x54, 0x61,
+	0x43, 0xbb, 0x75, 0x3d, 0x47, 0xf4, 0x60, 0xd5, 0x06, 0xfd, 0xe8, 0xa9, 0x63, 0xee, 0xa7, 0x9a,
+	0x17, 0x57, 0xf6, 0x11, 0x14, 0xb4, 0x4c, 0xb5, 0x6e, 0x61, 0x61, 0x71, 0x80, 0xb9, 0xaf, 0xa6,
+	0x8f, 0x16, 0x74, 0x12, 0xd3, 0x69, 0x21, 0x15, 0x2e, 0xc2, 0x9a, 0xe7, 0x3b, 0xec, 0xa5, 0x68,
+	0xc9, 0xaa, 0x2d, 0x6f, 0xcc, 0xc7, 0x50, 0xce, 0xce, 0xaa, 0x90, 0x4b, 0x70, 0x4b, 0xcd, 0x39,
+	0x91, 0xb2, 0x60, 0xeb, 0xdb, 0xd6, 0xb7, 0x05, 0x58, 0x13, 0xa1, 0xf8, 0x27, 0x04, 0xaf, 0x27,
+	0x67, 0x22, 0xde, 0x9b, 0xab, 0xeb, 0xea, 0xc1, 0x6a, 0xdc, 0x5f, 0x4e, 0x2c, 0x89, 0xcc, 0xfa,
+	0x37, 0x7f, 0xfe, 0xf7, 0xe3, 0x8a, 0x89, 0xb7, 0x49, 0xfa, 0x00, 0x08, 0xa3, 0x80, 0xee, 0x24,
+	0x86, 0x08, 0x21, 0x2f, 0x27, 0x1c, 0x7e, 0x27, 0xdb, 0x21, 0x31, 0x46, 0x8d, 0x7b, 0xd7, 0x8b,
+	0x94, 0x7d, 0x55, 0xd8, 0x6f, 0xe1, 0x3b, 0x73, 0xf6, 0x72, 0x7e, 0xe2, 0x1f, 0x10, 0xbc, 0x96,
+	0x98, 0x6a, 0xb8, 0x91, 0x9d, 0x38, 0x6b, 0xc0, 0x1a, 0x7b, 0x4b, 0x69, 0x15, 0xcb, 0x8e, 0x60,
+	0x79, 0x1b, 0x57, 0x49, 0xf6, 0x49, 0xd9, 0x1d, 0x2b, 0x82, 0x9f, 0x11, 0xbc, 0x3a, 0x9b, 0x02,
+	0xef, 0x2e, 0xb6, 0xd1, 0x44, 0x8d, 0x65, 0xa4, 0x0a, 0xa8, 0x29, 0x80, 0xf6, 0xf0, 0xee, 0x02,
+	0x20, 0x72, 0xa8, 0xc6, 0xe4, 0x11, 0x3e, 0x46, 0xb0, 0x1e, 0xcf, 0x00, 0x5c, 0xbb, 0xc2, 0x2c,
+	0x35, 0x86, 0x8c, 0x9d, 0x85, 0x3a, 0x45, 0x64, 0x0a, 0xa2, 0x32, 0x36, 0xe6, 0x89, 0x62, 0xd3,
+	0xef, 0x10, 0x14, 0x74, 0x24, 0x7e, 0xf7, 0xfa, 0xcc, 0x1a, 0xa0, 0xb6, 0x48, 0xa6, 0xfc, 0x1f,
+	0x0a, 0xff, 0x06, 0xae, 0x5f, 0xed, 0x4f, 0x0e, 0x67, 0x36, 0xee, 0x11, 0xfe, 0x1d, 0xc1, 0xed,
+	0xd4, 0x6e, 0xc4, 0xf7, 0xaf, 0x77, 0x4b, 0x8e, 0x02, 0xe3, 0xc1, 0x92, 0x6a, 0x85, 0xf8, 0x89,
+	0x40, 0xfc, 0x10, 0x3f, 0x5e, 0x16, 0x91, 0xa8, 0x0d, 0x46, 0x0e, 0xc5, 0x0c, 0x39, 0x6a, 0x77,
+	0x4e, 0xce, 0x2b, 0xe8, 0xf4, 0xbc, 0x82, 0xfe, 0x3d, 0xaf, 0xa0, 0xef, 0x2f, 0x2a, 0xb9, 0xd3,
+	0x8b, 0x4a, 0xee, 0xaf, 0x8b, 0x4a, 0xee, 0xab, 0xdd, 0x99, 0xd3, 0x4b, 0x66, 0x97, 0x7f, 0xa7,
+	0xcd, 0x16, 0x79, 0xa9, 0x9d, 0xc4, 0x21, 0xd6, 0xcb, 0x8b, 0xdf, 0x60, 0xef, 0xfd, 0x3f, 0x00,
+	0xa6, 0x06, 0xa6, 0xc2, 0x70, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -478,6 +795,12 @@ type QueryClient interface {
 	ClaimsRecords(ctx context.Context, in *QueryClaimsRecordsRequest, opts ...grpc.CallOption) (*QueryClaimsRecordsResponse, error)
 	// ClaimsRecord returns the claims record for a given address
 	ClaimsRecord(ctx context.Context, in *QueryClaimsRecordRequest, opts ...grpc.CallOption) (*QueryClaimsRecordResponse, error)
+	// Campaigns returns all Merkle-root airdrop campaigns
+	Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error)
+	// Campaign returns the Merkle-root airdrop campaign for a given id
+	Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error)
+	// CampaignClaimed returns whether the leaf of a campaign has been claimed
+	CampaignClaimed(ctx context.Context, in *QueryCampaignClaimedRequest, opts ...grpc.CallOption) (*QueryCampaignClaimedResponse, error)
 }
 
 type queryClient struct {
@@ -524,6 +847,33 @@ func (c *queryClient) ClaimsRecord(ctx context.Context, in *QueryClaimsRecordReq
 	return out, nil
 }
 
+func (c *queryClient) Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error) {
+	out := new(QueryCampaignsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/Campaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error) {
+	out := new(QueryCampaignResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/Campaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CampaignClaimed(ctx context.Context, in *QueryCampaignClaimedRequest, opts ...grpc.CallOption) (*QueryCampaignClaimedResponse, error) {
+	out := new(QueryCampaignClaimedResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/CampaignClaimed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TotalUnclaimed queries the total unclaimed tokens from the airdrop
@@ -534,6 +884,12 @@ type QueryServer interface {
 	ClaimsRecords(context.Context, *QueryClaimsRecordsRequest) (*QueryClaimsRecordsResponse, error)
 	// ClaimsRecord returns the claims record for a given address
 	ClaimsRecord(context.Context, *QueryClaimsRecordRequest) (*QueryClaimsRecordResponse, error)
+	// Campaigns returns all Merkle-root airdrop campaigns
+	Campaigns(context.Context, *QueryCampaignsRequest) (*QueryCampaignsResponse, error)
+	// Campaign returns the Merkle-root airdrop campaign for a given id
+	Campaign(context.Context, *QueryCampaignRequest) (*QueryCampaignResponse, error)
+	// CampaignClaimed returns whether the leaf of a campaign has been claimed
+	CampaignClaimed(context.Context, *QueryCampaignClaimedRequest) (*QueryCampaignClaimedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -552,6 +908,15 @@ func (*UnimplementedQueryServer) ClaimsRecords(ctx context.Context, req *QueryCl
 func (*UnimplementedQueryServer) ClaimsRecord(ctx context.Context, req *QueryClaimsRecordRequest) (*QueryClaimsRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimsRecord not implemented")
 }
+func (*UnimplementedQueryServer) Campaigns(ctx context.Context, req *QueryCampaignsRequest) (*QueryCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaigns not implemented")
+}
+func (*UnimplementedQueryServer) Campaign(ctx context.Context, req *QueryCampaignRequest) (*QueryCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaign not implemented")
+}
+func (*UnimplementedQueryServer) CampaignClaimed(ctx context.Context, req *QueryCampaignClaimedRequest) (*QueryCampaignClaimedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CampaignClaimed not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -629,6 +994,60 @@ func _Query_ClaimsRecord_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Campaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/Campaigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaigns(ctx, req.(*QueryCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/Campaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaign(ctx, req.(*QueryCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CampaignClaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignClaimedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CampaignClaimed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/CampaignClaimed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CampaignClaimed(ctx, req.(*QueryCampaignClaimedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.claims.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -649,6 +1068,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimsRecord",
 			Handler:    _Query_ClaimsRecord_Handler,
 		},
+		{
+			MethodName: "Campaigns",
+			Handler:    _Query_Campaigns_Handler,
+		},
+		{
+			MethodName: "Campaign",
+			Handler:    _Query_Campaign_Handler,
+		},
+		{
+			MethodName: "CampaignClaimed",
+			Handler:    _Query_CampaignClaimed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/claims/v1/query.proto",
@@ -931,23 +1362,234 @@ func (m *QueryClaimsRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCampaignsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTotalUnclaimedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *QueryCampaignsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignClaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignClaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimed {
+		i--
+		if m.Claimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTotalUnclaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -1048,6 +1690,88 @@ func (m *QueryClaimsRecordResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCampaignsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *QueryCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Campaign.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCampaignClaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryCampaignClaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claimed {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1727,6 +2451,522 @@ func (m *QueryClaimsRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCampaignsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignClaimedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignClaimedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignClaimedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignClaimedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Claimed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -33,6 +33,11 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
 	k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
+		// Paused epochs don't advance until they are resumed
+		if epochInfo.Paused {
+			return false
+		}
+
 		// Has it not started, and is the block time > initial epoch start time
 		shouldInitialEpochStart := !epochInfo.EpochCountingStarted && !epochInfo.StartTime.After(ctx.BlockTime())
 
@@ -21,7 +21,10 @@ import (
 	"github.com/evmos/evmos/v12/x/epochs/types"
 )
 
-var _ types.EpochHooks = MultiEpochHooks{}
+var (
+	_ types.EpochHooks         = MultiEpochHooks{}
+	_ types.EpochScheduleHooks = MultiEpochScheduleHooks{}
+)
 
 // combine multiple epoch hooks, all hook functions are run in array sequence
 type MultiEpochHooks []types.EpochHooks
@@ -46,6 +49,31 @@ func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier stri
 	}
 }
 
+// combine multiple epoch schedule hooks, all hook functions are run in array sequence
+type MultiEpochScheduleHooks []types.EpochScheduleHooks
+
+func NewMultiEpochScheduleHooks(hooks ...types.EpochScheduleHooks) MultiEpochScheduleHooks {
+	return hooks
+}
+
+// AfterEpochScheduleChanged is called after the schedule of an epoch is changed
+func (mh MultiEpochScheduleHooks) AfterEpochScheduleChanged(ctx sdk.Context, epochInfo types.EpochInfo, change types.ScheduleChange) {
+	for i := range mh {
+		mh[i].AfterEpochScheduleChanged(ctx, epochInfo, change)
+	}
+}
+
+// BeforeEpochDeleted is called before an epoch is deleted. It returns the
+// first error returned by the hooks
+func (mh MultiEpochScheduleHooks) BeforeEpochDeleted(ctx sdk.Context, epochIdentifier string) error {
+	for i := range mh {
+		if err := mh[i].BeforeEpochDeleted(ctx, epochIdentifier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // AfterEpochEnd executes the indicated hook after epochs ends
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
 	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
@@ -55,3 +83,20 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber in
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
 	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
 }
+
+// AfterEpochScheduleChanged executes the indicated hook after the schedule of
+// an epoch is changed
+func (k Keeper) AfterEpochScheduleChanged(ctx sdk.Context, epochInfo types.EpochInfo, change types.ScheduleChange) {
+	if k.scheduleHooks == nil {
+		return
+	}
+	k.scheduleHooks.AfterEpochScheduleChanged(ctx, epochInfo, change)
+}
+
+// BeforeEpochDeleted executes the indicated hook before an epoch is deleted
+func (k Keeper) BeforeEpochDeleted(ctx sdk.Context, identifier string) error {
+	if k.scheduleHooks == nil {
+		return nil
+	}
+	return k.scheduleHooks.BeforeEpochDeleted(ctx, identifier)
+}
@@ -31,14 +31,23 @@ import (
 type Keeper struct {
 	cdc      codec.Codec
 	storeKey storetypes.StoreKey
-	hooks    types.EpochHooks
+	// the address capable of executing the epoch management messages. Typically, this should be the x/gov module account.
+	authority     sdk.AccAddress
+	hooks         types.EpochHooks
+	scheduleHooks types.EpochScheduleHooks
 }
 
 // NewKeeper returns a new instance of epochs Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, authority sdk.AccAddress) *Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
 	}
 }
 
@@ -53,6 +62,17 @@ func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 	return k
 }
 
+// SetScheduleHooks set the epoch schedule hooks
+func (k *Keeper) SetScheduleHooks(sh types.EpochScheduleHooks) *Keeper {
+	if k.scheduleHooks != nil {
+		panic("cannot set epochs schedule hooks twice")
+	}
+
+	k.scheduleHooks = sh
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
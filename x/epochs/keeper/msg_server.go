@@ -0,0 +1,226 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v12/x/epochs/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// CreateEpoch implements the gRPC MsgServer interface. When a CreateEpoch
+// proposal passes, it creates a new epoch that starts counting at the given
+// start time, or at the current block time if it is in the past. The update
+// can only be performed if the requested authority is the Cosmos SDK
+// governance module account.
+func (k Keeper) CreateEpoch(goCtx context.Context, req *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochAlreadyExists, "identifier %s", req.Identifier)
+	}
+
+	startTime := req.StartTime
+	if startTime.Before(ctx.BlockTime()) {
+		startTime = ctx.BlockTime()
+	}
+
+	epochInfo := types.EpochInfo{
+		Identifier:              req.Identifier,
+		StartTime:               startTime,
+		Duration:                req.Duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		EpochCountingStarted:    false,
+	}
+
+	k.SetEpochInfo(ctx, epochInfo)
+	k.AfterEpochScheduleChanged(ctx, epochInfo, types.ScheduleChangeCreate)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+			sdk.NewAttribute(types.AttributeEpochDuration, req.Duration.String()),
+			sdk.NewAttribute(types.AttributeEpochStartTime, startTime.String()),
+		),
+	)
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// UpdateEpochDuration implements the gRPC MsgServer interface. When an
+// UpdateEpochDuration proposal passes, it updates the duration of an epoch.
+// The new duration already applies to the current epoch. The update can only
+// be performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k Keeper) UpdateEpochDuration(goCtx context.Context, req *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochInfo, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	epochInfo.Duration = req.Duration
+
+	k.SetEpochInfo(ctx, epochInfo)
+	k.AfterEpochScheduleChanged(ctx, epochInfo, types.ScheduleChangeUpdateDuration)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateEpochDuration,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+			sdk.NewAttribute(types.AttributeEpochDuration, req.Duration.String()),
+		),
+	)
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+// PauseEpoch implements the gRPC MsgServer interface. When a PauseEpoch
+// proposal passes, it pauses an epoch so that it doesn't end nor start until
+// it is resumed. The update can only be performed if the requested authority
+// is the Cosmos SDK governance module account.
+func (k Keeper) PauseEpoch(goCtx context.Context, req *types.MsgPauseEpoch) (*types.MsgPauseEpochResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochInfo, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	if epochInfo.Paused {
+		return nil, errorsmod.Wrapf(types.ErrEpochPaused, "identifier %s", req.Identifier)
+	}
+
+	epochInfo.Paused = true
+
+	k.SetEpochInfo(ctx, epochInfo)
+	k.AfterEpochScheduleChanged(ctx, epochInfo, types.ScheduleChangePause)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePauseEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+		),
+	)
+
+	return &types.MsgPauseEpochResponse{}, nil
+}
+
+// ResumeEpoch implements the gRPC MsgServer interface. When a ResumeEpoch
+// proposal passes, it resumes a paused epoch. If the epoch counting already
+// started, the current epoch restarts at the current block time, so that the
+// epochs missed while paused are not caught up. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k Keeper) ResumeEpoch(goCtx context.Context, req *types.MsgResumeEpoch) (*types.MsgResumeEpochResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochInfo, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	if !epochInfo.Paused {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotPaused, "identifier %s", req.Identifier)
+	}
+
+	epochInfo.Paused = false
+	if epochInfo.EpochCountingStarted {
+		epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+		epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
+	}
+
+	k.SetEpochInfo(ctx, epochInfo)
+	k.AfterEpochScheduleChanged(ctx, epochInfo, types.ScheduleChangeResume)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResumeEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+		),
+	)
+
+	return &types.MsgResumeEpochResponse{}, nil
+}
+
+// DeleteEpoch implements the gRPC MsgServer interface. When a DeleteEpoch
+// proposal passes, it deletes an epoch. The deletion fails if a module still
+// references the epoch identifier, e.g. in its parameters. The update can
+// only be performed if the requested authority is the Cosmos SDK governance
+// module account.
+func (k Keeper) DeleteEpoch(goCtx context.Context, req *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	if err := k.BeforeEpochDeleted(ctx, req.Identifier); err != nil {
+		return nil, err
+	}
+
+	k.DeleteEpochInfo(ctx, req.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+		),
+	)
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
+
+// validateAuthority checks that the authority is the governance module account
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), authority)
+	}
+	return nil
+}
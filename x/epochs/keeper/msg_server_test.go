@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v12/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgCreateEpoch
+		expectErr bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgCreateEpoch{Authority: "invalid", Identifier: "month", Duration: time.Hour},
+			true,
+		},
+		{
+			"fail - epoch already exists",
+			&types.MsgCreateEpoch{Authority: authority, Identifier: types.DayEpochID, Duration: time.Hour},
+			true,
+		},
+		{
+			"pass - start time in the past is set to the block time",
+			&types.MsgCreateEpoch{Authority: authority, Identifier: "month", Duration: time.Hour},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, err := suite.app.EpochsKeeper.CreateEpoch(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, tc.request.Identifier)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.request.Duration, epochInfo.Duration)
+			suite.Require().Equal(suite.ctx.BlockTime(), epochInfo.StartTime)
+			suite.Require().False(epochInfo.EpochCountingStarted)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpochDuration() {
+	suite.SetupTest()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.app.EpochsKeeper.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{
+		Authority: authority, Identifier: "month", Duration: time.Hour,
+	})
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	_, err = suite.app.EpochsKeeper.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{
+		Authority: authority, Identifier: types.DayEpochID, Duration: time.Hour,
+	})
+	suite.Require().NoError(err)
+
+	epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DayEpochID)
+	suite.Require().True(found)
+	suite.Require().Equal(time.Hour, epochInfo.Duration)
+}
+
+func (suite *KeeperTestSuite) TestPauseAndResumeEpoch() {
+	suite.SetupTest()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// start the epoch counting
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DayEpochID)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+
+	_, err := suite.app.EpochsKeeper.ResumeEpoch(sdk.WrapSDKContext(suite.ctx), &types.MsgResumeEpoch{
+		Authority: authority, Identifier: types.DayEpochID,
+	})
+	suite.Require().ErrorIs(err, types.ErrEpochNotPaused)
+
+	_, err = suite.app.EpochsKeeper.PauseEpoch(sdk.WrapSDKContext(suite.ctx), &types.MsgPauseEpoch{
+		Authority: authority, Identifier: types.DayEpochID,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.app.EpochsKeeper.PauseEpoch(sdk.WrapSDKContext(suite.ctx), &types.MsgPauseEpoch{
+		Authority: authority, Identifier: types.DayEpochID,
+	})
+	suite.Require().ErrorIs(err, types.ErrEpochPaused)
+
+	// the paused epoch doesn't end
+	suite.ctx = suite.ctx.WithBlockHeight(2).WithBlockTime(suite.ctx.BlockTime().Add(48 * time.Hour))
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, found = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DayEpochID)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+
+	_, err = suite.app.EpochsKeeper.ResumeEpoch(sdk.WrapSDKContext(suite.ctx), &types.MsgResumeEpoch{
+		Authority: authority, Identifier: types.DayEpochID,
+	})
+	suite.Require().NoError(err)
+
+	// the current epoch restarts at the resume time
+	epochInfo, found = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DayEpochID)
+	suite.Require().True(found)
+	suite.Require().False(epochInfo.Paused)
+	suite.Require().Equal(suite.ctx.BlockTime(), epochInfo.CurrentEpochStartTime)
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpochStartHeight)
+
+	suite.ctx = suite.ctx.WithBlockHeight(3).WithBlockTime(suite.ctx.BlockTime().Add(24*time.Hour + time.Second))
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, found = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DayEpochID)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch)
+}
+
+func (suite *KeeperTestSuite) TestDeleteEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name       string
+		identifier string
+		malleate   func()
+		expectErr  bool
+	}{
+		{
+			"fail - epoch not found",
+			"month",
+			func() {},
+			true,
+		},
+		{
+			"fail - epoch used by inflation",
+			types.DayEpochID,
+			func() {},
+			true,
+		},
+		{
+			"fail - epoch used by incentives",
+			types.WeekEpochID,
+			func() {},
+			true,
+		},
+		{
+			"pass - unused epoch",
+			"month",
+			func() {
+				_, err := suite.app.EpochsKeeper.CreateEpoch(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateEpoch{
+					Authority: authority, Identifier: "month", Duration: 30 * 24 * time.Hour,
+				})
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			_, err := suite.app.EpochsKeeper.DeleteEpoch(sdk.WrapSDKContext(suite.ctx), &types.MsgDeleteEpoch{
+				Authority: authority, Identifier: tc.identifier,
+			})
+			_, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, tc.identifier)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().False(found)
+		})
+	}
+}
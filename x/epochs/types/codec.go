@@ -19,6 +19,53 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+var (
+	amino = codec.NewLegacyAmino()
+	// ModuleCdc references the global epochs module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	createEpochName         = "evmos/epochs/MsgCreateEpoch"
+	updateEpochDurationName = "evmos/epochs/MsgUpdateEpochDuration"
+	pauseEpochName          = "evmos/epochs/MsgPauseEpoch"
+	resumeEpochName         = "evmos/epochs/MsgResumeEpoch"
+	deleteEpochName         = "evmos/epochs/MsgDeleteEpoch"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces registers the client interfaces to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateEpoch{},
+		&MsgUpdateEpochDuration{},
+		&MsgPauseEpoch{},
+		&MsgResumeEpoch{},
+		&MsgDeleteEpoch{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateEpoch{}, createEpochName, nil)
+	cdc.RegisterConcrete(&MsgUpdateEpochDuration{}, updateEpochDurationName, nil)
+	cdc.RegisterConcrete(&MsgPauseEpoch{}, pauseEpochName, nil)
+	cdc.RegisterConcrete(&MsgResumeEpoch{}, resumeEpochName, nil)
+	cdc.RegisterConcrete(&MsgDeleteEpoch{}, deleteEpochName, nil)
+}
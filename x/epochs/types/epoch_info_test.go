@@ -46,6 +46,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				false,
 			},
 			false,
 		},
@@ -59,6 +60,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				false,
 			},
 			false,
 		},
@@ -72,6 +74,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				false,
 			},
 			false,
 		},
@@ -85,6 +88,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				-1,
+				false,
 			},
 			false,
 		},
@@ -98,6 +102,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				false,
 			},
 			true,
 		},
@@ -21,6 +21,14 @@ const (
 	EventTypeEpochEnd   = "epoch_end"
 	EventTypeEpochStart = "epoch_start"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	EventTypeCreateEpoch         = "create_epoch"
+	EventTypeUpdateEpochDuration = "update_epoch_duration"
+	EventTypePauseEpoch          = "pause_epoch"
+	EventTypeResumeEpoch         = "resume_epoch"
+	EventTypeDeleteEpoch         = "delete_epoch"
+
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "identifier"
+	AttributeEpochDuration   = "duration"
 )
@@ -45,6 +45,8 @@ type EpochInfo struct {
 	EpochCountingStarted bool `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	// current_epoch_start_height of the epoch
 	CurrentEpochStartHeight int64 `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// paused defines if the epoch is paused, in which case it doesn't advance
+	Paused bool `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -129,6 +131,13 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	// epochs is a slice of EpochInfo that defines the epochs in the genesis state
@@ -183,36 +192,37 @@ func init() {
 func init() { proto.RegisterFile("evmos/epochs/v1/genesis.proto", fileDescriptor_c74bc0b3e7fa01c2) }
 
 var fileDescriptor_c74bc0b3e7fa01c2 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xae, 0x69, 0x29, 0x57, 0x73, 0xe8, 0x84, 0x75, 0x40, 0xa8, 0x74, 0x4e, 0x14, 0x96, 0x22,
-	0x90, 0xad, 0x1e, 0x0c, 0x08, 0xb6, 0x1e, 0x88, 0x63, 0x4d, 0x19, 0x10, 0x4b, 0x95, 0xb6, 0xae,
-	0x63, 0xe9, 0x12, 0x47, 0xc9, 0x4b, 0x45, 0x37, 0x66, 0xa6, 0x1b, 0xf9, 0x49, 0x37, 0xde, 0xc8,
-	0x54, 0x50, 0xbb, 0x31, 0xde, 0x2f, 0x40, 0xb1, 0x93, 0x52, 0xae, 0x20, 0x96, 0x28, 0x7e, 0xdf,
-	0xf7, 0xbe, 0xcf, 0xdf, 0xd3, 0x33, 0x3e, 0x12, 0xf3, 0x58, 0xe7, 0x5c, 0xa4, 0x7a, 0x12, 0xe5,
-	0x7c, 0xde, 0xe7, 0x52, 0x24, 0x22, 0x57, 0x39, 0x4b, 0x33, 0x0d, 0x9a, 0x1c, 0x18, 0x98, 0x59,
-	0x98, 0xcd, 0xfb, 0xdd, 0x43, 0xa9, 0xa5, 0x36, 0x18, 0x2f, 0xff, 0x2c, 0xad, 0x4b, 0xa5, 0xd6,
-	0xf2, 0x4c, 0x70, 0x73, 0x1a, 0x17, 0x33, 0x3e, 0x2d, 0xb2, 0x10, 0x94, 0x4e, 0x2a, 0xdc, 0xbd,
-	0x8e, 0x83, 0x8a, 0x45, 0x0e, 0x61, 0x9c, 0x5a, 0x82, 0xff, 0xa5, 0x85, 0x3b, 0x6f, 0x4a, 0x93,
-	0x77, 0xc9, 0x4c, 0x13, 0x8a, 0xb1, 0x9a, 0x8a, 0x04, 0xd4, 0x4c, 0x89, 0xcc, 0x41, 0x1e, 0xea,
-	0x75, 0x82, 0xad, 0x0a, 0xf9, 0x80, 0x71, 0x0e, 0x61, 0x06, 0xa3, 0x52, 0xc6, 0xb9, 0xe1, 0xa1,
-	0xde, 0xed, 0xe3, 0x2e, 0xb3, 0x1e, 0xac, 0xf6, 0x60, 0xef, 0x6b, 0x8f, 0xc1, 0xd1, 0xc5, 0xd2,
-	0x6d, 0x5c, 0x2d, 0xdd, 0xbb, 0x8b, 0x30, 0x3e, 0x7b, 0xe9, 0xff, 0xee, 0xf5, 0xcf, 0xbf, 0xbb,
-	0x28, 0xe8, 0x98, 0x42, 0x49, 0x27, 0x11, 0xde, 0xab, 0xaf, 0xee, 0x34, 0x8d, 0xee, 0xc3, 0x1d,
-	0xdd, 0xd7, 0x15, 0x61, 0xd0, 0x2f, 0x65, 0x7f, 0x2e, 0x5d, 0x52, 0xb7, 0x3c, 0xd5, 0xb1, 0x02,
-	0x11, 0xa7, 0xb0, 0xb8, 0x5a, 0xba, 0x07, 0xd6, 0xac, 0xc6, 0xfc, 0xaf, 0xa5, 0xd5, 0x46, 0x9d,
-	0x3c, 0xc2, 0x77, 0x26, 0x45, 0x96, 0x89, 0x04, 0x46, 0x66, 0xba, 0x4e, 0xcb, 0x43, 0xbd, 0x66,
-	0xb0, 0x5f, 0x15, 0xcd, 0x30, 0xc8, 0x67, 0x84, 0x9d, 0x3f, 0x58, 0xa3, 0xad, 0xdc, 0x37, 0xff,
-	0x9b, 0xfb, 0x49, 0x95, 0xdb, 0xb5, 0x57, 0xf9, 0x97, 0x92, 0x9d, 0xc2, 0xbd, 0x6d, 0xe7, 0xe1,
-	0x66, 0x22, 0xcf, 0xf1, 0x7d, 0xcb, 0x9f, 0xe8, 0x22, 0x01, 0x95, 0x48, 0xdb, 0x28, 0xa6, 0x4e,
-	0xdb, 0x43, 0xbd, 0xbd, 0xe0, 0xd0, 0xa0, 0x27, 0x15, 0x38, 0xb4, 0x18, 0x79, 0x85, 0xbb, 0x7f,
-	0x73, 0x8b, 0x84, 0x92, 0x11, 0x38, 0xb7, 0x4c, 0xd4, 0x07, 0x3b, 0x86, 0xa7, 0x06, 0xf6, 0x4f,
-	0xf1, 0xfe, 0x5b, 0xbb, 0x85, 0x43, 0x08, 0x41, 0x90, 0x17, 0xb8, 0x6d, 0x17, 0xd0, 0x41, 0x5e,
-	0xd3, 0x44, 0xbe, 0xb6, 0x95, 0x6c, 0xb3, 0x3a, 0x83, 0x56, 0x19, 0x39, 0xa8, 0xf8, 0x83, 0x93,
-	0x8b, 0x15, 0x45, 0x97, 0x2b, 0x8a, 0x7e, 0xac, 0x28, 0x3a, 0x5f, 0xd3, 0xc6, 0xe5, 0x9a, 0x36,
-	0xbe, 0xad, 0x69, 0xe3, 0xe3, 0x63, 0xa9, 0x20, 0x2a, 0xc6, 0x6c, 0xa2, 0x63, 0x5e, 0x3d, 0x01,
-	0xf3, 0x9d, 0xf7, 0x8f, 0xf9, 0xa7, 0xfa, 0x39, 0xc0, 0x22, 0x15, 0xf9, 0xb8, 0x6d, 0x26, 0xfb,
-	0xec, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x43, 0x38, 0x3f, 0x5f, 0x2b, 0x03, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x91, 0x10, 0x92, 0xa3, 0xa8, 0xe2, 0x54, 0xca, 0x11, 0xa9, 0xb6, 0x65, 0x96, 0x20,
+	0x90, 0xad, 0x14, 0x06, 0x04, 0x5b, 0x0a, 0xa2, 0xac, 0x0e, 0x03, 0x62, 0x89, 0x9c, 0xe4, 0x62,
+	0x9f, 0x54, 0xfb, 0x2c, 0xdf, 0x73, 0x44, 0x36, 0x7e, 0x42, 0x47, 0x66, 0x7e, 0x4d, 0xc7, 0x8e,
+	0x4c, 0x06, 0x25, 0x1b, 0x63, 0x7f, 0x01, 0xf2, 0xdd, 0x39, 0x84, 0x06, 0xd4, 0xc5, 0xf2, 0xbd,
+	0xef, 0x7b, 0xdf, 0x77, 0xef, 0xd3, 0x3b, 0x7c, 0xc4, 0x16, 0x89, 0x90, 0x3e, 0xcb, 0xc4, 0x34,
+	0x96, 0xfe, 0x62, 0xe0, 0x47, 0x2c, 0x65, 0x92, 0x4b, 0x2f, 0xcb, 0x05, 0x08, 0xb2, 0xaf, 0x60,
+	0x4f, 0xc3, 0xde, 0x62, 0xd0, 0x3b, 0x88, 0x44, 0x24, 0x14, 0xe6, 0x57, 0x7f, 0x9a, 0xd6, 0xb3,
+	0x22, 0x21, 0xa2, 0x33, 0xe6, 0xab, 0xd3, 0xa4, 0x98, 0xfb, 0xb3, 0x22, 0x0f, 0x81, 0x8b, 0xd4,
+	0xe0, 0xf6, 0x75, 0x1c, 0x78, 0xc2, 0x24, 0x84, 0x49, 0xa6, 0x09, 0xee, 0xb7, 0x16, 0xee, 0xbe,
+	0xad, 0x4c, 0xde, 0xa7, 0x73, 0x41, 0x2c, 0x8c, 0xf9, 0x8c, 0xa5, 0xc0, 0xe7, 0x9c, 0xe5, 0x14,
+	0x39, 0xa8, 0xdf, 0x0d, 0xb6, 0x2a, 0xe4, 0x23, 0xc6, 0x12, 0xc2, 0x1c, 0xc6, 0x95, 0x0c, 0xbd,
+	0xe5, 0xa0, 0xfe, 0xdd, 0xe3, 0x9e, 0xa7, 0x3d, 0xbc, 0xda, 0xc3, 0xfb, 0x50, 0x7b, 0x0c, 0x8f,
+	0x2e, 0x4a, 0xbb, 0x71, 0x55, 0xda, 0xf7, 0x97, 0x61, 0x72, 0xf6, 0xca, 0xfd, 0xd3, 0xeb, 0x9e,
+	0xff, 0xb0, 0x51, 0xd0, 0x55, 0x85, 0x8a, 0x4e, 0x62, 0xdc, 0xa9, 0xaf, 0x4e, 0x9b, 0x4a, 0xf7,
+	0xd1, 0x8e, 0xee, 0x1b, 0x43, 0x18, 0x0e, 0x2a, 0xd9, 0x5f, 0xa5, 0x4d, 0xea, 0x96, 0x67, 0x22,
+	0xe1, 0xc0, 0x92, 0x0c, 0x96, 0x57, 0xa5, 0xbd, 0xaf, 0xcd, 0x6a, 0xcc, 0xfd, 0x5a, 0x59, 0x6d,
+	0xd4, 0xc9, 0x63, 0x7c, 0x6f, 0x5a, 0xe4, 0x39, 0x4b, 0x61, 0xac, 0xd2, 0xa5, 0x2d, 0x07, 0xf5,
+	0x9b, 0xc1, 0x9e, 0x29, 0xaa, 0x30, 0xc8, 0x17, 0x84, 0xe9, 0x5f, 0xac, 0xf1, 0xd6, 0xdc, 0xb7,
+	0x6f, 0x9c, 0xfb, 0xa9, 0x99, 0xdb, 0xd6, 0x57, 0xf9, 0x9f, 0x92, 0x4e, 0xe1, 0xc1, 0xb6, 0xf3,
+	0x68, 0x93, 0xc8, 0x0b, 0x7c, 0xa8, 0xf9, 0x53, 0x51, 0xa4, 0xc0, 0xd3, 0x48, 0x37, 0xb2, 0x19,
+	0x6d, 0x3b, 0xa8, 0xdf, 0x09, 0x0e, 0x14, 0x7a, 0x62, 0xc0, 0x91, 0xc6, 0xc8, 0x6b, 0xdc, 0xfb,
+	0x97, 0x5b, 0xcc, 0x78, 0x14, 0x03, 0xbd, 0xa3, 0x46, 0x7d, 0xb8, 0x63, 0x78, 0xaa, 0x60, 0x72,
+	0x88, 0xdb, 0x59, 0x58, 0x48, 0x36, 0xa3, 0x1d, 0x65, 0x61, 0x4e, 0xee, 0x29, 0xde, 0x7b, 0xa7,
+	0xb7, 0x73, 0x04, 0x21, 0x30, 0xf2, 0x12, 0xb7, 0xf5, 0x62, 0x52, 0xe4, 0x34, 0x55, 0x14, 0xd7,
+	0xb6, 0xd5, 0xdb, 0xac, 0xd4, 0xb0, 0x55, 0x45, 0x11, 0x18, 0xfe, 0xf0, 0xe4, 0x62, 0x65, 0xa1,
+	0xcb, 0x95, 0x85, 0x7e, 0xae, 0x2c, 0x74, 0xbe, 0xb6, 0x1a, 0x97, 0x6b, 0xab, 0xf1, 0x7d, 0x6d,
+	0x35, 0x3e, 0x3d, 0x89, 0x38, 0xc4, 0xc5, 0xc4, 0x9b, 0x8a, 0xc4, 0x37, 0x4f, 0x43, 0x7d, 0x17,
+	0x83, 0x63, 0xff, 0x73, 0xfd, 0x4c, 0x60, 0x99, 0x31, 0x39, 0x69, 0xab, 0xc4, 0x9f, 0xff, 0x1e,
+	0x00, 0x5a, 0x31, 0x4f, 0xaf, 0x43, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -235,6 +245,16 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -362,6 +382,9 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -604,6 +627,26 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -25,3 +25,25 @@ type EpochHooks interface {
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
 }
+
+// EpochScheduleHooks defines the hooks notified of the changes of the epochs
+// schedule made at runtime
+type EpochScheduleHooks interface {
+	// AfterEpochScheduleChanged is called after an epoch is created, its duration
+	// updated, or it is paused or resumed
+	AfterEpochScheduleChanged(ctx sdk.Context, epochInfo EpochInfo, change ScheduleChange)
+	// BeforeEpochDeleted is called before an epoch is deleted. It returns an
+	// error to abort the deletion if the module still references the epoch
+	BeforeEpochDeleted(ctx sdk.Context, epochIdentifier string) error
+}
+
+// ScheduleChange defines the type of change of the epochs schedule
+type ScheduleChange string
+
+// epochs schedule changes
+const (
+	ScheduleChangeCreate         ScheduleChange = "create"
+	ScheduleChangeUpdateDuration ScheduleChange = "update_duration"
+	ScheduleChangePause          ScheduleChange = "pause"
+	ScheduleChangeResume         ScheduleChange = "resume"
+)
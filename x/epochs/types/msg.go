@@ -0,0 +1,147 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateEpoch{}
+	_ sdk.Msg = &MsgUpdateEpochDuration{}
+	_ sdk.Msg = &MsgPauseEpoch{}
+	_ sdk.Msg = &MsgResumeEpoch{}
+	_ sdk.Msg = &MsgDeleteEpoch{}
+)
+
+// GetSigners returns the expected signers for a MsgCreateEpoch message.
+func (m *MsgCreateEpoch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+
+	return validateDuration(m.Duration)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateEpochDuration message.
+func (m *MsgUpdateEpochDuration) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateEpochDuration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+
+	return validateDuration(m.Duration)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateEpochDuration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgPauseEpoch message.
+func (m *MsgPauseEpoch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgPauseEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgPauseEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResumeEpoch message.
+func (m *MsgResumeEpoch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgResumeEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResumeEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgDeleteEpoch message.
+func (m *MsgDeleteEpoch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeleteEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+func validateDuration(duration time.Duration) error {
+	if duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDuration, "duration must be positive, got %s", duration)
+	}
+	return nil
+}
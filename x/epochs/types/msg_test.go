@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateEpochValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgCreateEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgCreateEpoch{Authority: "invalid", Identifier: "month", Duration: time.Hour},
+			false,
+		},
+		{
+			"fail - invalid identifier",
+			&MsgCreateEpoch{Authority: authority, Identifier: " ", Duration: time.Hour},
+			false,
+		},
+		{
+			"fail - zero duration",
+			&MsgCreateEpoch{Authority: authority, Identifier: "month"},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgCreateEpoch{Authority: authority, Identifier: "month", StartTime: time.Now(), Duration: time.Hour},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateEpochDurationValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateEpochDuration
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUpdateEpochDuration{Authority: "invalid", Identifier: DayEpochID, Duration: time.Hour},
+			false,
+		},
+		{
+			"fail - negative duration",
+			&MsgUpdateEpochDuration{Authority: authority, Identifier: DayEpochID, Duration: -time.Hour},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgUpdateEpochDuration{Authority: authority, Identifier: DayEpochID, Duration: time.Hour},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
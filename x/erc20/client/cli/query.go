@@ -40,6 +40,7 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
 		GetParamsCmd(),
+		GetConversionLimitCmd(),
 	)
 	return cmd
 }
@@ -142,3 +143,35 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetConversionLimitCmd queries the conversion limit of a registered token pair
+func GetConversionLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-limit TOKEN",
+		Short: "Get the conversion limit of a registered token pair",
+		Long:  "Get the conversion limit of a registered token pair and the amounts converted within its current window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConversionLimitRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.ConversionLimit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -50,12 +50,22 @@ func InitGenesis(
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
 	}
+
+	for _, limit := range data.ConversionLimits {
+		k.SetConversionLimit(ctx, limit)
+	}
+
+	for _, legacyContract := range data.LegacyContracts {
+		k.SetLegacyContract(ctx, legacyContract)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
+		Params:           k.GetParams(ctx),
+		TokenPairs:       k.GetTokenPairs(ctx),
+		ConversionLimits: k.GetConversionLimits(ctx),
+		LegacyContracts:  k.GetLegacyContracts(ctx),
 	}
 }
@@ -94,6 +94,12 @@ func (suite *GenesisTestSuite) TestERC20InitGenesis() {
 						Enabled:       true,
 						ContractOwner: types.OWNER_MODULE,
 					},
+				},
+				[]types.ConversionLimit{
+					types.NewConversionLimit("coin", "day", math.NewInt(1000), math.ZeroInt()),
+				},
+				[]types.LegacyContract{
+					{Erc20Address: "0xB8c77482e45F1F44dE1745F52C74426C631bDD52", Denom: "coin"},
 				}),
 			false,
 		},
@@ -119,6 +125,12 @@ func (suite *GenesisTestSuite) TestERC20InitGenesis() {
 		} else {
 			suite.Require().Len(tc.genesisState.TokenPairs, 0)
 		}
+
+		limits := suite.app.Erc20Keeper.GetConversionLimits(suite.ctx)
+		suite.Require().Len(limits, len(tc.genesisState.ConversionLimits))
+
+		legacyContracts := suite.app.Erc20Keeper.GetLegacyContracts(suite.ctx)
+		suite.Require().Len(legacyContracts, len(tc.genesisState.LegacyContracts))
 	}
 }
 
@@ -145,6 +157,12 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 						Enabled:       true,
 						ContractOwner: types.OWNER_MODULE,
 					},
+				},
+				[]types.ConversionLimit{
+					types.NewConversionLimit("coin", "day", math.NewInt(1000), math.ZeroInt()),
+				},
+				[]types.LegacyContract{
+					{Erc20Address: "0xB8c77482e45F1F44dE1745F52C74426C631bDD52", Denom: "coin"},
 				}),
 		},
 	}
@@ -163,6 +181,8 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 			} else {
 				suite.Require().Len(genesisExported.TokenPairs, 0)
 			}
+			suite.Require().Len(genesisExported.ConversionLimits, len(tc.genesisState.ConversionLimits))
+			suite.Require().Len(genesisExported.LegacyContracts, len(tc.genesisState.LegacyContracts))
 		})
 		// }
 	}
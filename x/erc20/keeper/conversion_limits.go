@@ -0,0 +1,207 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+// GetConversionLimits returns all the conversion limits
+func (k Keeper) GetConversionLimits(ctx sdk.Context) []types.ConversionLimit {
+	limits := []types.ConversionLimit{}
+
+	k.IterateConversionLimits(ctx, func(limit types.ConversionLimit) (stop bool) {
+		limits = append(limits, limit)
+		return false
+	})
+
+	return limits
+}
+
+// IterateConversionLimits iterates over all the stored conversion limits
+func (k Keeper) IterateConversionLimits(ctx sdk.Context, cb func(limit types.ConversionLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixConversionLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limit types.ConversionLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &limit)
+
+		if cb(limit) {
+			break
+		}
+	}
+}
+
+// GetConversionLimit returns the conversion limit of the given denomination
+func (k Keeper) GetConversionLimit(ctx sdk.Context, denom string) (types.ConversionLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionLimit)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.ConversionLimit{}, false
+	}
+
+	var limit types.ConversionLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+	return limit, true
+}
+
+// SetConversionLimit stores a conversion limit
+func (k Keeper) SetConversionLimit(ctx sdk.Context, limit types.ConversionLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionLimit)
+	bz := k.cdc.MustMarshal(&limit)
+	store.Set([]byte(limit.Denom), bz)
+}
+
+// DeleteConversionLimit removes the conversion limit of the given denomination
+// together with its window
+func (k Keeper) DeleteConversionLimit(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionLimit)
+	store.Delete([]byte(denom))
+	k.deleteConversionWindow(ctx, denom)
+}
+
+// GetConversionWindow returns the amounts converted within the current window
+// of the given denomination
+func (k Keeper) GetConversionWindow(ctx sdk.Context, denom string) types.ConversionWindow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionWindow)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.NewConversionWindow()
+	}
+
+	var window types.ConversionWindow
+	k.cdc.MustUnmarshal(bz, &window)
+	return window
+}
+
+// setConversionWindow stores the conversion window of the given denomination
+func (k Keeper) setConversionWindow(ctx sdk.Context, denom string, window types.ConversionWindow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionWindow)
+	bz := k.cdc.MustMarshal(&window)
+	store.Set([]byte(denom), bz)
+}
+
+// deleteConversionWindow deletes the conversion window of the given denomination
+func (k Keeper) deleteConversionWindow(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionWindow)
+	store.Delete([]byte(denom))
+}
+
+// ConsumeConversionLimit records the conversion of the given amount of a token
+// pair in the current window of its conversion limit. It fails if the
+// conversion would exceed the limit. Once the window volume reaches the limit,
+// the circuit breaker disables the token pair until governance resets it.
+func (k Keeper) ConsumeConversionLimit(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	direction types.ConversionDirection,
+	amount sdk.Int,
+) error {
+	limit, found := k.GetConversionLimit(ctx, pair.Denom)
+	if !found {
+		return nil
+	}
+
+	max := limit.Max(direction)
+	if max.IsZero() {
+		return nil
+	}
+
+	window := k.GetConversionWindow(ctx, pair.Denom)
+	if window.Tripped {
+		return errorsmod.Wrapf(
+			types.ErrConversionLimit,
+			"conversions of %s are paused until governance resets its conversion limit", pair.Denom,
+		)
+	}
+
+	volume := window.Volume(direction).Add(amount)
+	if volume.GT(max) {
+		return errorsmod.Wrapf(
+			types.ErrConversionLimit,
+			"%s conversion of %s%s exceeds the remaining limit %s", direction, amount, pair.Denom, max.Sub(window.Volume(direction)),
+		)
+	}
+
+	window.AddVolume(direction, amount)
+
+	if volume.Equal(max) {
+		window.Tripped = true
+		pair.Enabled = false
+		k.SetTokenPair(ctx, pair)
+
+		k.Logger(ctx).Info(
+			"conversion limit reached, disabling token pair",
+			"coin", pair.Denom, "contract", pair.Erc20Address, "direction", direction.String(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConversionLimitPause,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyDirection, direction.String()),
+				sdk.NewAttribute(types.AttributeKeyVolume, volume.String()),
+				sdk.NewAttribute(types.AttributeKeyLimit, max.String()),
+			),
+		)
+	}
+
+	k.setConversionWindow(ctx, pair.Denom, window)
+	return nil
+}
+
+// ResetConversionWindow clears the converted amounts of a token pair and
+// enables it again if it was disabled by its conversion limit
+func (k Keeper) ResetConversionWindow(ctx sdk.Context, pair types.TokenPair) types.TokenPair {
+	window := k.GetConversionWindow(ctx, pair.Denom)
+	if window.Tripped && !pair.Enabled {
+		pair.Enabled = true
+		k.SetTokenPair(ctx, pair)
+	}
+
+	k.deleteConversionWindow(ctx, pair.Denom)
+	return pair
+}
+
+// resetConversionWindows clears the converted amounts of the conversion limits
+// with the given epoch identifier. Token pairs disabled by their limit remain
+// disabled until governance resets them.
+func (k Keeper) resetConversionWindows(ctx sdk.Context, epochIdentifier string) {
+	k.IterateConversionLimits(ctx, func(limit types.ConversionLimit) (stop bool) {
+		if limit.EpochIdentifier != epochIdentifier {
+			return false
+		}
+
+		window := k.GetConversionWindow(ctx, limit.Denom)
+		if !window.Tripped {
+			k.deleteConversionWindow(ctx, limit.Denom)
+			return false
+		}
+
+		tripped := types.NewConversionWindow()
+		tripped.Tripped = true
+		k.setConversionWindow(ctx, limit.Denom, tripped)
+		return false
+	})
+}
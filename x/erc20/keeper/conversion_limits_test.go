@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	epochstypes "github.com/evmos/evmos/v12/x/epochs/types"
+	"github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestConsumeConversionLimit() {
+	testCases := []struct {
+		name       string
+		malleate   func()
+		direction  types.ConversionDirection
+		amount     int64
+		expPass    bool
+		expVolume  int64
+		expTripped bool
+	}{
+		{
+			"ok - no limit",
+			func() {},
+			types.CoinToERC20,
+			100,
+			true,
+			0,
+			false,
+		},
+		{
+			"ok - direction not limited",
+			func() {
+				limit := types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.ZeroInt(), math.NewInt(10))
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+			},
+			types.CoinToERC20,
+			100,
+			true,
+			0,
+			false,
+		},
+		{
+			"ok - below limit",
+			func() {
+				limit := types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+			},
+			types.CoinToERC20,
+			60,
+			true,
+			60,
+			false,
+		},
+		{
+			"ok - limit reached",
+			func() {
+				limit := types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+			},
+			types.CoinToERC20,
+			100,
+			true,
+			100,
+			true,
+		},
+		{
+			"ok - limit reached with the window volume",
+			func() {
+				limit := types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.ZeroInt(), math.NewInt(100))
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+
+				pair := suite.getTokenPair(cosmosTokenBase)
+				err := suite.app.Erc20Keeper.ConsumeConversionLimit(suite.ctx, pair, types.ERC20ToCoin, math.NewInt(40))
+				suite.Require().NoError(err)
+			},
+			types.ERC20ToCoin,
+			60,
+			true,
+			100,
+			true,
+		},
+		{
+			"fail - limit exceeded with the window volume",
+			func() {
+				limit := types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+
+				pair := suite.getTokenPair(cosmosTokenBase)
+				err := suite.app.Erc20Keeper.ConsumeConversionLimit(suite.ctx, pair, types.CoinToERC20, math.NewInt(60))
+				suite.Require().NoError(err)
+			},
+			types.CoinToERC20,
+			50,
+			false,
+			60,
+			false,
+		},
+		{
+			"fail - single conversion jumps past the limit",
+			func() {
+				limit := types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+			},
+			types.CoinToERC20,
+			150,
+			false,
+			0,
+			false,
+		},
+		{
+			"fail - window tripped",
+			func() {
+				limit := types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+
+				pair := suite.getTokenPair(cosmosTokenBase)
+				err := suite.app.Erc20Keeper.ConsumeConversionLimit(suite.ctx, pair, types.CoinToERC20, math.NewInt(100))
+				suite.Require().NoError(err)
+			},
+			types.CoinToERC20,
+			10,
+			false,
+			100,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.setupRegisterCoin(metadataCoin)
+
+			tc.malleate()
+
+			pair := suite.getTokenPair(cosmosTokenBase)
+			err := suite.app.Erc20Keeper.ConsumeConversionLimit(suite.ctx, pair, tc.direction, math.NewInt(tc.amount))
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrConversionLimit)
+			}
+
+			window := suite.app.Erc20Keeper.GetConversionWindow(suite.ctx, cosmosTokenBase)
+			suite.Require().Equal(tc.expVolume, window.Volume(tc.direction).Int64())
+			suite.Require().Equal(tc.expTripped, window.Tripped)
+
+			pair = suite.getTokenPair(cosmosTokenBase)
+			suite.Require().Equal(!tc.expTripped, pair.Enabled)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConversionLimitEpochReset() {
+	suite.SetupTest()
+	suite.setupRegisterCoin(metadataCoin)
+	suite.setupRegisterCoin(metadataIbc)
+
+	suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt()))
+	suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, types.NewConversionLimit(ibcBase, epochstypes.WeekEpochID, math.NewInt(100), math.ZeroInt()))
+
+	err := suite.app.Erc20Keeper.ConsumeConversionLimit(suite.ctx, suite.getTokenPair(cosmosTokenBase), types.CoinToERC20, math.NewInt(100))
+	suite.Require().NoError(err)
+	err = suite.app.Erc20Keeper.ConsumeConversionLimit(suite.ctx, suite.getTokenPair(ibcBase), types.CoinToERC20, math.NewInt(10))
+	suite.Require().NoError(err)
+
+	suite.app.Erc20Keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+
+	// the window is reset but the token pair remains disabled
+	window := suite.app.Erc20Keeper.GetConversionWindow(suite.ctx, cosmosTokenBase)
+	suite.Require().True(window.CoinToERC20.IsZero())
+	suite.Require().True(window.Tripped)
+	suite.Require().False(suite.getTokenPair(cosmosTokenBase).Enabled)
+
+	// the window of other epochs is kept
+	window = suite.app.Erc20Keeper.GetConversionWindow(suite.ctx, ibcBase)
+	suite.Require().Equal(int64(10), window.CoinToERC20.Int64())
+
+	suite.app.Erc20Keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.WeekEpochID, 1)
+	window = suite.app.Erc20Keeper.GetConversionWindow(suite.ctx, ibcBase)
+	suite.Require().True(window.CoinToERC20.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestConversionLimitGovernanceMsgs() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	limit := types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+
+	testCases := []struct {
+		name      string
+		malleate  func() error
+		expectErr bool
+	}{
+		{
+			"fail - update with invalid authority",
+			func() error {
+				_, err := suite.app.Erc20Keeper.UpdateConversionLimit(suite.ctx, &types.MsgUpdateConversionLimit{Authority: "foobar", Limit: limit})
+				return err
+			},
+			true,
+		},
+		{
+			"fail - update unregistered denom",
+			func() error {
+				unregistered := limit
+				unregistered.Denom = "unregistered"
+				_, err := suite.app.Erc20Keeper.UpdateConversionLimit(suite.ctx, &types.MsgUpdateConversionLimit{Authority: authority, Limit: unregistered})
+				return err
+			},
+			true,
+		},
+		{
+			"pass - update",
+			func() error {
+				_, err := suite.app.Erc20Keeper.UpdateConversionLimit(suite.ctx, &types.MsgUpdateConversionLimit{Authority: authority, Limit: limit})
+				suite.Require().NoError(err)
+
+				stored, found := suite.app.Erc20Keeper.GetConversionLimit(suite.ctx, cosmosTokenBase)
+				suite.Require().True(found)
+				suite.Require().Equal(limit, stored)
+				return nil
+			},
+			false,
+		},
+		{
+			"pass - remove",
+			func() error {
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+
+				disabled := types.NewConversionLimit(cosmosTokenBase, epochstypes.DayEpochID, math.ZeroInt(), math.ZeroInt())
+				_, err := suite.app.Erc20Keeper.UpdateConversionLimit(suite.ctx, &types.MsgUpdateConversionLimit{Authority: authority, Limit: disabled})
+				suite.Require().NoError(err)
+
+				_, found := suite.app.Erc20Keeper.GetConversionLimit(suite.ctx, cosmosTokenBase)
+				suite.Require().False(found)
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - reset with invalid authority",
+			func() error {
+				_, err := suite.app.Erc20Keeper.ResetConversionLimit(suite.ctx, &types.MsgResetConversionLimit{Authority: "foobar", Token: cosmosTokenBase})
+				return err
+			},
+			true,
+		},
+		{
+			"pass - reset enables the tripped token pair",
+			func() error {
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+				err := suite.app.Erc20Keeper.ConsumeConversionLimit(suite.ctx, suite.getTokenPair(cosmosTokenBase), types.CoinToERC20, math.NewInt(100))
+				suite.Require().NoError(err)
+				suite.Require().False(suite.getTokenPair(cosmosTokenBase).Enabled)
+
+				_, err = suite.app.Erc20Keeper.ResetConversionLimit(suite.ctx, &types.MsgResetConversionLimit{Authority: authority, Token: cosmosTokenBase})
+				suite.Require().NoError(err)
+
+				window := suite.app.Erc20Keeper.GetConversionWindow(suite.ctx, cosmosTokenBase)
+				suite.Require().Equal(types.NewConversionWindow(), window)
+				suite.Require().True(suite.getTokenPair(cosmosTokenBase).Enabled)
+				return nil
+			},
+			false,
+		},
+		{
+			"pass - reset keeps the token pair disabled by governance",
+			func() error {
+				pair := suite.getTokenPair(cosmosTokenBase)
+				pair.Enabled = false
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+
+				_, err := suite.app.Erc20Keeper.ResetConversionLimit(suite.ctx, &types.MsgResetConversionLimit{Authority: authority, Token: cosmosTokenBase})
+				suite.Require().NoError(err)
+				suite.Require().False(suite.getTokenPair(cosmosTokenBase).Enabled)
+				return nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupRegisterCoin(metadataCoin)
+
+			err := tc.malleate()
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) getTokenPair(denom string) types.TokenPair {
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, denom)
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().True(found)
+	return pair
+}
@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"strings"
-
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -14,11 +12,12 @@ type ERC20BankContractRegistrationHook struct {
 }
 
 type keeper interface {
-	RegisterCoin(ctx sdk.Context, coinMetadata banktypes.Metadata) (*types.TokenPair, error)
+	GetAutoRegistrationRules(ctx sdk.Context) []types.AutoRegistrationRule
+	AutoRegisterCoin(ctx sdk.Context, coinMetadata banktypes.Metadata, rule types.AutoRegistrationRule) (*types.TokenPair, error)
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	UpdateCoinMetadata(ctx sdk.Context, coinMetadata banktypes.Metadata) (types.TokenPair, error)
 }
 
-const denomIBCPrefix = "ibc/"
-
 // NewERC20ContractRegistrationHook returns the DenomMetadataHooks for ERC20 registration
 func NewERC20ContractRegistrationHook(erc20Keeper keeper) ERC20BankContractRegistrationHook {
 	return ERC20BankContractRegistrationHook{
@@ -26,22 +25,34 @@ func NewERC20ContractRegistrationHook(erc20Keeper keeper) ERC20BankContractRegis
 	}
 }
 
-// AfterDenomMetadataCreation deploys the ERC20 contract for the IBC denom. It is called after the
-// bank module creates the denom metadata. Without the ERC20 contract, the IBC denom cannot be
-// converted to the ERC20 token, and the IBC transfer will fail.
+// AfterDenomMetadataCreation deploys the ERC20 contract for the denoms matching the auto-registration
+// rules, which by default only include IBC denoms. It is called after the bank module creates the
+// denom metadata. Without the ERC20 contract, the IBC denom cannot be converted to the ERC20 token,
+// and the IBC transfer will fail.
 func (e ERC20BankContractRegistrationHook) AfterDenomMetadataCreation(ctx sdk.Context, newDenomMetadata banktypes.Metadata) error {
-	// only deploy for IBC denom.
-	if !strings.HasPrefix(strings.ToLower(newDenomMetadata.Base), denomIBCPrefix) {
+	rule, found := types.MatchAutoRegistrationRule(e.erc20Keeper.GetAutoRegistrationRules(ctx), newDenomMetadata.Base)
+	if !found {
 		return nil
 	}
 
-	if _, err := e.erc20Keeper.RegisterCoin(ctx, newDenomMetadata); err != nil {
+	if _, err := e.erc20Keeper.AutoRegisterCoin(ctx, newDenomMetadata, rule); err != nil {
 		return errorsmod.Wrapf(types.ErrERC20RegisterToken, "denom base: %s", newDenomMetadata.Base)
 	}
 
 	return nil
 }
 
-func (e ERC20BankContractRegistrationHook) AfterDenomMetadataUpdate(sdk.Context, banktypes.Metadata) error {
-	return types.ErrERC20UpdateToken
+// AfterDenomMetadataUpdate pushes the updated denom metadata to the ERC20 contract of a registered
+// token pair. It is called after the bank module updates the denom metadata. Denoms without a
+// token pair are ignored.
+func (e ERC20BankContractRegistrationHook) AfterDenomMetadataUpdate(ctx sdk.Context, denomMetadata banktypes.Metadata) error {
+	if !e.erc20Keeper.IsDenomRegistered(ctx, denomMetadata.Base) {
+		return nil
+	}
+
+	if _, err := e.erc20Keeper.UpdateCoinMetadata(ctx, denomMetadata); err != nil {
+		return errorsmod.Wrapf(types.ErrERC20UpdateToken, "denom base: %s: %s", denomMetadata.Base, err.Error())
+	}
+
+	return nil
 }
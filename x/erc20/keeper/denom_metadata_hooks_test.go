@@ -48,6 +48,34 @@ func TestERC20BankContractRegistrationHook_AfterDenomMetadataCreation(t *testing
 				},
 			},
 			expectRegistered: false,
+		}, {
+			name: "factory denom with prefix rule",
+			fields: fields{
+				erc20Keeper: &mockKeeper{
+					rules: []types.AutoRegistrationRule{
+						{Denom: "factory/", Match: types.DENOM_MATCH_PREFIX},
+					},
+				},
+			},
+			args: args{
+				newDenomMetadata: banktypes.Metadata{
+					Base: "factory/creator/token",
+				},
+			},
+			expectRegistered: true,
+		}, {
+			name: "ibc denom without rules",
+			fields: fields{
+				erc20Keeper: &mockKeeper{
+					rules: []types.AutoRegistrationRule{},
+				},
+			},
+			args: args{
+				newDenomMetadata: banktypes.Metadata{
+					Base: "ibc/eth",
+				},
+			},
+			expectRegistered: false,
 		}, {
 			name: "error",
 			fields: fields{
@@ -74,13 +102,69 @@ func TestERC20BankContractRegistrationHook_AfterDenomMetadataCreation(t *testing
 	}
 }
 
+func TestERC20BankContractRegistrationHook_AfterDenomMetadataUpdate(t *testing.T) {
+	tests := []struct {
+		name          string
+		erc20Keeper   *mockKeeper
+		expectUpdated bool
+		expectErr     error
+	}{
+		{
+			name:          "success",
+			erc20Keeper:   &mockKeeper{denomRegistered: true},
+			expectUpdated: true,
+		}, {
+			name:          "denom not registered",
+			erc20Keeper:   &mockKeeper{},
+			expectUpdated: false,
+		}, {
+			name: "error",
+			erc20Keeper: &mockKeeper{
+				denomRegistered: true,
+				err:             fmt.Errorf("error"),
+			},
+			expectUpdated: false,
+			expectErr:     types.ErrERC20UpdateToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := keeper.NewERC20ContractRegistrationHook(tt.erc20Keeper)
+			err := e.AfterDenomMetadataUpdate(sdk.Context{}, banktypes.Metadata{Base: "ibc/eth"})
+			require.ErrorIs(t, err, tt.expectErr)
+			require.Equal(t, tt.erc20Keeper.updated, tt.expectUpdated)
+		})
+	}
+}
+
 type mockKeeper struct {
-	registered bool
-	err        error
+	rules           []types.AutoRegistrationRule
+	registered      bool
+	updated         bool
+	denomRegistered bool
+	err             error
 	sync.Mutex
 }
 
-func (m *mockKeeper) RegisterCoin(sdk.Context, banktypes.Metadata) (*types.TokenPair, error) {
+func (m *mockKeeper) IsDenomRegistered(sdk.Context, string) bool {
+	return m.denomRegistered
+}
+
+func (m *mockKeeper) UpdateCoinMetadata(sdk.Context, banktypes.Metadata) (types.TokenPair, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.updated = m.err == nil
+	return types.TokenPair{}, m.err
+}
+
+func (m *mockKeeper) GetAutoRegistrationRules(sdk.Context) []types.AutoRegistrationRule {
+	if m.rules == nil {
+		return types.DefaultAutoRegistrationRules
+	}
+	return m.rules
+}
+
+func (m *mockKeeper) AutoRegisterCoin(sdk.Context, banktypes.Metadata, types.AutoRegistrationRule) (*types.TokenPair, error) {
 	m.Lock()
 	defer m.Unlock()
 	m.registered = m.err == nil
@@ -0,0 +1,81 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/evmos/evmos/v12/x/epochs/types"
+	"github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+// BeforeEpochStart performs a no-op
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// AfterEpochEnd resets the windows of the conversion limits with the given
+// epoch identifier
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+	k.resetConversionWindows(ctx, epochIdentifier)
+}
+
+// AfterEpochScheduleChanged performs a no-op
+func (k Keeper) AfterEpochScheduleChanged(_ sdk.Context, _ epochstypes.EpochInfo, _ epochstypes.ScheduleChange) {
+}
+
+// BeforeEpochDeleted returns an error if a conversion limit uses the epoch
+func (k Keeper) BeforeEpochDeleted(ctx sdk.Context, epochIdentifier string) error {
+	var denom string
+	k.IterateConversionLimits(ctx, func(limit types.ConversionLimit) (stop bool) {
+		if limit.EpochIdentifier == epochIdentifier {
+			denom = limit.Denom
+			return true
+		}
+		return false
+	})
+
+	if denom != "" {
+		return errorsmod.Wrapf(epochstypes.ErrEpochInUse, "%s is the epoch identifier of the %s conversion limit", epochIdentifier, denom)
+	}
+	return nil
+}
+
+// ___________________________________________________________________________________________________
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// BeforeEpochStart implements EpochHooks
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+// AfterEpochEnd implements EpochHooks
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
+
+var _ epochstypes.EpochScheduleHooks = Hooks{}
+
+// AfterEpochScheduleChanged implements EpochScheduleHooks
+func (h Hooks) AfterEpochScheduleChanged(ctx sdk.Context, epochInfo epochstypes.EpochInfo, change epochstypes.ScheduleChange) {
+	h.k.AfterEpochScheduleChanged(ctx, epochInfo, change)
+}
+
+// BeforeEpochDeleted implements EpochScheduleHooks
+func (h Hooks) BeforeEpochDeleted(ctx sdk.Context, epochIdentifier string) error {
+	return h.k.BeforeEpochDeleted(ctx, epochIdentifier)
+}
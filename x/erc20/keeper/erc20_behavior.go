@@ -0,0 +1,234 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v12/contracts"
+	"github.com/evmos/evmos/v12/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// balanceSlotProbes is the number of storage slots probed to locate the
+// balances mapping of an ERC20 contract
+const balanceSlotProbes = 128
+
+// logTransferSigHash is the topic of the ERC20 Transfer event
+var logTransferSigHash = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// VerifyERC20Behavior simulates a transfer round trip of an ERC20 contract
+// between two probe accounts in a cached context and returns an error if the
+// contract:
+//   - takes a fee on transfer or moves a different amount than requested
+//   - rebases balances, i.e. the balances are not stored as plain amounts or
+//     the total supply changes on transfers
+//   - changes the balance of a third party on transfer
+//   - emits an Approval event on transfer
+//
+// The probe sender is funded by writing its balance in the contract storage
+// instead of minting, so the contract supply is left untouched. All the state
+// changes of the simulation are discarded.
+func (k Keeper) VerifyERC20Behavior(ctx sdk.Context, contract common.Address) error {
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	// the simulation state is discarded, so don't charge the store accesses
+	// of the probe writes and calls to the caller
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.
+		WithKVGasConfig(storetypes.GasConfig{}).
+		WithTransientKVGasConfig(storetypes.GasConfig{})
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	// derive the probe accounts from the block so that they can't be known in
+	// advance by the contract
+	seed := crypto.Keccak256(contract.Bytes(), ctx.HeaderHash())
+	sender := common.BytesToAddress(crypto.Keccak256(seed, []byte("sender")))
+	receiver := common.BytesToAddress(crypto.Keccak256(seed, []byte("receiver")))
+
+	slot, err := k.findBalanceSlot(cacheCtx, erc20, contract, sender)
+	if err != nil {
+		return err
+	}
+
+	amount := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(erc20Data.Decimals)), nil)
+	k.evmKeeper.SetState(cacheCtx, contract, slot, common.BigToHash(amount).Bytes())
+
+	k.ensureAccount(cacheCtx, sender)
+	k.ensureAccount(cacheCtx, receiver)
+
+	supply := k.TotalSupply(cacheCtx, erc20, contract)
+	if supply == nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve total supply")
+	}
+
+	if err := k.simulateTransfer(cacheCtx, erc20, contract, sender, receiver, amount); err != nil {
+		return err
+	}
+
+	if err := k.simulateTransfer(cacheCtx, erc20, contract, receiver, sender, amount); err != nil {
+		return err
+	}
+
+	supplyAfter := k.TotalSupply(cacheCtx, erc20, contract)
+	if supplyAfter == nil || supplyAfter.Cmp(supply) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrUnsupportedERC20,
+			"total supply changed on transfer - expected: %v, actual: %v", supply, supplyAfter,
+		)
+	}
+
+	return nil
+}
+
+// findBalanceSlot returns the storage key of the balance of the given account
+// for contracts that store balances in a mapping located in one of the first
+// storage slots, using either the Solidity or the Vyper key layout. The probe
+// writes are discarded.
+func (k Keeper) findBalanceSlot(
+	ctx sdk.Context,
+	erc20 abi.ABI,
+	contract, account common.Address,
+) (common.Hash, error) {
+	probeCtx, _ := ctx.CacheContext()
+
+	// write a distinct marker on every candidate key and read it back through
+	// balanceOf to identify the key used by the contract
+	keys := make([]common.Hash, 0, 2*balanceSlotProbes)
+	for i := 0; i < balanceSlotProbes; i++ {
+		slot := common.BigToHash(big.NewInt(int64(i)))
+		keys = append(keys,
+			crypto.Keccak256Hash(common.LeftPadBytes(account.Bytes(), 32), slot.Bytes()), // Solidity
+			crypto.Keccak256Hash(slot.Bytes(), common.LeftPadBytes(account.Bytes(), 32)), // Vyper
+		)
+	}
+
+	for i, key := range keys {
+		marker := big.NewInt(int64(i + 1))
+		k.evmKeeper.SetState(probeCtx, contract, key, common.BigToHash(marker).Bytes())
+	}
+
+	balance := k.BalanceOf(probeCtx, erc20, contract, account)
+	if balance == nil || balance.Sign() <= 0 || balance.Cmp(big.NewInt(int64(len(keys)))) > 0 {
+		return common.Hash{}, errorsmod.Wrapf(
+			types.ErrUnsupportedERC20,
+			"balances of contract %s are not stored as plain amounts", contract,
+		)
+	}
+
+	return keys[balance.Int64()-1], nil
+}
+
+// simulateTransfer transfers the given amount and verifies that only the
+// balances of the sender and receiver changed by exactly that amount
+func (k Keeper) simulateTransfer(
+	ctx sdk.Context,
+	erc20 abi.ABI,
+	contract, from, to common.Address,
+	amount *big.Int,
+) error {
+	balanceFrom := k.BalanceOf(ctx, erc20, contract, from)
+	balanceTo := k.BalanceOf(ctx, erc20, contract, to)
+	if balanceFrom == nil || balanceTo == nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	res, err := k.CallEVM(ctx, erc20, from, contract, true, "transfer", to, amount)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrUnsupportedERC20, "transfer failed: %s", err.Error())
+	}
+
+	if err := k.monitorApprovalEvent(res); err != nil {
+		return err
+	}
+
+	if err := verifyTransferLogs(res, contract, from, to, amount); err != nil {
+		return err
+	}
+
+	expFrom := new(big.Int).Sub(balanceFrom, amount)
+	if balanceFromAfter := k.BalanceOf(ctx, erc20, contract, from); balanceFromAfter == nil || balanceFromAfter.Cmp(expFrom) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrUnsupportedERC20,
+			"invalid sender balance after transfer - expected: %v, actual: %v", expFrom, balanceFromAfter,
+		)
+	}
+
+	expTo := new(big.Int).Add(balanceTo, amount)
+	if balanceToAfter := k.BalanceOf(ctx, erc20, contract, to); balanceToAfter == nil || balanceToAfter.Cmp(expTo) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrUnsupportedERC20,
+			"invalid receiver balance after transfer - expected: %v, actual: %v", expTo, balanceToAfter,
+		)
+	}
+
+	return nil
+}
+
+// verifyTransferLogs returns an error unless the contract emitted a single
+// Transfer event for the given transfer
+func verifyTransferLogs(
+	res *evmtypes.MsgEthereumTxResponse,
+	contract, from, to common.Address,
+	amount *big.Int,
+) error {
+	transfers := 0
+	for _, log := range res.Logs {
+		if common.HexToAddress(log.Address) != contract ||
+			len(log.Topics) == 0 || log.Topics[0] != logTransferSigHash.Hex() {
+			continue
+		}
+
+		transfers++
+		if len(log.Topics) != 3 ||
+			common.HexToAddress(log.Topics[1]) != from ||
+			common.HexToAddress(log.Topics[2]) != to ||
+			new(big.Int).SetBytes(log.Data).Cmp(amount) != 0 {
+			return errorsmod.Wrap(
+				types.ErrUnsupportedERC20, "transfer changed the balance of a third party",
+			)
+		}
+	}
+
+	if transfers != 1 {
+		return errorsmod.Wrapf(
+			types.ErrUnsupportedERC20, "expected a single Transfer event, got %d", transfers,
+		)
+	}
+
+	return nil
+}
+
+// ensureAccount creates the account of the given address if it doesn't exist
+func (k Keeper) ensureAccount(ctx sdk.Context, address common.Address) {
+	if k.accountKeeper.GetAccount(ctx, address.Bytes()) != nil {
+		return
+	}
+
+	acc := k.accountKeeper.NewAccountWithAddress(ctx, address.Bytes())
+	k.accountKeeper.SetAccount(ctx, acc)
+}
@@ -43,19 +43,24 @@ func (k Keeper) DeployERC20Contract(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
 ) (common.Address, error) {
-	decimals := uint8(0)
-	if len(coinMetadata.DenomUnits) > 0 {
-		decimalsIdx := len(coinMetadata.DenomUnits) - 1
-		decimals = uint8(coinMetadata.DenomUnits[decimalsIdx].Exponent)
-	}
+	return k.deployERC20Contract(ctx, coinMetadata.Name, coinMetadata.Symbol, metadataDecimals(coinMetadata))
+}
+
+// deployERC20Contract deploys an ERC20 contract with the given name, symbol
+// and decimals on the EVM with the erc20 module account as owner.
+func (k Keeper) deployERC20Contract(
+	ctx sdk.Context,
+	name, symbol string,
+	decimals uint8,
+) (common.Address, error) {
 	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(
 		"",
-		coinMetadata.Name,
-		coinMetadata.Symbol,
+		name,
+		symbol,
 		decimals,
 	)
 	if err != nil {
-		return common.Address{}, errorsmod.Wrapf(types.ErrABIPack, "coin metadata is invalid %s: %s", coinMetadata.Name, err.Error())
+		return common.Address{}, errorsmod.Wrapf(types.ErrABIPack, "coin metadata is invalid %s: %s", name, err.Error())
 	}
 
 	data := make([]byte, len(contracts.ERC20MinterBurnerDecimalsContract.Bin)+len(ctorArgs))
@@ -70,12 +75,22 @@ func (k Keeper) DeployERC20Contract(
 	contractAddr := crypto.CreateAddress(types.ModuleAddress, nonce)
 	_, err = k.CallEVMWithData(ctx, types.ModuleAddress, nil, data, true)
 	if err != nil {
-		return common.Address{}, errorsmod.Wrapf(err, "failed to deploy contract for %s", coinMetadata.Name)
+		return common.Address{}, errorsmod.Wrapf(err, "failed to deploy contract for %s", name)
 	}
 
 	return contractAddr, nil
 }
 
+// metadataDecimals returns the decimals of the ERC20 representation of a coin,
+// i.e. the exponent of its largest denomination unit.
+func metadataDecimals(coinMetadata banktypes.Metadata) uint8 {
+	if len(coinMetadata.DenomUnits) == 0 {
+		return 0
+	}
+	decimalsIdx := len(coinMetadata.DenomUnits) - 1
+	return uint8(coinMetadata.DenomUnits[decimalsIdx].Exponent)
+}
+
 // QueryERC20 returns the data of a deployed ERC20 contract
 func (k Keeper) QueryERC20(
 	ctx sdk.Context,
@@ -152,6 +167,30 @@ func (k Keeper) BalanceOf(
 	return balance
 }
 
+// TotalSupply queries the total supply of a given ERC20 contract
+func (k Keeper) TotalSupply(
+	ctx sdk.Context,
+	abi abi.ABI,
+	contract common.Address,
+) *big.Int {
+	res, err := k.CallEVM(ctx, abi, types.ModuleAddress, contract, false, "totalSupply")
+	if err != nil {
+		return nil
+	}
+
+	unpacked, err := abi.Unpack("totalSupply", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil
+	}
+
+	supply, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+
+	return supply
+}
+
 // CallEVM performs a smart contract method call using given args
 func (k Keeper) CallEVM(
 	ctx sdk.Context,
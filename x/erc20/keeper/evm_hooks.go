@@ -108,9 +108,13 @@ func (k Keeper) PostTxProcessing(
 			continue
 		}
 
-		// Check that the contract is a registered token pair
+		// Check that the contract is a registered token pair. Tokens of a
+		// legacy contract are converted through the pair of its denomination.
 		contractAddr := log.Address
 		id := k.GetERC20Map(ctx, contractAddr)
+		if legacyContract, found := k.GetLegacyContract(ctx, contractAddr); found {
+			id = k.GetDenomMap(ctx, legacyContract.Denom)
+		}
 		if len(id) == 0 {
 			continue
 		}
@@ -140,6 +144,12 @@ func (k Keeper) PostTxProcessing(
 		// create the corresponding sdk.Coin that is paired with ERC20
 		coins := sdk.Coins{{Denom: pair.Denom, Amount: sdk.NewIntFromBigInt(tokens)}}
 
+		// revert the tx if the conversion exceeds the limit of the pair, as the
+		// tokens sent to the module address would not be converted otherwise
+		if err := k.ConsumeConversionLimit(ctx, pair, types.ERC20ToCoin, coins[0].Amount); err != nil {
+			return err
+		}
+
 		// Perform token conversion. We can now assume that the sender of a
 		// registered token wants to mint a Cosmos coin.
 		switch pair.ContractOwner {
@@ -428,3 +428,28 @@ func (suite *KeeperTestSuite) TestPostTxProcessing() {
 	}
 	suite.mintFeeCollector = false
 }
+
+func (suite *KeeperTestSuite) TestEvmHooksLegacyContract() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	suite.ensureHooksSet()
+
+	legacy := suite.setupConvertedCoin(100)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	suite.Commit()
+
+	pair, err := suite.app.Erc20Keeper.MigrateTokenPairContract(suite.ctx, cosmosTokenBase, "", "")
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	// the tokens sent to the module address on the legacy contract are converted
+	_ = suite.TransferERC20TokenToModule(legacy, suite.address, big.NewInt(40))
+
+	cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, cosmosTokenBase)
+	suite.Require().Equal(int64(40), cosmosBalance.Amount.Int64())
+	suite.Require().Equal(int64(60), suite.BalanceOf(legacy, suite.address).(*big.Int).Int64())
+	suite.Require().Equal(int64(0), suite.BalanceOf(legacy, types.ModuleAddress).(*big.Int).Int64())
+	suite.Require().Equal(int64(0), suite.BalanceOf(pair.GetERC20Contract(), suite.address).(*big.Int).Int64())
+
+	suite.mintFeeCollector = false
+}
@@ -99,3 +99,29 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// ConversionLimit returns the conversion limit of a registered token pair and
+// the amounts converted within its current window
+func (k Keeper) ConversionLimit(c context.Context, req *types.QueryConversionLimitRequest) (*types.QueryConversionLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pairRes, err := k.TokenPair(c, &types.QueryTokenPairRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	denom := pairRes.TokenPair.Denom
+	limit, found := k.GetConversionLimit(ctx, denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "conversion limit for token '%s'", req.Token)
+	}
+
+	return &types.QueryConversionLimitResponse{
+		Limit:  limit,
+		Window: k.GetConversionWindow(ctx, denom),
+	}, nil
+}
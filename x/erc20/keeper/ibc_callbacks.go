@@ -17,6 +17,8 @@
 package keeper
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -205,7 +207,16 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 	// fields from the packet data
 
 	// convert Coin to ERC20
-	if _, err = k.ConvertCoin(sdk.WrapSDKContext(ctx), msg); err != nil {
+	_, err = k.ConvertCoin(sdk.WrapSDKContext(ctx), msg)
+	switch {
+	case errors.Is(err, types.ErrConversionLimit), errors.Is(err, types.ErrERC20TokenPairDisabled):
+		// no-op, keep the refunded coins if the token pair conversions are limited
+		k.Logger(ctx).Debug(
+			"skipping conversion of refunded coins",
+			"coin", coin.String(), "error", err.Error(),
+		)
+		return nil
+	case err != nil:
 		return err
 	}
 
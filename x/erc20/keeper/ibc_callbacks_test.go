@@ -388,6 +388,59 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketLegacyContract() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	receiverPk := secp256k1.GenPrivKey()
+	receiver := sdk.AccAddress(receiverPk.PubKey().Address())
+	receiverHex := common.BytesToAddress(receiver.Bytes())
+
+	sourceChannel := "channel-292"
+	evmosChannel := "channel-0"
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+
+	suite.app.TransferKeeper.SetDenomTrace(suite.ctx, transfertypes.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s", transfertypes.PortID, evmosChannel),
+		BaseDenom: cosmosTokenBase,
+	})
+	suite.app.IBCKeeper.ChannelKeeper.SetChannel(suite.ctx, transfertypes.PortID, evmosChannel, channeltypes.Channel{
+		State:          channeltypes.INIT,
+		Ordering:       channeltypes.UNORDERED,
+		Counterparty:   channeltypes.NewCounterparty(transfertypes.PortID, sourceChannel),
+		ConnectionHops: []string{sourceChannel},
+	})
+
+	// the receiver holds 300 tokens of the legacy contract and 700 coins
+	coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(1000)))
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, receiver, coins)
+	suite.Require().NoError(err)
+
+	legacy := suite.setupRegisterCoin(metadataCoin).GetERC20Contract()
+	msg := types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(300)), receiverHex, receiver)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	pair, err := suite.app.Erc20Keeper.MigrateTokenPairContract(suite.ctx, cosmosTokenBase, "", "")
+	suite.Require().NoError(err)
+
+	prefixedDenom := transfertypes.GetDenomPrefix(transfertypes.PortID, sourceChannel) + cosmosTokenBase
+	transfer := transfertypes.NewFungibleTokenPacketData(prefixedDenom, "100", receiver.String(), receiver.String(), "")
+	bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+	packet := channeltypes.NewPacket(bz, 100, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, timeoutHeight, 0)
+
+	ack := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, packet, ibcmock.MockAcknowledgement)
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	// the auto-conversion moves the legacy balance to the current contract
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	suite.Require().Equal(int64(1000), suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, pair.GetERC20Contract(), receiverHex).Int64())
+	suite.Require().Equal(int64(0), suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, legacy, receiverHex).Int64())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, receiver, cosmosTokenBase).IsZero())
+
+	suite.mintFeeCollector = false
+}
+
 func (suite *KeeperTestSuite) TestConvertCoinToERC20FromPacket() {
 	senderAddr := "evmos1x2w87cvt5mqjncav4lxy8yfreynn273xn5335v"
 
@@ -0,0 +1,130 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v12/contracts"
+	"github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+// RegisterInvariants registers the erc20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-backing", k.EscrowBackingInvariant())
+}
+
+// EscrowBackingInvariant checks that every enabled native Cosmos coin pair is
+// fully backed: the coins escrowed in the module account must cover the ERC20
+// total supply of the current and legacy contracts, which are owned by the
+// module.
+//
+// Native ERC20 token pairs are checked the same way against the module's
+// balance on their contract. Contracts which balances can decrease outside of
+// the conversions (e.g. rebasing tokens) can be exempted through the escrow
+// exempt contracts param: their shortfall is logged and reported through an
+// event instead of breaking the invariant.
+//
+// NOTE: the backing can exceed the supply, as tokens can be burned through the
+// ERC20 contract or transferred to the module address directly. These funds
+// are locked and don't break the accounting of the conversions. Pairs which
+// contract can't be queried are skipped.
+func (k Keeper) EscrowBackingInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		params := k.GetParams(ctx)
+
+		k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+			if !pair.Enabled {
+				return false
+			}
+
+			contract := pair.GetERC20Contract()
+
+			var backing, supply *big.Int
+			switch {
+			case pair.IsNativeCoin():
+				backing = k.bankKeeper.GetBalance(ctx, moduleAddr, pair.Denom).Amount.BigInt()
+				supply = k.TotalSupply(ctx, erc20, contract)
+				// the escrow also backs the tokens of the legacy contracts
+				for _, legacyContract := range k.GetLegacyContractsByDenom(ctx, pair.Denom) {
+					if supply == nil {
+						break
+					}
+					if legacySupply := k.TotalSupply(ctx, erc20, legacyContract); legacySupply != nil {
+						supply = new(big.Int).Add(supply, legacySupply)
+					}
+				}
+			case pair.IsNativeERC20():
+				backing = k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+				supply = k.bankKeeper.GetSupply(ctx, pair.Denom).Amount.BigInt()
+			default:
+				return false
+			}
+
+			// skip the pairs which contract can't be queried (e.g. selfdestructed
+			// contracts), as their conversions fail until they are removed
+			if backing == nil || supply == nil {
+				return false
+			}
+
+			if backing.Cmp(supply) >= 0 {
+				return false
+			}
+
+			if pair.IsNativeERC20() && params.IsEscrowExempt(contract) {
+				k.Logger(ctx).Error(
+					"ERC20 token pair escrow doesn't back the coin supply",
+					"coin", pair.Denom, "contract", pair.Erc20Address,
+					"escrow", backing.String(), "supply", supply.String(),
+				)
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeEscrowShortfall,
+						sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+						sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+						sdk.NewAttribute(types.AttributeKeyEscrow, backing.String()),
+						sdk.NewAttribute(types.AttributeKeySupply, supply.String()),
+					),
+				)
+				return false
+			}
+
+			broken++
+			msg += fmt.Sprintf(
+				"\ttoken pair %s (%s) is not backed - escrowed: %v, supply: %v\n",
+				pair.Denom, pair.Erc20Address, backing, supply,
+			)
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrow backing",
+			fmt.Sprintf("found %d token pairs not backed by their escrow\n%s", broken, msg),
+		), broken != 0
+	}
+}
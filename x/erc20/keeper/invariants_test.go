@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/contracts"
+	"github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestEscrowBackingInvariant() {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expBroken    bool
+		expShortfall bool
+	}{
+		{
+			"no token pairs",
+			func() {},
+			false,
+			false,
+		},
+		{
+			"native coin - escrow matches the ERC20 supply",
+			func() {
+				suite.setupConvertedCoin(100)
+			},
+			false,
+			false,
+		},
+		{
+			"native coin - ERC20 tokens burned",
+			func() {
+				contract := suite.setupConvertedCoin(100)
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, suite.address, contract, true, "burn", big.NewInt(40))
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+		},
+		{
+			"native coin - disabled pair is skipped",
+			func() {
+				contract := suite.setupConvertedCoin(100)
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, types.ModuleAddress, contract, true, "mint", suite.address, big.NewInt(40))
+				suite.Require().NoError(err)
+				_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, cosmosTokenBase)
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+		},
+		{
+			"invariant broken - native coin - ERC20 tokens minted without escrow",
+			func() {
+				contract := suite.setupConvertedCoin(100)
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, types.ModuleAddress, contract, true, "mint", suite.address, big.NewInt(40))
+				suite.Require().NoError(err)
+			},
+			true,
+			false,
+		},
+		{
+			"invariant broken - native coin - escrow released without burning",
+			func() {
+				suite.setupConvertedCoin(100)
+				coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 40))
+				err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), coins)
+				suite.Require().NoError(err)
+			},
+			true,
+			false,
+		},
+		{
+			"native ERC20 - tokens transferred to the module",
+			func() {
+				contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+				suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+				suite.TransferERC20TokenToModule(contract, suite.address, big.NewInt(100))
+			},
+			false,
+			false,
+		},
+		{
+			"invariant broken - native ERC20 - coins minted without escrow",
+			func() {
+				contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+				coins := sdk.NewCoins(sdk.NewInt64Coin(types.CreateDenom(contract.String()), 100))
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			true,
+			false,
+		},
+		{
+			"invariant broken - native ERC20 - escrow burned by the contract owner",
+			func() {
+				suite.setupBurnedERC20Escrow()
+			},
+			true,
+			false,
+		},
+		{
+			"native ERC20 - exempt contract - escrow shortfall only emits an event",
+			func() {
+				contract := suite.setupBurnedERC20Escrow()
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EscrowExemptContracts = []string{contract.Hex()}
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			_, broken := suite.app.Erc20Keeper.EscrowBackingInvariant()(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+
+			shortfall := false
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeEscrowShortfall {
+					shortfall = true
+				}
+			}
+			suite.Require().Equal(tc.expShortfall, shortfall)
+		})
+	}
+}
+
+// setupBurnedERC20Escrow registers a native ERC20 pair, converts 100 tokens
+// and burns 40 of them from the module address through the contract owner
+func (suite *KeeperTestSuite) setupBurnedERC20Escrow() common.Address {
+	contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+	suite.TransferERC20TokenToModule(contract, suite.address, big.NewInt(100))
+	_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, suite.address, contract, true, "burnCoins", types.ModuleAddress, big.NewInt(40))
+	suite.Require().NoError(err)
+	return contract
+}
+
+// setupConvertedCoin registers the native coin pair and converts the given
+// amount of coins to ERC20 tokens
+func (suite *KeeperTestSuite) setupConvertedCoin(amount int64) common.Address {
+	pair := suite.setupRegisterCoin(metadataCoin)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, amount))
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), coins)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgConvertCoin(coins[0], suite.address, suite.address.Bytes())
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	return pair.GetERC20Contract()
+}
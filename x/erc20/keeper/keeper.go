@@ -38,6 +38,7 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	evmKeeper     types.EVMKeeper
 	stakingKeeper types.StakingKeeper
+	hooks         types.ERC20Hooks
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -66,6 +67,26 @@ func NewKeeper(
 	}
 }
 
+// SetHooks set the token pair conversion hooks
+func (k *Keeper) SetHooks(eh types.ERC20Hooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set erc20 hooks twice")
+	}
+
+	k.hooks = eh
+
+	return k
+}
+
+// AfterTokenConversion executes the token pair conversion hooks, if set, after
+// the coins or tokens of a token pair are converted on behalf of the sender
+func (k Keeper) AfterTokenConversion(ctx sdk.Context, sender sdk.AccAddress, pair types.TokenPair) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterTokenConversion(ctx, sender, pair)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
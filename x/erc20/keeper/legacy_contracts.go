@@ -0,0 +1,143 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/contracts"
+	"github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+// GetLegacyContracts returns all the legacy contracts of the token pairs
+func (k Keeper) GetLegacyContracts(ctx sdk.Context) []types.LegacyContract {
+	legacyContracts := []types.LegacyContract{}
+
+	k.IterateLegacyContracts(ctx, func(legacyContract types.LegacyContract) (stop bool) {
+		legacyContracts = append(legacyContracts, legacyContract)
+		return false
+	})
+
+	return legacyContracts
+}
+
+// IterateLegacyContracts iterates over all the stored legacy contracts
+func (k Keeper) IterateLegacyContracts(ctx sdk.Context, cb func(legacyContract types.LegacyContract) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixLegacyContract)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var legacyContract types.LegacyContract
+		k.cdc.MustUnmarshal(iterator.Value(), &legacyContract)
+
+		if cb(legacyContract) {
+			break
+		}
+	}
+}
+
+// GetLegacyContractsByDenom returns the legacy contracts of the token pair of
+// the given denomination
+func (k Keeper) GetLegacyContractsByDenom(ctx sdk.Context, denom string) []common.Address {
+	contracts := []common.Address{}
+
+	k.IterateLegacyContracts(ctx, func(legacyContract types.LegacyContract) (stop bool) {
+		if legacyContract.Denom == denom {
+			contracts = append(contracts, legacyContract.GetERC20Contract())
+		}
+		return false
+	})
+
+	return contracts
+}
+
+// GetLegacyContract returns the legacy contract of the given address
+func (k Keeper) GetLegacyContract(ctx sdk.Context, erc20 common.Address) (types.LegacyContract, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLegacyContract)
+	bz := store.Get(erc20.Bytes())
+	if len(bz) == 0 {
+		return types.LegacyContract{}, false
+	}
+
+	var legacyContract types.LegacyContract
+	k.cdc.MustUnmarshal(bz, &legacyContract)
+	return legacyContract, true
+}
+
+// SetLegacyContract stores a legacy contract
+func (k Keeper) SetLegacyContract(ctx sdk.Context, legacyContract types.LegacyContract) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLegacyContract)
+	bz := k.cdc.MustMarshal(&legacyContract)
+	store.Set(legacyContract.GetERC20Contract().Bytes(), bz)
+}
+
+// deleteLegacyContracts removes the legacy contracts of the token pair of the
+// given denomination
+func (k Keeper) deleteLegacyContracts(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLegacyContract)
+	for _, contract := range k.GetLegacyContractsByDenom(ctx, denom) {
+		store.Delete(contract.Bytes())
+	}
+}
+
+// MigrateLegacyBalances moves the tokens of the holder on the legacy contracts
+// of a native Cosmos coin pair to its current contract. The escrowed coins
+// back the tokens of every contract of the pair, so the migration burns and
+// mints the same amount without changing the escrow. Legacy contracts that
+// can't be queried are skipped.
+func (k Keeper) MigrateLegacyBalances(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	holder common.Address,
+) error {
+	if !pair.IsNativeCoin() {
+		return nil
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	for _, legacyContract := range k.GetLegacyContractsByDenom(ctx, pair.Denom) {
+		balance := k.BalanceOf(ctx, erc20, legacyContract, holder)
+		if balance == nil || balance.Sign() == 0 {
+			continue
+		}
+
+		if _, err := k.CallEVM(ctx, erc20, types.ModuleAddress, legacyContract, true, "burnCoins", holder, balance); err != nil {
+			return err
+		}
+		if _, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "mint", holder, balance); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMigrateLegacyBalance,
+				sdk.NewAttribute(types.AttributeKeyReceiver, holder.Hex()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, balance.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, legacyContract.Hex()),
+				sdk.NewAttribute(types.AttributeKeyNewERC20, pair.Erc20Address),
+			),
+		)
+	}
+
+	return nil
+}
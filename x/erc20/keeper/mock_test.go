@@ -33,6 +33,9 @@ func (m *MockEVMKeeper) GetAccountWithoutBalance(_ sdk.Context, _ common.Address
 	return args.Get(0).(*statedb.Account)
 }
 
+func (m *MockEVMKeeper) SetState(_ sdk.Context, _ common.Address, _ common.Hash, _ []byte) {
+}
+
 func (m *MockEVMKeeper) EstimateGas(_ context.Context, _ *evm.EthCallRequest) (*evm.EstimateGasResponse, error) {
 	args := m.Called(mock.Anything, mock.Anything)
 	if args.Get(0) == nil {
@@ -95,11 +98,19 @@ func (b *MockBankKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (bankty
 func (b *MockBankKeeper) SetDenomMetaData(_ sdk.Context, _ banktypes.Metadata) {
 }
 
+func (b *MockBankKeeper) IterateAllDenomMetaData(_ sdk.Context, _ func(banktypes.Metadata) bool) {
+}
+
 func (b *MockBankKeeper) HasSupply(_ sdk.Context, _ string) bool {
 	args := b.Called(mock.Anything, mock.Anything)
 	return args.Bool(0)
 }
 
+func (b *MockBankKeeper) GetSupply(_ sdk.Context, _ string) sdk.Coin {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Get(0).(sdk.Coin)
+}
+
 func (b *MockBankKeeper) GetBalance(_ sdk.Context, _ sdk.AccAddress, _ string) sdk.Coin {
 	args := b.Called(mock.Anything, mock.Anything)
 	return args.Get(0).(sdk.Coin)
@@ -62,19 +62,39 @@ func (k Keeper) ConvertCoin(
 			"deleting selfdestructed token pair from state",
 			"contract", pair.Erc20Address,
 		)
+		k.DeleteConversionLimit(ctx, pair.Denom)
 		// NOTE: return nil error to persist the changes from the deletion
 		return nil, nil
 	}
 
+	if err := k.ConsumeConversionLimit(ctx, pair, types.CoinToERC20, msg.Coin.Amount); err != nil {
+		return nil, err
+	}
+
+	// Move the receiver tokens of the legacy contracts to the current contract
+	if err := k.MigrateLegacyBalances(ctx, pair, receiver); err != nil {
+		return nil, err
+	}
+
 	// Check ownership and execute conversion
+	var res *types.MsgConvertCoinResponse
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
+		res, err = k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
 	case pair.IsNativeERC20():
-		return k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
+		res, err = k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
 	default:
 		return nil, types.ErrUndefinedOwner
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.AfterTokenConversion(ctx, sender, pair); err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 // ConvertERC20 converts ERC20 tokens into native Cosmos coins for both
@@ -89,7 +109,14 @@ func (k Keeper) ConvertERC20(
 	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
 	sender := common.HexToAddress(msg.Sender)
 
-	pair, err := k.MintingEnabled(ctx, sender.Bytes(), receiver, msg.ContractAddress)
+	// Tokens of a legacy contract are converted through the current contract
+	// of its token pair, to which the sender balance is moved below
+	token := msg.ContractAddress
+	if legacyContract, found := k.GetLegacyContract(ctx, common.HexToAddress(token)); found {
+		token = legacyContract.Denom
+	}
+
+	pair, err := k.MintingEnabled(ctx, sender.Bytes(), receiver, token)
 	if err != nil {
 		return nil, err
 	}
@@ -104,19 +131,38 @@ func (k Keeper) ConvertERC20(
 			"deleting selfdestructed token pair from state",
 			"contract", pair.Erc20Address,
 		)
+		k.DeleteConversionLimit(ctx, pair.Denom)
 		// NOTE: return nil error to persist the changes from the deletion
 		return nil, nil
 	}
 
+	if err := k.ConsumeConversionLimit(ctx, pair, types.ERC20ToCoin, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.MigrateLegacyBalances(ctx, pair, sender); err != nil {
+		return nil, err
+	}
+
 	// Check ownership and execute conversion
+	var res *types.MsgConvertERC20Response
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
+		res, err = k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
 	case pair.IsNativeERC20():
-		return k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
+		res, err = k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
 	default:
 		return nil, types.ErrUndefinedOwner
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.AfterTokenConversion(ctx, sender.Bytes(), pair); err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 // RegisterERC20 creates a Cosmos coin and registers the token pair between the
@@ -599,3 +645,168 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateTokenPairMetadata implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the metadata of a native Cosmos coin and migrates its token pair to an ERC20 contract with
+// the new metadata only if the requested authority is the Cosmos SDK governance module account
+func (k *Keeper) UpdateTokenPairMetadata(goCtx context.Context, req *types.MsgUpdateTokenPairMetadata) (*types.MsgUpdateTokenPairMetadataResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.UpdateCoinMetadata(ctx, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateTokenMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDisplay, req.Metadata.Display),
+			sdk.NewAttribute(types.AttributeKeySymbol, req.Metadata.Symbol),
+		),
+	)
+
+	return &types.MsgUpdateTokenPairMetadataResponse{Erc20Address: pair.Erc20Address}, nil
+}
+
+// MigrateTokenPair implements the gRPC MsgServer interface. After a successful governance vote
+// it replaces the ERC20 contract of a token pair only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) MigrateTokenPair(goCtx context.Context, req *types.MsgMigrateTokenPair) (*types.MsgMigrateTokenPairResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prevID := k.GetTokenPairID(ctx, req.Token)
+	prevPair, _ := k.GetTokenPair(ctx, prevID)
+
+	pair, err := k.MigrateTokenPairContract(ctx, req.Token, req.NewContract, req.EscrowRecipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, prevPair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyNewERC20, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgMigrateTokenPairResponse{Erc20Address: pair.Erc20Address}, nil
+}
+
+// DeregisterTokenPair implements the gRPC MsgServer interface. After a successful governance vote
+// it permanently removes a token pair only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) DeregisterTokenPair(goCtx context.Context, req *types.MsgDeregisterTokenPair) (*types.MsgDeregisterTokenPairResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.RemoveTokenPair(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgDeregisterTokenPairResponse{}, nil
+}
+
+// BackfillAutoRegistration implements the gRPC MsgServer interface. After a successful governance vote
+// it registers the existing denom metadata matching the auto-registration rules only if the requested
+// authority is the Cosmos SDK governance module account
+func (k *Keeper) BackfillAutoRegistration(goCtx context.Context, req *types.MsgBackfillAutoRegistration) (*types.MsgBackfillAutoRegistrationResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsERC20Enabled(ctx) {
+		return nil, errorsmod.Wrap(types.ErrERC20Disabled, "erc20 module is disabled")
+	}
+
+	pairs := k.RegisterMatchingCoins(ctx)
+	for _, pair := range pairs {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRegisterCoin,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			),
+		)
+	}
+
+	return &types.MsgBackfillAutoRegistrationResponse{TokenPairs: pairs}, nil
+}
+
+// UpdateConversionLimit implements the gRPC MsgServer interface. After a successful governance vote
+// it sets or removes the conversion limit of a token pair only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateConversionLimit(goCtx context.Context, req *types.MsgUpdateConversionLimit) (*types.MsgUpdateConversionLimitResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsDenomRegistered(ctx, req.Limit.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "coin denomination not registered: %s", req.Limit.Denom)
+	}
+
+	if req.Limit.IsDisabled() {
+		k.DeleteConversionLimit(ctx, req.Limit.Denom)
+	} else {
+		k.SetConversionLimit(ctx, req.Limit)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateConversionLimit,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, req.Limit.Denom),
+			sdk.NewAttribute(types.AttributeKeyEpochIdentifier, req.Limit.EpochIdentifier),
+		),
+	)
+
+	return &types.MsgUpdateConversionLimitResponse{}, nil
+}
+
+// ResetConversionLimit implements the gRPC MsgServer interface. After a successful governance vote
+// it resets the conversion window of a token pair, enabling it again if it was disabled by its
+// conversion limit, only if the requested authority is the Cosmos SDK governance module account
+func (k *Keeper) ResetConversionLimit(goCtx context.Context, req *types.MsgResetConversionLimit) (*types.MsgResetConversionLimitResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.getTokenPairByToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	pair = k.ResetConversionWindow(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetConversionLimit,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgResetConversionLimitResponse{}, nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/evmos/evmos/v12/contracts"
 	"github.com/evmos/evmos/v12/testutil"
 	"github.com/evmos/evmos/v12/utils"
 	"github.com/evmos/evmos/v12/x/erc20/keeper"
@@ -63,6 +64,48 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 			true,
 			true,
 		},
+		{
+			"ok - conversion limit reached",
+			100,
+			10,
+			func(common.Address) {
+				limit := types.NewConversionLimit(cosmosTokenBase, "day", math.NewInt(10), math.ZeroInt())
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+			},
+			func() {},
+			true,
+			false,
+		},
+		{
+			"fail - conversion limit exceeded",
+			100,
+			10,
+			func(common.Address) {
+				limit := types.NewConversionLimit(cosmosTokenBase, "day", math.NewInt(5), math.ZeroInt())
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+			},
+			func() {},
+			false,
+			false,
+		},
+		{
+			"fail - conversion limit tripped",
+			100,
+			10,
+			func(common.Address) {
+				limit := types.NewConversionLimit(cosmosTokenBase, "day", math.NewInt(5), math.ZeroInt())
+				suite.app.Erc20Keeper.SetConversionLimit(suite.ctx, limit)
+
+				// trip the circuit breaker and re-enable the token pair
+				pair := suite.getTokenPair(cosmosTokenBase)
+				err := suite.app.Erc20Keeper.ConsumeConversionLimit(suite.ctx, pair, types.CoinToERC20, math.NewInt(5))
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+			},
+			func() {},
+			false,
+			false,
+		},
 		{
 			"fail - insufficient funds",
 			0,
@@ -909,18 +952,22 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
 			suite.Require().Equal(sdk.NewInt(tc.mint), cosmosBalance.Amount)
 
-			// Precondition: Mint escrow tokens on module account
-			suite.GrantERC20Token(contractAddr, suite.address, types.ModuleAddress, "MINTER_ROLE")
-			suite.MintERC20Token(contractAddr, types.ModuleAddress, types.ModuleAddress, big.NewInt(tc.mint))
+			// Precondition: Mint escrow tokens on module account. The tokens are
+			// minted through a keeper call so that the EVM hooks don't convert
+			// them to unbacked coins.
+			_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, suite.address, contractAddr, true, "mint", types.ModuleAddress, big.NewInt(tc.mint))
+			suite.Require().NoError(err)
 			tokenBalance := suite.BalanceOf(contractAddr, types.ModuleAddress)
 			suite.Require().Equal(big.NewInt(tc.mint), tokenBalance)
 
 			tc.malleate(contractAddr)
 			suite.Commit()
 
-			// Convert Coins back to ERC20s
+			// Convert Coins back to ERC20s. The conversion runs in a cached
+			// context so that failed conversions are reverted as in a tx.
 			receiver := suite.address
-			ctx := sdk.WrapSDKContext(suite.ctx)
+			cacheCtx, writeCache := suite.ctx.CacheContext()
+			ctx := sdk.WrapSDKContext(cacheCtx)
 			msg := types.NewMsgConvertCoin(
 				sdk.NewCoin(coinName, sdk.NewInt(tc.convert)),
 				receiver,
@@ -929,6 +976,9 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 
 			tc.extra()
 			res, err := suite.app.Erc20Keeper.ConvertCoin(ctx, msg)
+			if err == nil {
+				writeCache()
+			}
 
 			expRes := &types.MsgConvertCoinResponse{}
 			suite.Commit()
@@ -1463,3 +1513,182 @@ func (suite *KeeperTestSuite) TestRegisterERC20AsToken() { //nolint:govet // we
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTokenPairGovernanceMsgs() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		malleate  func(pair types.TokenPair) error
+		expectErr bool
+	}{
+		{
+			"fail - update metadata with invalid authority",
+			func(types.TokenPair) error {
+				_, err := suite.app.Erc20Keeper.UpdateTokenPairMetadata(suite.ctx, &types.MsgUpdateTokenPairMetadata{Authority: "foobar", Metadata: metadataCoin})
+				return err
+			},
+			true,
+		},
+		{
+			"pass - update metadata",
+			func(types.TokenPair) error {
+				_, err := suite.app.Erc20Keeper.UpdateTokenPairMetadata(suite.ctx, &types.MsgUpdateTokenPairMetadata{Authority: authority, Metadata: metadataCoin})
+				return err
+			},
+			false,
+		},
+		{
+			"fail - migrate with invalid authority",
+			func(pair types.TokenPair) error {
+				_, err := suite.app.Erc20Keeper.MigrateTokenPair(suite.ctx, &types.MsgMigrateTokenPair{Authority: "foobar", Token: pair.Denom})
+				return err
+			},
+			true,
+		},
+		{
+			"pass - migrate",
+			func(pair types.TokenPair) error {
+				res, err := suite.app.Erc20Keeper.MigrateTokenPair(suite.ctx, &types.MsgMigrateTokenPair{Authority: authority, Token: pair.Denom})
+				if err == nil {
+					suite.Require().NotEqual(pair.Erc20Address, res.Erc20Address)
+				}
+				return err
+			},
+			false,
+		},
+		{
+			"fail - deregister with invalid authority",
+			func(pair types.TokenPair) error {
+				_, err := suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, &types.MsgDeregisterTokenPair{Authority: "foobar", Token: pair.Denom})
+				return err
+			},
+			true,
+		},
+		{
+			"pass - deregister",
+			func(pair types.TokenPair) error {
+				_, err := suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, &types.MsgDeregisterTokenPair{Authority: authority, Token: pair.Denom})
+				return err
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			pair := suite.setupRegisterCoin(metadataCoin)
+
+			err := tc.malleate(*pair)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBackfillAutoRegistration() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		authority string
+		expPairs  int
+		expectErr bool
+	}{
+		{
+			"fail - invalid authority",
+			func() {},
+			"foobar",
+			0,
+			true,
+		},
+		{
+			"fail - erc20 disabled",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableErc20 = false
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			authority,
+			0,
+			true,
+		},
+		{
+			"pass - register matching denom",
+			func() {},
+			authority,
+			1,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadataIbc)
+			tc.malleate()
+
+			res, err := suite.app.Erc20Keeper.BackfillAutoRegistration(suite.ctx, &types.MsgBackfillAutoRegistration{Authority: tc.authority})
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.TokenPairs, tc.expPairs)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertLegacyContract() {
+	testCases := []struct {
+		name       string
+		convertERC bool
+	}{
+		{"ok - convert ERC20 of the legacy contract", true},
+		{"ok - convert coin migrates the legacy balance", false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			legacy := suite.setupConvertedCoin(100)
+			sender := sdk.AccAddress(suite.address.Bytes())
+			suite.Commit()
+
+			pair, err := suite.app.Erc20Keeper.MigrateTokenPairContract(suite.ctx, cosmosTokenBase, "", "")
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			if tc.convertERC {
+				msg := types.NewMsgConvertERC20(sdk.NewInt(40), sender, legacy, suite.address)
+				_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, cosmosTokenBase)
+				suite.Require().Equal(int64(40), balance.Amount.Int64())
+				suite.Require().Equal(int64(60), suite.BalanceOf(pair.GetERC20Contract(), suite.address).(*big.Int).Int64())
+			} else {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 10))
+				err = suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+				err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+				suite.Require().NoError(err)
+
+				msg := types.NewMsgConvertCoin(coins[0], suite.address, sender)
+				_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(int64(110), suite.BalanceOf(pair.GetERC20Contract(), suite.address).(*big.Int).Int64())
+			}
+			suite.Require().Equal(int64(0), suite.BalanceOf(legacy, suite.address).(*big.Int).Int64())
+		})
+	}
+	suite.mintFeeCollector = false
+}
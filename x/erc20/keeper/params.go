@@ -29,8 +29,10 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	enableEvmHook := k.GetEnableEVMHook(ctx)
 	registrationFee := k.GetRegistrationFee(ctx)
+	autoRegistrationRules := k.GetAutoRegistrationRules(ctx)
+	escrowExemptContracts := k.GetEscrowExemptContracts(ctx)
 
-	return types.NewParams(enableErc20, enableEvmHook, registrationFee)
+	return types.NewParams(enableErc20, enableEvmHook, registrationFee, autoRegistrationRules, escrowExemptContracts)
 }
 
 // SetParams sets the erc20 parameters to the param space.
@@ -45,6 +47,8 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err != nil {
 		return err
 	}
+	k.setAutoRegistrationRules(ctx, params.AutoRegistrationRules)
+	k.setEscrowExemptContracts(ctx, params.EscrowExemptContracts)
 
 	return nil
 }
@@ -107,3 +111,49 @@ func (k Keeper) GetRegistrationFee(ctx sdk.Context) math.Int {
 	}
 	return feeAmt
 }
+
+// setAutoRegistrationRules sets the AutoRegistrationRules param in the store
+func (k Keeper) setAutoRegistrationRules(ctx sdk.Context, rules []types.AutoRegistrationRule) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.AutoRegistrationRules{Rules: rules})
+	store.Set(types.ParamStoreKeyAutoRegistrationRules, bz)
+}
+
+// GetAutoRegistrationRules returns the rules of the denominations registered
+// automatically when their metadata is created. It returns the default rules
+// if they were never set.
+func (k Keeper) GetAutoRegistrationRules(ctx sdk.Context) []types.AutoRegistrationRule {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.ParamStoreKeyAutoRegistrationRules) {
+		return types.DefaultAutoRegistrationRules
+	}
+
+	var rules types.AutoRegistrationRules
+	k.cdc.MustUnmarshal(store.Get(types.ParamStoreKeyAutoRegistrationRules), &rules)
+	return rules.Rules
+}
+
+// setEscrowExemptContracts sets the EscrowExemptContracts param in the store
+func (k Keeper) setEscrowExemptContracts(ctx sdk.Context, contracts []string) {
+	store := ctx.KVStore(k.storeKey)
+	if len(contracts) == 0 {
+		store.Delete(types.ParamStoreKeyEscrowExemptContracts)
+		return
+	}
+	bz := k.cdc.MustMarshal(&types.EscrowExemptContracts{Contracts: contracts})
+	store.Set(types.ParamStoreKeyEscrowExemptContracts, bz)
+}
+
+// GetEscrowExemptContracts returns the ERC20 contracts exempted from the
+// escrow backing invariant
+func (k Keeper) GetEscrowExemptContracts(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyEscrowExemptContracts)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var contracts types.EscrowExemptContracts
+	k.cdc.MustUnmarshal(bz, &contracts)
+	return contracts.Contracts
+}
@@ -26,6 +26,19 @@ func (suite *KeeperTestSuite) TestParams() {
 			},
 			true,
 		},
+		{
+			"success - Checks that cleared auto-registration rules are not defaulted",
+			func() interface{} {
+				return []types.AutoRegistrationRule(nil)
+			},
+			func() interface{} {
+				params := types.DefaultParams()
+				params.AutoRegistrationRules = nil
+				suite.Require().NoError(suite.app.Erc20Keeper.SetParams(suite.ctx, params))
+				return suite.app.Erc20Keeper.GetAutoRegistrationRules(suite.ctx)
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -22,6 +22,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/evmos/evmos/v12/contracts"
 	"github.com/evmos/evmos/v12/x/erc20/types"
 )
 
@@ -30,6 +31,65 @@ import (
 func (k Keeper) RegisterCoin(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
+) (*types.TokenPair, error) {
+	return k.registerCoin(ctx, coinMetadata, coinMetadata.Name, coinMetadata.Symbol)
+}
+
+// AutoRegisterCoin deploys an erc20 contract named after the templates of the
+// given auto-registration rule and creates the token pair for the existing
+// cosmos coin
+func (k Keeper) AutoRegisterCoin(
+	ctx sdk.Context,
+	coinMetadata banktypes.Metadata,
+	rule types.AutoRegistrationRule,
+) (*types.TokenPair, error) {
+	name, symbol := rule.ERC20NameAndSymbol(coinMetadata)
+	return k.registerCoin(ctx, coinMetadata, name, symbol)
+}
+
+// RegisterMatchingCoins registers the token pairs of all the existing denom
+// metadata that matches the auto-registration rules and isn't registered yet.
+// Denominations that fail to register are skipped.
+func (k Keeper) RegisterMatchingCoins(ctx sdk.Context) []types.TokenPair {
+	rules := k.GetAutoRegistrationRules(ctx)
+
+	// collect the metadata first as the registrations write to the bank store
+	candidates := []banktypes.Metadata{}
+	k.bankKeeper.IterateAllDenomMetaData(ctx, func(metadata banktypes.Metadata) bool {
+		if _, found := types.MatchAutoRegistrationRule(rules, metadata.Base); found && !k.IsDenomRegistered(ctx, metadata.Base) {
+			candidates = append(candidates, metadata)
+		}
+		return false
+	})
+
+	pairs := []types.TokenPair{}
+	for _, metadata := range candidates {
+		rule, _ := types.MatchAutoRegistrationRule(rules, metadata.Base)
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		pair, err := k.AutoRegisterCoin(cacheCtx, metadata, rule)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to auto-register coin",
+				"denom", metadata.Base,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		writeFn()
+		pairs = append(pairs, *pair)
+	}
+
+	return pairs
+}
+
+// registerCoin deploys an erc20 contract with the given name and symbol and
+// creates the token pair for the existing cosmos coin
+func (k Keeper) registerCoin(
+	ctx sdk.Context,
+	coinMetadata banktypes.Metadata,
+	name, symbol string,
 ) (*types.TokenPair, error) {
 	// Check if ERC20 is enabled
 	if !k.IsERC20Enabled(ctx) {
@@ -54,7 +114,7 @@ func (k Keeper) RegisterCoin(
 		)
 	}
 
-	addr, err := k.DeployERC20Contract(ctx, coinMetadata)
+	addr, err := k.deployERC20Contract(ctx, name, symbol, metadataDecimals(coinMetadata))
 	if err != nil {
 		return nil, errorsmod.Wrap(
 			err, "failed to create wrapped coin denom metadata for ERC20",
@@ -75,12 +135,22 @@ func (k Keeper) RegisterERC20(
 	ctx sdk.Context,
 	contract common.Address,
 ) (*types.TokenPair, error) {
-	// Check if ERC20 is already registered
+	// Check if ERC20 is already registered, either as the current or a legacy
+	// contract of a token pair
 	if k.IsERC20Registered(ctx, contract) {
 		return nil, errorsmod.Wrapf(
 			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", contract.String(),
 		)
 	}
+	if _, found := k.GetLegacyContract(ctx, contract); found {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract is a legacy contract of a token pair: %s", contract.String(),
+		)
+	}
+
+	if err := k.VerifyERC20Behavior(ctx, contract); err != nil {
+		return nil, errorsmod.Wrapf(err, "contract %s failed the behavior verification", contract.String())
+	}
 
 	metadata, err := k.CreateCoinMetadata(ctx, contract)
 	if err != nil {
@@ -179,6 +249,298 @@ func (k Keeper) ToggleConversion(
 	ctx sdk.Context,
 	token string,
 ) (types.TokenPair, error) {
+	pair, err := k.getTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	pair.Enabled = !pair.Enabled
+
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// UpdateCoinMetadata updates the bank metadata of a registered native
+// Cosmos coin and migrates its token pair to a new ERC20 contract deployed
+// with the updated name, symbol and decimals. The metadata of ERC20 native
+// token pairs is defined by their contract and can't be updated.
+func (k Keeper) UpdateCoinMetadata(
+	ctx sdk.Context,
+	coinMetadata banktypes.Metadata,
+) (types.TokenPair, error) {
+	pair, err := k.getTokenPairByToken(ctx, coinMetadata.Base)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if !pair.IsNativeCoin() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrERC20UpdateToken, "metadata of ERC20 native token pairs is defined by the contract %s", pair.Erc20Address,
+		)
+	}
+
+	if err := coinMetadata.Validate(); err != nil {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrERC20UpdateToken, "coin metadata is invalid %s: %s", coinMetadata.Base, err.Error(),
+		)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, coinMetadata)
+
+	// the contract is only replaced if its name, symbol or decimals change
+	erc20Data, err := k.QueryERC20(ctx, pair.GetERC20Contract())
+	if err == nil && erc20Data == types.NewERC20Data(coinMetadata.Name, coinMetadata.Symbol, metadataDecimals(coinMetadata)) {
+		return pair, nil
+	}
+
+	contract, err := k.migrateNativeCoinContract(ctx, pair)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	return k.replaceTokenPairContract(ctx, pair, contract), nil
+}
+
+// MigrateTokenPairContract replaces the ERC20 contract of a token pair, keeping its
+// Cosmos denomination and conversion status:
+//   - native Cosmos coin pairs are migrated to a new module-owned contract. The
+//     previous contract is kept as a legacy contract of the pair, whose token
+//     balances are moved to the new contract when their holders convert the pair
+//   - ERC20 native pairs are migrated to the given contract, which must already
+//     hold on the module account an escrow that backs the Cosmos coin supply.
+//     The module escrow on the previous contract is released to the given
+//     escrow recipient
+//
+// Allowances on the previous contract are not migrated.
+func (k Keeper) MigrateTokenPairContract(
+	ctx sdk.Context,
+	token string,
+	newContract string,
+	escrowRecipient string,
+) (types.TokenPair, error) {
+	pair, err := k.getTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	var contract common.Address
+	switch {
+	case pair.IsNativeCoin():
+		if newContract != "" {
+			return types.TokenPair{}, errorsmod.Wrap(
+				types.ErrTokenPairMigration, "contracts of native Cosmos coin pairs are deployed by the module",
+			)
+		}
+		if escrowRecipient != "" {
+			return types.TokenPair{}, errorsmod.Wrap(
+				types.ErrTokenPairMigration, "native Cosmos coin pairs don't have a module escrow to release",
+			)
+		}
+		contract, err = k.migrateNativeCoinContract(ctx, pair)
+	case pair.IsNativeERC20():
+		if newContract == "" {
+			return types.TokenPair{}, errorsmod.Wrap(
+				types.ErrTokenPairMigration, "new contract is required for ERC20 native token pairs",
+			)
+		}
+		if escrowRecipient == "" {
+			return types.TokenPair{}, errorsmod.Wrap(
+				types.ErrTokenPairMigration, "escrow recipient is required for ERC20 native token pairs",
+			)
+		}
+		contract = common.HexToAddress(newContract)
+		if err = k.verifyNativeERC20Escrow(ctx, pair, contract); err == nil {
+			err = k.releaseNativeERC20Escrow(ctx, pair, common.HexToAddress(escrowRecipient))
+		}
+	default:
+		return types.TokenPair{}, types.ErrUndefinedOwner
+	}
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	return k.replaceTokenPairContract(ctx, pair, contract), nil
+}
+
+// RemoveTokenPair permanently removes a token pair. It fails if any
+// converted tokens are still outstanding, as they would no longer be
+// convertible back.
+func (k Keeper) RemoveTokenPair(
+	ctx sdk.Context,
+	token string,
+) (types.TokenPair, error) {
+	pair, err := k.getTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	switch {
+	case pair.IsNativeCoin():
+		erc20Contracts := append([]common.Address{pair.GetERC20Contract()}, k.GetLegacyContractsByDenom(ctx, pair.Denom)...)
+		for _, contract := range erc20Contracts {
+			if k.hasOutstandingSupply(ctx, contract) {
+				return types.TokenPair{}, errorsmod.Wrapf(
+					types.ErrTokenPairInUse, "contract %s has an outstanding supply", contract,
+				)
+			}
+		}
+	case pair.IsNativeERC20():
+		if supply := k.bankKeeper.GetSupply(ctx, pair.Denom); supply.IsPositive() {
+			return types.TokenPair{}, errorsmod.Wrapf(
+				types.ErrTokenPairInUse, "coin %s has an outstanding supply", supply,
+			)
+		}
+	default:
+		return types.TokenPair{}, types.ErrUndefinedOwner
+	}
+
+	k.DeleteTokenPair(ctx, pair)
+	k.DeleteConversionLimit(ctx, pair.Denom)
+	return pair, nil
+}
+
+// migrateNativeCoinContract deploys a new ERC20 contract for a native Cosmos
+// coin pair with its current bank metadata and records the previous contract
+// as a legacy contract of the pair.
+func (k Keeper) migrateNativeCoinContract(
+	ctx sdk.Context,
+	pair types.TokenPair,
+) (common.Address, error) {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	if !found {
+		return common.Address{}, errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "denom metadata not found for %s", pair.Denom,
+		)
+	}
+
+	contract, err := k.DeployERC20Contract(ctx, metadata)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	k.SetLegacyContract(ctx, types.NewLegacyContract(pair.GetERC20Contract(), pair.Denom))
+	return contract, nil
+}
+
+// replaceTokenPairContract stores the token pair with the given contract in
+// place of the current one, keeping its denomination, owner, conversion status
+// and legacy contracts.
+func (k Keeper) replaceTokenPairContract(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	contract common.Address,
+) types.TokenPair {
+	migrated := types.NewTokenPair(contract, pair.Denom, pair.ContractOwner)
+	migrated.Enabled = pair.Enabled
+
+	k.deleteTokenPair(ctx, pair.GetID())
+	k.deleteERC20Map(ctx, pair.GetERC20Contract())
+	k.SetTokenPair(ctx, migrated)
+	k.SetDenomMap(ctx, migrated.Denom, migrated.GetID())
+	k.SetERC20Map(ctx, contract, migrated.GetID())
+
+	return migrated
+}
+
+// hasOutstandingSupply returns true if the given module-owned contract has
+// tokens in circulation or its supply can't be queried. Selfdestructed
+// contracts have no outstanding tokens.
+func (k Keeper) hasOutstandingSupply(ctx sdk.Context, contract common.Address) bool {
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return false
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	supply := k.TotalSupply(ctx, erc20, contract)
+	return supply == nil || supply.Sign() != 0
+}
+
+// verifyNativeERC20Escrow checks that the contract an ERC20 native pair
+// migrates to is an unregistered ERC20 with the same decimals, whose balance
+// on the module account backs the full supply of the Cosmos coin.
+func (k Keeper) verifyNativeERC20Escrow(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	contract common.Address,
+) error {
+	if _, found := k.GetLegacyContract(ctx, contract); found || k.IsERC20Registered(ctx, contract) {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", contract,
+		)
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "account %s is not a contract", contract,
+		)
+	}
+
+	if err := k.VerifyERC20Behavior(ctx, contract); err != nil {
+		return errorsmod.Wrapf(err, "contract %s failed the behavior verification", contract)
+	}
+
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom); found && metadataDecimals(metadata) != erc20Data.Decimals {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "decimals mismatch: expected %d, got %d", metadataDecimals(metadata), erc20Data.Decimals,
+		)
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	escrow := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+	if escrow == nil || escrow.Cmp(supply.Amount.BigInt()) < 0 {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairMigration, "module escrow on contract %s doesn't back the coin supply %s", contract, supply,
+		)
+	}
+
+	return nil
+}
+
+// releaseNativeERC20Escrow transfers the tokens escrowed by the module on the
+// current contract of an ERC20 native token pair to the recipient. It must
+// only be called once the escrow on the contract that replaces it backs the
+// Cosmos coin supply.
+func (k Keeper) releaseNativeERC20Escrow(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	recipient common.Address,
+) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	escrow := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+	if escrow == nil || escrow.Sign() == 0 {
+		return nil
+	}
+
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "transfer", recipient, escrow)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to release the module escrow on contract %s", contract)
+	}
+
+	var unpackedRet types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
+		return err
+	}
+
+	if !unpackedRet.Value {
+		return errorsmod.Wrapf(types.ErrEVMCall, "failed to release the module escrow on contract %s", contract)
+	}
+
+	return nil
+}
+
+// getTokenPairByToken returns the registered token pair of a token, given
+// either its ERC20 hex address or its Cosmos denomination
+func (k Keeper) getTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, error) {
 	id := k.GetTokenPairID(ctx, token)
 	if len(id) == 0 {
 		return types.TokenPair{}, errorsmod.Wrapf(
@@ -193,9 +555,6 @@ func (k Keeper) ToggleConversion(
 		)
 	}
 
-	pair.Enabled = !pair.Enabled
-
-	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
 
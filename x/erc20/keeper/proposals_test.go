@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -91,6 +92,19 @@ func (suite *KeeperTestSuite) setupRegisterERC20Pair(contractType int) common.Ad
 	suite.Require().NoError(err)
 	suite.Commit()
 
+	if contractType != contractMinterBurner {
+		// malicious contracts are rejected by RegisterERC20, so the pair is
+		// stored directly to test the checks performed on conversions
+		metadata, err := suite.app.Erc20Keeper.CreateCoinMetadata(suite.ctx, contract)
+		suite.Require().NoError(err)
+
+		pair := types.NewTokenPair(contract, metadata.Name, types.OWNER_EXTERNAL)
+		suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+		suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+		suite.app.Erc20Keeper.SetERC20Map(suite.ctx, contract, pair.GetID())
+		return contract
+	}
+
 	_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
 	suite.Require().NoError(err)
 	return contract
@@ -249,6 +263,7 @@ func (suite KeeperTestSuite) TestRegisterCoin() { //nolint:govet // we can copy
 func (suite KeeperTestSuite) TestRegisterERC20() { //nolint:govet // we can copy locks here because it is a test
 	var (
 		contractAddr common.Address
+		coinName     string
 		pair         types.TokenPair
 	)
 	testCases := []struct {
@@ -282,6 +297,26 @@ func (suite KeeperTestSuite) TestRegisterERC20() { //nolint:govet // we can copy
 			func() {},
 			true,
 		},
+		{
+			"fail - transfer changes the balance of a third party",
+			func() {
+				var err error
+				contractAddr, err = suite.DeployContractDirectBalanceManipulation()
+				suite.Require().NoError(err)
+				coinName = types.CreateDenom(contractAddr.String())
+			},
+			false,
+		},
+		{
+			"fail - transfer emits an Approval event",
+			func() {
+				var err error
+				contractAddr, err = suite.DeployContractMaliciousDelayed()
+				suite.Require().NoError(err)
+				coinName = types.CreateDenom(contractAddr.String())
+			},
+			false,
+		},
 		{
 			"force fail evm",
 			func() {
@@ -306,7 +341,7 @@ func (suite KeeperTestSuite) TestRegisterERC20() { //nolint:govet // we can copy
 			contractAddr, err = suite.DeployContract(erc20Name, erc20Symbol, cosmosDecimals)
 			suite.Require().NoError(err)
 
-			coinName := types.CreateDenom(contractAddr.String())
+			coinName = types.CreateDenom(contractAddr.String())
 			pair = types.NewTokenPair(contractAddr, coinName, types.OWNER_EXTERNAL)
 
 			tc.malleate()
@@ -416,3 +451,490 @@ func (suite KeeperTestSuite) TestToggleConverision() { //nolint:govet // we can
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateCoinMetadata() {
+	testCases := []struct {
+		name        string
+		malleate    func() banktypes.Metadata
+		expPass     bool
+		expMigrated bool
+	}{
+		{
+			"fail - token pair not registered",
+			func() banktypes.Metadata {
+				metadata := metadataIbc
+				return metadata
+			},
+			false,
+			false,
+		},
+		{
+			"fail - ERC20 native token pair",
+			func() banktypes.Metadata {
+				contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+				metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.CreateDenom(contract.String()))
+				suite.Require().True(found)
+				return metadata
+			},
+			false,
+			false,
+		},
+		{
+			"fail - invalid metadata",
+			func() banktypes.Metadata {
+				metadata := metadataCoin
+				metadata.Symbol = ""
+				return metadata
+			},
+			false,
+			false,
+		},
+		{
+			"ok - unchanged ERC20 metadata keeps the contract",
+			func() banktypes.Metadata {
+				metadata := metadataCoin
+				metadata.Description = "updated description"
+				return metadata
+			},
+			true,
+			false,
+		},
+		{
+			"ok - name and symbol",
+			func() banktypes.Metadata {
+				metadata := metadataCoin
+				metadata.Name = "Fixed Token"
+				metadata.Symbol = "FIX"
+				return metadata
+			},
+			true,
+			true,
+		},
+		{
+			"ok - long name and decimals",
+			func() banktypes.Metadata {
+				metadata := metadataCoin
+				metadata.Name = "Coin Token With A Name Longer Than Thirty Two Bytes"
+				metadata.DenomUnits = []*banktypes.DenomUnit{
+					{Denom: cosmosTokenBase, Exponent: 0},
+					{Denom: cosmosTokenDisplay, Exponent: uint32(cosmosDecimals)},
+				}
+				return metadata
+			},
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			pair := suite.setupRegisterCoin(metadataCoin)
+
+			metadata := tc.malleate()
+			updated, err := suite.app.Erc20Keeper.UpdateCoinMetadata(suite.ctx, metadata)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+
+				stored, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, metadata.Base)
+				suite.Require().True(found)
+				suite.Require().Equal(metadata, stored)
+
+				erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, updated.GetERC20Contract())
+				suite.Require().NoError(err)
+				suite.Require().Equal(metadata.Name, erc20Data.Name)
+				suite.Require().Equal(metadata.Symbol, erc20Data.Symbol)
+				suite.Require().Equal(metadata.DenomUnits[1].Exponent, uint32(erc20Data.Decimals))
+
+				_, isLegacy := suite.app.Erc20Keeper.GetLegacyContract(suite.ctx, pair.GetERC20Contract())
+				suite.Require().Equal(tc.expMigrated, isLegacy)
+				if tc.expMigrated {
+					suite.Require().NotEqual(pair.Erc20Address, updated.Erc20Address)
+				} else {
+					suite.Require().Equal(*pair, updated)
+				}
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAfterDenomMetadataUpdate() {
+	testCases := []struct {
+		name       string
+		metadata   banktypes.Metadata
+		expUpdated bool
+	}{
+		{
+			"ok - unregistered denom is ignored",
+			metadataIbc,
+			false,
+		},
+		{
+			"ok - registered denom updates the ERC20 contract",
+			func() banktypes.Metadata {
+				metadata := metadataCoin
+				metadata.Name = "Fixed Token"
+				metadata.Symbol = "FIX"
+				return metadata
+			}(),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			pair := suite.setupRegisterCoin(metadataCoin)
+
+			hook := keeper.NewERC20ContractRegistrationHook(suite.app.Erc20Keeper)
+			err := hook.AfterDenomMetadataUpdate(suite.ctx, tc.metadata)
+			suite.Require().NoError(err)
+
+			updated, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, cosmosTokenBase))
+			suite.Require().True(found)
+			if !tc.expUpdated {
+				suite.Require().Equal(*pair, updated)
+				return
+			}
+
+			suite.Require().NotEqual(pair.Erc20Address, updated.Erc20Address)
+			erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, updated.GetERC20Contract())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.metadata.Name, erc20Data.Name)
+			suite.Require().Equal(tc.metadata.Symbol, erc20Data.Symbol)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateTokenPairContract() {
+	var newContract, escrowRecipient string
+
+	testCases := []struct {
+		name       string
+		nativeCoin bool
+		malleate   func(pair types.TokenPair)
+		expPass    bool
+	}{
+		{
+			"fail - new contract for native Cosmos coin pair",
+			true,
+			func(types.TokenPair) {
+				newContract = utiltx.GenerateAddress().String()
+			},
+			false,
+		},
+		{
+			"fail - escrow recipient for native Cosmos coin pair",
+			true,
+			func(types.TokenPair) {
+				escrowRecipient = utiltx.GenerateAddress().Hex()
+			},
+			false,
+		},
+		{
+			"ok - native Cosmos coin pair",
+			true,
+			func(types.TokenPair) {},
+			true,
+		},
+		{
+			"fail - ERC20 native pair without new contract",
+			false,
+			func(types.TokenPair) {},
+			false,
+		},
+		{
+			"fail - ERC20 native pair migrated to a registered contract",
+			false,
+			func(pair types.TokenPair) {
+				newContract = pair.Erc20Address
+			},
+			false,
+		},
+		{
+			"fail - ERC20 native pair migrated to a non contract account",
+			false,
+			func(types.TokenPair) {
+				newContract = utiltx.GenerateAddress().String()
+			},
+			false,
+		},
+		{
+			"fail - ERC20 native pair without escrow recipient",
+			false,
+			func(pair types.TokenPair) {
+				contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				newContract = contract.String()
+				escrowRecipient = ""
+				suite.Commit()
+			},
+			false,
+		},
+		{
+			"fail - ERC20 native pair without escrow on the new contract",
+			false,
+			func(pair types.TokenPair) {
+				contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				newContract = contract.String()
+
+				coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100))
+				err = suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"ok - ERC20 native pair with escrow on the new contract",
+			false,
+			func(pair types.TokenPair) {
+				contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				newContract = contract.String()
+
+				suite.MintERC20Token(contract, suite.address, types.ModuleAddress, big.NewInt(100))
+				// tokens sent to the module on the registered contract are
+				// escrowed and converted to coins, then released on migration
+				suite.MintERC20Token(pair.GetERC20Contract(), suite.address, types.ModuleAddress, big.NewInt(100))
+				suite.Require().Equal(int64(100), suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom).Amount.Int64())
+				suite.Commit()
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+			newContract = ""
+			escrowRecipient = ""
+
+			var pair types.TokenPair
+			if tc.nativeCoin {
+				pair = *suite.setupRegisterCoin(metadataCoin)
+
+				// convert coins so that the ERC20 contract has a holder
+				sender := sdk.AccAddress(suite.address.Bytes())
+				coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+				err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+				suite.Require().NoError(err)
+
+				msg := types.NewMsgConvertCoin(coins[0], suite.address, sender)
+				_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+				suite.Commit()
+			} else {
+				contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+				id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contract.String())
+				pair, _ = suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+				escrowRecipient = utiltx.GenerateAddress().Hex()
+			}
+
+			tc.malleate(pair)
+
+			migrated, err := suite.app.Erc20Keeper.MigrateTokenPairContract(suite.ctx, pair.Denom, newContract, escrowRecipient)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(pair.Denom, migrated.Denom)
+				suite.Require().Equal(pair.ContractOwner, migrated.ContractOwner)
+				suite.Require().NotEqual(pair.Erc20Address, migrated.Erc20Address)
+
+				suite.Require().False(suite.app.Erc20Keeper.IsTokenPairRegistered(suite.ctx, pair.GetID()))
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, pair.GetERC20Contract()))
+				id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, pair.Denom)
+				stored, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+				suite.Require().True(found)
+				suite.Require().Equal(migrated, stored)
+
+				if tc.nativeCoin {
+					// balances are only migrated when the holder converts the pair
+					legacyContract, found := suite.app.Erc20Keeper.GetLegacyContract(suite.ctx, pair.GetERC20Contract())
+					suite.Require().True(found)
+					suite.Require().Equal(pair.Denom, legacyContract.Denom)
+					suite.Require().Equal(int64(100), suite.BalanceOf(pair.GetERC20Contract(), suite.address).(*big.Int).Int64())
+					suite.Require().Equal(int64(0), suite.BalanceOf(migrated.GetERC20Contract(), suite.address).(*big.Int).Int64())
+				} else {
+					// the module escrow on the previous contract is released
+					recipient := common.HexToAddress(escrowRecipient)
+					suite.Require().Equal(int64(0), suite.BalanceOf(pair.GetERC20Contract(), types.ModuleAddress).(*big.Int).Int64())
+					suite.Require().Equal(int64(100), suite.BalanceOf(pair.GetERC20Contract(), recipient).(*big.Int).Int64())
+				}
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestRemoveTokenPair() {
+	testCases := []struct {
+		name       string
+		nativeCoin bool
+		malleate   func(pair types.TokenPair)
+		expPass    bool
+	}{
+		{
+			"ok - native Cosmos coin pair without supply",
+			true,
+			func(types.TokenPair) {},
+			true,
+		},
+		{
+			"fail - native Cosmos coin pair with outstanding tokens",
+			true,
+			func(types.TokenPair) {
+				sender := sdk.AccAddress(suite.address.Bytes())
+				coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+				err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+				suite.Require().NoError(err)
+
+				msg := types.NewMsgConvertCoin(coins[0], suite.address, sender)
+				_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"ok - ERC20 native pair without supply",
+			false,
+			func(types.TokenPair) {},
+			true,
+		},
+		{
+			"fail - ERC20 native pair with outstanding coins",
+			false,
+			func(pair types.TokenPair) {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100))
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			var pair types.TokenPair
+			if tc.nativeCoin {
+				pair = *suite.setupRegisterCoin(metadataCoin)
+			} else {
+				contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+				id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contract.String())
+				pair, _ = suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			}
+
+			tc.malleate(pair)
+
+			_, err := suite.app.Erc20Keeper.RemoveTokenPair(suite.ctx, pair.Erc20Address)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().False(suite.app.Erc20Keeper.IsTokenPairRegistered(suite.ctx, pair.GetID()))
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, pair.GetERC20Contract()))
+				suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, pair.Denom))
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().True(suite.app.Erc20Keeper.IsTokenPairRegistered(suite.ctx, pair.GetID()))
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestRegisterMatchingCoins() {
+	factoryBase := "factory/creator/utoken"
+	metadataFactory := banktypes.Metadata{
+		Description: "factory token",
+		Base:        factoryBase,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: factoryBase, Exponent: 0},
+			{Denom: "token", Exponent: 6},
+		},
+		Name:    "Token",
+		Symbol:  "TKN",
+		Display: "token",
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expDenoms []string
+		expName   string
+		expSymbol string
+	}{
+		{
+			"ok - default rules only register IBC denoms",
+			func() {},
+			[]string{ibcBase},
+			"",
+			"",
+		},
+		{
+			"ok - custom rule with templates",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.AutoRegistrationRules = []types.AutoRegistrationRule{
+					{Denom: "factory/", Match: types.DENOM_MATCH_PREFIX, NameTemplate: "Factory {name}", SymbolTemplate: "f{symbol}"},
+				}
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			[]string{factoryBase},
+			"Factory Token",
+			"fTKN",
+		},
+		{
+			"ok - registered denoms are skipped",
+			func() {
+				suite.setupRegisterCoin(metadataIbc)
+			},
+			[]string{},
+			"",
+			"",
+		},
+		{
+			"ok - no rules",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.AutoRegistrationRules = nil
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			[]string{},
+			"",
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadataIbc)
+			suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadataFactory)
+
+			tc.malleate()
+
+			pairs := suite.app.Erc20Keeper.RegisterMatchingCoins(suite.ctx)
+			denoms := []string{}
+			for _, pair := range pairs {
+				denoms = append(denoms, pair.Denom)
+				suite.Require().True(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, pair.Denom))
+			}
+			suite.Require().Equal(tc.expDenoms, denoms)
+
+			if tc.expName != "" {
+				erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, pairs[0].GetERC20Contract())
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expName, erc20Data.Name)
+				suite.Require().Equal(tc.expSymbol, erc20Data.Symbol)
+				suite.Require().Equal(uint8(6), erc20Data.Decimals)
+			}
+		})
+	}
+}
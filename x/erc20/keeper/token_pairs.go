@@ -87,12 +87,13 @@ func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	store.Set(key, bz)
 }
 
-// DeleteTokenPair removes a token pair.
+// DeleteTokenPair removes a token pair together with its legacy contracts.
 func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	id := tokenPair.GetID()
 	k.deleteTokenPair(ctx, id)
 	k.deleteERC20Map(ctx, tokenPair.GetERC20Contract())
 	k.deleteDenomMap(ctx, tokenPair.Denom)
+	k.deleteLegacyContracts(ctx, tokenPair.Denom)
 }
 
 // deleteTokenPair deletes the token pair for the given id
@@ -0,0 +1,134 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// templatePlaceholder matches the placeholders of an auto-registration template
+var templatePlaceholder = regexp.MustCompile(`\{[^{}]*\}`)
+
+// ValidateAutoRegistrationRules performs a stateless validation of a list of
+// auto-registration rules
+func ValidateAutoRegistrationRules(rules []AutoRegistrationRule) error {
+	seen := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+
+		key := rule.Match.String() + "|" + strings.ToLower(rule.Denom)
+		if seen[key] {
+			return fmt.Errorf("duplicate auto-registration rule for denom %s", rule.Denom)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of an auto-registration rule
+func (r AutoRegistrationRule) Validate() error {
+	switch r.Match {
+	case DENOM_MATCH_PREFIX:
+		if strings.TrimSpace(r.Denom) == "" {
+			return fmt.Errorf("auto-registration rule prefix cannot be blank")
+		}
+	case DENOM_MATCH_EXACT:
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return fmt.Errorf("invalid auto-registration rule denom: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid auto-registration rule match for denom %s: %s", r.Denom, r.Match)
+	}
+
+	if err := validateTemplate(r.NameTemplate); err != nil {
+		return fmt.Errorf("invalid name template for denom %s: %w", r.Denom, err)
+	}
+
+	if err := validateTemplate(r.SymbolTemplate); err != nil {
+		return fmt.Errorf("invalid symbol template for denom %s: %w", r.Denom, err)
+	}
+
+	return nil
+}
+
+// Matches returns true if the rule applies to the given base denomination.
+// Denominations are compared case-insensitively.
+func (r AutoRegistrationRule) Matches(denom string) bool {
+	denom, ruleDenom := strings.ToLower(denom), strings.ToLower(r.Denom)
+	switch r.Match {
+	case DENOM_MATCH_PREFIX:
+		return strings.HasPrefix(denom, ruleDenom)
+	case DENOM_MATCH_EXACT:
+		return denom == ruleDenom
+	default:
+		return false
+	}
+}
+
+// ERC20NameAndSymbol returns the name and symbol of the ERC20 contract
+// deployed for the given coin metadata
+func (r AutoRegistrationRule) ERC20NameAndSymbol(coinMetadata banktypes.Metadata) (string, string) {
+	name, symbol := coinMetadata.Name, coinMetadata.Symbol
+	if r.NameTemplate != "" {
+		name = renderTemplate(r.NameTemplate, coinMetadata)
+	}
+	if r.SymbolTemplate != "" {
+		symbol = renderTemplate(r.SymbolTemplate, coinMetadata)
+	}
+	return name, symbol
+}
+
+// MatchAutoRegistrationRule returns the first rule that applies to the given
+// base denomination
+func MatchAutoRegistrationRule(rules []AutoRegistrationRule, denom string) (AutoRegistrationRule, bool) {
+	for _, rule := range rules {
+		if rule.Matches(denom) {
+			return rule, true
+		}
+	}
+	return AutoRegistrationRule{}, false
+}
+
+// validateTemplate checks that a template only references known placeholders
+func validateTemplate(template string) error {
+	for _, placeholder := range templatePlaceholder.FindAllString(template, -1) {
+		switch placeholder {
+		case "{base}", "{display}", "{name}", "{symbol}":
+		default:
+			return fmt.Errorf("unknown placeholder %s", placeholder)
+		}
+	}
+	return nil
+}
+
+// renderTemplate replaces the template placeholders with the coin metadata fields
+func renderTemplate(template string, coinMetadata banktypes.Metadata) string {
+	return strings.NewReplacer(
+		"{base}", coinMetadata.Base,
+		"{display}", coinMetadata.Display,
+		"{name}", coinMetadata.Name,
+		"{symbol}", coinMetadata.Symbol,
+	).Replace(template)
+}
@@ -0,0 +1,136 @@
+package types_test
+
+import (
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/evmos/evmos/v12/x/erc20/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAutoRegistrationRules(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rules    []types.AutoRegistrationRule
+		expError bool
+	}{
+		{"default", types.DefaultAutoRegistrationRules, false},
+		{"empty", []types.AutoRegistrationRule{}, false},
+		{
+			"valid prefix and exact rules",
+			[]types.AutoRegistrationRule{
+				{Denom: "factory/", Match: types.DENOM_MATCH_PREFIX, NameTemplate: "Factory {name}", SymbolTemplate: "f{symbol}"},
+				{Denom: "uatom", Match: types.DENOM_MATCH_EXACT},
+			},
+			false,
+		},
+		{
+			"unspecified match",
+			[]types.AutoRegistrationRule{{Denom: "factory/"}},
+			true,
+		},
+		{
+			"blank prefix",
+			[]types.AutoRegistrationRule{{Denom: " ", Match: types.DENOM_MATCH_PREFIX}},
+			true,
+		},
+		{
+			"invalid exact denom",
+			[]types.AutoRegistrationRule{{Denom: "1atom", Match: types.DENOM_MATCH_EXACT}},
+			true,
+		},
+		{
+			"unknown placeholder",
+			[]types.AutoRegistrationRule{{Denom: "factory/", Match: types.DENOM_MATCH_PREFIX, NameTemplate: "{description}"}},
+			true,
+		},
+		{
+			"duplicate rule",
+			[]types.AutoRegistrationRule{
+				{Denom: "ibc/", Match: types.DENOM_MATCH_PREFIX},
+				{Denom: "ibc/", Match: types.DENOM_MATCH_PREFIX, NameTemplate: "{base}"},
+			},
+			true,
+		},
+		{
+			"duplicate rule with different case",
+			[]types.AutoRegistrationRule{
+				{Denom: "ibc/", Match: types.DENOM_MATCH_PREFIX},
+				{Denom: "IBC/", Match: types.DENOM_MATCH_PREFIX},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateAutoRegistrationRules(tc.rules)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMatchAutoRegistrationRule(t *testing.T) {
+	rules := []types.AutoRegistrationRule{
+		{Denom: "factory/creator/token", Match: types.DENOM_MATCH_EXACT, NameTemplate: "exact"},
+		{Denom: "factory/", Match: types.DENOM_MATCH_PREFIX, NameTemplate: "prefix"},
+		{Denom: "ibc/", Match: types.DENOM_MATCH_PREFIX, NameTemplate: "ibc"},
+	}
+
+	testCases := []struct {
+		name     string
+		denom    string
+		expFound bool
+		expName  string
+	}{
+		{"exact rule takes precedence", "factory/creator/token", true, "exact"},
+		{"prefix rule", "factory/creator/other", true, "prefix"},
+		{"exact rule is case insensitive", "Factory/Creator/Token", true, "exact"},
+		{"ibc prefix rule", "ibc/ABC", true, "ibc"},
+		{"uppercase ibc prefix", "IBC/ABC", true, "ibc"},
+		{"no match", "gravity0xABC", false, ""},
+	}
+
+	for _, tc := range testCases {
+		rule, found := types.MatchAutoRegistrationRule(rules, tc.denom)
+		require.Equal(t, tc.expFound, found, tc.name)
+		require.Equal(t, tc.expName, rule.NameTemplate, tc.name)
+	}
+}
+
+func TestERC20NameAndSymbol(t *testing.T) {
+	metadata := banktypes.Metadata{
+		Base:    "factory/creator/utoken",
+		Display: "token",
+		Name:    "Token",
+		Symbol:  "TKN",
+	}
+
+	testCases := []struct {
+		name      string
+		rule      types.AutoRegistrationRule
+		expName   string
+		expSymbol string
+	}{
+		{
+			"empty templates keep the metadata",
+			types.AutoRegistrationRule{},
+			"Token",
+			"TKN",
+		},
+		{
+			"templates with placeholders",
+			types.AutoRegistrationRule{NameTemplate: "Factory {name} ({display})", SymbolTemplate: "f{symbol}"},
+			"Factory Token (token)",
+			"fTKN",
+		},
+	}
+
+	for _, tc := range testCases {
+		name, symbol := tc.rule.ERC20NameAndSymbol(metadata)
+		require.Equal(t, tc.expName, name, tc.name)
+		require.Equal(t, tc.expSymbol, symbol, tc.name)
+	}
+}
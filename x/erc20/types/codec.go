@@ -43,6 +43,12 @@ const (
 	convertERC20Name = "evmos/MsgConvertERC20"
 	convertCoinName  = "evmos/MsgConvertCoin"
 	updateParams     = "evmos/erc20/MsgUpdateParams"
+	updateMetadata   = "evmos/erc20/MsgUpdateTokenPairMetadata"
+	migratePair      = "evmos/erc20/MsgMigrateTokenPair"
+	deregisterPair   = "evmos/erc20/MsgDeregisterTokenPair"
+	backfillPairs    = "evmos/erc20/MsgBackfillAutoRegistration"
+	updateLimit      = "evmos/erc20/MsgUpdateConversionLimit"
+	resetLimit       = "evmos/erc20/MsgResetConversionLimit"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -58,6 +64,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConvertCoin{},
 		&MsgConvertERC20{},
 		&MsgUpdateParams{},
+		&MsgUpdateTokenPairMetadata{},
+		&MsgMigrateTokenPair{},
+		&MsgDeregisterTokenPair{},
+		&MsgBackfillAutoRegistration{},
+		&MsgUpdateConversionLimit{},
+		&MsgResetConversionLimit{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -74,6 +86,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // Amino JSON serialization and EIP-712 compatibility.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParams, nil)
+	cdc.RegisterConcrete(&MsgUpdateTokenPairMetadata{}, updateMetadata, nil)
+	cdc.RegisterConcrete(&MsgMigrateTokenPair{}, migratePair, nil)
+	cdc.RegisterConcrete(&MsgDeregisterTokenPair{}, deregisterPair, nil)
+	cdc.RegisterConcrete(&MsgBackfillAutoRegistration{}, backfillPairs, nil)
+	cdc.RegisterConcrete(&MsgUpdateConversionLimit{}, updateLimit, nil)
+	cdc.RegisterConcrete(&MsgResetConversionLimit{}, resetLimit, nil)
 	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
 }
@@ -0,0 +1,117 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/evmos/evmos/v12/x/epochs/types"
+)
+
+// ConversionDirection defines the direction of a token pair conversion
+type ConversionDirection int
+
+const (
+	// CoinToERC20 converts Cosmos coins to ERC20 tokens
+	CoinToERC20 ConversionDirection = iota
+	// ERC20ToCoin converts ERC20 tokens to Cosmos coins
+	ERC20ToCoin
+)
+
+// String returns the name of the conversion direction
+func (d ConversionDirection) String() string {
+	switch d {
+	case CoinToERC20:
+		return "coin_to_erc20"
+	case ERC20ToCoin:
+		return "erc20_to_coin"
+	default:
+		return "unspecified"
+	}
+}
+
+// NewConversionLimit returns an instance of ConversionLimit
+func NewConversionLimit(denom, epochIdentifier string, maxCoinToERC20, maxERC20ToCoin sdk.Int) ConversionLimit {
+	return ConversionLimit{
+		Denom:           denom,
+		EpochIdentifier: epochIdentifier,
+		MaxCoinToERC20:  maxCoinToERC20,
+		MaxERC20ToCoin:  maxERC20ToCoin,
+	}
+}
+
+// Validate performs a stateless validation of a conversion limit
+func (cl ConversionLimit) Validate() error {
+	if err := sdk.ValidateDenom(cl.Denom); err != nil {
+		return err
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(cl.EpochIdentifier); err != nil {
+		return err
+	}
+
+	if cl.MaxCoinToERC20.IsNil() || cl.MaxCoinToERC20.IsNegative() {
+		return fmt.Errorf("invalid maximum coin to ERC20 conversion amount: %s", cl.MaxCoinToERC20)
+	}
+
+	if cl.MaxERC20ToCoin.IsNil() || cl.MaxERC20ToCoin.IsNegative() {
+		return fmt.Errorf("invalid maximum ERC20 to coin conversion amount: %s", cl.MaxERC20ToCoin)
+	}
+
+	return nil
+}
+
+// IsDisabled returns true if the limit doesn't restrict any conversion direction
+func (cl ConversionLimit) IsDisabled() bool {
+	return cl.MaxCoinToERC20.IsZero() && cl.MaxERC20ToCoin.IsZero()
+}
+
+// Max returns the maximum amount converted per window in the given direction.
+// A zero amount means the direction is not limited.
+func (cl ConversionLimit) Max(direction ConversionDirection) sdk.Int {
+	if direction == CoinToERC20 {
+		return cl.MaxCoinToERC20
+	}
+	return cl.MaxERC20ToCoin
+}
+
+// NewConversionWindow returns an empty conversion window
+func NewConversionWindow() ConversionWindow {
+	return ConversionWindow{
+		CoinToERC20: sdk.ZeroInt(),
+		ERC20ToCoin: sdk.ZeroInt(),
+	}
+}
+
+// Volume returns the amount converted within the window in the given direction
+func (cw ConversionWindow) Volume(direction ConversionDirection) sdk.Int {
+	if direction == CoinToERC20 {
+		return cw.CoinToERC20
+	}
+	return cw.ERC20ToCoin
+}
+
+// AddVolume adds the converted amount to the window volume of the given direction
+func (cw *ConversionWindow) AddVolume(direction ConversionDirection, amount sdk.Int) {
+	if direction == CoinToERC20 {
+		cw.CoinToERC20 = cw.CoinToERC20.Add(amount)
+		return
+	}
+	cw.ERC20ToCoin = cw.ERC20ToCoin.Add(amount)
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/evmos/evmos/v12/x/erc20/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversionLimitValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		limit    types.ConversionLimit
+		expError bool
+	}{
+		{
+			"valid",
+			types.NewConversionLimit("acoin", "day", math.NewInt(100), math.NewInt(10)),
+			false,
+		},
+		{
+			"valid - disabled",
+			types.NewConversionLimit("acoin", "day", math.ZeroInt(), math.ZeroInt()),
+			false,
+		},
+		{
+			"invalid denom",
+			types.NewConversionLimit("1coin", "day", math.NewInt(100), math.NewInt(10)),
+			true,
+		},
+		{
+			"empty epoch identifier",
+			types.NewConversionLimit("acoin", "", math.NewInt(100), math.NewInt(10)),
+			true,
+		},
+		{
+			"negative coin to erc20 amount",
+			types.NewConversionLimit("acoin", "day", math.NewInt(-1), math.NewInt(10)),
+			true,
+		},
+		{
+			"nil erc20 to coin amount",
+			types.ConversionLimit{Denom: "acoin", EpochIdentifier: "day", MaxCoinToERC20: math.NewInt(100)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.limit.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestConversionWindowAddVolume(t *testing.T) {
+	window := types.NewConversionWindow()
+	window.AddVolume(types.CoinToERC20, math.NewInt(10))
+	window.AddVolume(types.ERC20ToCoin, math.NewInt(5))
+	window.AddVolume(types.CoinToERC20, math.NewInt(3))
+
+	require.Equal(t, int64(13), window.Volume(types.CoinToERC20).Int64())
+	require.Equal(t, int64(5), window.Volume(types.ERC20ToCoin).Int64())
+}
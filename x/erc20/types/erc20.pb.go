@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -57,7 +58,8 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 }
 
 // TokenPair defines an instance that records a pairing consisting of a native
-//  Cosmos Coin and an ERC20 token address.
+//
+//	Cosmos Coin and an ERC20 token address.
 type TokenPair struct {
 	// erc20_address is the hex address of ERC20 contract token
 	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
@@ -373,6 +375,177 @@ func (m *ProposalMetadata) GetMetadata() []types.Metadata {
 	return nil
 }
 
+// ConversionLimit defines the maximum amount of a token pair that can be converted in
+// each direction within a window that is reset at the end of every epoch. A conversion
+// to ERC20 escrows (or burns) the coins and mints (or unescrows) the same amount of
+// tokens, so each limit bounds both the mint/burn and the escrow/unescrow volume of its
+// direction. A zero amount disables the limit of the direction.
+type ConversionLimit struct {
+	// denom is the Cosmos base denomination of the token pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// epoch_identifier is the identifier of the epoch at whose end the window is reset
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// max_coin_to_erc20 is the maximum amount of coins converted to ERC20 tokens per window
+	MaxCoinToERC20 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_coin_to_erc20,json=maxCoinToErc20,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_coin_to_erc20"`
+	// max_erc20_to_coin is the maximum amount of ERC20 tokens converted to coins per window
+	MaxERC20ToCoin github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_erc20_to_coin,json=maxErc20ToCoin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_erc20_to_coin"`
+}
+
+func (m *ConversionLimit) Reset()         { *m = ConversionLimit{} }
+func (m *ConversionLimit) String() string { return proto.CompactTextString(m) }
+func (*ConversionLimit) ProtoMessage()    {}
+func (*ConversionLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{5}
+}
+func (m *ConversionLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionLimit.Merge(m, src)
+}
+func (m *ConversionLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionLimit proto.InternalMessageInfo
+
+func (m *ConversionLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ConversionLimit) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+// ConversionWindow defines the amounts of a token pair converted within the current
+// window of its conversion limit.
+type ConversionWindow struct {
+	// coin_to_erc20 is the amount of coins converted to ERC20 tokens
+	CoinToERC20 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=coin_to_erc20,json=coinToErc20,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"coin_to_erc20"`
+	// erc20_to_coin is the amount of ERC20 tokens converted to coins
+	ERC20ToCoin github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=erc20_to_coin,json=erc20ToCoin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_to_coin"`
+	// tripped is true if the token pair was paused after reaching the conversion limit
+	Tripped bool `protobuf:"varint,3,opt,name=tripped,proto3" json:"tripped,omitempty"`
+}
+
+func (m *ConversionWindow) Reset()         { *m = ConversionWindow{} }
+func (m *ConversionWindow) String() string { return proto.CompactTextString(m) }
+func (*ConversionWindow) ProtoMessage()    {}
+func (*ConversionWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{6}
+}
+func (m *ConversionWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionWindow.Merge(m, src)
+}
+func (m *ConversionWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionWindow proto.InternalMessageInfo
+
+func (m *ConversionWindow) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
+	}
+	return false
+}
+
+// LegacyContract defines a previous ERC20 contract of a native Cosmos coin token pair
+// that was replaced by a migration. Its tokens remain backed by the escrowed coins and
+// are moved to the current contract of the pair when their holder converts the pair.
+type LegacyContract struct {
+	// erc20_address is the hex address of the replaced ERC20 contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// denom is the Cosmos base denomination of the token pair
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *LegacyContract) Reset()         { *m = LegacyContract{} }
+func (m *LegacyContract) String() string { return proto.CompactTextString(m) }
+func (*LegacyContract) ProtoMessage()    {}
+func (*LegacyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{7}
+}
+func (m *LegacyContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LegacyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LegacyContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LegacyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyContract.Merge(m, src)
+}
+func (m *LegacyContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *LegacyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyContract proto.InternalMessageInfo
+
+func (m *LegacyContract) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *LegacyContract) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
 	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
@@ -380,44 +553,59 @@ func init() {
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
 	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "evmos.erc20.v1.ToggleTokenConversionProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
+	proto.RegisterType((*ConversionLimit)(nil), "evmos.erc20.v1.ConversionLimit")
+	proto.RegisterType((*ConversionWindow)(nil), "evmos.erc20.v1.ConversionWindow")
+	proto.RegisterType((*LegacyContract)(nil), "evmos.erc20.v1.LegacyContract")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xce, 0xb4, 0x5b, 0xed, 0x4e, 0xdb, 0x10, 0x87, 0x5d, 0x08, 0x0b, 0x4d, 0xc3, 0x0a, 0x12,
-	0x3c, 0x24, 0x4d, 0xbc, 0x89, 0x20, 0xdd, 0x6d, 0x84, 0x4a, 0xbb, 0xbb, 0xa4, 0x5b, 0x14, 0x2f,
-	0xcb, 0x24, 0x19, 0x62, 0xe8, 0x6e, 0x66, 0x99, 0x19, 0xa3, 0x1e, 0xbc, 0x7b, 0xf4, 0xe2, 0x5d,
-	0xd0, 0x3f, 0xa6, 0xc7, 0x1e, 0x3d, 0x89, 0xec, 0x5e, 0xfc, 0x33, 0x24, 0x33, 0x89, 0x58, 0x8f,
-	0xf6, 0x12, 0xde, 0xf7, 0xbd, 0x1f, 0xf9, 0xe6, 0x7b, 0x3c, 0xd8, 0x23, 0xe5, 0x82, 0x72, 0x8f,
-	0xb0, 0x24, 0x38, 0xf4, 0x4a, 0x5f, 0x05, 0xee, 0x92, 0x51, 0x41, 0x91, 0x2e, 0x73, 0xae, 0xa2,
-	0x4a, 0xbf, 0x67, 0x25, 0x94, 0x57, 0xc5, 0x31, 0x2e, 0x2e, 0xbd, 0xd2, 0x8f, 0x89, 0xc0, 0xbe,
-	0x04, 0xaa, 0xbe, 0xd7, 0xc9, 0x68, 0x46, 0x65, 0xe8, 0x55, 0x91, 0x62, 0xfb, 0xdf, 0x00, 0x6c,
-	0x4f, 0xe9, 0x25, 0x29, 0x26, 0x38, 0x67, 0xe8, 0x3e, 0xdc, 0x93, 0xf3, 0x66, 0x38, 0x4d, 0x19,
-	0xe1, 0xdc, 0x04, 0x36, 0x70, 0xda, 0xd1, 0xae, 0x24, 0x8f, 0x14, 0x87, 0x3a, 0x70, 0x2b, 0x25,
-	0x05, 0x5d, 0x98, 0x1b, 0x32, 0xa9, 0x00, 0x32, 0xe1, 0x5d, 0x52, 0xe0, 0x78, 0x4e, 0x52, 0x73,
-	0xd3, 0x06, 0xce, 0x76, 0xd4, 0x40, 0xf4, 0x04, 0xea, 0x09, 0x2d, 0x04, 0xc3, 0x89, 0x98, 0xd1,
-	0xb7, 0x05, 0x61, 0x66, 0xcb, 0x06, 0x8e, 0x1e, 0x74, 0xdd, 0x9b, 0x2f, 0x70, 0xc7, 0x55, 0x32,
-	0xda, 0x6b, 0x8a, 0x25, 0x7c, 0xdc, 0xfa, 0xf5, 0xe5, 0x00, 0xf4, 0x3f, 0x03, 0xd8, 0x89, 0x48,
-	0x96, 0x73, 0x41, 0xd8, 0x90, 0xe6, 0xc5, 0x84, 0xd1, 0x25, 0xe5, 0x78, 0x5e, 0x89, 0x11, 0xb9,
-	0x98, 0x93, 0x5a, 0xa9, 0x02, 0xc8, 0x86, 0x3b, 0x29, 0xe1, 0x09, 0xcb, 0x97, 0x22, 0xa7, 0x45,
-	0x2d, 0xf4, 0x6f, 0x0a, 0x3d, 0x85, 0xdb, 0x0b, 0x22, 0x70, 0x8a, 0x05, 0x36, 0x37, 0xed, 0x4d,
-	0x67, 0x27, 0xd8, 0x77, 0x95, 0x81, 0xae, 0xf4, 0xac, 0x36, 0xd0, 0x3d, 0xab, 0x8b, 0x06, 0xad,
-	0xab, 0x1f, 0x07, 0x5a, 0xf4, 0xa7, 0x49, 0xea, 0xd2, 0xfa, 0x1f, 0x60, 0xb7, 0x91, 0x15, 0x46,
-	0xc3, 0xe0, 0xf0, 0xd6, 0xba, 0x1e, 0x40, 0x5d, 0xfa, 0x51, 0x2f, 0x80, 0x70, 0xa9, 0xae, 0x1d,
-	0xfd, 0xc3, 0xd6, 0xbf, 0xe7, 0x70, 0x7f, 0x4a, 0xb3, 0x6c, 0x4e, 0xe4, 0x0a, 0x87, 0xb4, 0x28,
-	0x09, 0xe3, 0x39, 0xbd, 0xbd, 0x3d, 0x55, 0x5f, 0x35, 0x52, 0xee, 0xb2, 0xea, 0xab, 0x40, 0xbd,
-	0x8b, 0x73, 0x68, 0x34, 0xf3, 0x1b, 0x77, 0x6e, 0xd8, 0x09, 0xfe, 0xc3, 0xce, 0x87, 0xcf, 0xe1,
-	0x96, 0xdc, 0x37, 0xea, 0xc2, 0x7b, 0xe3, 0x17, 0xa3, 0x30, 0x9a, 0x5d, 0x8c, 0xce, 0x27, 0xe1,
-	0xf0, 0xe4, 0xd9, 0x49, 0x78, 0x6c, 0x68, 0xc8, 0x80, 0xbb, 0x8a, 0x3e, 0x1b, 0x1f, 0x5f, 0x9c,
-	0x86, 0x06, 0x40, 0x08, 0xea, 0x8a, 0x09, 0x5f, 0x4e, 0xc3, 0x68, 0x74, 0x74, 0x6a, 0x6c, 0xf4,
-	0x5a, 0x1f, 0xbf, 0x5a, 0xda, 0x60, 0x70, 0xb5, 0xb2, 0xc0, 0xf5, 0xca, 0x02, 0x3f, 0x57, 0x16,
-	0xf8, 0xb4, 0xb6, 0xb4, 0xeb, 0xb5, 0xa5, 0x7d, 0x5f, 0x5b, 0xda, 0x2b, 0x27, 0xcb, 0xc5, 0xeb,
-	0x37, 0xb1, 0x9b, 0xd0, 0x85, 0x57, 0x9f, 0x96, 0xfc, 0x96, 0x7e, 0xe0, 0xbd, 0xab, 0xcf, 0x4c,
-	0xbc, 0x5f, 0x12, 0x1e, 0xdf, 0x91, 0xe7, 0xf1, 0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x84,
-	0x1f, 0x6f, 0xa1, 0x82, 0x03, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xda, 0x48,
+	0x1c, 0x65, 0x80, 0xec, 0x26, 0x43, 0x70, 0x88, 0x95, 0x48, 0x16, 0x52, 0x0c, 0x62, 0xa5, 0x88,
+	0x5d, 0x69, 0xed, 0x40, 0x6f, 0x55, 0xab, 0x36, 0x10, 0x57, 0xa2, 0x25, 0x7f, 0xe4, 0x10, 0xa5,
+	0xea, 0xc5, 0x1a, 0xec, 0xa9, 0x33, 0x02, 0x7b, 0x2c, 0x7b, 0x4a, 0x92, 0x43, 0xef, 0x3d, 0xf6,
+	0xd2, 0x7b, 0xa5, 0xf6, 0xd8, 0x0f, 0x92, 0x63, 0x8e, 0x55, 0x0f, 0x51, 0x45, 0x2e, 0x3d, 0xf7,
+	0x13, 0x54, 0x9e, 0x19, 0x02, 0xf4, 0xd6, 0x70, 0x81, 0x79, 0x6f, 0xfe, 0xbc, 0xdf, 0x7b, 0x33,
+	0xfe, 0xc1, 0x32, 0x1e, 0x05, 0x34, 0x31, 0x71, 0xec, 0x36, 0x77, 0xcc, 0x51, 0x43, 0x0c, 0x8c,
+	0x28, 0xa6, 0x8c, 0xaa, 0x0a, 0x9f, 0x33, 0x04, 0x35, 0x6a, 0x94, 0x75, 0x97, 0x26, 0xe9, 0xe2,
+	0x3e, 0x0a, 0x07, 0xe6, 0xa8, 0xd1, 0xc7, 0x0c, 0x35, 0x38, 0x10, 0xeb, 0xcb, 0x1b, 0x3e, 0xf5,
+	0x29, 0x1f, 0x9a, 0xe9, 0x48, 0xb0, 0xb5, 0xcf, 0x00, 0xae, 0xf4, 0xe8, 0x00, 0x87, 0x47, 0x88,
+	0xc4, 0xea, 0x3f, 0xb0, 0xc8, 0xcf, 0x73, 0x90, 0xe7, 0xc5, 0x38, 0x49, 0x34, 0x50, 0x05, 0xf5,
+	0x15, 0x7b, 0x95, 0x93, 0xbb, 0x82, 0x53, 0x37, 0xe0, 0x92, 0x87, 0x43, 0x1a, 0x68, 0x59, 0x3e,
+	0x29, 0x80, 0xaa, 0xc1, 0xbf, 0x71, 0x88, 0xfa, 0x43, 0xec, 0x69, 0xb9, 0x2a, 0xa8, 0x2f, 0xdb,
+	0x13, 0xa8, 0x3e, 0x82, 0x8a, 0x4b, 0x43, 0x16, 0x23, 0x97, 0x39, 0xf4, 0x3c, 0xc4, 0xb1, 0x96,
+	0xaf, 0x82, 0xba, 0xd2, 0xdc, 0x34, 0xe6, 0x1d, 0x18, 0x87, 0xe9, 0xa4, 0x5d, 0x9c, 0x2c, 0xe6,
+	0xf0, 0x61, 0xfe, 0xc7, 0xc7, 0x0a, 0xa8, 0x7d, 0x00, 0x70, 0xc3, 0xc6, 0x3e, 0x49, 0x18, 0x8e,
+	0xdb, 0x94, 0x84, 0x47, 0x31, 0x8d, 0x68, 0x82, 0x86, 0x69, 0x31, 0x8c, 0xb0, 0x21, 0x96, 0x95,
+	0x0a, 0xa0, 0x56, 0x61, 0xc1, 0xc3, 0x89, 0x1b, 0x93, 0x88, 0x11, 0x1a, 0xca, 0x42, 0x67, 0x29,
+	0xf5, 0x09, 0x5c, 0x0e, 0x30, 0x43, 0x1e, 0x62, 0x48, 0xcb, 0x55, 0x73, 0xf5, 0x42, 0x73, 0xcb,
+	0x10, 0x01, 0x1a, 0x3c, 0x33, 0x19, 0xa0, 0xb1, 0x2f, 0x17, 0xb5, 0xf2, 0x57, 0x37, 0x95, 0x8c,
+	0x7d, 0xb7, 0x89, 0xd7, 0x95, 0xa9, 0xbd, 0x85, 0x9b, 0x93, 0xb2, 0x2c, 0xbb, 0xdd, 0xdc, 0x59,
+	0xb8, 0xae, 0x6d, 0xa8, 0xf0, 0x3c, 0xe4, 0x05, 0xe0, 0x84, 0x57, 0xb7, 0x62, 0xff, 0xc6, 0x4a,
+	0xf9, 0x04, 0x6e, 0xf5, 0xa8, 0xef, 0x0f, 0x31, 0xbf, 0xc2, 0x36, 0x0d, 0x47, 0x38, 0x4e, 0x08,
+	0x5d, 0x3c, 0x9e, 0x74, 0x5f, 0x7a, 0xa4, 0x96, 0x93, 0xfb, 0x52, 0x20, 0xef, 0xe2, 0x18, 0x96,
+	0x26, 0xe7, 0x4f, 0xd2, 0x99, 0x8b, 0x13, 0xdc, 0x23, 0xce, 0xda, 0x97, 0x2c, 0x5c, 0x9b, 0xd6,
+	0xdf, 0x25, 0x01, 0x61, 0xd3, 0x87, 0x06, 0x66, 0x1f, 0xda, 0xbf, 0xb0, 0x84, 0x23, 0xea, 0x9e,
+	0x39, 0xc4, 0xc3, 0x21, 0x23, 0xaf, 0x09, 0x8e, 0xa5, 0x83, 0x35, 0xce, 0x77, 0xee, 0x68, 0x75,
+	0x00, 0xd7, 0x03, 0x74, 0xe1, 0xb8, 0x94, 0x84, 0x0e, 0xa3, 0x0e, 0x8f, 0x50, 0x38, 0x6a, 0x3d,
+	0x4d, 0xf5, 0xbf, 0xdd, 0x54, 0xb6, 0x7d, 0xc2, 0xce, 0xde, 0xf4, 0x0d, 0x97, 0x06, 0xa6, 0xfc,
+	0x80, 0xc4, 0xdf, 0xff, 0x89, 0x37, 0x30, 0xd9, 0x65, 0x84, 0x13, 0xa3, 0x13, 0xb2, 0xf1, 0x4d,
+	0x45, 0xd9, 0x47, 0x17, 0xe9, 0xd3, 0xeb, 0x51, 0x7e, 0xd3, 0xb6, 0x12, 0xdc, 0xe1, 0xf4, 0xdc,
+	0x89, 0x98, 0xf8, 0x7e, 0x18, 0xe5, 0xaa, 0x5a, 0x7e, 0x01, 0x31, 0x2e, 0xd3, 0xa3, 0xa9, 0x06,
+	0x17, 0xe3, 0x32, 0x02, 0xd7, 0x7e, 0x02, 0x58, 0x9a, 0xc6, 0x75, 0x4a, 0x42, 0x8f, 0x9e, 0xab,
+	0x08, 0x16, 0xe7, 0xad, 0xf2, 0xdc, 0x5a, 0x8f, 0xff, 0x58, 0xbd, 0x30, 0xeb, 0xb3, 0xe0, 0xce,
+	0x98, 0x44, 0xb0, 0x38, 0x6f, 0x30, 0x7b, 0x5f, 0x89, 0x59, 0x77, 0x05, 0x3c, 0xb5, 0x96, 0x36,
+	0x12, 0x16, 0x93, 0x28, 0x9a, 0x36, 0x12, 0x09, 0x6b, 0x2f, 0xa0, 0xd2, 0xc5, 0x3e, 0x72, 0x2f,
+	0xdb, 0xb2, 0x43, 0x2c, 0xd0, 0xaf, 0xfe, 0x7b, 0x0e, 0x97, 0x78, 0x83, 0x51, 0x37, 0xe1, 0xfa,
+	0xe1, 0xe9, 0x81, 0x65, 0x3b, 0x27, 0x07, 0xc7, 0x47, 0x56, 0xbb, 0xf3, 0xac, 0x63, 0xed, 0x95,
+	0x32, 0x6a, 0x09, 0xae, 0x0a, 0x7a, 0xff, 0x70, 0xef, 0xa4, 0x6b, 0x95, 0x80, 0xaa, 0x42, 0x45,
+	0x30, 0xd6, 0xcb, 0x9e, 0x65, 0x1f, 0xec, 0x76, 0x4b, 0xd9, 0x72, 0xfe, 0xdd, 0x27, 0x3d, 0xd3,
+	0x6a, 0x5d, 0x8d, 0x75, 0x70, 0x3d, 0xd6, 0xc1, 0xf7, 0xb1, 0x0e, 0xde, 0xdf, 0xea, 0x99, 0xeb,
+	0x5b, 0x3d, 0xf3, 0xf5, 0x56, 0xcf, 0xbc, 0xaa, 0xcf, 0x04, 0x22, 0x7b, 0x39, 0xff, 0x1d, 0x35,
+	0x9a, 0xe6, 0x85, 0xec, 0xeb, 0x3c, 0x96, 0xfe, 0x5f, 0xbc, 0x1f, 0x3f, 0xf8, 0x35, 0x00, 0x91,
+	0xf9, 0x2e, 0xc7, 0xf3, 0x05, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -713,6 +901,153 @@ func (m *ProposalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConversionLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxERC20ToCoin.Size()
+		i -= size
+		if _, err := m.MaxERC20ToCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxCoinToERC20.Size()
+		i -= size
+		if _, err := m.MaxCoinToERC20.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ERC20ToCoin.Size()
+		i -= size
+		if _, err := m.ERC20ToCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CoinToERC20.Size()
+		i -= size
+		if _, err := m.CoinToERC20.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LegacyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LegacyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LegacyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
 	offset -= sovErc20(v)
 	base := offset
@@ -829,6 +1164,60 @@ func (m *ProposalMetadata) Size() (n int) {
 	return n
 }
 
+func (m *ConversionLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.MaxCoinToERC20.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.MaxERC20ToCoin.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *ConversionWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinToERC20.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.ERC20ToCoin.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Tripped {
+		n += 2
+	}
+	return n
+}
+
+func (m *LegacyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
 func sovErc20(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1512,6 +1901,440 @@ func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConversionLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCoinToERC20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCoinToERC20.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxERC20ToCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxERC20ToCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinToERC20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinToERC20.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20ToCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ERC20ToCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipErc20(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
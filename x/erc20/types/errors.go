@@ -36,4 +36,8 @@ var (
 	ErrERC20TokenPairDisabled = errorsmod.Register(ModuleName, 13, "erc20 token pair is disabled")
 	ErrERC20RegisterToken     = errorsmod.Register(ModuleName, 14, "erc20 token registration")
 	ErrERC20UpdateToken       = errorsmod.Register(ModuleName, 15, "erc20 token update not allowed")
+	ErrTokenPairMigration     = errorsmod.Register(ModuleName, 16, "erc20 token pair migration failed")
+	ErrTokenPairInUse         = errorsmod.Register(ModuleName, 17, "erc20 token pair has outstanding supply")
+	ErrConversionLimit        = errorsmod.Register(ModuleName, 18, "erc20 token pair conversion limit exceeded")
+	ErrUnsupportedERC20       = errorsmod.Register(ModuleName, 19, "unsupported erc20 contract behavior")
 )
@@ -33,12 +33,28 @@ const (
 	EventTypeRegisterCoin          = "register_coin"
 	EventTypeRegisterERC20         = "register_erc20"
 	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+	EventTypeUpdateTokenMetadata   = "update_token_pair_metadata"
+	EventTypeMigrateTokenPair      = "migrate_token_pair"
+	EventTypeMigrateLegacyBalance  = "migrate_legacy_balance"
+	EventTypeDeregisterTokenPair   = "deregister_token_pair"
+	EventTypeUpdateConversionLimit = "update_conversion_limit"
+	EventTypeConversionLimitPause  = "conversion_limit_pause"
+	EventTypeResetConversionLimit  = "reset_conversion_limit"
+	EventTypeEscrowShortfall       = "escrow_shortfall"
 
 	AttributeKeyCosmosCoin = "cosmos_coin"
 	AttributeKeyERC20Token = "erc20_token" // #nosec
 	AttributeKeyReceiver   = "receiver"
 	AttributeKeyDisplay    = "display"
 	AttributeKeySymbol     = "symbol"
+	AttributeKeyNewERC20   = "new_erc20_token" // #nosec
+	AttributeKeyDirection  = "direction"
+	AttributeKeyVolume     = "volume"
+	AttributeKeyLimit      = "limit"
+	AttributeKeyEscrow     = "escrow"
+	AttributeKeySupply     = "supply"
+
+	AttributeKeyEpochIdentifier = "epoch_identifier"
 
 	// ERC20EventTransfer defines the transfer event for ERC20
 	ERC20EventTransfer = "Transfer"
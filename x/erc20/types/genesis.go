@@ -19,10 +19,12 @@ package types
 import "fmt"
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
+func NewGenesisState(params Params, pairs []TokenPair, limits []ConversionLimit, legacyContracts []LegacyContract) GenesisState {
 	return GenesisState{
-		Params:     params,
-		TokenPairs: pairs,
+		Params:           params,
+		TokenPairs:       pairs,
+		ConversionLimits: limits,
+		LegacyContracts:  legacyContracts,
 	}
 }
 
@@ -56,5 +58,37 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenLimit := make(map[string]bool)
+
+	for _, l := range gs.ConversionLimits {
+		if seenLimit[l.Denom] {
+			return fmt.Errorf("conversion limit duplicated on genesis: '%s'", l.Denom)
+		}
+		if !seenDenom[l.Denom] {
+			return fmt.Errorf("conversion limit for unregistered coin denomination on genesis: '%s'", l.Denom)
+		}
+
+		if err := l.Validate(); err != nil {
+			return err
+		}
+
+		seenLimit[l.Denom] = true
+	}
+
+	for _, lc := range gs.LegacyContracts {
+		if seenErc20[lc.Erc20Address] {
+			return fmt.Errorf("legacy ERC20 contract duplicated on genesis '%s'", lc.Erc20Address)
+		}
+		if !seenDenom[lc.Denom] {
+			return fmt.Errorf("legacy ERC20 contract for unregistered coin denomination on genesis: '%s'", lc.Denom)
+		}
+
+		if err := lc.Validate(); err != nil {
+			return err
+		}
+
+		seenErc20[lc.Erc20Address] = true
+	}
+
 	return gs.Params.Validate()
 }
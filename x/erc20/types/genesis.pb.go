@@ -24,12 +24,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomMatch enumerates how an auto-registration rule is matched against a denomination.
+type DenomMatch int32
+
+const (
+	// DENOM_MATCH_UNSPECIFIED defines an invalid/undefined match.
+	DENOM_MATCH_UNSPECIFIED DenomMatch = 0
+	// DENOM_MATCH_PREFIX matches the denominations that start with the rule denom.
+	DENOM_MATCH_PREFIX DenomMatch = 1
+	// DENOM_MATCH_EXACT matches the denomination equal to the rule denom.
+	DENOM_MATCH_EXACT DenomMatch = 2
+)
+
+var DenomMatch_name = map[int32]string{
+	0: "DENOM_MATCH_UNSPECIFIED",
+	1: "DENOM_MATCH_PREFIX",
+	2: "DENOM_MATCH_EXACT",
+}
+
+var DenomMatch_value = map[string]int32{
+	"DENOM_MATCH_UNSPECIFIED": 0,
+	"DENOM_MATCH_PREFIX":      1,
+	"DENOM_MATCH_EXACT":       2,
+}
+
+func (x DenomMatch) String() string {
+	return proto.EnumName(DenomMatch_name, int32(x))
+}
+
+func (DenomMatch) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{0}
+}
+
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
 	// params are the erc20 module parameters at genesis
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// conversion_limits is a slice of the conversion limits of the token pairs at genesis
+	ConversionLimits []ConversionLimit `protobuf:"bytes,3,rep,name=conversion_limits,json=conversionLimits,proto3" json:"conversion_limits"`
+	// legacy_contracts is a slice of the replaced ERC20 contracts of the token pairs at genesis
+	LegacyContracts []LegacyContract `protobuf:"bytes,4,rep,name=legacy_contracts,json=legacyContracts,proto3" json:"legacy_contracts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +115,20 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetConversionLimits() []ConversionLimit {
+	if m != nil {
+		return m.ConversionLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLegacyContracts() []LegacyContract {
+	if m != nil {
+		return m.LegacyContracts
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -87,6 +137,13 @@ type Params struct {
 	// Coin by transferring the Tokens through a MsgEthereumTx to the ModuleAddress Ethereum address.
 	EnableEVMHook   bool                                   `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
 	RegistrationFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=registration_fee,json=registrationFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"registration_fee"`
+	// auto_registration_rules define the denominations for which an ERC20 contract is deployed
+	// automatically when their bank metadata is created. The first matching rule applies.
+	AutoRegistrationRules []AutoRegistrationRule `protobuf:"bytes,4,rep,name=auto_registration_rules,json=autoRegistrationRules,proto3" json:"auto_registration_rules"`
+	// escrow_exempt_contracts are the ERC20 contracts of native ERC20 token pairs whose escrow
+	// shortfall is only reported through an event instead of breaking the escrow backing invariant,
+	// e.g. rebasing tokens which balance of the module address can decrease.
+	EscrowExemptContracts []string `protobuf:"bytes,5,rep,name=escrow_exempt_contracts,json=escrowExemptContracts,proto3" json:"escrow_exempt_contracts,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -136,38 +193,244 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetAutoRegistrationRules() []AutoRegistrationRule {
+	if m != nil {
+		return m.AutoRegistrationRules
+	}
+	return nil
+}
+
+func (m *Params) GetEscrowExemptContracts() []string {
+	if m != nil {
+		return m.EscrowExemptContracts
+	}
+	return nil
+}
+
+// AutoRegistrationRule defines a set of denominations registered automatically as token pairs
+// together with the templates used to name their ERC20 contracts. Templates can reference the
+// coin metadata fields with the {base}, {display}, {name} and {symbol} placeholders. An empty
+// template uses the metadata name or symbol as is.
+type AutoRegistrationRule struct {
+	// denom is the denomination prefix or the exact denomination matched by the rule
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// match defines how denom is matched against the base denomination of the metadata
+	Match DenomMatch `protobuf:"varint,2,opt,name=match,proto3,enum=evmos.erc20.v1.DenomMatch" json:"match,omitempty"`
+	// name_template is the template of the ERC20 token name
+	NameTemplate string `protobuf:"bytes,3,opt,name=name_template,json=nameTemplate,proto3" json:"name_template,omitempty"`
+	// symbol_template is the template of the ERC20 token symbol
+	SymbolTemplate string `protobuf:"bytes,4,opt,name=symbol_template,json=symbolTemplate,proto3" json:"symbol_template,omitempty"`
+}
+
+func (m *AutoRegistrationRule) Reset()         { *m = AutoRegistrationRule{} }
+func (m *AutoRegistrationRule) String() string { return proto.CompactTextString(m) }
+func (*AutoRegistrationRule) ProtoMessage()    {}
+func (*AutoRegistrationRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{2}
+}
+func (m *AutoRegistrationRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRegistrationRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRegistrationRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRegistrationRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRegistrationRule.Merge(m, src)
+}
+func (m *AutoRegistrationRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRegistrationRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRegistrationRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRegistrationRule proto.InternalMessageInfo
+
+func (m *AutoRegistrationRule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AutoRegistrationRule) GetMatch() DenomMatch {
+	if m != nil {
+		return m.Match
+	}
+	return DENOM_MATCH_UNSPECIFIED
+}
+
+func (m *AutoRegistrationRule) GetNameTemplate() string {
+	if m != nil {
+		return m.NameTemplate
+	}
+	return ""
+}
+
+func (m *AutoRegistrationRule) GetSymbolTemplate() string {
+	if m != nil {
+		return m.SymbolTemplate
+	}
+	return ""
+}
+
+// AutoRegistrationRules is a list of auto-registration rules, used to store them
+type AutoRegistrationRules struct {
+	// rules is the list of auto-registration rules
+	Rules []AutoRegistrationRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *AutoRegistrationRules) Reset()         { *m = AutoRegistrationRules{} }
+func (m *AutoRegistrationRules) String() string { return proto.CompactTextString(m) }
+func (*AutoRegistrationRules) ProtoMessage()    {}
+func (*AutoRegistrationRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{3}
+}
+func (m *AutoRegistrationRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRegistrationRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRegistrationRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRegistrationRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRegistrationRules.Merge(m, src)
+}
+func (m *AutoRegistrationRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRegistrationRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRegistrationRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRegistrationRules proto.InternalMessageInfo
+
+func (m *AutoRegistrationRules) GetRules() []AutoRegistrationRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// EscrowExemptContracts is a list of ERC20 contracts exempted from the escrow backing invariant,
+// used to store them
+type EscrowExemptContracts struct {
+	// contracts is the list of ERC20 contract addresses
+	Contracts []string `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+}
+
+func (m *EscrowExemptContracts) Reset()         { *m = EscrowExemptContracts{} }
+func (m *EscrowExemptContracts) String() string { return proto.CompactTextString(m) }
+func (*EscrowExemptContracts) ProtoMessage()    {}
+func (*EscrowExemptContracts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{4}
+}
+func (m *EscrowExemptContracts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowExemptContracts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowExemptContracts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowExemptContracts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowExemptContracts.Merge(m, src)
+}
+func (m *EscrowExemptContracts) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowExemptContracts) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowExemptContracts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowExemptContracts proto.InternalMessageInfo
+
+func (m *EscrowExemptContracts) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("evmos.erc20.v1.DenomMatch", DenomMatch_name, DenomMatch_value)
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
+	proto.RegisterType((*AutoRegistrationRule)(nil), "evmos.erc20.v1.AutoRegistrationRule")
+	proto.RegisterType((*AutoRegistrationRules)(nil), "evmos.erc20.v1.AutoRegistrationRules")
+	proto.RegisterType((*EscrowExemptContracts)(nil), "evmos.erc20.v1.EscrowExemptContracts")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x3f, 0x4f, 0x83, 0x40,
-	0x18, 0xc6, 0xb9, 0xd6, 0x34, 0x7a, 0xb4, 0x56, 0x89, 0x31, 0xd8, 0x18, 0x5a, 0x3b, 0x18, 0x16,
-	0x0f, 0x8b, 0x2e, 0x6e, 0x86, 0xa4, 0xfe, 0x19, 0x4c, 0x1a, 0x34, 0x26, 0xba, 0x10, 0x5a, 0x5f,
-	0x29, 0x41, 0x38, 0xc2, 0x9d, 0x44, 0xbf, 0x80, 0xb3, 0x5f, 0xc9, 0xad, 0x63, 0x47, 0xe3, 0xd0,
-	0x18, 0xfa, 0x45, 0x0c, 0x07, 0x4d, 0x6a, 0x17, 0x38, 0x9e, 0xe7, 0xf7, 0x3c, 0xbc, 0xb9, 0x17,
-	0xef, 0x43, 0x1a, 0x52, 0x66, 0x40, 0x32, 0x32, 0x8f, 0x8d, 0xb4, 0x67, 0x78, 0x10, 0x01, 0xf3,
-	0x19, 0x89, 0x13, 0xca, 0xa9, 0xb2, 0x29, 0x5c, 0x22, 0x5c, 0x92, 0xf6, 0x5a, 0xad, 0x15, 0xba,
-	0x30, 0x04, 0xdb, 0xda, 0xf1, 0xa8, 0x47, 0xc5, 0xd1, 0xc8, 0x4f, 0x85, 0xda, 0xfd, 0x40, 0xb8,
-	0x7e, 0x59, 0x74, 0xde, 0x72, 0x97, 0x83, 0x72, 0x8a, 0x6b, 0xb1, 0x9b, 0xb8, 0x21, 0x53, 0x51,
-	0x07, 0xe9, 0xb2, 0xb9, 0x4b, 0xfe, 0xff, 0x83, 0x0c, 0x84, 0x6b, 0xad, 0x4d, 0x66, 0x6d, 0xc9,
-	0x2e, 0x59, 0xe5, 0x1c, 0xcb, 0x9c, 0x06, 0x10, 0x39, 0xb1, 0xeb, 0x27, 0x4c, 0xad, 0x74, 0xaa,
-	0xba, 0x6c, 0xee, 0xad, 0x46, 0xef, 0x72, 0x64, 0xe0, 0xfa, 0x49, 0x99, 0xc6, 0x7c, 0x21, 0xb0,
-	0xee, 0x17, 0xc2, 0xb5, 0xa2, 0x5a, 0x39, 0xc0, 0x75, 0x88, 0xdc, 0xe1, 0x0b, 0x38, 0x22, 0x29,
-	0x06, 0x59, 0xb7, 0xe5, 0x42, 0xeb, 0xe7, 0x92, 0x72, 0x86, 0x9b, 0x0b, 0x24, 0x0d, 0x9d, 0x31,
-	0xa5, 0x81, 0x5a, 0xc9, 0x29, 0x6b, 0x3b, 0x9b, 0xb5, 0x1b, 0xfd, 0x82, 0xbc, 0xbf, 0xb9, 0xa2,
-	0x34, 0xb0, 0x1b, 0x65, 0x30, 0x0d, 0xf3, 0x4f, 0xe5, 0x01, 0x6f, 0x25, 0xe0, 0xf9, 0x8c, 0x27,
-	0x2e, 0xf7, 0x69, 0xe4, 0x3c, 0x03, 0xa8, 0xd5, 0x0e, 0xd2, 0x37, 0x2c, 0x92, 0x0f, 0xf5, 0x33,
-	0x6b, 0x1f, 0x7a, 0x3e, 0x1f, 0xbf, 0x0e, 0xc9, 0x88, 0x86, 0xc6, 0x88, 0xb2, 0xfc, 0x46, 0x8b,
-	0xd7, 0x11, 0x7b, 0x0a, 0x0c, 0xfe, 0x1e, 0x03, 0x23, 0xd7, 0x11, 0xb7, 0x9b, 0xcb, 0x3d, 0x17,
-	0x00, 0x96, 0x35, 0xc9, 0x34, 0x34, 0xcd, 0x34, 0xf4, 0x9b, 0x69, 0xe8, 0x73, 0xae, 0x49, 0xd3,
-	0xb9, 0x26, 0x7d, 0xcf, 0x35, 0xe9, 0x51, 0x5f, 0xaa, 0x2c, 0x77, 0x24, 0x9e, 0x69, 0xcf, 0x34,
-	0xde, 0xca, 0x7d, 0x89, 0xe2, 0x61, 0x4d, 0xec, 0xe5, 0xe4, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x2b,
-	0x60, 0x78, 0x6c, 0xf9, 0x01, 0x00, 0x00,
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcb, 0x6e, 0xda, 0x40,
+	0x18, 0x85, 0x31, 0x97, 0xa8, 0xfc, 0x24, 0x81, 0x8c, 0x42, 0x42, 0x69, 0x64, 0x28, 0xad, 0x5a,
+	0x54, 0xa9, 0x26, 0xa1, 0x17, 0xa9, 0xbb, 0x00, 0x71, 0x1a, 0xa4, 0x90, 0x20, 0x87, 0x56, 0x49,
+	0x17, 0xb5, 0x06, 0x67, 0x4a, 0x2c, 0x6c, 0x0f, 0xf2, 0x0c, 0x6e, 0xf2, 0x06, 0x5d, 0xf6, 0x1d,
+	0xba, 0x6c, 0x1f, 0x24, 0xcb, 0x2c, 0xab, 0x4a, 0x8d, 0x2a, 0xf2, 0x22, 0x95, 0xc7, 0xa6, 0x5c,
+	0xc4, 0xa6, 0x1b, 0xb0, 0xcf, 0xf9, 0xfe, 0x63, 0xcf, 0xf1, 0x68, 0x60, 0x8b, 0x78, 0x36, 0x65,
+	0x15, 0xe2, 0x1a, 0xd5, 0xed, 0x8a, 0xb7, 0x53, 0xe9, 0x11, 0x87, 0x30, 0x93, 0x29, 0x03, 0x97,
+	0x72, 0x8a, 0x56, 0x85, 0xab, 0x08, 0x57, 0xf1, 0x76, 0xf2, 0xf9, 0x39, 0x3a, 0x30, 0x04, 0x9b,
+	0x5f, 0xef, 0xd1, 0x1e, 0x15, 0x97, 0x15, 0xff, 0x2a, 0x50, 0x4b, 0xdf, 0xa3, 0xb0, 0xfc, 0x36,
+	0xc8, 0x3c, 0xe1, 0x98, 0x13, 0xf4, 0x12, 0x96, 0x06, 0xd8, 0xc5, 0x36, 0xcb, 0x49, 0x45, 0xa9,
+	0x9c, 0xaa, 0x6e, 0x28, 0xb3, 0xcf, 0x50, 0xda, 0xc2, 0xad, 0xc7, 0xaf, 0x6f, 0x0b, 0x11, 0x2d,
+	0x64, 0xd1, 0x2e, 0xa4, 0x38, 0xed, 0x13, 0x47, 0x1f, 0x60, 0xd3, 0x65, 0xb9, 0x68, 0x31, 0x56,
+	0x4e, 0x55, 0xef, 0xcf, 0x8f, 0x76, 0x7c, 0xa4, 0x8d, 0x4d, 0x37, 0x9c, 0x06, 0x3e, 0x16, 0x18,
+	0xd2, 0x60, 0xcd, 0xa0, 0x8e, 0x47, 0x5c, 0x66, 0x52, 0x47, 0xb7, 0x4c, 0xdb, 0xe4, 0x2c, 0x17,
+	0x13, 0x39, 0x85, 0xf9, 0x9c, 0xc6, 0x3f, 0xf0, 0xd0, 0xe7, 0xc2, 0xb4, 0x8c, 0x31, 0x2b, 0x33,
+	0x74, 0x0c, 0x19, 0x8b, 0xf4, 0xb0, 0x71, 0xa5, 0x1b, 0xd4, 0xe1, 0x2e, 0x36, 0x38, 0xcb, 0xc5,
+	0x45, 0xa4, 0x3c, 0x1f, 0x79, 0x28, 0xb8, 0x46, 0x88, 0x85, 0x89, 0x69, 0x6b, 0x46, 0x65, 0xa5,
+	0xdf, 0x51, 0x58, 0x0a, 0xd6, 0x8f, 0x1e, 0xc2, 0x32, 0x71, 0x70, 0xd7, 0x22, 0xba, 0xc8, 0x10,
+	0x6d, 0xdd, 0xd3, 0x52, 0x81, 0xa6, 0xfa, 0x12, 0x7a, 0x03, 0xe9, 0x31, 0xe2, 0xd9, 0xfa, 0x05,
+	0xa5, 0xfd, 0x5c, 0xd4, 0xa7, 0xea, 0x6b, 0xa3, 0xdb, 0xc2, 0x8a, 0x1a, 0x90, 0xef, 0x5b, 0x07,
+	0x94, 0xf6, 0xb5, 0x95, 0x70, 0xd0, 0xb3, 0xfd, 0x5b, 0x74, 0x06, 0x19, 0x97, 0xf4, 0x4c, 0xc6,
+	0x5d, 0xcc, 0xfd, 0x3e, 0x3e, 0x11, 0x92, 0x8b, 0x15, 0xa5, 0x72, 0xb2, 0xae, 0xf8, 0x6f, 0xf6,
+	0xeb, 0xb6, 0xf0, 0xa4, 0x67, 0xf2, 0x8b, 0x61, 0x57, 0x31, 0xa8, 0x5d, 0x31, 0x28, 0xf3, 0x3f,
+	0x7b, 0xf0, 0xf7, 0x9c, 0x9d, 0xf7, 0x2b, 0xfc, 0x6a, 0x40, 0x98, 0xd2, 0x74, 0xb8, 0x96, 0x9e,
+	0xce, 0xd9, 0x27, 0x04, 0x75, 0x61, 0x13, 0x0f, 0x39, 0xd5, 0x67, 0xf2, 0xdd, 0xa1, 0x45, 0xc6,
+	0xdd, 0x3c, 0x9e, 0xef, 0xa6, 0x36, 0xe4, 0x54, 0x9b, 0xa2, 0xb5, 0xa1, 0x45, 0xc2, 0x86, 0xb2,
+	0x78, 0x81, 0xc7, 0xd0, 0x6b, 0xd8, 0x24, 0xcc, 0x70, 0xe9, 0x67, 0x9d, 0x5c, 0x12, 0x7b, 0xc0,
+	0xa7, 0xfa, 0x4f, 0x14, 0x63, 0xe5, 0xa4, 0x96, 0x0d, 0x6c, 0x55, 0xb8, 0x93, 0x7e, 0x7f, 0x48,
+	0xb0, 0xbe, 0xe8, 0x69, 0x68, 0x1d, 0x12, 0xe7, 0xc4, 0xa1, 0xb6, 0xa8, 0x39, 0xa9, 0x05, 0x37,
+	0x68, 0x1b, 0x12, 0x36, 0xe6, 0xc6, 0x85, 0xa8, 0x75, 0xb5, 0x9a, 0x9f, 0x7f, 0xf1, 0x3d, 0x9f,
+	0x6a, 0xf9, 0x84, 0x16, 0x80, 0xe8, 0x11, 0xac, 0x38, 0xd8, 0x26, 0x3a, 0x27, 0xf6, 0xc0, 0xc2,
+	0x3c, 0x2c, 0x55, 0x5b, 0xf6, 0xc5, 0x4e, 0xa8, 0xa1, 0xa7, 0x90, 0x66, 0x57, 0x76, 0x97, 0x5a,
+	0x13, 0x2c, 0x2e, 0xb0, 0xd5, 0x40, 0x1e, 0x83, 0xa5, 0x33, 0xc8, 0xd6, 0x16, 0xae, 0x7f, 0x17,
+	0x12, 0x41, 0xa3, 0xd2, 0x7f, 0x37, 0x1a, 0x0c, 0x96, 0x5e, 0x41, 0x56, 0x5d, 0x54, 0x11, 0xda,
+	0x82, 0xe4, 0xa4, 0x4c, 0x49, 0x94, 0x39, 0x11, 0x9e, 0x7d, 0x04, 0x98, 0x2c, 0x1a, 0x3d, 0x80,
+	0xcd, 0x3d, 0xf5, 0xe8, 0xb8, 0xa5, 0xb7, 0x6a, 0x9d, 0xc6, 0x81, 0xfe, 0xee, 0xe8, 0xa4, 0xad,
+	0x36, 0x9a, 0xfb, 0x4d, 0x75, 0x2f, 0x13, 0x41, 0x1b, 0x80, 0xa6, 0xcd, 0xb6, 0xa6, 0xee, 0x37,
+	0x4f, 0x33, 0x12, 0xca, 0xc2, 0xda, 0xb4, 0xae, 0x9e, 0xd6, 0x1a, 0x9d, 0x4c, 0x34, 0x1f, 0xff,
+	0xf2, 0x4d, 0x8e, 0xd4, 0xeb, 0xd7, 0x23, 0x59, 0xba, 0x19, 0xc9, 0xd2, 0x9f, 0x91, 0x2c, 0x7d,
+	0xbd, 0x93, 0x23, 0x37, 0x77, 0x72, 0xe4, 0xe7, 0x9d, 0x1c, 0xf9, 0x50, 0x9e, 0xda, 0x8f, 0xe1,
+	0x29, 0x24, 0x7e, 0xbd, 0x9d, 0x6a, 0xe5, 0x32, 0x3c, 0x91, 0xc4, 0xae, 0xec, 0x2e, 0x89, 0x93,
+	0xe7, 0xc5, 0xdf, 0x01, 0x00, 0x17, 0xf9, 0xde, 0x1b, 0xdb, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -190,6 +453,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LegacyContracts) > 0 {
+		for iNdEx := len(m.LegacyContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LegacyContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConversionLimits) > 0 {
+		for iNdEx := len(m.ConversionLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -237,6 +528,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EscrowExemptContracts) > 0 {
+		for iNdEx := len(m.EscrowExemptContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EscrowExemptContracts[iNdEx])
+			copy(dAtA[i:], m.EscrowExemptContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EscrowExemptContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AutoRegistrationRules) > 0 {
+		for iNdEx := len(m.AutoRegistrationRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRegistrationRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.RegistrationFee.Size()
 		i -= size
@@ -270,6 +584,124 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoRegistrationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRegistrationRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRegistrationRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SymbolTemplate) > 0 {
+		i -= len(m.SymbolTemplate)
+		copy(dAtA[i:], m.SymbolTemplate)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SymbolTemplate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NameTemplate) > 0 {
+		i -= len(m.NameTemplate)
+		copy(dAtA[i:], m.NameTemplate)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NameTemplate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Match != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Match))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRegistrationRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRegistrationRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRegistrationRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowExemptContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowExemptContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowExemptContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -295,6 +727,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ConversionLimits) > 0 {
+		for _, e := range m.ConversionLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LegacyContracts) > 0 {
+		for _, e := range m.LegacyContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -312,14 +756,80 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.RegistrationFee.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AutoRegistrationRules) > 0 {
+		for _, e := range m.AutoRegistrationRules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowExemptContracts) > 0 {
+		for _, s := range m.EscrowExemptContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *AutoRegistrationRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Match != 0 {
+		n += 1 + sovGenesis(uint64(m.Match))
+	}
+	l = len(m.NameTemplate)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.SymbolTemplate)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoRegistrationRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscrowExemptContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -417,6 +927,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionLimits = append(m.ConversionLimits, ConversionLimit{})
+			if err := m.ConversionLimits[len(m.ConversionLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyContracts = append(m.LegacyContracts, LegacyContract{})
+			if err := m.LegacyContracts[len(m.LegacyContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -541,6 +1119,403 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegistrationRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRegistrationRules = append(m.AutoRegistrationRules, AutoRegistrationRule{})
+			if err := m.AutoRegistrationRules[len(m.AutoRegistrationRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowExemptContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowExemptContracts = append(m.EscrowExemptContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRegistrationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRegistrationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRegistrationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			m.Match = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Match |= DenomMatch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRegistrationRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRegistrationRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRegistrationRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, AutoRegistrationRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowExemptContracts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowExemptContracts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowExemptContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
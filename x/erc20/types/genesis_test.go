@@ -127,6 +127,112 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - conversion limit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []types.ConversionLimit{
+					types.NewConversionLimit("usdt", "day", math.NewInt(100), math.ZeroInt()),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated conversion limit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []types.ConversionLimit{
+					types.NewConversionLimit("usdt", "day", math.NewInt(100), math.ZeroInt()),
+					types.NewConversionLimit("usdt", "week", math.NewInt(100), math.ZeroInt()),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - conversion limit for unregistered denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConversionLimits: []types.ConversionLimit{
+					types.NewConversionLimit("usdt", "day", math.NewInt(100), math.ZeroInt()),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid conversion limit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionLimits: []types.ConversionLimit{
+					types.NewConversionLimit("usdt", "day", math.NewInt(-1), math.ZeroInt()),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - legacy contract",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				LegacyContracts: []types.LegacyContract{
+					{Erc20Address: "0xB8c77482e45F1F44dE1745F52C74426C631bDD52", Denom: "usdt"},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - legacy contract of a registered pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				LegacyContracts: []types.LegacyContract{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Denom: "usdt"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - legacy contract for unregistered denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				LegacyContracts: []types.LegacyContract{
+					{Erc20Address: "0xB8c77482e45F1F44dE1745F52C74426C631bDD52", Denom: "usdt"},
+				},
+			},
+			expPass: false,
+		},
 		{
 			name:     "empty genesis",
 			genState: &types.GenesisState{},
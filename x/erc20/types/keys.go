@@ -45,6 +45,9 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixConversionLimit
+	prefixConversionWindow
+	prefixLegacyContract
 )
 
 // KVStore key prefixes
@@ -52,4 +55,7 @@ var (
 	KeyPrefixTokenPair        = []byte{prefixTokenPair}
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixConversionLimit  = []byte{prefixConversionLimit}
+	KeyPrefixConversionWindow = []byte{prefixConversionWindow}
+	KeyPrefixLegacyContract   = []byte{prefixLegacyContract}
 )
@@ -0,0 +1,46 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+// NewLegacyContract returns an instance of LegacyContract
+func NewLegacyContract(erc20Address common.Address, denom string) LegacyContract {
+	return LegacyContract{
+		Erc20Address: erc20Address.String(),
+		Denom:        denom,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (lc LegacyContract) GetERC20Contract() common.Address {
+	return common.HexToAddress(lc.Erc20Address)
+}
+
+// Validate performs a stateless validation of a LegacyContract
+func (lc LegacyContract) Validate() error {
+	if err := sdk.ValidateDenom(lc.Denom); err != nil {
+		return err
+	}
+
+	return evmostypes.ValidateAddress(lc.Erc20Address)
+}
@@ -25,6 +25,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
 )
 
 var (
@@ -32,6 +34,12 @@ var (
 	_ sdk.Msg = &MsgConvertERC20{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgRegisterERC20AsToken{}
+	_ sdk.Msg = &MsgUpdateTokenPairMetadata{}
+	_ sdk.Msg = &MsgMigrateTokenPair{}
+	_ sdk.Msg = &MsgDeregisterTokenPair{}
+	_ sdk.Msg = &MsgBackfillAutoRegistration{}
+	_ sdk.Msg = &MsgUpdateConversionLimit{}
+	_ sdk.Msg = &MsgResetConversionLimit{}
 
 	_ legacytx.LegacyMsg = &MsgRegisterERC20AsToken{}
 )
@@ -190,3 +198,148 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgUpdateTokenPairMetadata message.
+func (m *MsgUpdateTokenPairMetadata) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateTokenPairMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Metadata.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateTokenPairMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgMigrateTokenPair message.
+func (m *MsgMigrateTokenPair) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgMigrateTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := validateToken(m.Token); err != nil {
+		return err
+	}
+
+	if m.NewContract != "" {
+		if err := evmostypes.ValidateAddress(m.NewContract); err != nil {
+			return errorsmod.Wrap(err, "new contract address")
+		}
+	}
+
+	if m.EscrowRecipient != "" {
+		if err := evmostypes.ValidateAddress(m.EscrowRecipient); err != nil {
+			return errorsmod.Wrap(err, "escrow recipient address")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMigrateTokenPair) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgDeregisterTokenPair message.
+func (m *MsgDeregisterTokenPair) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeregisterTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return validateToken(m.Token)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeregisterTokenPair) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgBackfillAutoRegistration message.
+func (m *MsgBackfillAutoRegistration) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgBackfillAutoRegistration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBackfillAutoRegistration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateConversionLimit message.
+func (m *MsgUpdateConversionLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateConversionLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Limit.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateConversionLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetConversionLimit message.
+func (m *MsgResetConversionLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgResetConversionLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return validateToken(m.Token)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResetConversionLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// validateToken checks that the token identifier is either a hex contract
+// address or a valid Cosmos denomination
+func validateToken(token string) error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := evmostypes.ValidateAddress(token); err != nil {
+		return sdk.ValidateDenom(token)
+	}
+	return nil
+}
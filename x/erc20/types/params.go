@@ -20,6 +20,9 @@ import (
 	fmt "fmt"
 
 	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
 )
 
 // Parameter store key
@@ -27,27 +30,44 @@ var (
 	ParamStoreKeyEnableErc20     = []byte("EnableErc20")
 	ParamStoreKeyEnableEVMHook   = []byte("EnableEVMHook")
 	ParamStoreKeyRegistrationFee = []byte("RegistrationFee")
+	// ParamStoreKeyAutoRegistrationRules is the store key of the auto-registration rules
+	ParamStoreKeyAutoRegistrationRules = []byte("AutoRegistrationRules")
+	// ParamStoreKeyEscrowExemptContracts is the store key of the contracts
+	// exempted from the escrow backing invariant
+	ParamStoreKeyEscrowExemptContracts = []byte("EscrowExemptContracts")
 
 	DefaultRegistrationFee = math.NewInt(10).MulRaw(1e18) // 10 tokens of native denom
+	// DefaultAutoRegistrationRules deploys an ERC20 contract for every IBC voucher
+	DefaultAutoRegistrationRules = []AutoRegistrationRule{
+		{
+			Denom: "ibc/",
+			Match: DENOM_MATCH_PREFIX,
+		},
+	}
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	enableErc20, enableEVMHook bool,
 	registrationFee math.Int,
+	autoRegistrationRules []AutoRegistrationRule,
+	escrowExemptContracts []string,
 ) Params {
 	return Params{
-		EnableErc20:     enableErc20,
-		EnableEVMHook:   enableEVMHook,
-		RegistrationFee: registrationFee,
+		EnableErc20:           enableErc20,
+		EnableEVMHook:         enableEVMHook,
+		RegistrationFee:       registrationFee,
+		AutoRegistrationRules: autoRegistrationRules,
+		EscrowExemptContracts: escrowExemptContracts,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:     true,
-		EnableEVMHook:   true,
-		RegistrationFee: DefaultRegistrationFee,
+		EnableErc20:           true,
+		EnableEVMHook:         true,
+		RegistrationFee:       DefaultRegistrationFee,
+		AutoRegistrationRules: DefaultAutoRegistrationRules,
 	}
 }
 
@@ -73,5 +93,39 @@ func (p Params) Validate() error {
 		return fmt.Errorf("registration fee cannot be negative: %s", p.RegistrationFee)
 	}
 
+	if err := ValidateAutoRegistrationRules(p.AutoRegistrationRules); err != nil {
+		return err
+	}
+
+	return ValidateEscrowExemptContracts(p.EscrowExemptContracts)
+}
+
+// ValidateEscrowExemptContracts checks that the contracts exempted from the
+// escrow backing invariant are valid and unique hex addresses
+func ValidateEscrowExemptContracts(contracts []string) error {
+	seen := make(map[common.Address]bool, len(contracts))
+	for _, contract := range contracts {
+		if err := evmostypes.ValidateAddress(contract); err != nil {
+			return fmt.Errorf("invalid escrow exempt contract: %w", err)
+		}
+
+		addr := common.HexToAddress(contract)
+		if seen[addr] {
+			return fmt.Errorf("duplicate escrow exempt contract %s", contract)
+		}
+		seen[addr] = true
+	}
+
 	return nil
 }
+
+// IsEscrowExempt returns true if the given contract is exempted from the
+// escrow backing invariant
+func (p Params) IsEscrowExempt(contract common.Address) bool {
+	for _, exempt := range p.EscrowExemptContracts {
+		if common.HexToAddress(exempt) == contract {
+			return true
+		}
+	}
+	return false
+}
@@ -25,9 +25,37 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", types.DefaultParams(), false},
 		{
 			"valid",
-			types.NewParams(true, true, math.NewIntWithDecimal(1, 18)),
+			types.NewParams(true, true, math.NewIntWithDecimal(1, 18), types.DefaultAutoRegistrationRules, nil),
 			false,
 		},
+		{
+			"valid - no auto-registration rules",
+			types.NewParams(true, true, math.NewIntWithDecimal(1, 18), nil, nil),
+			false,
+		},
+		{
+			"invalid auto-registration rule",
+			types.NewParams(true, true, math.NewIntWithDecimal(1, 18), []types.AutoRegistrationRule{{Denom: "factory/"}}, nil),
+			true,
+		},
+		{
+			"valid - escrow exempt contract",
+			types.NewParams(true, true, math.NewIntWithDecimal(1, 18), nil, []string{"0xdAC17F958D2ee523a2206206994597C13D831ec7"}),
+			false,
+		},
+		{
+			"invalid escrow exempt contract",
+			types.NewParams(true, true, math.NewIntWithDecimal(1, 18), nil, []string{"0xinvalid"}),
+			true,
+		},
+		{
+			"duplicate escrow exempt contract",
+			types.NewParams(true, true, math.NewIntWithDecimal(1, 18), nil, []string{
+				"0xdAC17F958D2ee523a2206206994597C13D831ec7",
+				"0xdac17f958d2ee523a2206206994597c13d831ec7",
+			}),
+			true,
+		},
 		{
 			"empty",
 			types.Params{},
@@ -311,6 +311,109 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryConversionLimitRequest is the request type for the Query/ConversionLimit RPC method.
+type QueryConversionLimitRequest struct {
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryConversionLimitRequest) Reset()         { *m = QueryConversionLimitRequest{} }
+func (m *QueryConversionLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionLimitRequest) ProtoMessage()    {}
+func (*QueryConversionLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{6}
+}
+func (m *QueryConversionLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionLimitRequest.Merge(m, src)
+}
+func (m *QueryConversionLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionLimitRequest proto.InternalMessageInfo
+
+func (m *QueryConversionLimitRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// QueryConversionLimitResponse is the response type for the Query/ConversionLimit RPC
+// method.
+type QueryConversionLimitResponse struct {
+	// limit is the conversion limit of the token pair
+	Limit ConversionLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// window is the amount converted within the current window of the limit
+	Window ConversionWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window"`
+}
+
+func (m *QueryConversionLimitResponse) Reset()         { *m = QueryConversionLimitResponse{} }
+func (m *QueryConversionLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionLimitResponse) ProtoMessage()    {}
+func (*QueryConversionLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{7}
+}
+func (m *QueryConversionLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionLimitResponse.Merge(m, src)
+}
+func (m *QueryConversionLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionLimitResponse proto.InternalMessageInfo
+
+func (m *QueryConversionLimitResponse) GetLimit() ConversionLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return ConversionLimit{}
+}
+
+func (m *QueryConversionLimitResponse) GetWindow() ConversionWindow {
+	if m != nil {
+		return m.Window
+	}
+	return ConversionWindow{}
+}
+
 func init() {
 	proto.RegisterType((*QueryTokenPairsRequest)(nil), "evmos.erc20.v1.QueryTokenPairsRequest")
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "evmos.erc20.v1.QueryTokenPairsResponse")
@@ -318,44 +421,52 @@ func init() {
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "evmos.erc20.v1.QueryTokenPairResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.erc20.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryConversionLimitRequest)(nil), "evmos.erc20.v1.QueryConversionLimitRequest")
+	proto.RegisterType((*QueryConversionLimitResponse)(nil), "evmos.erc20.v1.QueryConversionLimitResponse")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/query.proto", fileDescriptor_fba814bce17cabdf) }
 
 var fileDescriptor_fba814bce17cabdf = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x8b, 0xd3, 0x4e,
-	0x14, 0xef, 0xec, 0x7e, 0xb7, 0xd0, 0x57, 0xf8, 0x1e, 0xc6, 0x5a, 0x6b, 0xd4, 0xb8, 0xa4, 0x6c,
-	0xb7, 0x28, 0xce, 0x98, 0xe8, 0x59, 0xa4, 0x07, 0x3d, 0x78, 0xa9, 0xc5, 0x83, 0x78, 0xd1, 0x69,
-	0x19, 0x62, 0xd0, 0x66, 0xd2, 0xcc, 0x34, 0xb8, 0x88, 0x97, 0xbd, 0x88, 0x37, 0xc1, 0x7f, 0xc1,
-	0x3f, 0x66, 0x8f, 0x0b, 0x5e, 0x3c, 0x89, 0xb4, 0xfe, 0x21, 0x92, 0x99, 0x49, 0xba, 0x89, 0x4b,
-	0x7b, 0x29, 0xc9, 0x7b, 0xef, 0xf3, 0xeb, 0xbd, 0x14, 0x1c, 0x9e, 0xcd, 0x85, 0xa4, 0x3c, 0x9d,
-	0x05, 0xf7, 0x69, 0xe6, 0xd3, 0xc5, 0x92, 0xa7, 0x27, 0x24, 0x49, 0x85, 0x12, 0xf8, 0x7f, 0xdd,
-	0x23, 0xba, 0x47, 0x32, 0xdf, 0xb9, 0x33, 0x13, 0x32, 0x1f, 0x9e, 0x32, 0xc9, 0xcd, 0x20, 0xcd,
-	0xfc, 0x29, 0x57, 0xcc, 0xa7, 0x09, 0x0b, 0xa3, 0x98, 0xa9, 0x48, 0xc4, 0x06, 0xeb, 0xd4, 0x79,
-	0x0d, 0x89, 0xe9, 0xdd, 0xac, 0xf5, 0x42, 0x1e, 0x73, 0x19, 0x49, 0xdb, 0xed, 0x84, 0x22, 0x14,
-	0xfa, 0x91, 0xe6, 0x4f, 0x05, 0x26, 0x14, 0x22, 0x7c, 0xcf, 0x29, 0x4b, 0x22, 0xca, 0xe2, 0x58,
-	0x28, 0x2d, 0x66, 0x31, 0xde, 0x1b, 0xe8, 0x3e, 0xcf, 0xfd, 0xbc, 0x10, 0xef, 0x78, 0x3c, 0x66,
-	0x51, 0x2a, 0x27, 0x7c, 0xb1, 0xe4, 0x52, 0xe1, 0x27, 0x00, 0x1b, 0x6f, 0x3d, 0x74, 0x88, 0x86,
-	0xed, 0x60, 0x40, 0x4c, 0x10, 0x92, 0x07, 0x21, 0x26, 0xb1, 0x0d, 0x42, 0xc6, 0x2c, 0xe4, 0x16,
-	0x3b, 0xb9, 0x80, 0xf4, 0xbe, 0x23, 0xb8, 0xf6, 0x8f, 0x84, 0x4c, 0x44, 0x2c, 0x39, 0x7e, 0x0c,
-	0x6d, 0x95, 0x57, 0x5f, 0x27, 0x79, 0xb9, 0x87, 0x0e, 0xf7, 0x87, 0xed, 0xe0, 0x3a, 0xa9, 0x6e,
-	0x8f, 0x94, 0xc0, 0xd1, 0x7f, 0x67, 0xbf, 0x6e, 0x37, 0x26, 0xa0, 0x4a, 0x26, 0xfc, 0xb4, 0xe2,
-	0x72, 0x4f, 0xbb, 0x3c, 0xde, 0xe9, 0xd2, 0xc8, 0x57, 0x6c, 0xde, 0x83, 0xab, 0x55, 0x97, 0xc5,
-	0x1e, 0x3a, 0x70, 0xa0, 0xf5, 0xf4, 0x0a, 0x5a, 0x13, 0xf3, 0xe2, 0xbd, 0xac, 0xef, 0xad, 0xcc,
-	0xf4, 0x08, 0x60, 0x93, 0xc9, 0xee, 0x6d, 0x67, 0xa4, 0x56, 0x19, 0xc9, 0xeb, 0x00, 0xd6, 0xcc,
-	0x63, 0x96, 0xb2, 0x79, 0x71, 0x0d, 0xef, 0x19, 0x5c, 0xa9, 0x54, 0xad, 0xd8, 0x43, 0x68, 0x26,
-	0xba, 0x62, 0x85, 0xba, 0x75, 0x21, 0x33, 0x6f, 0x55, 0xec, 0x6c, 0xf0, 0x65, 0x1f, 0x0e, 0x34,
-	0x1b, 0x3e, 0x45, 0x00, 0x9b, 0xbb, 0xe0, 0x41, 0x1d, 0x7e, 0xf9, 0xb7, 0xe1, 0x1c, 0xef, 0x9c,
-	0x33, 0xfe, 0xbc, 0xfe, 0xe9, 0x8f, 0x3f, 0xdf, 0xf6, 0x6e, 0xe1, 0x1b, 0xb4, 0xf6, 0xe5, 0x5e,
-	0x38, 0x3b, 0xfe, 0x8c, 0xa0, 0x55, 0x62, 0xf1, 0xd1, 0x76, 0xee, 0xc2, 0xc2, 0x60, 0xd7, 0x98,
-	0x75, 0x70, 0x57, 0x3b, 0x38, 0xc2, 0xfd, 0x2d, 0x0e, 0xe8, 0x47, 0xfd, 0xf2, 0x09, 0x2f, 0xa0,
-	0x69, 0x16, 0x86, 0xbd, 0x4b, 0xe9, 0x2b, 0x37, 0x71, 0xfa, 0x5b, 0x67, 0xac, 0xbe, 0xab, 0xf5,
-	0x7b, 0xb8, 0x5b, 0xd7, 0x37, 0xb7, 0x18, 0x8d, 0xce, 0x56, 0x2e, 0x3a, 0x5f, 0xb9, 0xe8, 0xf7,
-	0xca, 0x45, 0x5f, 0xd7, 0x6e, 0xe3, 0x7c, 0xed, 0x36, 0x7e, 0xae, 0xdd, 0xc6, 0xab, 0x61, 0x18,
-	0xa9, 0xb7, 0xcb, 0x29, 0x99, 0x89, 0x79, 0x81, 0xd5, 0xbf, 0x99, 0x1f, 0xd0, 0x0f, 0x96, 0x47,
-	0x9d, 0x24, 0x5c, 0x4e, 0x9b, 0xfa, 0xbf, 0xfc, 0xe0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe9,
-	0x56, 0x20, 0xb6, 0x93, 0x04, 0x00, 0x00,
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0x8f, 0xfb, 0x6d, 0x23, 0xe5, 0x45, 0xfa, 0x22, 0x1d, 0x21, 0x04, 0xb7, 0xb8, 0x91, 0xa3,
+	0xa6, 0x81, 0x80, 0x0f, 0xa7, 0x6c, 0x48, 0x15, 0x0a, 0x12, 0x0c, 0x30, 0x84, 0x08, 0x09, 0xc4,
+	0x52, 0x9c, 0x70, 0x32, 0x16, 0x8d, 0xcf, 0xf1, 0x39, 0x2e, 0x15, 0x62, 0xe9, 0xc2, 0x8a, 0xc4,
+	0xc8, 0xc0, 0xc2, 0xc6, 0x3f, 0xd2, 0xb1, 0x12, 0x0b, 0x13, 0x42, 0x09, 0x7f, 0x08, 0xf2, 0xdd,
+	0xd9, 0xa9, 0x8f, 0x90, 0xb0, 0x44, 0xf6, 0xbd, 0xf7, 0xf9, 0xf5, 0xee, 0xc5, 0xa0, 0x93, 0x78,
+	0x44, 0x19, 0x26, 0xe1, 0xb0, 0x73, 0x0b, 0xc7, 0x36, 0x1e, 0x4f, 0x48, 0x78, 0x6c, 0x05, 0x21,
+	0x8d, 0x28, 0xfa, 0x9f, 0xd7, 0x2c, 0x5e, 0xb3, 0x62, 0x5b, 0xbf, 0x3e, 0xa4, 0x2c, 0x69, 0x1e,
+	0x38, 0x8c, 0x88, 0x46, 0x1c, 0xdb, 0x03, 0x12, 0x39, 0x36, 0x0e, 0x1c, 0xd7, 0xf3, 0x9d, 0xc8,
+	0xa3, 0xbe, 0xc0, 0xea, 0x2a, 0xaf, 0x20, 0x11, 0xb5, 0x2d, 0xa5, 0xe6, 0x12, 0x9f, 0x30, 0x8f,
+	0xc9, 0x6a, 0xc5, 0xa5, 0x2e, 0xe5, 0x8f, 0x38, 0x79, 0x4a, 0x31, 0x2e, 0xa5, 0xee, 0x21, 0xc1,
+	0x4e, 0xe0, 0x61, 0xc7, 0xf7, 0x69, 0xc4, 0xc5, 0x24, 0xc6, 0x7c, 0x01, 0xd5, 0xc7, 0x89, 0x9f,
+	0x27, 0xf4, 0x35, 0xf1, 0x7b, 0x8e, 0x17, 0xb2, 0x3e, 0x19, 0x4f, 0x08, 0x8b, 0xd0, 0x7d, 0x80,
+	0xb9, 0xb7, 0x9a, 0x56, 0xd7, 0x5a, 0xe5, 0x4e, 0xd3, 0x12, 0x41, 0xac, 0x24, 0x88, 0x25, 0x12,
+	0xcb, 0x20, 0x56, 0xcf, 0x71, 0x89, 0xc4, 0xf6, 0xcf, 0x21, 0xcd, 0x2f, 0x1a, 0x5c, 0xfe, 0x43,
+	0x82, 0x05, 0xd4, 0x67, 0x04, 0xdd, 0x85, 0x72, 0x94, 0x9c, 0x1e, 0x04, 0xc9, 0x71, 0x4d, 0xab,
+	0xff, 0xd7, 0x2a, 0x77, 0xae, 0x58, 0xf9, 0xe9, 0x59, 0x19, 0xb0, 0xbb, 0x7e, 0xfa, 0x63, 0xbb,
+	0xd0, 0x87, 0x28, 0x63, 0x42, 0x0f, 0x72, 0x2e, 0xd7, 0xb8, 0xcb, 0xdd, 0x95, 0x2e, 0x85, 0x7c,
+	0xce, 0xe6, 0x4d, 0xb8, 0x94, 0x77, 0x99, 0xce, 0xa1, 0x02, 0x1b, 0x5c, 0x8f, 0x8f, 0xa0, 0xd4,
+	0x17, 0x2f, 0xe6, 0x33, 0x75, 0x6e, 0x59, 0xa6, 0x7d, 0x80, 0x79, 0x26, 0x39, 0xb7, 0x95, 0x91,
+	0x4a, 0x59, 0x24, 0xb3, 0x02, 0x88, 0x33, 0xf7, 0x9c, 0xd0, 0x19, 0xa5, 0xb7, 0x61, 0x3e, 0x84,
+	0x8b, 0xb9, 0x53, 0x29, 0x76, 0x1b, 0x8a, 0x01, 0x3f, 0x91, 0x42, 0x55, 0x55, 0x48, 0xf4, 0x4b,
+	0x15, 0xd9, 0x6b, 0xee, 0xc1, 0x26, 0x27, 0xbb, 0x47, 0xfd, 0x98, 0x84, 0xcc, 0xa3, 0xfe, 0x23,
+	0x6f, 0xe4, 0x45, 0xcb, 0x13, 0x7f, 0xd2, 0x60, 0x6b, 0x31, 0x4a, 0x7a, 0xb9, 0x03, 0x1b, 0x87,
+	0xc9, 0x81, 0xb4, 0xb2, 0xad, 0x5a, 0x51, 0x70, 0xd2, 0x93, 0xc0, 0xa0, 0x7d, 0x28, 0x1e, 0x79,
+	0xfe, 0x4b, 0x7a, 0x24, 0xef, 0xb0, 0xfe, 0x77, 0xf4, 0x53, 0xde, 0x97, 0x46, 0x12, 0xa8, 0xce,
+	0xd7, 0x75, 0xd8, 0xe0, 0xee, 0xd0, 0x89, 0x06, 0x30, 0x5f, 0x35, 0xd4, 0x54, 0x89, 0x16, 0xaf,
+	0xbb, 0xbe, 0xbb, 0xb2, 0x4f, 0xc4, 0x34, 0x1b, 0x27, 0xdf, 0x7e, 0x7d, 0x5c, 0xbb, 0x8a, 0x36,
+	0xb1, 0xf2, 0x67, 0x3c, 0xb7, 0xc9, 0xe8, 0xbd, 0x06, 0xa5, 0x0c, 0x8b, 0x76, 0x96, 0x73, 0xa7,
+	0x16, 0x9a, 0xab, 0xda, 0xa4, 0x83, 0x36, 0x77, 0xb0, 0x83, 0x1a, 0x4b, 0x1c, 0xe0, 0xb7, 0xfc,
+	0xe5, 0x1d, 0x1a, 0x43, 0x51, 0xec, 0x00, 0x32, 0x17, 0xd2, 0xe7, 0xd6, 0x4c, 0x6f, 0x2c, 0xed,
+	0x91, 0xfa, 0x06, 0xd7, 0xaf, 0xa1, 0xaa, 0xaa, 0x2f, 0xd6, 0x0b, 0x7d, 0xd6, 0xe0, 0x82, 0x72,
+	0xd9, 0xa8, 0xbd, 0x90, 0x78, 0xf1, 0x02, 0xea, 0x37, 0xfe, 0xad, 0x59, 0xda, 0xb1, 0xb9, 0x9d,
+	0x36, 0xba, 0xa6, 0xda, 0x19, 0x66, 0x80, 0x03, 0xbe, 0x64, 0xd9, 0x50, 0xba, 0xdd, 0xd3, 0xa9,
+	0xa1, 0x9d, 0x4d, 0x0d, 0xed, 0xe7, 0xd4, 0xd0, 0x3e, 0xcc, 0x8c, 0xc2, 0xd9, 0xcc, 0x28, 0x7c,
+	0x9f, 0x19, 0x85, 0xe7, 0x2d, 0xd7, 0x8b, 0x5e, 0x4d, 0x06, 0xd6, 0x90, 0x8e, 0x52, 0x3a, 0xfe,
+	0x1b, 0xdb, 0x1d, 0xfc, 0x46, 0x52, 0x47, 0xc7, 0x01, 0x61, 0x83, 0x22, 0xff, 0x80, 0xee, 0xfd,
+	0x1e, 0x00, 0x9e, 0x83, 0x93, 0x68, 0x08, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -376,6 +487,8 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ConversionLimit retrieves the conversion limit of a token pair and its current window
+	ConversionLimit(ctx context.Context, in *QueryConversionLimitRequest, opts ...grpc.CallOption) (*QueryConversionLimitResponse, error)
 }
 
 type queryClient struct {
@@ -413,6 +526,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ConversionLimit(ctx context.Context, in *QueryConversionLimitRequest, opts ...grpc.CallOption) (*QueryConversionLimitResponse, error) {
+	out := new(QueryConversionLimitResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/ConversionLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TokenPairs retrieves registered token pairs
@@ -421,6 +543,8 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ConversionLimit retrieves the conversion limit of a token pair and its current window
+	ConversionLimit(context.Context, *QueryConversionLimitRequest) (*QueryConversionLimitResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -436,6 +560,9 @@ func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ConversionLimit(ctx context.Context, req *QueryConversionLimitRequest) (*QueryConversionLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionLimit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -495,6 +622,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConversionLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/ConversionLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionLimit(ctx, req.(*QueryConversionLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -511,6 +656,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ConversionLimit",
+			Handler:    _Query_ConversionLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/query.proto",
@@ -719,6 +868,79 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConversionLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -806,6 +1028,32 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConversionLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Window.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1316,6 +1564,204 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConversionLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,6 +10,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -427,610 +428,1904 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgConvertCoin)(nil), "evmos.erc20.v1.MsgConvertCoin")
-	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
-	proto.RegisterType((*MsgConvertERC20)(nil), "evmos.erc20.v1.MsgConvertERC20")
-	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
-	proto.RegisterType((*MsgRegisterERC20AsToken)(nil), "evmos.erc20.v1.MsgRegisterERC20AsToken")
-	proto.RegisterType((*MsgRegisterERC20AsTokenResponse)(nil), "evmos.erc20.v1.MsgRegisterERC20AsTokenResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.erc20.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.erc20.v1.MsgUpdateParamsResponse")
+// MsgUpdateTokenPairMetadata is the Msg/UpdateTokenPairMetadata request type.
+type MsgUpdateTokenPairMetadata struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// metadata is the new bank metadata of the coin. The base denomination must
+	// match a registered native Cosmos coin token pair.
+	Metadata types1.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
+func (m *MsgUpdateTokenPairMetadata) Reset()         { *m = MsgUpdateTokenPairMetadata{} }
+func (m *MsgUpdateTokenPairMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTokenPairMetadata) ProtoMessage()    {}
+func (*MsgUpdateTokenPairMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{8}
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTokenPairMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTokenPairMetadata.Merge(m, src)
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTokenPairMetadata.DiscardUnknown(m)
+}
 
-var fileDescriptor_f8926fc6cb676914 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0x6d, 0x08, 0x76, 0x52, 0x5a, 0x19, 0x4a, 0x9b, 0x2e, 0xb2, 0x69, 0x23, 0xb4,
-	0xa9, 0xd0, 0x9d, 0x66, 0x2b, 0x1e, 0x7a, 0x6b, 0x8a, 0x82, 0x87, 0x82, 0xac, 0x0a, 0xe2, 0xa5,
-	0x4c, 0x36, 0xc3, 0x74, 0xa9, 0x3b, 0xb3, 0xcc, 0x4c, 0x97, 0xf6, 0xe2, 0x21, 0x5f, 0x40, 0xc1,
-	0xa3, 0x5f, 0xc2, 0x83, 0x07, 0x3f, 0x42, 0x8f, 0x45, 0x2f, 0xe2, 0xa1, 0x48, 0x2b, 0xf8, 0x35,
-	0x64, 0x67, 0x27, 0xdb, 0xdd, 0xba, 0xa9, 0x5e, 0x92, 0xcc, 0xfc, 0xff, 0xef, 0xbd, 0xdf, 0x7b,
-	0xbc, 0x09, 0x58, 0x22, 0x49, 0xc4, 0x25, 0x22, 0x22, 0xf0, 0xb6, 0x50, 0xd2, 0x43, 0xea, 0xc4,
-	0x8d, 0x05, 0x57, 0x1c, 0xce, 0x69, 0xc1, 0xd5, 0x82, 0x9b, 0xf4, 0x6c, 0x27, 0xe0, 0x32, 0x75,
-	0x0e, 0xb0, 0x24, 0x28, 0xe9, 0x0d, 0x88, 0xc2, 0x3d, 0x14, 0xf0, 0x90, 0x65, 0x7e, 0x7b, 0xc9,
-	0xe8, 0x91, 0xa4, 0x69, 0x9e, 0x48, 0x52, 0x23, 0x2c, 0x67, 0xc2, 0x81, 0x3e, 0xa1, 0xec, 0x60,
-	0xa4, 0x7b, 0x37, 0x8a, 0x53, 0xc2, 0x88, 0x0c, 0xc7, 0xea, 0x02, 0xe5, 0x94, 0x67, 0x51, 0xe9,
-	0xaf, 0x71, 0x0c, 0xe5, 0x9c, 0xbe, 0x21, 0x08, 0xc7, 0x21, 0xc2, 0x8c, 0x71, 0x85, 0x55, 0xc8,
-	0x99, 0x89, 0xe9, 0x9c, 0x82, 0xb9, 0x7d, 0x49, 0xf7, 0x38, 0x4b, 0x88, 0x50, 0x7b, 0x3c, 0x64,
-	0x70, 0x1b, 0xd4, 0x53, 0xca, 0x96, 0xb5, 0x62, 0x75, 0x9b, 0xde, 0xb2, 0x6b, 0x00, 0xd2, 0x36,
-	0x5c, 0xd3, 0x86, 0x9b, 0x1a, 0xfb, 0xf5, 0xb3, 0x8b, 0x76, 0xcd, 0xd7, 0x66, 0x68, 0x83, 0x3b,
-	0x82, 0x04, 0x24, 0x4c, 0x88, 0x68, 0x4d, 0xad, 0x58, 0xdd, 0x19, 0x3f, 0x3f, 0xc3, 0x45, 0xd0,
-	0x90, 0x84, 0x0d, 0x89, 0x68, 0x4d, 0x6b, 0xc5, 0x9c, 0x3a, 0x2d, 0xb0, 0x58, 0x2e, 0xed, 0x13,
-	0x19, 0x73, 0x26, 0x49, 0xe7, 0x8b, 0x05, 0xe6, 0xaf, 0xa5, 0xc7, 0xfe, 0x9e, 0xb7, 0x05, 0x37,
-	0xc0, 0xdd, 0x80, 0x33, 0x25, 0x70, 0xa0, 0x0e, 0xf0, 0x70, 0x28, 0x88, 0x94, 0x1a, 0x71, 0xc6,
-	0x9f, 0x1f, 0xdf, 0xef, 0x66, 0xd7, 0xf0, 0x09, 0x68, 0xe0, 0x88, 0x1f, 0x33, 0x95, 0xa1, 0xf4,
-	0xdd, 0x14, 0xf4, 0xc7, 0x45, 0x7b, 0x8d, 0x86, 0xea, 0xf0, 0x78, 0xe0, 0x06, 0x3c, 0x32, 0x63,
-	0x35, 0x5f, 0x9b, 0x72, 0x78, 0x84, 0xd4, 0x69, 0x4c, 0xa4, 0xfb, 0x94, 0x29, 0xdf, 0x44, 0x97,
-	0x9a, 0x9a, 0x9e, 0xd8, 0x54, 0xbd, 0xd4, 0xd4, 0x32, 0x58, 0xba, 0x41, 0x9e, 0x77, 0x15, 0x6a,
-	0xc9, 0x27, 0x34, 0x94, 0x8a, 0x08, 0xad, 0xed, 0xca, 0x17, 0xfc, 0x88, 0xb0, 0x42, 0x36, 0xab,
-	0x98, 0xad, 0xb2, 0xe9, 0xa9, 0xca, 0xa6, 0x77, 0x9a, 0xa3, 0xdf, 0x9f, 0x1e, 0x8c, 0x29, 0x56,
-	0x41, 0x7b, 0x42, 0xa9, 0x9c, 0xe6, 0x5d, 0x36, 0xe3, 0x97, 0xf1, 0x10, 0x2b, 0xf2, 0x0c, 0x0b,
-	0x1c, 0x49, 0xf8, 0x08, 0xcc, 0xe0, 0x63, 0x75, 0xc8, 0x45, 0xa8, 0x4e, 0x33, 0x92, 0x7e, 0xeb,
-	0xeb, 0xe7, 0xcd, 0x05, 0xb3, 0x02, 0xa6, 0xd4, 0x73, 0x25, 0x42, 0x46, 0xfd, 0x6b, 0x2b, 0x7c,
-	0x08, 0x1a, 0xb1, 0xce, 0xa0, 0xe1, 0x9a, 0xde, 0xa2, 0x5b, 0x7e, 0x0b, 0x6e, 0x96, 0xdf, 0x6c,
-	0x8c, 0xf1, 0xee, 0xcc, 0xa5, 0xc4, 0xd7, 0x59, 0xcc, 0xe8, 0x8a, 0x40, 0x63, 0x58, 0x6f, 0x54,
-	0x07, 0xd3, 0xfb, 0x92, 0xc2, 0xb7, 0xa0, 0x59, 0x5c, 0x55, 0xe7, 0x66, 0x9d, 0xf2, 0x3e, 0xd9,
-	0x6b, 0xb7, 0xeb, 0xf9, 0x2c, 0xd6, 0x47, 0xdf, 0x7e, 0x7d, 0x98, 0x5a, 0x85, 0x6d, 0xf4, 0xd7,
-	0xe3, 0x46, 0x41, 0xe6, 0x3f, 0xd0, 0x6b, 0x3e, 0xb2, 0xc0, 0x6c, 0x69, 0x2b, 0xdb, 0x93, 0x2b,
-	0x68, 0x83, 0xbd, 0xfe, 0x0f, 0x43, 0xce, 0xd0, 0xd5, 0x0c, 0x1d, 0xb8, 0x72, 0x0b, 0x83, 0xbe,
-	0x83, 0x1f, 0x2d, 0xb0, 0x50, 0xb9, 0x45, 0x55, 0xb5, 0xaa, 0x8c, 0x36, 0xfa, 0x4f, 0x63, 0x0e,
-	0xb7, 0xa1, 0xe1, 0xee, 0xc3, 0xd5, 0x0a, 0x38, 0x61, 0x02, 0x0d, 0xdd, 0x2b, 0x30, 0x5b, 0xda,
-	0xa9, 0xaa, 0x09, 0x15, 0x0d, 0x95, 0x13, 0xaa, 0x5a, 0x82, 0x7e, 0xff, 0xec, 0xd2, 0xb1, 0xce,
-	0x2f, 0x1d, 0xeb, 0xe7, 0xa5, 0x63, 0xbd, 0xbf, 0x72, 0x6a, 0xe7, 0x57, 0x4e, 0xed, 0xfb, 0x95,
-	0x53, 0x7b, 0xdd, 0x2d, 0x3c, 0x6c, 0x03, 0xa8, 0x3f, 0x93, 0x9e, 0x87, 0x4e, 0x0c, 0xac, 0x7e,
-	0xde, 0x83, 0x86, 0xfe, 0xd7, 0xdb, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0xab, 0x8e, 0x1c, 0xd1,
-	0xc6, 0x05, 0x00, 0x00,
+var xxx_messageInfo_MsgUpdateTokenPairMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateTokenPairMetadata) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgUpdateTokenPairMetadata) GetMetadata() types1.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types1.Metadata{}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgUpdateTokenPairMetadataResponse defines the response structure for executing a
+// MsgUpdateTokenPairMetadata message.
+type MsgUpdateTokenPairMetadataResponse struct {
+	// erc20_address is the hex address of the ERC20 contract deployed with the
+	// updated metadata
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
-	// that is registered on the token mapping.
-	ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error)
-	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
-	// contract that is registered on the token mapping.
-	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
-	// RegisterERC20AsToken registers an ERC20 token contract for native Cosmos coin representation
-	RegisterERC20AsToken(ctx context.Context, in *MsgRegisterERC20AsToken, opts ...grpc.CallOption) (*MsgRegisterERC20AsTokenResponse, error)
-	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgUpdateTokenPairMetadataResponse) Reset()         { *m = MsgUpdateTokenPairMetadataResponse{} }
+func (m *MsgUpdateTokenPairMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTokenPairMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateTokenPairMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{9}
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTokenPairMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTokenPairMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTokenPairMetadataResponse.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_MsgUpdateTokenPairMetadataResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateTokenPairMetadataResponse) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgMigrateTokenPair is the Msg/MigrateTokenPair request type.
+type MsgMigrateTokenPair struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// new_contract is the hex address of the ERC20 contract that replaces the
+	// current one. It must be empty for native Cosmos coin pairs, for which the
+	// module deploys the new contract.
+	NewContract string `protobuf:"bytes,3,opt,name=new_contract,json=newContract,proto3" json:"new_contract,omitempty"`
+	// escrow_recipient is the hex address that receives the tokens escrowed by
+	// the module on the previous contract of an ERC20 native token pair, as they
+	// no longer back any coins after the migration. It is required for ERC20
+	// native token pairs and must be empty for native Cosmos coin pairs.
+	EscrowRecipient string `protobuf:"bytes,4,opt,name=escrow_recipient,json=escrowRecipient,proto3" json:"escrow_recipient,omitempty"`
 }
 
-func (c *msgClient) ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error) {
-	out := new(MsgConvertCoinResponse)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertCoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateTokenPair) Reset()         { *m = MsgMigrateTokenPair{} }
+func (m *MsgMigrateTokenPair) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateTokenPair) ProtoMessage()    {}
+func (*MsgMigrateTokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{10}
+}
+func (m *MsgMigrateTokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateTokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateTokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgMigrateTokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateTokenPair.Merge(m, src)
+}
+func (m *MsgMigrateTokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateTokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateTokenPair.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error) {
-	out := new(MsgConvertERC20Response)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgMigrateTokenPair proto.InternalMessageInfo
+
+func (m *MsgMigrateTokenPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterERC20AsToken(ctx context.Context, in *MsgRegisterERC20AsToken, opts ...grpc.CallOption) (*MsgRegisterERC20AsTokenResponse, error) {
-	out := new(MsgRegisterERC20AsTokenResponse)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/RegisterERC20AsToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateTokenPair) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateTokenPair) GetNewContract() string {
+	if m != nil {
+		return m.NewContract
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
-	// that is registered on the token mapping.
-	ConvertCoin(context.Context, *MsgConvertCoin) (*MsgConvertCoinResponse, error)
-	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
-	// contract that is registered on the token mapping.
-	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
-	// RegisterERC20AsToken registers an ERC20 token contract for native Cosmos coin representation
-	RegisterERC20AsToken(context.Context, *MsgRegisterERC20AsToken) (*MsgRegisterERC20AsTokenResponse, error)
-	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgMigrateTokenPair) GetEscrowRecipient() string {
+	if m != nil {
+		return m.EscrowRecipient
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgMigrateTokenPairResponse defines the response structure for executing a
+// MsgMigrateTokenPair message.
+type MsgMigrateTokenPairResponse struct {
+	// erc20_address is the hex address of the ERC20 contract the pair migrated to
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
 }
 
-func (*UnimplementedMsgServer) ConvertCoin(ctx context.Context, req *MsgConvertCoin) (*MsgConvertCoinResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoin not implemented")
+func (m *MsgMigrateTokenPairResponse) Reset()         { *m = MsgMigrateTokenPairResponse{} }
+func (m *MsgMigrateTokenPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateTokenPairResponse) ProtoMessage()    {}
+func (*MsgMigrateTokenPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{11}
 }
-func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
+func (m *MsgMigrateTokenPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RegisterERC20AsToken(ctx context.Context, req *MsgRegisterERC20AsToken) (*MsgRegisterERC20AsTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20AsToken not implemented")
+func (m *MsgMigrateTokenPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateTokenPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgMigrateTokenPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateTokenPairResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgMigrateTokenPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateTokenPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateTokenPairResponse.DiscardUnknown(m)
 }
 
-func _Msg_ConvertCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCoin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCoin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Msg/ConvertCoin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCoin(ctx, req.(*MsgConvertCoin))
+var xxx_messageInfo_MsgMigrateTokenPairResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateTokenPairResponse) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertERC20)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertERC20(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Msg/ConvertERC20",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertERC20(ctx, req.(*MsgConvertERC20))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgDeregisterTokenPair is the Msg/DeregisterTokenPair request type.
+type MsgDeregisterTokenPair struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func _Msg_RegisterERC20AsToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterERC20AsToken)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterERC20AsToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Msg/RegisterERC20AsToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterERC20AsToken(ctx, req.(*MsgRegisterERC20AsToken))
+func (m *MsgDeregisterTokenPair) Reset()         { *m = MsgDeregisterTokenPair{} }
+func (m *MsgDeregisterTokenPair) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterTokenPair) ProtoMessage()    {}
+func (*MsgDeregisterTokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{12}
+}
+func (m *MsgDeregisterTokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterTokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterTokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeregisterTokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterTokenPair.Merge(m, src)
+}
+func (m *MsgDeregisterTokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterTokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterTokenPair.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeregisterTokenPair proto.InternalMessageInfo
+
+func (m *MsgDeregisterTokenPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return ""
+}
+
+func (m *MsgDeregisterTokenPair) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.erc20.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConvertCoin",
-			Handler:    _Msg_ConvertCoin_Handler,
-		},
-		{
-			MethodName: "ConvertERC20",
-			Handler:    _Msg_ConvertERC20_Handler,
-		},
-		{
-			MethodName: "RegisterERC20AsToken",
-			Handler:    _Msg_RegisterERC20AsToken_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/erc20/v1/tx.proto",
+// MsgDeregisterTokenPairResponse defines the response structure for executing a
+// MsgDeregisterTokenPair message.
+type MsgDeregisterTokenPairResponse struct {
 }
 
-func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeregisterTokenPairResponse) Reset()         { *m = MsgDeregisterTokenPairResponse{} }
+func (m *MsgDeregisterTokenPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterTokenPairResponse) ProtoMessage()    {}
+func (*MsgDeregisterTokenPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{13}
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterTokenPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterTokenPairResponse.Merge(m, src)
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterTokenPairResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConvertCoin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDeregisterTokenPairResponse proto.InternalMessageInfo
+
+// MsgBackfillAutoRegistration is the Msg/BackfillAutoRegistration request type.
+type MsgBackfillAutoRegistration struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgConvertCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgBackfillAutoRegistration) Reset()         { *m = MsgBackfillAutoRegistration{} }
+func (m *MsgBackfillAutoRegistration) String() string { return proto.CompactTextString(m) }
+func (*MsgBackfillAutoRegistration) ProtoMessage()    {}
+func (*MsgBackfillAutoRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{14}
+}
+func (m *MsgBackfillAutoRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBackfillAutoRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBackfillAutoRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgConvertCoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBackfillAutoRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBackfillAutoRegistration.Merge(m, src)
 }
-
-func (m *MsgConvertCoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBackfillAutoRegistration) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgConvertCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBackfillAutoRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBackfillAutoRegistration.DiscardUnknown(m)
 }
 
-func (m *MsgConvertERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBackfillAutoRegistration proto.InternalMessageInfo
+
+func (m *MsgBackfillAutoRegistration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgConvertERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgBackfillAutoRegistrationResponse defines the response structure for executing a
+// MsgBackfillAutoRegistration message.
+type MsgBackfillAutoRegistrationResponse struct {
+	// token_pairs are the token pairs registered by the backfill
+	TokenPairs []TokenPair `protobuf:"bytes,1,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
 }
 
-func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgBackfillAutoRegistrationResponse) Reset()         { *m = MsgBackfillAutoRegistrationResponse{} }
+func (m *MsgBackfillAutoRegistrationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBackfillAutoRegistrationResponse) ProtoMessage()    {}
+func (*MsgBackfillAutoRegistrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{15}
+}
+func (m *MsgBackfillAutoRegistrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBackfillAutoRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBackfillAutoRegistrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBackfillAutoRegistrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBackfillAutoRegistrationResponse.Merge(m, src)
+}
+func (m *MsgBackfillAutoRegistrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBackfillAutoRegistrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBackfillAutoRegistrationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBackfillAutoRegistrationResponse proto.InternalMessageInfo
+
+func (m *MsgBackfillAutoRegistrationResponse) GetTokenPairs() []TokenPair {
+	if m != nil {
+		return m.TokenPairs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateConversionLimit is the Msg/UpdateConversionLimit request type.
+type MsgUpdateConversionLimit struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// limit is the conversion limit of the token pair. The limit is removed if
+	// both of its maximum amounts are zero.
+	Limit ConversionLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
 }
 
-func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateConversionLimit) Reset()         { *m = MsgUpdateConversionLimit{} }
+func (m *MsgUpdateConversionLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateConversionLimit) ProtoMessage()    {}
+func (*MsgUpdateConversionLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{16}
 }
-
-func (m *MsgRegisterERC20AsToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateConversionLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateConversionLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateConversionLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterERC20AsToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateConversionLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateConversionLimit.Merge(m, src)
+}
+func (m *MsgUpdateConversionLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateConversionLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateConversionLimit.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterERC20AsToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgUpdateConversionLimit proto.InternalMessageInfo
+
+func (m *MsgUpdateConversionLimit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRegisterERC20AsTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateConversionLimit) GetLimit() ConversionLimit {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return ConversionLimit{}
 }
 
-func (m *MsgRegisterERC20AsTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateConversionLimitResponse defines the response structure for executing a
+// MsgUpdateConversionLimit message.
+type MsgUpdateConversionLimitResponse struct {
 }
 
-func (m *MsgRegisterERC20AsTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateConversionLimitResponse) Reset()         { *m = MsgUpdateConversionLimitResponse{} }
+func (m *MsgUpdateConversionLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateConversionLimitResponse) ProtoMessage()    {}
+func (*MsgUpdateConversionLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{17}
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateConversionLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateConversionLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateConversionLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateConversionLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateConversionLimitResponse.Merge(m, src)
+}
+func (m *MsgUpdateConversionLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateConversionLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateConversionLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateConversionLimitResponse proto.InternalMessageInfo
+
+// MsgResetConversionLimit is the Msg/ResetConversionLimit request type.
+type MsgResetConversionLimit struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgResetConversionLimit) Reset()         { *m = MsgResetConversionLimit{} }
+func (m *MsgResetConversionLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgResetConversionLimit) ProtoMessage()    {}
+func (*MsgResetConversionLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{18}
+}
+func (m *MsgResetConversionLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetConversionLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetConversionLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgResetConversionLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetConversionLimit.Merge(m, src)
+}
+func (m *MsgResetConversionLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetConversionLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetConversionLimit.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResetConversionLimit proto.InternalMessageInfo
+
+func (m *MsgResetConversionLimit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResetConversionLimit) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgResetConversionLimitResponse defines the response structure for executing a
+// MsgResetConversionLimit message.
+type MsgResetConversionLimitResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgResetConversionLimitResponse) Reset()         { *m = MsgResetConversionLimitResponse{} }
+func (m *MsgResetConversionLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetConversionLimitResponse) ProtoMessage()    {}
+func (*MsgResetConversionLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{19}
 }
-func (m *MsgConvertCoin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgResetConversionLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetConversionLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetConversionLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgResetConversionLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetConversionLimitResponse.Merge(m, src)
+}
+func (m *MsgResetConversionLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetConversionLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetConversionLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConvertCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgResetConversionLimitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgConvertCoin)(nil), "evmos.erc20.v1.MsgConvertCoin")
+	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
+	proto.RegisterType((*MsgConvertERC20)(nil), "evmos.erc20.v1.MsgConvertERC20")
+	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
+	proto.RegisterType((*MsgRegisterERC20AsToken)(nil), "evmos.erc20.v1.MsgRegisterERC20AsToken")
+	proto.RegisterType((*MsgRegisterERC20AsTokenResponse)(nil), "evmos.erc20.v1.MsgRegisterERC20AsTokenResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.erc20.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.erc20.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateTokenPairMetadata)(nil), "evmos.erc20.v1.MsgUpdateTokenPairMetadata")
+	proto.RegisterType((*MsgUpdateTokenPairMetadataResponse)(nil), "evmos.erc20.v1.MsgUpdateTokenPairMetadataResponse")
+	proto.RegisterType((*MsgMigrateTokenPair)(nil), "evmos.erc20.v1.MsgMigrateTokenPair")
+	proto.RegisterType((*MsgMigrateTokenPairResponse)(nil), "evmos.erc20.v1.MsgMigrateTokenPairResponse")
+	proto.RegisterType((*MsgDeregisterTokenPair)(nil), "evmos.erc20.v1.MsgDeregisterTokenPair")
+	proto.RegisterType((*MsgDeregisterTokenPairResponse)(nil), "evmos.erc20.v1.MsgDeregisterTokenPairResponse")
+	proto.RegisterType((*MsgBackfillAutoRegistration)(nil), "evmos.erc20.v1.MsgBackfillAutoRegistration")
+	proto.RegisterType((*MsgBackfillAutoRegistrationResponse)(nil), "evmos.erc20.v1.MsgBackfillAutoRegistrationResponse")
+	proto.RegisterType((*MsgUpdateConversionLimit)(nil), "evmos.erc20.v1.MsgUpdateConversionLimit")
+	proto.RegisterType((*MsgUpdateConversionLimitResponse)(nil), "evmos.erc20.v1.MsgUpdateConversionLimitResponse")
+	proto.RegisterType((*MsgResetConversionLimit)(nil), "evmos.erc20.v1.MsgResetConversionLimit")
+	proto.RegisterType((*MsgResetConversionLimitResponse)(nil), "evmos.erc20.v1.MsgResetConversionLimitResponse")
+}
+
+func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
+
+var fileDescriptor_f8926fc6cb676914 = []byte{
+	// 1035 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x69, 0xd4, 0x3c, 0x87, 0xa4, 0xda, 0x9a, 0xc4, 0x59, 0xc0, 0x76, 0x1c, 0xa9,
+	0x71, 0x8b, 0xba, 0x1b, 0x3b, 0x88, 0x43, 0x39, 0x40, 0x1c, 0x40, 0xaa, 0x84, 0xa5, 0xca, 0x80,
+	0x84, 0xb8, 0x58, 0x93, 0xf5, 0xb0, 0x1d, 0x39, 0x9e, 0x59, 0xcd, 0x4c, 0x9c, 0x46, 0x42, 0x1c,
+	0xf2, 0x0f, 0x80, 0xc4, 0x91, 0x03, 0x27, 0xee, 0x1c, 0x90, 0xe0, 0xca, 0xad, 0xc7, 0x0a, 0x2e,
+	0x88, 0x43, 0x85, 0x12, 0x24, 0xfe, 0x0d, 0xb4, 0xb3, 0xb3, 0x13, 0x7b, 0x7f, 0xa4, 0x6e, 0x04,
+	0x17, 0xdb, 0x33, 0xef, 0x9b, 0xf7, 0xbe, 0xef, 0xed, 0x7b, 0x6f, 0xd6, 0xb0, 0x81, 0xc7, 0x23,
+	0x26, 0x3c, 0xcc, 0xfd, 0xf6, 0xae, 0x37, 0x6e, 0x79, 0xf2, 0x89, 0x1b, 0x72, 0x26, 0x99, 0xbd,
+	0xaa, 0x0c, 0xae, 0x32, 0xb8, 0xe3, 0x96, 0x53, 0xf5, 0x99, 0x88, 0x90, 0x87, 0x88, 0x0e, 0xbd,
+	0x71, 0xeb, 0x10, 0x4b, 0xd4, 0x52, 0x8b, 0x18, 0x3f, 0x61, 0x17, 0xd8, 0xd8, 0x7d, 0x46, 0xa8,
+	0xb6, 0x6f, 0x68, 0xfb, 0x48, 0x04, 0x51, 0x9c, 0x91, 0x08, 0xb4, 0x61, 0x33, 0x36, 0xf4, 0xd5,
+	0xca, 0x8b, 0x17, 0xda, 0xe4, 0xa4, 0xc8, 0xc5, 0x64, 0x62, 0xdb, 0xeb, 0x29, 0x5b, 0x80, 0x29,
+	0x16, 0x24, 0x39, 0x59, 0x0e, 0x58, 0xc0, 0x62, 0x8f, 0xd1, 0xaf, 0xe4, 0x4c, 0xc0, 0x58, 0x70,
+	0x84, 0x3d, 0x14, 0x12, 0x0f, 0x51, 0xca, 0x24, 0x92, 0x84, 0x51, 0x7d, 0xa6, 0x71, 0x0a, 0xab,
+	0x5d, 0x11, 0x1c, 0x30, 0x3a, 0xc6, 0x5c, 0x1e, 0x30, 0x42, 0xed, 0x3d, 0x58, 0x8c, 0x14, 0x54,
+	0xac, 0xba, 0xd5, 0x2c, 0xb5, 0x37, 0x5d, 0x4d, 0x2e, 0x92, 0xe8, 0x6a, 0x89, 0x6e, 0x04, 0xec,
+	0x2c, 0x3e, 0x7d, 0x5e, 0x9b, 0xeb, 0x29, 0xb0, 0xed, 0xc0, 0x4d, 0x8e, 0x7d, 0x4c, 0xc6, 0x98,
+	0x57, 0xe6, 0xeb, 0x56, 0x73, 0xb9, 0x67, 0xd6, 0xf6, 0x3a, 0x2c, 0x09, 0x4c, 0x07, 0x98, 0x57,
+	0x16, 0x94, 0x45, 0xaf, 0x1a, 0x15, 0x58, 0x9f, 0x0e, 0xdd, 0xc3, 0x22, 0x64, 0x54, 0xe0, 0xc6,
+	0x2f, 0x16, 0xac, 0x5d, 0x9a, 0x3e, 0xe8, 0x1d, 0xb4, 0x77, 0xed, 0xbb, 0x70, 0xcb, 0x67, 0x54,
+	0x72, 0xe4, 0xcb, 0x3e, 0x1a, 0x0c, 0x38, 0x16, 0x42, 0x51, 0x5c, 0xee, 0xad, 0x25, 0xfb, 0xfb,
+	0xf1, 0xb6, 0xfd, 0x21, 0x2c, 0xa1, 0x11, 0x3b, 0xa6, 0x32, 0xa6, 0xd2, 0x71, 0x23, 0xa2, 0x7f,
+	0x3e, 0xaf, 0xdd, 0x09, 0x88, 0x7c, 0x7c, 0x7c, 0xe8, 0xfa, 0x6c, 0xa4, 0x53, 0xae, 0xbf, 0xee,
+	0x8b, 0xc1, 0xd0, 0x93, 0xa7, 0x21, 0x16, 0xee, 0x43, 0x2a, 0x7b, 0xfa, 0xf4, 0x94, 0xa8, 0x85,
+	0x42, 0x51, 0x8b, 0x53, 0xa2, 0x36, 0x61, 0x23, 0xc5, 0xdc, 0xa8, 0x22, 0xca, 0xd4, 0xc3, 0x01,
+	0x11, 0x12, 0x73, 0x65, 0xdb, 0x17, 0x9f, 0xb0, 0x21, 0xa6, 0x13, 0xde, 0xac, 0x49, 0x6f, 0xb9,
+	0xa2, 0xe7, 0x73, 0x45, 0x3f, 0x28, 0x9d, 0xfd, 0xf3, 0xe3, 0xbd, 0x84, 0xc5, 0x16, 0xd4, 0x0a,
+	0x42, 0x19, 0x36, 0x5f, 0xc7, 0x39, 0xfe, 0x34, 0x1c, 0x20, 0x89, 0x1f, 0x21, 0x8e, 0x46, 0xc2,
+	0x7e, 0x1b, 0x96, 0xd1, 0xb1, 0x7c, 0xcc, 0x38, 0x91, 0xa7, 0x31, 0x93, 0x4e, 0xe5, 0xb7, 0x9f,
+	0xee, 0x97, 0x75, 0x09, 0xe8, 0x50, 0x1f, 0x4b, 0x4e, 0x68, 0xd0, 0xbb, 0x84, 0xda, 0x6f, 0xc1,
+	0x52, 0xa8, 0x3c, 0x28, 0x72, 0xa5, 0xf6, 0xba, 0x3b, 0xdd, 0x47, 0x6e, 0xec, 0x5f, 0x57, 0x8c,
+	0xc6, 0x3e, 0x58, 0x8d, 0x18, 0x5f, 0x7a, 0xd1, 0xa9, 0x9b, 0x24, 0x64, 0xc8, 0xfe, 0x60, 0x81,
+	0x63, 0x6c, 0x4a, 0xc7, 0x23, 0x44, 0x78, 0x17, 0x4b, 0x34, 0x40, 0x12, 0x5d, 0x9b, 0xf7, 0xbb,
+	0x70, 0x73, 0xa4, 0x7d, 0x68, 0xe6, 0x6f, 0x5c, 0x96, 0x3b, 0x1d, 0x9a, 0x72, 0x4f, 0x02, 0x69,
+	0x01, 0xe6, 0x50, 0x46, 0xc2, 0x43, 0x68, 0x14, 0xd3, 0x4c, 0xd4, 0xd8, 0xdb, 0xf0, 0x8a, 0xca,
+	0x4c, 0xaa, 0x8e, 0x57, 0xd4, 0xa6, 0x26, 0xdb, 0xf8, 0xd5, 0x82, 0xdb, 0x5d, 0x11, 0x74, 0x49,
+	0xc0, 0x27, 0x9d, 0x5d, 0x5b, 0x6b, 0x19, 0x6e, 0xc8, 0xc8, 0x89, 0xae, 0x9f, 0x78, 0x61, 0x6f,
+	0xc1, 0x0a, 0xc5, 0x27, 0xfd, 0xa4, 0x98, 0x74, 0x99, 0x97, 0x28, 0x3e, 0x39, 0xd0, 0x5b, 0x51,
+	0x0d, 0x62, 0xe1, 0x73, 0x76, 0xd2, 0xe7, 0xd8, 0x27, 0x21, 0xc1, 0x54, 0xea, 0x9a, 0x5f, 0x8b,
+	0xf7, 0x7b, 0xc9, 0x76, 0x26, 0x1d, 0x1d, 0x78, 0x2d, 0x47, 0xc2, 0xcb, 0xe5, 0x61, 0xac, 0xa6,
+	0xc4, 0xfb, 0x98, 0xeb, 0x62, 0xfe, 0x9f, 0x32, 0x91, 0xe1, 0x5e, 0x87, 0x6a, 0x7e, 0x5c, 0x53,
+	0x94, 0x58, 0xa9, 0xeb, 0x20, 0x7f, 0xf8, 0x05, 0x39, 0x3a, 0xda, 0x3f, 0x96, 0x2c, 0x6e, 0x38,
+	0xae, 0x06, 0xec, 0x75, 0xe9, 0x65, 0x88, 0x04, 0xb0, 0x7d, 0x45, 0x18, 0x93, 0xcc, 0xf7, 0xa0,
+	0xa4, 0x84, 0xf4, 0x43, 0x44, 0x78, 0x94, 0xca, 0x05, 0x35, 0xbd, 0x53, 0x8d, 0x68, 0x54, 0xe8,
+	0x52, 0x06, 0x99, 0x6c, 0x88, 0xc6, 0xf7, 0x16, 0x54, 0x4c, 0xf5, 0xc6, 0x13, 0x4c, 0x10, 0x46,
+	0x3f, 0x22, 0x23, 0x22, 0xaf, 0x9d, 0xec, 0x77, 0xe0, 0xc6, 0x51, 0xe4, 0x40, 0xf7, 0x57, 0x2d,
+	0x4d, 0x28, 0x15, 0x47, 0xd3, 0x8a, 0xcf, 0x64, 0x52, 0xd1, 0x80, 0x7a, 0x11, 0x41, 0xf3, 0x54,
+	0x4e, 0xf4, 0x94, 0x15, 0x58, 0xfe, 0x57, 0x1a, 0x66, 0x2b, 0x98, 0x64, 0xe6, 0x66, 0x03, 0x27,
+	0xdc, 0xda, 0x3f, 0x2f, 0xc3, 0x42, 0x57, 0x04, 0xf6, 0x57, 0x50, 0x9a, 0xbc, 0x71, 0xab, 0xe9,
+	0xa4, 0x4c, 0x5f, 0x8b, 0xce, 0x9d, 0xab, 0xed, 0x46, 0xfa, 0xce, 0xd9, 0xef, 0x7f, 0x7f, 0x3b,
+	0xbf, 0x65, 0xd7, 0xbc, 0xcc, 0xfb, 0x8d, 0xe7, 0xc7, 0xf8, 0xbe, 0xba, 0xad, 0xcf, 0x2c, 0x58,
+	0x99, 0xba, 0x5c, 0x6b, 0xc5, 0x11, 0x14, 0xc0, 0xd9, 0x79, 0x01, 0xc0, 0x70, 0x68, 0x2a, 0x0e,
+	0x0d, 0xbb, 0x7e, 0x05, 0x07, 0xb5, 0x67, 0x7f, 0x67, 0x41, 0x39, 0xf7, 0x32, 0xcc, 0x8b, 0x95,
+	0x07, 0x74, 0xbc, 0x19, 0x81, 0x86, 0xdc, 0x5d, 0x45, 0x6e, 0xdb, 0xde, 0xca, 0x21, 0x97, 0xf4,
+	0xb9, 0x66, 0xf7, 0x19, 0xac, 0x4c, 0x5d, 0x8d, 0x79, 0x19, 0x9a, 0x04, 0x38, 0x3b, 0x2f, 0x00,
+	0x98, 0x46, 0x3d, 0x85, 0x8d, 0xa2, 0x7b, 0xec, 0x5e, 0xa1, 0x8f, 0x0c, 0xd6, 0x69, 0xcf, 0x8e,
+	0x35, 0xa1, 0x07, 0x70, 0x2b, 0x73, 0x9f, 0x6c, 0xe7, 0xf8, 0x49, 0x83, 0x9c, 0x37, 0x67, 0x00,
+	0x99, 0x28, 0x23, 0xb8, 0x9d, 0x37, 0xae, 0xf3, 0xaa, 0x38, 0x07, 0xe7, 0xb8, 0xb3, 0xe1, 0x4c,
+	0xb8, 0x2f, 0xa1, 0x52, 0x38, 0x83, 0xf3, 0x78, 0x17, 0x81, 0x9d, 0xbd, 0x97, 0x00, 0x9b, 0xe8,
+	0x02, 0x5e, 0xcd, 0x1f, 0x98, 0xcd, 0xc2, 0xe7, 0x93, 0x42, 0x3a, 0xbb, 0xb3, 0x22, 0x4d, 0xd0,
+	0x10, 0xca, 0x79, 0x73, 0xa6, 0xa0, 0x73, 0xb2, 0x40, 0xc7, 0x9b, 0x11, 0x98, 0x44, 0xec, 0x74,
+	0x9e, 0x9e, 0x57, 0xad, 0x67, 0xe7, 0x55, 0xeb, 0xaf, 0xf3, 0xaa, 0xf5, 0xcd, 0x45, 0x75, 0xee,
+	0xd9, 0x45, 0x75, 0xee, 0x8f, 0x8b, 0xea, 0xdc, 0xe7, 0xcd, 0x89, 0x97, 0x6a, 0xdd, 0x55, 0xea,
+	0x73, 0xdc, 0x6a, 0x7b, 0x4f, 0x74, 0x87, 0xa9, 0x57, 0xeb, 0xc3, 0x25, 0xf5, 0x8f, 0x63, 0xef,
+	0xdf, 0x01, 0x00, 0x01, 0x46, 0x35, 0x36, 0x7e, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
+	// that is registered on the token mapping.
+	ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error)
+	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
+	// contract that is registered on the token mapping.
+	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
+	// RegisterERC20AsToken registers an ERC20 token contract for native Cosmos coin representation
+	RegisterERC20AsToken(ctx context.Context, in *MsgRegisterERC20AsToken, opts ...grpc.CallOption) (*MsgRegisterERC20AsTokenResponse, error)
+	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateTokenPairMetadata defines a governance operation for updating the
+	// bank metadata of a native Cosmos coin token pair and migrating the pair to
+	// an ERC20 contract deployed with the new metadata.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairMetadata(ctx context.Context, in *MsgUpdateTokenPairMetadata, opts ...grpc.CallOption) (*MsgUpdateTokenPairMetadataResponse, error)
+	// MigrateTokenPair defines a governance operation for replacing the ERC20
+	// contract of a token pair, migrating the escrow and token balances.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error)
+	// DeregisterTokenPair defines a governance operation for permanently removing a
+	// token pair. The authority is hard-coded to the Cosmos SDK x/gov module account
+	DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error)
+	// BackfillAutoRegistration defines a governance operation for registering the existing
+	// denom metadata that matches the auto-registration rules but has no token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	BackfillAutoRegistration(ctx context.Context, in *MsgBackfillAutoRegistration, opts ...grpc.CallOption) (*MsgBackfillAutoRegistrationResponse, error)
+	// UpdateConversionLimit defines a governance operation for setting or removing the
+	// conversion limit of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateConversionLimit(ctx context.Context, in *MsgUpdateConversionLimit, opts ...grpc.CallOption) (*MsgUpdateConversionLimitResponse, error)
+	// ResetConversionLimit defines a governance operation for resetting the conversion
+	// window of a token pair, resuming its conversions if the limit paused them.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ResetConversionLimit(ctx context.Context, in *MsgResetConversionLimit, opts ...grpc.CallOption) (*MsgResetConversionLimitResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error) {
+	out := new(MsgConvertCoinResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgConvertERC20) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error) {
+	out := new(MsgConvertERC20Response)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RegisterERC20AsToken(ctx context.Context, in *MsgRegisterERC20AsToken, opts ...grpc.CallOption) (*MsgRegisterERC20AsTokenResponse, error) {
+	out := new(MsgRegisterERC20AsTokenResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/RegisterERC20AsToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateTokenPairMetadata(ctx context.Context, in *MsgUpdateTokenPairMetadata, opts ...grpc.CallOption) (*MsgUpdateTokenPairMetadataResponse, error) {
+	out := new(MsgUpdateTokenPairMetadataResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/UpdateTokenPairMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgConvertERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error) {
+	out := new(MsgMigrateTokenPairResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/MigrateTokenPair", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgRegisterERC20AsToken) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error) {
+	out := new(MsgDeregisterTokenPairResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/DeregisterTokenPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BackfillAutoRegistration(ctx context.Context, in *MsgBackfillAutoRegistration, opts ...grpc.CallOption) (*MsgBackfillAutoRegistrationResponse, error) {
+	out := new(MsgBackfillAutoRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/BackfillAutoRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateConversionLimit(ctx context.Context, in *MsgUpdateConversionLimit, opts ...grpc.CallOption) (*MsgUpdateConversionLimitResponse, error) {
+	out := new(MsgUpdateConversionLimitResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/UpdateConversionLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetConversionLimit(ctx context.Context, in *MsgResetConversionLimit, opts ...grpc.CallOption) (*MsgResetConversionLimitResponse, error) {
+	out := new(MsgResetConversionLimitResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ResetConversionLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
+	// that is registered on the token mapping.
+	ConvertCoin(context.Context, *MsgConvertCoin) (*MsgConvertCoinResponse, error)
+	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
+	// contract that is registered on the token mapping.
+	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
+	// RegisterERC20AsToken registers an ERC20 token contract for native Cosmos coin representation
+	RegisterERC20AsToken(context.Context, *MsgRegisterERC20AsToken) (*MsgRegisterERC20AsTokenResponse, error)
+	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateTokenPairMetadata defines a governance operation for updating the
+	// bank metadata of a native Cosmos coin token pair and migrating the pair to
+	// an ERC20 contract deployed with the new metadata.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairMetadata(context.Context, *MsgUpdateTokenPairMetadata) (*MsgUpdateTokenPairMetadataResponse, error)
+	// MigrateTokenPair defines a governance operation for replacing the ERC20
+	// contract of a token pair, migrating the escrow and token balances.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	MigrateTokenPair(context.Context, *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error)
+	// DeregisterTokenPair defines a governance operation for permanently removing a
+	// token pair. The authority is hard-coded to the Cosmos SDK x/gov module account
+	DeregisterTokenPair(context.Context, *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error)
+	// BackfillAutoRegistration defines a governance operation for registering the existing
+	// denom metadata that matches the auto-registration rules but has no token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	BackfillAutoRegistration(context.Context, *MsgBackfillAutoRegistration) (*MsgBackfillAutoRegistrationResponse, error)
+	// UpdateConversionLimit defines a governance operation for setting or removing the
+	// conversion limit of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateConversionLimit(context.Context, *MsgUpdateConversionLimit) (*MsgUpdateConversionLimitResponse, error)
+	// ResetConversionLimit defines a governance operation for resetting the conversion
+	// window of a token pair, resuming its conversions if the limit paused them.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ResetConversionLimit(context.Context, *MsgResetConversionLimit) (*MsgResetConversionLimitResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ConvertCoin(ctx context.Context, req *MsgConvertCoin) (*MsgConvertCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoin not implemented")
+}
+func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
+}
+func (*UnimplementedMsgServer) RegisterERC20AsToken(ctx context.Context, req *MsgRegisterERC20AsToken) (*MsgRegisterERC20AsTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20AsToken not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTokenPairMetadata(ctx context.Context, req *MsgUpdateTokenPairMetadata) (*MsgUpdateTokenPairMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenPairMetadata not implemented")
+}
+func (*UnimplementedMsgServer) MigrateTokenPair(ctx context.Context, req *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTokenPair not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterTokenPair(ctx context.Context, req *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterTokenPair not implemented")
+}
+func (*UnimplementedMsgServer) BackfillAutoRegistration(ctx context.Context, req *MsgBackfillAutoRegistration) (*MsgBackfillAutoRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillAutoRegistration not implemented")
+}
+func (*UnimplementedMsgServer) UpdateConversionLimit(ctx context.Context, req *MsgUpdateConversionLimit) (*MsgUpdateConversionLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversionLimit not implemented")
+}
+func (*UnimplementedMsgServer) ResetConversionLimit(ctx context.Context, req *MsgResetConversionLimit) (*MsgResetConversionLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetConversionLimit not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ConvertCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoin(ctx, req.(*MsgConvertCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20(ctx, req.(*MsgConvertERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterERC20AsToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20AsToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20AsToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/RegisterERC20AsToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20AsToken(ctx, req.(*MsgRegisterERC20AsToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTokenPairMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTokenPairMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTokenPairMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/UpdateTokenPairMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTokenPairMetadata(ctx, req.(*MsgUpdateTokenPairMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/MigrateTokenPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateTokenPair(ctx, req.(*MsgMigrateTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/DeregisterTokenPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, req.(*MsgDeregisterTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BackfillAutoRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBackfillAutoRegistration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BackfillAutoRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/BackfillAutoRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BackfillAutoRegistration(ctx, req.(*MsgBackfillAutoRegistration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateConversionLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateConversionLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateConversionLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/UpdateConversionLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateConversionLimit(ctx, req.(*MsgUpdateConversionLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetConversionLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetConversionLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetConversionLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ResetConversionLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetConversionLimit(ctx, req.(*MsgResetConversionLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.erc20.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertCoin",
+			Handler:    _Msg_ConvertCoin_Handler,
+		},
+		{
+			MethodName: "ConvertERC20",
+			Handler:    _Msg_ConvertERC20_Handler,
+		},
+		{
+			MethodName: "RegisterERC20AsToken",
+			Handler:    _Msg_RegisterERC20AsToken_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateTokenPairMetadata",
+			Handler:    _Msg_UpdateTokenPairMetadata_Handler,
+		},
+		{
+			MethodName: "MigrateTokenPair",
+			Handler:    _Msg_MigrateTokenPair_Handler,
+		},
+		{
+			MethodName: "DeregisterTokenPair",
+			Handler:    _Msg_DeregisterTokenPair_Handler,
+		},
+		{
+			MethodName: "BackfillAutoRegistration",
+			Handler:    _Msg_BackfillAutoRegistration_Handler,
+		},
+		{
+			MethodName: "UpdateConversionLimit",
+			Handler:    _Msg_UpdateConversionLimit_Handler,
+		},
+		{
+			MethodName: "ResetConversionLimit",
+			Handler:    _Msg_ResetConversionLimit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/erc20/v1/tx.proto",
+}
+
+func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterERC20AsToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20AsToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20AsToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterERC20AsTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20AsTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20AsTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTokenPairMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTokenPairMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTokenPairMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTokenPairMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTokenPairMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTokenPairMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateTokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateTokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowRecipient) > 0 {
+		i -= len(m.EscrowRecipient)
+		copy(dAtA[i:], m.EscrowRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EscrowRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewContract) > 0 {
+		i -= len(m.NewContract)
+		copy(dAtA[i:], m.NewContract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateTokenPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateTokenPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterTokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterTokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterTokenPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterTokenPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBackfillAutoRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBackfillAutoRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBackfillAutoRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBackfillAutoRegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBackfillAutoRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBackfillAutoRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConversionLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConversionLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConversionLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConversionLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConversionLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConversionLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetConversionLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetConversionLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetConversionLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetConversionLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetConversionLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetConversionLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterERC20AsToken) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1038,53 +2333,1176 @@ func (m *MsgRegisterERC20AsToken) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterERC20AsTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTokenPairMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateTokenPairMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateTokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewContract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EscrowRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterTokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBackfillAutoRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBackfillAutoRegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateConversionLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateConversionLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetConversionLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetConversionLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20AsToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20AsToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20AsToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20AsTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20AsTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20AsTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRegisterERC20AsTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateTokenPairMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTokenPairMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTokenPairMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgUpdateTokenPairMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTokenPairMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTokenPairMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateTokenPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1107,17 +3525,49 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateTokenPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1127,28 +3577,27 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1176,11 +3625,11 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.NewContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRecipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1208,7 +3657,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.EscrowRecipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1231,7 +3680,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateTokenPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1254,12 +3703,44 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateTokenPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1281,7 +3762,7 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterTokenPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1304,15 +3785,15 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterTokenPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1340,11 +3821,11 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1372,45 +3853,111 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterTokenPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterTokenPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBackfillAutoRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBackfillAutoRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBackfillAutoRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1438,7 +3985,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1461,7 +4008,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgBackfillAutoRegistrationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1484,12 +4031,46 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBackfillAutoRegistrationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBackfillAutoRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1511,7 +4092,7 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterERC20AsToken) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConversionLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1534,15 +4115,15 @@ func (m *MsgRegisterERC20AsToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterERC20AsToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConversionLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterERC20AsToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConversionLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1570,13 +4151,13 @@ func (m *MsgRegisterERC20AsToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1586,23 +4167,24 @@ func (m *MsgRegisterERC20AsToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1625,7 +4207,7 @@ func (m *MsgRegisterERC20AsToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterERC20AsTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConversionLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1648,10 +4230,10 @@ func (m *MsgRegisterERC20AsTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterERC20AsTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConversionLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterERC20AsTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConversionLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1675,7 +4257,7 @@ func (m *MsgRegisterERC20AsTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgResetConversionLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1698,10 +4280,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetConversionLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetConversionLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1738,9 +4320,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1750,24 +4332,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1790,7 +4371,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetConversionLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1813,10 +4394,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetConversionLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetConversionLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
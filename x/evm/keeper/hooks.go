@@ -23,7 +23,10 @@ import (
 	"github.com/evmos/evmos/v12/x/evm/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks         = MultiEvmHooks{}
+	_ types.ContractGasHooks = MultiEvmHooks{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -42,3 +45,14 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	}
 	return nil
 }
+
+// RequiresContractGas returns true if any of the underlying hooks reads the
+// gas consumed by the contracts of the call tree.
+func (mh MultiEvmHooks) RequiresContractGas(ctx sdk.Context) bool {
+	for i := range mh {
+		if h, ok := mh[i].(types.ContractGasHooks); ok && h.RequiresContractGas(ctx) {
+			return true
+		}
+	}
+	return false
+}
@@ -31,6 +31,19 @@ func (dh FailureHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtype
 	return errors.New("post tx processing failed")
 }
 
+// ContractGasHook requires the contract gas of the call tree if set
+type ContractGasHook struct {
+	Required bool
+}
+
+func (dh ContractGasHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
+	return nil
+}
+
+func (dh ContractGasHook) RequiresContractGas(_ sdk.Context) bool {
+	return dh.Required
+}
+
 func (suite *KeeperTestSuite) TestEvmHooks() {
 	testCases := []struct {
 		msg       string
@@ -88,3 +101,44 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+func (suite *KeeperTestSuite) TestContractGasHooks() {
+	testCases := []struct {
+		msg    string
+		hooks  keeper.MultiEvmHooks
+		expGas bool
+	}{
+		{
+			"no hook requires the contract gas",
+			keeper.NewMultiEvmHooks(&LogRecordHook{}, ContractGasHook{}),
+			false,
+		},
+		{
+			"one hook requires the contract gas",
+			keeper.NewMultiEvmHooks(&LogRecordHook{}, ContractGasHook{Required: true}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper = suite.app.EvmKeeper.CleanHooks()
+			suite.app.EvmKeeper.SetHooks(tc.hooks)
+			suite.Require().Equal(tc.expGas, tc.hooks.RequiresContractGas(suite.ctx))
+
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+			suite.Commit()
+			suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.Address{0x1}, big.NewInt(10))
+
+			txIndex := suite.app.EvmKeeper.GetTxIndexTransient(suite.ctx) - 1
+			contractGas := suite.app.EvmKeeper.GetTxContractGasTransient(suite.ctx, txIndex)
+			if tc.expGas {
+				suite.Require().NotEmpty(contractGas)
+				suite.Require().Equal(contractAddr, contractGas[0].Contract)
+			} else {
+				suite.Require().Empty(contractGas)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// RegisterInvariants registers the evm module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "account-code", k.AccountCodeInvariant())
+}
+
+// AccountCodeInvariant checks that the code hash of every EthAccount with a
+// non-empty code hash references a contract code in the store
+func (k *Keeper) AccountCodeInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		k.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+			ethAccount, ok := account.(evmostypes.EthAccountI)
+			if !ok {
+				return false
+			}
+
+			codeHash := ethAccount.GetCodeHash()
+			if bytes.Equal(codeHash.Bytes(), types.EmptyCodeHash) {
+				return false
+			}
+
+			if len(k.GetCode(ctx, codeHash)) == 0 {
+				broken++
+				msg += fmt.Sprintf(
+					"\taccount %s references missing code %s\n", ethAccount.EthAddress(), codeHash,
+				)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "account code",
+			fmt.Sprintf("found %d accounts with missing code\n%s", broken, msg),
+		), broken != 0
+	}
+}
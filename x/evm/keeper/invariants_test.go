@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+func (suite *KeeperTestSuite) TestAccountCodeInvariant() {
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"no contracts",
+			func() {},
+			false,
+		},
+		{
+			"deployed contract",
+			func() {
+				suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+			},
+			false,
+		},
+		{
+			"invariant broken - code hash without code",
+			func() {
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.address.Bytes())
+				ethAcc, ok := acc.(evmostypes.EthAccountI)
+				suite.Require().True(ok)
+				err := ethAcc.SetCodeHash(common.BytesToHash(crypto.Keccak256([]byte("missing"))))
+				suite.Require().NoError(err)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, ethAcc)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			_, broken := suite.app.EvmKeeper.AccountCodeInvariant()(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
@@ -217,6 +217,43 @@ func (k Keeper) SetLogSizeTransient(ctx sdk.Context, logSize uint64) {
 	store.Set(types.KeyPrefixTransientLogSize, sdk.Uint64ToBigEndian(logSize))
 }
 
+// ----------------------------------------------------------------------------
+// Contract gas
+// ----------------------------------------------------------------------------
+
+// GetTxContractGasTransient returns the gas consumed by the contracts executed
+// in the call tree of the transaction with the given index on the current block.
+func (k Keeper) GetTxContractGasTransient(ctx sdk.Context, txIndex uint64) []types.ContractGas {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientContractGas)
+	bz := store.Get(sdk.Uint64ToBigEndian(txIndex))
+
+	entryLen := common.AddressLength + 8
+	contractGas := make([]types.ContractGas, 0, len(bz)/entryLen)
+	for i := 0; i+entryLen <= len(bz); i += entryLen {
+		contractGas = append(contractGas, types.ContractGas{
+			Contract: common.BytesToAddress(bz[i : i+common.AddressLength]),
+			Gas:      sdk.BigEndianToUint64(bz[i+common.AddressLength : i+entryLen]),
+		})
+	}
+
+	return contractGas
+}
+
+// SetTxContractGasTransient sets the gas consumed by the contracts executed in
+// the call tree of the transaction with the given index to the transient store.
+// This value is reset on every block.
+func (k Keeper) SetTxContractGasTransient(ctx sdk.Context, txIndex uint64, contractGas []types.ContractGas) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientContractGas)
+
+	bz := make([]byte, 0, len(contractGas)*(common.AddressLength+8))
+	for _, cg := range contractGas {
+		bz = append(bz, cg.Contract.Bytes()...)
+		bz = append(bz, sdk.Uint64ToBigEndian(cg.Gas)...)
+	}
+
+	store.Set(sdk.Uint64ToBigEndian(txIndex), bz)
+}
+
 // ----------------------------------------------------------------------------
 // Storage
 // ----------------------------------------------------------------------------
@@ -263,6 +300,13 @@ func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *et
 	return k.hooks.PostTxProcessing(ctx, msg, receipt)
 }
 
+// requiresContractGas returns true if the hooks read the gas consumed by the
+// contracts of the call tree.
+func (k Keeper) requiresContractGas(ctx sdk.Context) bool {
+	h, ok := k.hooks.(types.ContractGasHooks)
+	return ok && h.RequiresContractGas(ctx)
+}
+
 // Tracer return a default vm.Tracer based on current keeper state
 func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, ethCfg *params.ChainConfig) vm.EVMLogger {
 	return types.NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight())
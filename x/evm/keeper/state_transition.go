@@ -174,12 +174,26 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction, fro
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
+	// record the gas consumed by the contracts of the call tree for the hooks
+	var (
+		tracer            vm.EVMLogger
+		contractGasTracer *types.ContractGasTracer
+	)
+	if k.requiresContractGas(ctx) {
+		contractGasTracer = types.NewContractGasTracer(k.Tracer(ctx, msg, cfg.ChainConfig))
+		tracer = contractGasTracer
+	}
+
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, tracer, true, cfg, txConfig)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
 
+	if contractGasTracer != nil {
+		k.SetTxContractGasTransient(tmpCtx, uint64(txConfig.TxIndex), contractGasTracer.ContractGas())
+	}
+
 	logs := types.LogsToEthereum(res.Logs)
 
 	// Compute block bloom filter
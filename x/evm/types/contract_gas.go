@@ -0,0 +1,123 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// ContractGas defines the gas consumed by the code of a contract during the
+// execution of a transaction, excluding the gas consumed by the contracts it
+// calls.
+type ContractGas struct {
+	Contract common.Address
+	Gas      uint64
+}
+
+// contractFrame is a call frame of the call tree of a transaction
+type contractFrame struct {
+	contract    common.Address
+	childrenGas uint64
+}
+
+var _ vm.EVMLogger = &ContractGasTracer{}
+
+// ContractGasTracer is a lightweight vm.EVMLogger that records the gas
+// consumed by the code of every contract executed in the call tree of a
+// transaction. For delegate calls, the gas is attributed to the contract which
+// code is executed. All the events are forwarded to the wrapped tracer.
+type ContractGasTracer struct {
+	vm.EVMLogger
+
+	frames []contractFrame
+	gas    map[common.Address]uint64
+	order  []common.Address
+}
+
+// NewContractGasTracer creates a new ContractGasTracer wrapping the given tracer
+func NewContractGasTracer(tracer vm.EVMLogger) *ContractGasTracer {
+	return &ContractGasTracer{
+		EVMLogger: tracer,
+		gas:       make(map[common.Address]uint64),
+	}
+}
+
+// CaptureStart implements vm.EVMLogger interface
+func (t *ContractGasTracer) CaptureStart(env *vm.EVM, from, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.enter(to)
+	t.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+// CaptureEnd implements vm.EVMLogger interface
+func (t *ContractGasTracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) {
+	t.exit(gasUsed)
+	t.EVMLogger.CaptureEnd(output, gasUsed, tm, err)
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+func (t *ContractGasTracer) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.enter(to)
+	t.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// CaptureExit implements vm.EVMLogger interface
+func (t *ContractGasTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.exit(gasUsed)
+	t.EVMLogger.CaptureExit(output, gasUsed, err)
+}
+
+// ContractGas returns the gas consumed by the code of each contract executed
+// in the call tree, in the order of their first execution
+func (t *ContractGasTracer) ContractGas() []ContractGas {
+	contractGas := make([]ContractGas, len(t.order))
+	for i, contract := range t.order {
+		contractGas[i] = ContractGas{Contract: contract, Gas: t.gas[contract]}
+	}
+	return contractGas
+}
+
+// enter pushes a new call frame for the given contract
+func (t *ContractGasTracer) enter(contract common.Address) {
+	if _, ok := t.gas[contract]; !ok {
+		t.gas[contract] = 0
+		t.order = append(t.order, contract)
+	}
+	t.frames = append(t.frames, contractFrame{contract: contract})
+}
+
+// exit pops the current call frame and attributes the gas consumed by its own
+// code to its contract
+func (t *ContractGasTracer) exit(gasUsed uint64) {
+	if len(t.frames) == 0 {
+		return
+	}
+
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+
+	if gasUsed > frame.childrenGas {
+		t.gas[frame.contract] += gasUsed - frame.childrenGas
+	}
+
+	if len(t.frames) > 0 {
+		t.frames[len(t.frames)-1].childrenGas += gasUsed
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractGasTracer(t *testing.T) {
+	var (
+		sender  = common.HexToAddress("0x01")
+		router  = common.HexToAddress("0x02")
+		library = common.HexToAddress("0x03")
+		token   = common.HexToAddress("0x04")
+	)
+
+	tracer := NewContractGasTracer(NewNoOpTracer())
+
+	// router -> library (delegate call) -> token, router -> token
+	tracer.CaptureStart(nil, sender, router, false, nil, 100000, nil)
+	tracer.CaptureEnter(vm.DELEGATECALL, router, library, nil, 90000, nil)
+	tracer.CaptureEnter(vm.CALL, router, token, nil, 80000, nil)
+	tracer.CaptureExit(nil, 2000, nil)
+	tracer.CaptureExit(nil, 5000, nil)
+	tracer.CaptureEnter(vm.CALL, router, token, nil, 70000, nil)
+	tracer.CaptureExit(nil, 1500, nil)
+	tracer.CaptureEnd(nil, 10000, 0, nil)
+
+	require.Equal(t, []ContractGas{
+		{Contract: router, Gas: 3500},
+		{Contract: library, Gas: 3000},
+		{Contract: token, Gas: 3500},
+	}, tracer.ContractGas())
+}
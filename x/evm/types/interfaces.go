@@ -75,6 +75,13 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// ContractGasHooks is implemented by evm hooks that read the gas consumed by
+// each contract of the call tree. The contract gas tracer is only installed on
+// a transaction when one of the hooks requires it.
+type ContractGasHooks interface {
+	RequiresContractGas(ctx sdk.Context) bool
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -49,6 +49,7 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientContractGas
 )
 
 // KVStore key prefixes
@@ -60,10 +61,11 @@ var (
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom       = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex     = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize     = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed     = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientContractGas = []byte{prefixTransientContractGas}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
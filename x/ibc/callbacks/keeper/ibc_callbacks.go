@@ -0,0 +1,309 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v12/contracts"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	"github.com/evmos/evmos/v12/x/ibc/callbacks/types"
+)
+
+// SendPacket implements the ICS4Wrapper interface from the transfer module.
+// It sends the packet down the middleware stack and, if the ICS20 packet memo
+// defines a source callback, records the callback contract for the packet
+// sequence. Packets with an invalid source callback are rejected. A contract
+// other than the packet sender can only be registered if it authorizes the
+// sender through its isCallbackAuthorized function, so that no account can
+// trigger the callbacks of a contract without its consent (ADR-8).
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	var (
+		callback types.PacketCallback
+		found    bool
+	)
+
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err == nil {
+		callback, found, err = types.ParseSourceCallback(packetData.Memo)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if found {
+		sender, err := sdk.AccAddressFromBech32(packetData.Sender)
+		if err != nil {
+			return 0, errorsmod.Wrapf(types.ErrInvalidCallback, "invalid packet sender %s: %s", packetData.Sender, err)
+		}
+
+		account := k.evmKeeper.GetAccountWithoutBalance(ctx, callback.ContractAddress())
+		if account == nil || !account.IsContract() {
+			return 0, errorsmod.Wrapf(types.ErrInvalidCallback, "address %s is not a contract", callback.Contract)
+		}
+
+		senderAddr := common.BytesToAddress(sender)
+		if senderAddr != callback.ContractAddress() && !k.IsCallbackAuthorized(ctx, callback, senderAddr) {
+			return 0, errorsmod.Wrapf(
+				types.ErrInvalidCallback, "callback contract %s doesn't authorize the packet sender %s", callback.Contract, packetData.Sender,
+			)
+		}
+	}
+
+	sequence, err = k.ics4Wrapper.SendPacket(
+		ctx,
+		channelCap,
+		sourcePort,
+		sourceChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+		data,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return sequence, nil
+	}
+
+	k.SetPacketCallback(ctx, sourcePort, sourceChannel, sequence, callback)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCallback,
+			sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, sourcePort),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, sourceChannel),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyGasLimit, strconv.FormatUint(callback.GasLimit, 10)),
+		),
+	)
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface from the transfer module.
+// It calls the underlying WriteAcknowledgement function directly to move down the middleware stack.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, packet, ack)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnAcknowledgementPacket executes the source callback recorded for the
+// acknowledged packet, if any. The callback is removed afterwards.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) {
+	callback, found := k.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	k.DeletePacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	input, err := contracts.IBCCallbacksContract.ABI.Pack(
+		"onPacketAcknowledgement",
+		packet.SourceChannel, packet.SourcePort, packet.Sequence, packet.Data, acknowledgement,
+	)
+	if err != nil {
+		// should not happen as the arguments match the ABI
+		k.Logger(ctx).Error("failed to pack acknowledgement callback", "error", err.Error())
+		return
+	}
+
+	k.executeCallback(ctx, packet, types.AttributeValueAcknowledgement, callback, input)
+}
+
+// OnTimeoutPacket executes the source callback recorded for the timed out
+// packet, if any. The callback is removed afterwards.
+func (k Keeper) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) {
+	callback, found := k.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	k.DeletePacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	input, err := contracts.IBCCallbacksContract.ABI.Pack(
+		"onPacketTimeout",
+		packet.SourceChannel, packet.SourcePort, packet.Sequence, packet.Data,
+	)
+	if err != nil {
+		// should not happen as the arguments match the ABI
+		k.Logger(ctx).Error("failed to pack timeout callback", "error", err.Error())
+		return
+	}
+
+	k.executeCallback(ctx, packet, types.AttributeValueTimeout, callback, input)
+}
+
+// executeCallback calls the callback contract from the module account with
+// the callback gas limit. The state changes of the callback are only
+// committed if the execution succeeds. A failed callback never fails the
+// packet lifecycle, as the refund logic of the underlying application must
+// be preserved, so the outcome is only emitted as an event.
+func (k Keeper) executeCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	callbackType string,
+	callback types.PacketCallback,
+	input []byte,
+) {
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	res, err := k.CallContract(cacheCtx, callback, input)
+	if err == nil {
+		writeFn()
+	}
+
+	var gasUsed uint64
+	if res != nil {
+		gasUsed = res.GasUsed
+		// the relayer pays for the gas consumed by the callback
+		ctx.GasMeter().ConsumeGas(gasUsed, "ibc packet callback")
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyCallbackType, callbackType),
+		sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.SourcePort),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.SourceChannel),
+		sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		k.Logger(ctx).Debug(
+			"packet callback failed",
+			"contract", callback.Contract,
+			"type", callbackType,
+			"error", err.Error(),
+		)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCallback, attrs...))
+}
+
+// IsCallbackAuthorized returns true if the callback contract accepts the
+// callbacks of the packets sent by the given sender. The contract is queried
+// from the callbacks module account without committing any state change, and
+// the gas consumed is charged to the packet sender. A failed or reverted call
+// doesn't authorize the sender.
+func (k Keeper) IsCallbackAuthorized(
+	ctx sdk.Context,
+	callback types.PacketCallback,
+	sender common.Address,
+) bool {
+	input, err := contracts.IBCCallbacksContract.ABI.Pack("isCallbackAuthorized", sender)
+	if err != nil {
+		// should not happen as the arguments match the ABI
+		k.Logger(ctx).Error("failed to pack callback authorization", "error", err.Error())
+		return false
+	}
+
+	msg := newCallbackMessage(callback.ContractAddress(), types.AuthorizationGasLimit, input)
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), false)
+	if err != nil {
+		return false
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "ibc packet callback authorization")
+	if res.Failed() {
+		return false
+	}
+
+	unpacked, err := contracts.IBCCallbacksContract.ABI.Unpack("isCallbackAuthorized", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return false
+	}
+
+	authorized, ok := unpacked[0].(bool)
+	return ok && authorized
+}
+
+// CallContract executes the callback contract with the given input from the
+// callbacks module account. It returns the EVM response together with an
+// ErrCallbackReverted error if the execution failed.
+func (k Keeper) CallContract(
+	ctx sdk.Context,
+	callback types.PacketCallback,
+	input []byte,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	msg := newCallbackMessage(callback.ContractAddress(), callback.GasLimit, input)
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return res, errorsmod.Wrapf(types.ErrCallbackReverted, "contract %s: %s", callback.Contract, res.VmError)
+	}
+
+	return res, nil
+}
+
+// newCallbackMessage returns the EVM message that calls the given contract
+// from the callbacks module account
+func newCallbackMessage(contract common.Address, gasLimit uint64, input []byte) ethtypes.Message {
+	sender := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+
+	return ethtypes.NewMessage(
+		sender,
+		&contract,
+		0,             // nonce is not checked on contract calls
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		input,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+}
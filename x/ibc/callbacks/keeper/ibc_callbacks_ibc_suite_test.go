@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/contracts"
+	ibctesting "github.com/evmos/evmos/v12/ibc/testing"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/utils"
+	"github.com/evmos/evmos/v12/x/evm/statedb"
+	"github.com/evmos/evmos/v12/x/ibc/callbacks/types"
+	inflationtypes "github.com/evmos/evmos/v12/x/inflation/types"
+)
+
+type IBCTestingSuite struct {
+	suite.Suite
+	coordinator *ibcgotesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA      *ibcgotesting.TestChain // Evmos chain A
+	chainCosmos *ibcgotesting.TestChain // Cosmos chain
+
+	path *ibctesting.Path // chainA (Evmos) <--> chainCosmos
+}
+
+func (suite *IBCTestingSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1, 1) // initializes 1 Evmos test chain and 1 Cosmos Chain
+	suite.chainA = suite.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	suite.chainCosmos = suite.coordinator.GetChain(ibcgotesting.GetChainID(2))
+
+	suite.coordinator.CommitNBlocks(suite.chainA, 2)
+	suite.coordinator.CommitNBlocks(suite.chainCosmos, 2)
+
+	evmosChainA := suite.chainA.App.(*app.Evmos)
+
+	// Mint coins to pay tx fees and the transfers
+	amt, ok := sdk.NewIntFromString("1000000000000000000000")
+	suite.Require().True(ok)
+	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, amt))
+
+	err := evmosChainA.BankKeeper.MintCoins(suite.chainA.GetContext(), inflationtypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = evmosChainA.BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), inflationtypes.ModuleName, suite.chainA.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	evmParams := evmosChainA.EvmKeeper.GetParams(suite.chainA.GetContext())
+	evmParams.EvmDenom = utils.BaseDenom
+	err = evmosChainA.EvmKeeper.SetParams(suite.chainA.GetContext(), evmParams)
+	suite.Require().NoError(err)
+
+	// Set block proposer once, so its carried over on the ibc-go-testing suite
+	validators := evmosChainA.StakingKeeper.GetValidators(suite.chainA.GetContext(), 2)
+	cons, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.chainA.CurrentHeader.ProposerAddress = cons.Bytes()
+
+	err = evmosChainA.StakingKeeper.SetValidatorByConsAddr(suite.chainA.GetContext(), validators[0])
+	suite.Require().NoError(err)
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainCosmos) // clientID, connectionID, channelID empty
+	ibctesting.SetupPath(suite.coordinator, suite.path)                      // clientID, connectionID, channelID filled
+	suite.Require().Equal("channel-0", suite.path.EndpointA.ChannelID)
+}
+
+// setContract deploys the given runtime code at a random address of chain A
+func (suite *IBCTestingSuite) setContract(code []byte) common.Address {
+	evmosChainA := suite.chainA.App.(*app.Evmos)
+	ctx := suite.chainA.GetContext()
+
+	addr := utiltx.GenerateAddress()
+	codeHash := crypto.Keccak256(code)
+	evmosChainA.EvmKeeper.SetCode(ctx, codeHash, code)
+	err := evmosChainA.EvmKeeper.SetAccount(ctx, addr, statedb.Account{
+		Nonce:    1,
+		Balance:  big.NewInt(0),
+		CodeHash: codeHash,
+	})
+	suite.Require().NoError(err)
+	return addr
+}
+
+// transfer sends a MsgTransfer from the chain A sender that registers the
+// given contract as source callback and returns the sent packet
+func (suite *IBCTestingSuite) transfer(contract common.Address, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	memo := `{"src_callback":{"address":"` + contract.Hex() + `","gas_limit":"100000"}}`
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(utils.BaseDenom, sdk.NewInt(10)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainCosmos.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0, memo,
+	)
+
+	res, err := ibctesting.SendMsgs(suite.chainA, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.App.(*app.Evmos).CallbacksKeeper.GetPacketCallback(
+		suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence,
+	)
+	suite.Require().True(found)
+	return packet
+}
+
+func (suite *IBCTestingSuite) TestTransferCallbacks() {
+	testCases := []struct {
+		name    string
+		timeout bool
+	}{
+		{"acknowledgement callback executed", false},
+		{"timeout callback executed", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// the contract authorizes the chain A sender to register it
+			contract := suite.setContract(callbackCode(true))
+
+			var (
+				packet channeltypes.Packet
+				input  []byte
+				err    error
+			)
+			if tc.timeout {
+				// time out the packet at the next height of the counterparty
+				timeoutHeight := suite.path.EndpointA.GetClientState().GetLatestHeight().Increment().(clienttypes.Height)
+				packet = suite.transfer(contract, timeoutHeight)
+
+				suite.coordinator.CommitNBlocks(suite.chainCosmos, 2)
+				err = suite.path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+				err = suite.path.EndpointA.TimeoutPacket(packet)
+				suite.Require().NoError(err)
+
+				input, err = contracts.IBCCallbacksContract.ABI.Pack(
+					"onPacketTimeout", packet.SourceChannel, packet.SourcePort, packet.Sequence, packet.Data,
+				)
+				suite.Require().NoError(err)
+			} else {
+				packet = suite.transfer(contract, clienttypes.NewHeight(1000, 1000))
+
+				// receive the packet on the counterparty and relay the acknowledgement
+				err = suite.path.RelayPacket(packet)
+				suite.Require().NoError(err)
+
+				ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+				input, err = contracts.IBCCallbacksContract.ABI.Pack(
+					"onPacketAcknowledgement", packet.SourceChannel, packet.SourcePort, packet.Sequence, packet.Data, ack,
+				)
+				suite.Require().NoError(err)
+			}
+
+			evmosChainA := suite.chainA.App.(*app.Evmos)
+			ctx := suite.chainA.GetContext()
+
+			_, found := evmosChainA.CallbacksKeeper.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+
+			// the contract stored the calldata size of the executed callback
+			state := evmosChainA.EvmKeeper.GetState(ctx, contract, common.Hash{})
+			suite.Require().Equal(uint64(len(input)), state.Big().Uint64())
+		})
+	}
+}
+
+func (suite *IBCTestingSuite) TestTransferUnauthorizedCallback() {
+	contract := suite.setContract(callbackCode(false))
+
+	memo := `{"src_callback":{"address":"` + contract.Hex() + `"}}`
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(utils.BaseDenom, sdk.NewInt(10)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainCosmos.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1000, 1000), 0, memo,
+	)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, ibctesting.DefaultFeeAmt))
+	_, _, err := ibctesting.SignAndDeliver(
+		suite.T(),
+		suite.chainA.TxConfig,
+		suite.chainA.App.GetBaseApp(),
+		[]sdk.Msg{msg},
+		fee,
+		suite.chainA.ChainID,
+		[]uint64{suite.chainA.SenderAccount.GetAccountNumber()},
+		[]uint64{suite.chainA.SenderAccount.GetSequence()},
+		false, suite.chainA.SenderPrivKey,
+	)
+	suite.Require().ErrorContains(err, types.ErrInvalidCallback.Error())
+}
@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v12/contracts"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/evm/statedb"
+	"github.com/evmos/evmos/v12/x/ibc/callbacks/types"
+)
+
+var timeoutHeight = clienttypes.NewHeight(1000, 1000)
+
+// setContract deploys the given runtime code at a random address
+func (suite *KeeperTestSuite) setContract(code []byte) common.Address {
+	addr := utiltx.GenerateAddress()
+	codeHash := crypto.Keccak256(code)
+	suite.app.EvmKeeper.SetCode(suite.ctx, codeHash, code)
+	err := suite.app.EvmKeeper.SetAccount(suite.ctx, addr, statedb.Account{
+		Nonce:    1,
+		Balance:  big.NewInt(0),
+		CodeHash: codeHash,
+	})
+	suite.Require().NoError(err)
+	return addr
+}
+
+// callbackCode returns the runtime code of a callback contract that returns
+// the given value from isCallbackAuthorized and stores the calldata size at
+// slot 0 on any other call
+func callbackCode(authorized bool) []byte {
+	selector := contracts.IBCCallbacksContract.ABI.Methods["isCallbackAuthorized"].ID
+
+	var result byte
+	if authorized {
+		result = 1
+	}
+
+	return []byte{
+		byte(vm.PUSH1), 0, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 0xe0, byte(vm.SHR),
+		byte(vm.PUSH4), selector[0], selector[1], selector[2], selector[3], byte(vm.EQ),
+		byte(vm.PUSH1), 20, byte(vm.JUMPI),
+		// store the calldata size at slot 0
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP),
+		// return the authorization
+		byte(vm.JUMPDEST), byte(vm.PUSH1), result, byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}
+}
+
+// callbackEvents returns the packet callback events emitted on the context
+func (suite *KeeperTestSuite) callbackEvents() []sdk.Event {
+	var events []sdk.Event
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCallback {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (suite *KeeperTestSuite) TestPacketCallbacks() {
+	// storeCode stores the calldata size at slot 0 on any call
+	storeCode := []byte{byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP)}
+	// revertCode reverts on any call
+	revertCode := []byte{byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT)}
+
+	data := transfertypes.NewFungibleTokenPacketData("aevmos", "100", "sender", "receiver", "")
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", timeoutHeight, 0,
+	)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	testCases := []struct {
+		name       string
+		timeout    bool
+		malleate   func() (common.Address, bool)
+		expEvent   bool
+		expSuccess bool
+	}{
+		{
+			"no callback recorded",
+			false,
+			func() (common.Address, bool) {
+				return common.Address{}, false
+			},
+			false,
+			false,
+		},
+		{
+			"acknowledgement callback executed",
+			false,
+			func() (common.Address, bool) {
+				return suite.setContract(storeCode), true
+			},
+			true,
+			true,
+		},
+		{
+			"timeout callback executed",
+			true,
+			func() (common.Address, bool) {
+				return suite.setContract(storeCode), true
+			},
+			true,
+			true,
+		},
+		{
+			"acknowledgement callback reverts",
+			false,
+			func() (common.Address, bool) {
+				return suite.setContract(revertCode), true
+			},
+			true,
+			false,
+		},
+		{
+			"timeout callback reverts",
+			true,
+			func() (common.Address, bool) {
+				return suite.setContract(revertCode), true
+			},
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			contract, register := tc.malleate()
+			if register {
+				suite.app.CallbacksKeeper.SetPacketCallback(
+					suite.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence,
+					types.NewPacketCallback(contract, 100_000),
+				)
+			}
+
+			gasBefore := suite.ctx.GasMeter().GasConsumed()
+			if tc.timeout {
+				suite.app.CallbacksKeeper.OnTimeoutPacket(suite.ctx, packet)
+			} else {
+				suite.app.CallbacksKeeper.OnAcknowledgementPacket(suite.ctx, packet, ack)
+			}
+
+			_, found := suite.app.CallbacksKeeper.GetPacketCallback(
+				suite.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence,
+			)
+			suite.Require().False(found)
+
+			events := suite.callbackEvents()
+			if !tc.expEvent {
+				suite.Require().Empty(events)
+				return
+			}
+
+			suite.Require().Len(events, 1)
+			suite.Require().Greater(suite.ctx.GasMeter().GasConsumed(), gasBefore)
+
+			var success string
+			for _, attr := range events[0].Attributes {
+				if string(attr.Key) == types.AttributeKeySuccess {
+					success = string(attr.Value)
+				}
+			}
+
+			state := suite.app.EvmKeeper.GetState(suite.ctx, contract, common.Hash{})
+			if tc.expSuccess {
+				suite.Require().Equal("true", success)
+				suite.Require().NotEqual(common.Hash{}, state)
+			} else {
+				suite.Require().Equal("false", success)
+				suite.Require().Equal(common.Hash{}, state)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendPacketInvalidCallback() {
+	eoa := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		sender   string
+		contract func() common.Address
+	}{
+		{
+			"invalid packet sender",
+			"sender",
+			func() common.Address { return eoa },
+		},
+		{
+			"callback address is not a contract",
+			sdk.AccAddress(eoa.Bytes()).String(),
+			func() common.Address { return eoa },
+		},
+		{
+			"EOA sender names a contract without authorization",
+			sdk.AccAddress(eoa.Bytes()).String(),
+			func() common.Address { return suite.setContract([]byte{byte(vm.STOP)}) },
+		},
+		{
+			"EOA sender names a contract that doesn't authorize it",
+			sdk.AccAddress(eoa.Bytes()).String(),
+			func() common.Address { return suite.setContract(callbackCode(false)) },
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			memo := `{"src_callback":{"address":"` + tc.contract().Hex() + `"}}`
+			data := transfertypes.NewFungibleTokenPacketData("aevmos", "100", tc.sender, "receiver", memo)
+
+			_, err := suite.app.CallbacksKeeper.SendPacket(
+				suite.ctx, nil, transfertypes.PortID, "channel-0", timeoutHeight, 0, data.GetBytes(),
+			)
+			suite.Require().Error(err)
+			suite.Require().True(errorsmod.IsOf(err, types.ErrInvalidCallback))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIsCallbackAuthorized() {
+	sender := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name          string
+		code          []byte
+		expAuthorized bool
+	}{
+		{"contract authorizes the sender", callbackCode(true), true},
+		{"contract doesn't authorize the sender", callbackCode(false), false},
+		{"contract doesn't implement the authorization", []byte{byte(vm.STOP)}, false},
+		{"contract reverts", []byte{byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT)}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			contract := suite.setContract(tc.code)
+			callback := types.NewPacketCallback(contract, types.MaxGasLimit)
+
+			gasBefore := suite.ctx.GasMeter().GasConsumed()
+			authorized := suite.app.CallbacksKeeper.IsCallbackAuthorized(suite.ctx, callback, sender)
+			suite.Require().Equal(tc.expAuthorized, authorized)
+			suite.Require().Greater(suite.ctx.GasMeter().GasConsumed(), gasBefore)
+
+			// the authorization call doesn't change the contract state
+			state := suite.app.EvmKeeper.GetState(suite.ctx, contract, common.Hash{})
+			suite.Require().Equal(common.Hash{}, state)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v12/testutil"
+	feemarkettypes "github.com/evmos/evmos/v12/x/feemarket/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.Evmos
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func TestIBCTestingSuite(t *testing.T) {
+	suite.Run(t, new(IBCTestingSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	// consensus key
+	privCons, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	consAddress := sdk.ConsAddress(privCons.PubKey().Address())
+
+	suite.app = app.Setup(false, feemarkettypes.DefaultGenesisState())
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), "evmos_9001-1", consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	// set the block proposer as validator to retrieve the EVM coinbase
+	valAddr := sdk.ValAddress(consAddress.Bytes())
+	validator, err := stakingtypes.NewValidator(valAddr, privCons.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper, suite.ctx, validator, true)
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+// sourceCallbackMemo defines the ADR-8 source callback memo object
+type sourceCallbackMemo struct {
+	// Address is the hex address of the callback contract
+	Address string `json:"address"`
+	// GasLimit is the optional decimal gas limit of the callback execution
+	GasLimit string `json:"gas_limit"`
+}
+
+// NewPacketCallback returns a new PacketCallback instance
+func NewPacketCallback(contract common.Address, gasLimit uint64) PacketCallback {
+	return PacketCallback{
+		Contract: contract.Hex(),
+		GasLimit: gasLimit,
+	}
+}
+
+// ParseSourceCallback returns the source callback defined in the packet memo.
+// The boolean return value is false if the memo doesn't contain a source
+// callback, in which case the packet is not handled by the middleware. The
+// gas limit defaults to MaxGasLimit and is capped to it.
+func ParseSourceCallback(memo string) (PacketCallback, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return PacketCallback{}, false, nil
+	}
+
+	raw, found := fields[SourceCallbackKey]
+	if !found {
+		return PacketCallback{}, false, nil
+	}
+
+	var src sourceCallbackMemo
+	if err := json.Unmarshal(raw, &src); err != nil {
+		return PacketCallback{}, true, errorsmod.Wrap(ErrInvalidCallback, err.Error())
+	}
+
+	gasLimit := MaxGasLimit
+	if src.GasLimit != "" {
+		limit, err := strconv.ParseUint(src.GasLimit, 10, 64)
+		if err != nil {
+			return PacketCallback{}, true, errorsmod.Wrapf(ErrInvalidCallback, "invalid gas limit: %s", err)
+		}
+		if limit < gasLimit {
+			gasLimit = limit
+		}
+	}
+
+	callback := PacketCallback{
+		Contract: src.Address,
+		GasLimit: gasLimit,
+	}
+
+	if err := callback.Validate(); err != nil {
+		return PacketCallback{}, true, errorsmod.Wrap(ErrInvalidCallback, err.Error())
+	}
+
+	return callback, true, nil
+}
+
+// Validate performs a stateless validation of the packet callback
+func (pc PacketCallback) Validate() error {
+	if err := evmostypes.ValidateNonZeroAddress(pc.Contract); err != nil {
+		return fmt.Errorf("invalid contract: %w", err)
+	}
+	if pc.GasLimit == 0 || pc.GasLimit > MaxGasLimit {
+		return fmt.Errorf("gas limit must be between 1 and %d, got %d", MaxGasLimit, pc.GasLimit)
+	}
+	return nil
+}
+
+// ContractAddress returns the callback contract as a hex address
+func (pc PacketCallback) ContractAddress() common.Address {
+	return common.HexToAddress(pc.Contract)
+}
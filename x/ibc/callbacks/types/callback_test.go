@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSourceCallback(t *testing.T) {
+	invalidContract := "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ"
+	validContract := "0x5dCA2483280D9727c80b5518faC4556617fb194F"
+
+	testCases := []struct {
+		name        string
+		memo        string
+		expFound    bool
+		expPass     bool
+		expCallback PacketCallback
+	}{
+		{"empty memo", "", false, true, PacketCallback{}},
+		{"non JSON memo", "hello", false, true, PacketCallback{}},
+		{"no source callback", `{"evm":{}}`, false, true, PacketCallback{}},
+		{"invalid object", `{"src_callback":"0x"}`, true, false, PacketCallback{}},
+		{"invalid address", `{"src_callback":{"address":"` + invalidContract + `"}}`, true, false, PacketCallback{}},
+		{"zero address", `{"src_callback":{"address":"0x0000000000000000000000000000000000000000"}}`, true, false, PacketCallback{}},
+		{"invalid gas limit", `{"src_callback":{"address":"` + validContract + `","gas_limit":"abc"}}`, true, false, PacketCallback{}},
+		{"zero gas limit", `{"src_callback":{"address":"` + validContract + `","gas_limit":"0"}}`, true, false, PacketCallback{}},
+		{
+			"default gas limit",
+			`{"src_callback":{"address":"` + validContract + `"}}`,
+			true, true,
+			PacketCallback{Contract: validContract, GasLimit: MaxGasLimit},
+		},
+		{
+			"custom gas limit",
+			`{"src_callback":{"address":"` + validContract + `","gas_limit":"200000"}}`,
+			true, true,
+			PacketCallback{Contract: validContract, GasLimit: 200_000},
+		},
+		{
+			"gas limit capped",
+			`{"src_callback":{"address":"` + validContract + `","gas_limit":"100000000"}}`,
+			true, true,
+			PacketCallback{Contract: validContract, GasLimit: MaxGasLimit},
+		},
+	}
+
+	for _, tc := range testCases {
+		callback, found, err := ParseSourceCallback(tc.memo)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expCallback, callback, tc.name)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the name of the IBC callbacks middleware
+	ModuleName = "callbacks"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// SourceCallbackKey is the key of the JSON memo object that registers a
+	// source callback contract for an outgoing packet, as defined in ADR-8
+	SourceCallbackKey = "src_callback"
+
+	// MaxGasLimit is the maximum gas limit of a packet callback execution. It
+	// is also used when the memo doesn't specify a gas limit.
+	MaxGasLimit uint64 = 1_000_000
+
+	// AuthorizationGasLimit is the gas limit of the static call that checks
+	// whether a contract accepts the callbacks of the packets of a sender
+	AuthorizationGasLimit uint64 = 100_000
+)
+
+// prefix bytes for the callbacks persistent store
+const (
+	prefixPacketCallback = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixPacketCallback = []byte{prefixPacketCallback}
+)
+
+// PacketCallbackKey returns the store key of the callback recorded for the
+// packet with the given source port, source channel and sequence.
+func PacketCallbackKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/", portID, channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package evmhooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/evmos/evmos/v12/ibc"
+	"github.com/evmos/evmos/v12/x/ibc/evmhooks/keeper"
+	"github.com/evmos/evmos/v12/x/ibc/evmhooks/types"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the EVM hooks middleware
+// given the evmhooks keeper and the underlying application.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If the memo of the ICS20 packet requests an EVM contract call, the packet
+// receiver is replaced by the address derived from the destination channel and
+// the sender, the tokens are received through the underlying application and
+// the contract is then called from the derived address. The funds left unspent
+// by the call are sent to the original packet receiver. Packets without an EVM
+// memo are passed to the underlying application unmodified.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// let the ICS20 application return the error acknowledgement
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	memo, found, err := types.ParseEVMMemo(data.Memo)
+	if !found {
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the derived sender receives the tokens so that only the memo call can
+	// spend them
+	sender := types.DeriveSender(packet.DestinationChannel, data.Sender)
+	recvData := data
+	recvData.Receiver = sdk.AccAddress(sender.Bytes()).String()
+	packet.Data = recvData.GetBytes()
+
+	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
+
+	// return if the acknowledgement is an error ACK
+	if !ack.Success() {
+		return ack
+	}
+
+	return im.keeper.OnRecvPacket(ctx, packet, data, memo, ack)
+}
@@ -0,0 +1,240 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v12/contracts"
+	"github.com/evmos/evmos/v12/ibc"
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	"github.com/evmos/evmos/v12/x/ibc/evmhooks/types"
+)
+
+// OnRecvPacket performs the EVM contract call requested in the memo of a
+// received ICS20 packet. The received coins must have been sent to the derived
+// sender of the memo call, while the packet data holds the original receiver.
+//
+// The received coins are made available to the contract as follows:
+//   - the EVM denomination is sent as the value of the call
+//   - coins of a registered token pair are converted to their ERC20
+//     representation and the contract is approved to spend them
+//
+// After the call, the funds left unspent by the contract are sent to the
+// packet receiver and the remaining ERC20 allowance is revoked.
+//
+// Return an error acknowledgement, which reverts the transfer and refunds the
+// sender on the counterparty chain, if:
+//   - The packet receiver is not a valid address
+//   - The received coin has no EVM representation
+//   - The conversion of the received coin fails
+//   - The contract call fails or reverts
+//   - The unspent funds can't be sent to the receiver
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	memo types.EVMMemo,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid packet receiver %s: %s", data.Receiver, err),
+		)
+	}
+
+	sender := types.DeriveSender(packet.DestinationChannel, data.Sender)
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	value, err := k.prepareFunds(ctx, sender, memo.ContractAddress(), coin)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	res, err := k.CallContract(ctx, sender, memo, value)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if err := k.sweepFunds(ctx, sender, common.BytesToAddress(receiver), memo.ContractAddress(), coin); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	k.Logger(ctx).Debug(
+		"executed memo contract call",
+		"contract", memo.Contract,
+		"sender", sender.Hex(),
+		"gas-used", res.GasUsed,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEVMCall,
+			sdk.NewAttribute(types.AttributeKeyContract, memo.Contract),
+			sdk.NewAttribute(types.AttributeKeySender, sender.Hex()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+		),
+	)
+
+	return ack
+}
+
+// CallContract calls the contract of the memo from the derived sender with the
+// given value and the memo gas limit. The gas used by the call is consumed from
+// the context gas meter, so that it is charged to the relayer transaction. It
+// returns an error if the call reverts.
+func (k Keeper) CallContract(
+	ctx sdk.Context,
+	sender common.Address,
+	memo types.EVMMemo,
+	value *big.Int,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	nonce, err := k.accountKeeper.GetSequence(ctx, sender.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	contract := memo.ContractAddress()
+	msg := ethtypes.NewMessage(
+		sender,
+		&contract,
+		nonce,
+		value,         // amount
+		memo.GasLimit, // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		memo.Data(),
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm memo contract call")
+
+	if res.Failed() {
+		return nil, errorsmod.Wrapf(types.ErrContractCallReverted, "contract %s: %s", memo.Contract, res.VmError)
+	}
+
+	return res, nil
+}
+
+// prepareFunds makes the received coin available to the called contract. It
+// returns the value of the call, which is only positive if the coin is the EVM
+// denomination.
+func (k Keeper) prepareFunds(
+	ctx sdk.Context,
+	sender, contract common.Address,
+	coin sdk.Coin,
+) (*big.Int, error) {
+	if coin.Denom == k.evmKeeper.GetParams(ctx).EvmDenom {
+		return coin.Amount.BigInt(), nil
+	}
+
+	pairID := k.erc20Keeper.GetTokenPairID(ctx, coin.Denom)
+	if len(pairID) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotConvertible, "coin denom: %s", coin.Denom)
+	}
+
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, pairID)
+	if !found || !pair.Enabled {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotConvertible, "token pair disabled for coin denom: %s", coin.Denom)
+	}
+
+	// The coins might have already been converted by the ERC20 middleware, in
+	// which case only the remaining balance is converted
+	balance := k.bankKeeper.GetBalance(ctx, sender.Bytes(), coin.Denom)
+	if balance.IsPositive() {
+		convertCoin := sdk.NewCoin(coin.Denom, sdk.MinInt(balance.Amount, coin.Amount))
+		msg := erc20types.NewMsgConvertCoin(convertCoin, sender, sender.Bytes())
+		if _, err := k.erc20Keeper.ConvertCoin(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return nil, err
+		}
+	}
+
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	if _, err := k.erc20Keeper.CallEVM(
+		ctx, erc20ABI, sender, pair.GetERC20Contract(), true,
+		"approve", contract, coin.Amount.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0), nil
+}
+
+// sweepFunds sends the funds left unspent by the contract call from the derived
+// sender to the packet receiver. For coins of a registered token pair, the
+// allowance of the contract is revoked and the remaining ERC20 tokens are
+// transferred to the receiver.
+func (k Keeper) sweepFunds(
+	ctx sdk.Context,
+	sender, receiver, contract common.Address,
+	coin sdk.Coin,
+) error {
+	if coin.Denom != k.evmKeeper.GetParams(ctx).EvmDenom {
+		pair, _ := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, coin.Denom))
+		erc20 := pair.GetERC20Contract()
+		erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+		if _, err := k.erc20Keeper.CallEVM(
+			ctx, erc20ABI, sender, erc20, true,
+			"approve", contract, big.NewInt(0),
+		); err != nil {
+			return errorsmod.Wrap(err, "failed to revoke the contract allowance")
+		}
+
+		balance := k.erc20Keeper.BalanceOf(ctx, erc20ABI, erc20, sender)
+		if balance != nil && balance.Sign() > 0 {
+			if _, err := k.erc20Keeper.CallEVM(
+				ctx, erc20ABI, sender, erc20, true,
+				"transfer", receiver, balance,
+			); err != nil {
+				return errorsmod.Wrap(err, "failed to transfer the unspent tokens")
+			}
+		}
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, sender.Bytes())
+	if balances.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoins(ctx, sender.Bytes(), receiver.Bytes(), balances)
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v12/contracts"
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/ibc/evmhooks/types"
+	inflationtypes "github.com/evmos/evmos/v12/x/inflation/types"
+)
+
+var timeoutHeight = clienttypes.NewHeight(1000, 1000)
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	const (
+		senderAddr = "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueuafmxps"
+		channel    = "channel-0"
+	)
+	amount := sdk.NewInt(1000)
+	recipient := utiltx.GenerateAddress()
+	derived := types.DeriveSender(channel, senderAddr)
+	ibcDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channel, "uatom")).IBCDenom()
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	var receiver string
+
+	newPacket := func(denom string) (channeltypes.Packet, transfertypes.FungibleTokenPacketData) {
+		data := transfertypes.NewFungibleTokenPacketData(
+			denom, amount.String(), senderAddr, receiver, "",
+		)
+		packet := channeltypes.NewPacket(
+			data.GetBytes(), 1, transfertypes.PortID, "channel-1", transfertypes.PortID, channel, timeoutHeight, 0,
+		)
+		return packet, data
+	}
+
+	registerIBCCoin := func() common.Address {
+		metadata := banktypes.Metadata{
+			Description: "ATOM IBC voucher",
+			Base:        ibcDenom,
+			DenomUnits:  []*banktypes.DenomUnit{{Denom: ibcDenom, Exponent: 0}},
+			Name:        "ATOM channel-0",
+			Symbol:      "ibcATOM-0",
+			Display:     ibcDenom,
+		}
+		err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(ibcDenom, 1)})
+		suite.Require().NoError(err)
+		pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata)
+		suite.Require().NoError(err)
+		return pair.GetERC20Contract()
+	}
+
+	receiverAddress := func() common.Address {
+		addr, err := sdk.AccAddressFromBech32(receiver)
+		suite.Require().NoError(err)
+		return common.BytesToAddress(addr)
+	}
+
+	allowance := func(contract, spender common.Address) *big.Int {
+		res, err := suite.app.Erc20Keeper.CallEVM(
+			suite.ctx, erc20ABI, derived, contract, false,
+			"allowance", derived, spender,
+		)
+		suite.Require().NoError(err)
+		return new(big.Int).SetBytes(res.Ret)
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func() (denom string, memo types.EVMMemo)
+		expSuccess bool
+		postCheck  func(memo types.EVMMemo)
+	}{
+		{
+			"fail - invalid packet receiver",
+			func() (string, types.EVMMemo) {
+				receiver = "invalid"
+				evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, derived.Bytes(), sdk.Coins{sdk.NewCoin(evmDenom, amount)})
+				suite.Require().NoError(err)
+				denom := transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-1", evmDenom)
+				return denom, types.EVMMemo{Contract: utiltx.GenerateAddress().Hex(), GasLimit: 100_000}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - denom without EVM representation",
+			func() (string, types.EVMMemo) {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, derived.Bytes(), sdk.Coins{sdk.NewCoin(ibcDenom, amount)})
+				suite.Require().NoError(err)
+				return "uatom", types.EVMMemo{Contract: utiltx.GenerateAddress().Hex(), GasLimit: 100_000}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - contract call reverts",
+			func() (string, types.EVMMemo) {
+				contract := registerIBCCoin()
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, derived.Bytes(), sdk.Coins{sdk.NewCoin(ibcDenom, amount)})
+				suite.Require().NoError(err)
+				return "uatom", types.EVMMemo{Contract: contract.Hex(), Calldata: "0xdeadbeef", GasLimit: 100_000}
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - EVM denomination is sent as value",
+			func() (string, types.EVMMemo) {
+				evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, derived.Bytes(), sdk.Coins{sdk.NewCoin(evmDenom, amount)})
+				suite.Require().NoError(err)
+				denom := transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-1", evmDenom)
+				return denom, types.EVMMemo{Contract: utiltx.GenerateAddress().Hex(), GasLimit: 100_000}
+			},
+			true,
+			func(memo types.EVMMemo) {
+				evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, memo.ContractAddress().Bytes(), evmDenom)
+				suite.Require().Equal(amount, balance.Amount)
+				balance = suite.app.BankKeeper.GetBalance(suite.ctx, derived.Bytes(), evmDenom)
+				suite.Require().True(balance.IsZero())
+			},
+		},
+		{
+			"pass - unspent token pair coins are sent to the receiver",
+			func() (string, types.EVMMemo) {
+				registerIBCCoin()
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, derived.Bytes(), sdk.Coins{sdk.NewCoin(ibcDenom, amount)})
+				suite.Require().NoError(err)
+				return "uatom", types.EVMMemo{Contract: utiltx.GenerateAddress().Hex(), GasLimit: 100_000}
+			},
+			true,
+			func(memo types.EVMMemo) {
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, derived.Bytes(), ibcDenom)
+				suite.Require().True(balance.IsZero())
+
+				pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, ibcDenom))
+				suite.Require().True(found)
+
+				erc20Balance := suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20ABI, pair.GetERC20Contract(), derived)
+				suite.Require().Equal(int64(0), erc20Balance.Int64())
+				erc20Balance = suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20ABI, pair.GetERC20Contract(), receiverAddress())
+				suite.Require().Equal(amount.BigInt(), erc20Balance)
+				suite.Require().Equal(int64(0), allowance(pair.GetERC20Contract(), memo.ContractAddress()).Int64())
+			},
+		},
+		{
+			"pass - contract spends part of the funds",
+			func() (string, types.EVMMemo) {
+				contract := registerIBCCoin()
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, derived.Bytes(), sdk.Coins{sdk.NewCoin(ibcDenom, amount)})
+				suite.Require().NoError(err)
+
+				// the ERC20 contract transfers part of the derived sender tokens
+				calldata, err := erc20ABI.Pack("transfer", recipient, big.NewInt(400))
+				suite.Require().NoError(err)
+				return "uatom", types.EVMMemo{Contract: contract.Hex(), Calldata: hexutil.Encode(calldata), GasLimit: 100_000}
+			},
+			true,
+			func(memo types.EVMMemo) {
+				contract := memo.ContractAddress()
+				suite.Require().Equal(int64(400), suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20ABI, contract, recipient).Int64())
+				suite.Require().Equal(int64(0), suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20ABI, contract, derived).Int64())
+				suite.Require().Equal(int64(600), suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20ABI, contract, receiverAddress()).Int64())
+				suite.Require().Equal(int64(0), allowance(contract, contract).Int64())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			receiver = sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
+			denom, memo := tc.malleate()
+			packet, data := newPacket(denom)
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+			suite.ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+			res := suite.app.EVMHooksKeeper.OnRecvPacket(suite.ctx, packet, data, memo, ack)
+			suite.Require().Equal(tc.expSuccess, res.Success())
+			if tc.expSuccess {
+				// the gas used by the contract call is charged to the context
+				for _, event := range suite.ctx.EventManager().Events() {
+					if event.Type != types.EventTypeEVMCall {
+						continue
+					}
+					for _, attr := range event.Attributes {
+						if string(attr.Key) == types.AttributeKeyGasUsed {
+							gasUsed, err := strconv.ParseUint(string(attr.Value), 10, 64)
+							suite.Require().NoError(err)
+							suite.Require().GreaterOrEqual(suite.ctx.GasMeter().GasConsumed(), gasUsed)
+						}
+					}
+				}
+			}
+			if tc.postCheck != nil {
+				tc.postCheck(memo)
+			}
+		})
+	}
+}
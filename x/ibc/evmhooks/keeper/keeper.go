@@ -0,0 +1,55 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/evmos/v12/x/ibc/evmhooks/types"
+)
+
+// Keeper of the EVM hooks IBC middleware. It holds no state and executes the
+// EVM contract calls requested in the memo of the received ICS20 packets.
+type Keeper struct {
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	erc20Keeper   types.ERC20Keeper
+	evmKeeper     types.EVMKeeper
+}
+
+// NewKeeper creates a new EVM hooks Keeper instance
+func NewKeeper(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	erc20Keeper types.ERC20Keeper,
+	evmKeeper types.EVMKeeper,
+) Keeper {
+	return Keeper{
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		erc20Keeper:   erc20Keeper,
+		evmKeeper:     evmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
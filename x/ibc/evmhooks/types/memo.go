@@ -0,0 +1,103 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+// EVMMemo defines the EVM contract call requested in the memo of an ICS20
+// transfer packet, i.e:
+//
+//	{"evm": {"contract": "0x...", "calldata": "0x...", "gas_limit": 200000}}
+type EVMMemo struct {
+	// Contract is the hex address of the called contract
+	Contract string `json:"contract"`
+	// Calldata is the hex encoded input data of the call
+	Calldata string `json:"calldata"`
+	// GasLimit is the maximum gas consumed by the call
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+// ParseEVMMemo parses the EVM call of a transfer memo. It returns false if the
+// memo is not a JSON object or doesn't contain the EVM memo key, in which case
+// the packet is not meant for the middleware.
+func ParseEVMMemo(memo string) (EVMMemo, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return EVMMemo{}, false, nil
+	}
+
+	raw, found := fields[MemoKey]
+	if !found {
+		return EVMMemo{}, false, nil
+	}
+
+	var evmMemo EVMMemo
+	if err := json.Unmarshal(raw, &evmMemo); err != nil {
+		return EVMMemo{}, true, errorsmod.Wrap(ErrInvalidMemo, err.Error())
+	}
+
+	if err := evmMemo.Validate(); err != nil {
+		return EVMMemo{}, true, errorsmod.Wrap(ErrInvalidMemo, err.Error())
+	}
+
+	return evmMemo, true, nil
+}
+
+// Validate performs a stateless validation of the fields
+func (m EVMMemo) Validate() error {
+	if err := evmostypes.ValidateNonZeroAddress(m.Contract); err != nil {
+		return fmt.Errorf("invalid contract: %w", err)
+	}
+	if m.Calldata != "" {
+		if _, err := hexutil.Decode(m.Calldata); err != nil {
+			return fmt.Errorf("invalid calldata: %w", err)
+		}
+	}
+	if m.GasLimit == 0 || m.GasLimit > MaxGasLimit {
+		return fmt.Errorf("gas limit must be between 1 and %d, got %d", MaxGasLimit, m.GasLimit)
+	}
+	return nil
+}
+
+// ContractAddress returns the address of the called contract
+func (m EVMMemo) ContractAddress() common.Address {
+	return common.HexToAddress(m.Contract)
+}
+
+// Data returns the decoded input data of the call
+func (m EVMMemo) Data() []byte {
+	return common.FromHex(m.Calldata)
+}
+
+// DeriveSender returns the deterministic address that receives the transferred
+// funds and calls the contract on behalf of the sender on the counterparty
+// chain for the given destination channel. The address has no known private
+// key, so that it can only be used through the middleware.
+func DeriveSender(channelID, sender string) common.Address {
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("%s/%s/%s", ModuleName, channelID, sender)))
+	return common.BytesToAddress(hash)
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/ibc/evmhooks/types"
+)
+
+func TestParseEVMMemo(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		memo     string
+		expFound bool
+		expError bool
+	}{
+		{"empty memo", "", false, false},
+		{"plain text memo", "hello", false, false},
+		{"json memo without evm key", `{"forward": {"receiver": "evmos1"}}`, false, false},
+		{"invalid evm object", `{"evm": "0x1234"}`, true, true},
+		{"zero contract", `{"evm": {"contract": "0x0000000000000000000000000000000000000000", "gas_limit": 1}}`, true, true},
+		{"invalid calldata", fmt.Sprintf(`{"evm": {"contract": "%s", "calldata": "0xzz", "gas_limit": 1}}`, contract), true, true},
+		{"zero gas limit", fmt.Sprintf(`{"evm": {"contract": "%s"}}`, contract), true, true},
+		{"gas limit above max", fmt.Sprintf(`{"evm": {"contract": "%s", "gas_limit": %d}}`, contract, types.MaxGasLimit+1), true, true},
+		{"valid memo", fmt.Sprintf(`{"evm": {"contract": "%s", "calldata": "0xa9059cbb", "gas_limit": 100000}}`, contract), true, false},
+	}
+
+	for _, tc := range testCases {
+		memo, found, err := types.ParseEVMMemo(tc.memo)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if tc.expError {
+			require.ErrorIs(t, err, types.ErrInvalidMemo, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		if found {
+			require.Equal(t, contract, memo.ContractAddress(), tc.name)
+			require.Equal(t, []byte{0xa9, 0x05, 0x9c, 0xbb}, memo.Data(), tc.name)
+			require.Equal(t, uint64(100000), memo.GasLimit, tc.name)
+		}
+	}
+}
+
+func TestDeriveSender(t *testing.T) {
+	sender := types.DeriveSender("channel-0", "cosmos1sender")
+	require.Equal(t, sender, types.DeriveSender("channel-0", "cosmos1sender"))
+	require.NotEqual(t, sender, types.DeriveSender("channel-1", "cosmos1sender"))
+	require.NotEqual(t, sender, types.DeriveSender("channel-0", "cosmos1other"))
+}
@@ -0,0 +1,192 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package forward
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/evmos/evmos/v12/ibc"
+	"github.com/evmos/evmos/v12/x/ibc/forward/keeper"
+	"github.com/evmos/evmos/v12/x/ibc/forward/types"
+)
+
+var _ porttypes.Middleware = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the packet forward
+// middleware given the forward keeper, the underlying application and the
+// bare ICS20 transfer application. Packets that are forwarded to a next hop
+// are received, acknowledged and timed out by the transfer application only,
+// so that intermediate hops skip the ERC20 conversion, recovery, claims and
+// EVM hooks of the middlewares below.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper      keeper.Keeper
+	transferApp porttypes.IBCModule
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper, the
+// underlying application and the ICS20 transfer application
+func NewIBCMiddleware(k keeper.Keeper, app, transferApp porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{
+		Module:      ibc.NewModule(app),
+		keeper:      k,
+		transferApp: transferApp,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If the ICS20 packet memo defines a forward, the coins are received by the
+// intermediate address of the original sender and transferred to the next
+// hop. The acknowledgement is then written asynchronously once the forwarded
+// transfer completes. Other packets are passed to the underlying application.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, found, err := types.ParseForwardMetadata(data.Memo)
+	if !found {
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// receive the coins on the intermediate address
+	data.Receiver = types.DeriveIntermediateAddress(packet.DestinationChannel, data.Sender).String()
+	bz, err := transfertypes.ModuleCdc.MarshalJSON(&data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+	}
+
+	intermediatePacket := packet
+	intermediatePacket.Data = bz
+
+	ack := im.transferApp.OnRecvPacket(ctx, intermediatePacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	if err := im.keeper.ForwardPacket(ctx, packet, data, metadata); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the acknowledgement is written once the forwarded packet is acknowledged
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// If the packet was forwarded, the transfer application refunds the
+// intermediate address on error and the acknowledgement of the received
+// packet is written. Other packets are passed to the underlying application.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	if err := im.transferApp.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, inFlightPacket, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// If the packet was forwarded, the transfer application refunds the
+// intermediate address and the transfer is retried or the received packet
+// refunded. Other packets are passed to the underlying application.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return im.Module.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	if err := im.transferApp.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnTimeoutPacket(ctx, packet, inFlightPacket)
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	return im.keeper.SendPacket(
+		ctx,
+		chanCap,
+		sourcePort,
+		sourceChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+		data,
+	)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return im.keeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4 Wrapper interface
+func (im IBCMiddleware) GetAppVersion(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) (string, bool) {
+	return im.keeper.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,263 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/evmos/evmos/v12/ibc"
+	"github.com/evmos/evmos/v12/x/ibc/forward/types"
+)
+
+// SendPacket implements the ICS4Wrapper interface from the transfer module.
+// It calls the underlying SendPacket function directly to move down the middleware stack.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	return k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface from the transfer module.
+// It calls the underlying WriteAcknowledgement function directly to move down the middleware stack.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, packet, ack)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// ForwardPacket transfers the coins of a received packet, which were
+// credited to the intermediate address of the original sender, to the next
+// hop defined in the forward metadata. The received packet is recorded as in
+// flight so that its acknowledgement can be written asynchronously once the
+// forwarded transfer completes.
+func (k Keeper) ForwardPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	metadata types.ForwardMetadata,
+) error {
+	// error is checked when the metadata is parsed
+	memo, _ := metadata.NextMemo()
+
+	inFlightPacket := types.NewInFlightPacket(packet, data.Sender, metadata, memo)
+	return k.forward(ctx, inFlightPacket, metadata.Port, metadata.Channel)
+}
+
+// OnAcknowledgementPacket writes the acknowledgement of the received packet
+// once its forwarded transfer is acknowledged. The acknowledgement of the next
+// hop is passed back on success. On failure, the received packet is refunded.
+// The forwarded transfer must have already been refunded to the intermediate
+// address by the transfer application.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	inFlightPacket types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	k.DeleteInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	if !ack.Success() {
+		return k.RefundPacket(ctx, inFlightPacket, errorsmod.Wrap(types.ErrForwardFailed, ack.GetError()))
+	}
+
+	return k.writeAcknowledgement(ctx, inFlightPacket, ack)
+}
+
+// OnTimeoutPacket retries a timed out forwarded transfer while the received
+// packet has retries remaining, and refunds the received packet otherwise.
+// The forwarded transfer must have already been refunded to the intermediate
+// address by the transfer application.
+func (k Keeper) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	inFlightPacket types.InFlightPacket,
+) error {
+	k.DeleteInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	if inFlightPacket.RetriesRemaining == 0 {
+		return k.RefundPacket(ctx, inFlightPacket, errorsmod.Wrap(types.ErrForwardFailed, "forwarded packet timed out"))
+	}
+
+	inFlightPacket.RetriesRemaining--
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRetryForward,
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyRefundChannel, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(inFlightPacket.RefundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRetriesRemaining, strconv.FormatUint(uint64(inFlightPacket.RetriesRemaining), 10)),
+		),
+	)
+
+	if err := k.forward(ctx, inFlightPacket, packet.SourcePort, packet.SourceChannel); err != nil {
+		return k.RefundPacket(ctx, inFlightPacket, err)
+	}
+
+	return nil
+}
+
+// RefundPacket unwinds the receipt of a packet whose forwarded transfer
+// failed and writes an error acknowledgement for it, so that the original
+// sender is refunded on the counterparty chain.
+func (k Keeper) RefundPacket(
+	ctx sdk.Context,
+	inFlightPacket types.InFlightPacket,
+	reason error,
+) error {
+	if err := k.UnwindReceive(ctx, inFlightPacket); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundForward,
+			sdk.NewAttribute(types.AttributeKeyRefundChannel, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(inFlightPacket.RefundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, reason.Error()),
+		),
+	)
+
+	return k.writeAcknowledgement(ctx, inFlightPacket, channeltypes.NewErrorAcknowledgement(reason))
+}
+
+// UnwindReceive reverts the receipt of a forwarded packet by the transfer
+// application. The received coins are held as bank coins by the intermediate
+// address, as forwarded packets skip the ERC20 conversion. Coins native to
+// this chain are escrowed back in the receiving channel and vouchers are
+// burned, so that the error acknowledgement can release them on the
+// counterparty chain.
+func (k Keeper) UnwindReceive(ctx sdk.Context, inFlightPacket types.InFlightPacket) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.PacketData, &data); err != nil {
+		return errorsmod.Wrapf(types.ErrRefundFailed, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+	}
+
+	coin := receivedCoin(inFlightPacket, data)
+	coins := sdk.NewCoins(coin)
+	intermediate := types.DeriveIntermediateAddress(inFlightPacket.RefundChannelId, inFlightPacket.OriginalSender)
+
+	if transfertypes.ReceiverChainIsSource(inFlightPacket.PacketSrcPortId, inFlightPacket.PacketSrcChannelId, data.Denom) {
+		escrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+		if err := k.bankKeeper.SendCoins(ctx, intermediate, escrowAddress, coins); err != nil {
+			return errorsmod.Wrapf(types.ErrRefundFailed, "failed to escrow coins: %s", err)
+		}
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediate, transfertypes.ModuleName, coins); err != nil {
+		return errorsmod.Wrapf(types.ErrRefundFailed, "failed to send vouchers to module account: %s", err)
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+		return errorsmod.Wrapf(types.ErrRefundFailed, "failed to burn vouchers: %s", err)
+	}
+
+	return nil
+}
+
+// forward transfers the received coins from the intermediate address to the
+// next hop over the given port and channel, and records the in-flight packet
+// under the sequence of the forwarded transfer.
+func (k Keeper) forward(
+	ctx sdk.Context,
+	inFlightPacket types.InFlightPacket,
+	portID, channelID string,
+) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.PacketData, &data); err != nil {
+		return errorsmod.Wrapf(types.ErrForwardFailed, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+	}
+
+	coin := receivedCoin(inFlightPacket, data)
+	intermediate := types.DeriveIntermediateAddress(inFlightPacket.RefundChannelId, inFlightPacket.OriginalSender)
+	timeout := ctx.BlockTime().Add(time.Duration(inFlightPacket.Timeout))
+
+	msg := transfertypes.NewMsgTransfer(
+		portID,
+		channelID,
+		coin,
+		intermediate.String(),
+		inFlightPacket.ForwardReceiver,
+		clienttypes.ZeroHeight(),
+		uint64(timeout.UnixNano()),
+		inFlightPacket.ForwardMemo,
+	)
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	k.SetInFlightPacket(ctx, portID, channelID, res.Sequence, inFlightPacket)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardPacket,
+			sdk.NewAttribute(types.AttributeKeyReceiver, inFlightPacket.ForwardReceiver),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(res.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundChannel, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(inFlightPacket.RefundSequence, 10)),
+		),
+	)
+
+	return nil
+}
+
+// writeAcknowledgement writes the acknowledgement of the received packet of
+// an in-flight packet
+func (k Keeper) writeAcknowledgement(
+	ctx sdk.Context,
+	inFlightPacket types.InFlightPacket,
+	ack exported.Acknowledgement,
+) error {
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, inFlightPacket.ReceivedPacket(), ack)
+}
+
+// receivedCoin returns the coin credited to the intermediate address when
+// the packet of the in-flight packet was received
+func receivedCoin(inFlightPacket types.InFlightPacket, data transfertypes.FungibleTokenPacketData) sdk.Coin {
+	return ibc.GetReceivedCoin(
+		inFlightPacket.PacketSrcPortId, inFlightPacket.PacketSrcChannelId,
+		inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId,
+		data.Denom, data.Amount,
+	)
+}
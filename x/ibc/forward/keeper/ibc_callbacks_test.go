@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v12/testutil"
+	"github.com/evmos/evmos/v12/x/ibc/forward/types"
+)
+
+const (
+	sender   = "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueuhnecd2"
+	receiver = "osmo1qql8ag4cluz6r4dz28p3w00dnc9w8ueuyk4u6e"
+)
+
+func (suite *KeeperTestSuite) inFlightPacket(denom string) types.InFlightPacket {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "1000", sender, "evmos1", "")
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), 1, transfertypes.PortID, "channel-5", transfertypes.PortID, "channel-0",
+		clienttypes.NewHeight(0, 100), 0,
+	)
+	metadata := types.ForwardMetadata{Receiver: receiver, Port: transfertypes.PortID, Channel: "channel-1"}
+	return types.NewInFlightPacket(packet, sender, metadata, "")
+}
+
+func (suite *KeeperTestSuite) TestInFlightPackets() {
+	suite.SetupTest()
+
+	k := suite.app.ForwardKeeper
+	inFlightPacket := suite.inFlightPacket("uatom")
+
+	_, found := k.GetInFlightPacket(suite.ctx, transfertypes.PortID, "channel-1", 1)
+	suite.Require().False(found)
+
+	k.SetInFlightPacket(suite.ctx, transfertypes.PortID, "channel-1", 1, inFlightPacket)
+	stored, found := k.GetInFlightPacket(suite.ctx, transfertypes.PortID, "channel-1", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(inFlightPacket, stored)
+	suite.Require().Equal(types.DefaultRetries, stored.RetriesRemaining)
+	suite.Require().Equal(uint64(1), stored.ReceivedPacket().Sequence)
+	suite.Require().Equal("channel-0", stored.ReceivedPacket().DestinationChannel)
+
+	_, found = k.GetInFlightPacket(suite.ctx, transfertypes.PortID, "channel-1", 2)
+	suite.Require().False(found)
+
+	k.DeleteInFlightPacket(suite.ctx, transfertypes.PortID, "channel-1", 1)
+	_, found = k.GetInFlightPacket(suite.ctx, transfertypes.PortID, "channel-1", 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUnwindReceive() {
+	intermediate := types.DeriveIntermediateAddress("channel-0", sender)
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	testCases := []struct {
+		name      string
+		denom     string
+		malleate  func()
+		expPass   bool
+		expEscrow sdk.Coins
+		expSupply sdk.Coin
+	}{
+		{
+			"voucher burned",
+			"uatom",
+			func() {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, intermediate, sdk.NewCoins(sdk.NewInt64Coin(voucher, 1000)))
+				suite.Require().NoError(err)
+			},
+			true,
+			sdk.Coins{},
+			sdk.NewInt64Coin(voucher, 0),
+		},
+		{
+			"native coin escrowed",
+			"transfer/channel-5/anative",
+			func() {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, intermediate, sdk.NewCoins(sdk.NewInt64Coin("anative", 1000)))
+				suite.Require().NoError(err)
+			},
+			true,
+			sdk.NewCoins(sdk.NewInt64Coin("anative", 1000)),
+			sdk.NewInt64Coin("anative", 1000),
+		},
+		{
+			"insufficient balance",
+			"uatom",
+			func() {},
+			false,
+			sdk.Coins{},
+			sdk.NewInt64Coin(voucher, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := suite.app.ForwardKeeper.UnwindReceive(suite.ctx, suite.inFlightPacket(tc.denom))
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrRefundFailed)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, intermediate).IsZero())
+			suite.Require().Equal(tc.expEscrow, suite.app.BankKeeper.GetAllBalances(suite.ctx, escrow))
+			suite.Require().Equal(tc.expSupply, suite.app.BankKeeper.GetSupply(suite.ctx, tc.expSupply.Denom))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v12/x/ibc/forward/types"
+)
+
+// GetInFlightPacket returns the in-flight packet forwarded with the given
+// source port, source channel and sequence.
+func (k Keeper) GetInFlightPacket(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+) (types.InFlightPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	bz := store.Get(types.InFlightPacketKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.InFlightPacket{}, false
+	}
+
+	var inFlightPacket types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlightPacket)
+	return inFlightPacket, true
+}
+
+// SetInFlightPacket stores the in-flight packet forwarded with the given
+// source port, source channel and sequence.
+func (k Keeper) SetInFlightPacket(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+	inFlightPacket types.InFlightPacket,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	bz := k.cdc.MustMarshal(&inFlightPacket)
+	store.Set(types.InFlightPacketKey(portID, channelID, sequence), bz)
+}
+
+// DeleteInFlightPacket removes the in-flight packet forwarded with the given
+// source port, source channel and sequence.
+func (k Keeper) DeleteInFlightPacket(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	store.Delete(types.InFlightPacketKey(portID, channelID, sequence))
+}
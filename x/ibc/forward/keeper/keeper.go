@@ -0,0 +1,75 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/evmos/v12/x/ibc/forward/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// Keeper defines the packet forward middleware keeper. It forwards received
+// ICS20 packets to the next hop and writes their acknowledgement once the
+// forwarded transfer is acknowledged, retrying or refunding it on failure.
+type Keeper struct {
+	storeKey       storetypes.StoreKey
+	cdc            codec.BinaryCodec
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	bankKeeper     types.BankKeeper
+	ics4Wrapper    porttypes.ICS4Wrapper
+}
+
+// NewKeeper returns keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	tk types.TransferKeeper,
+	ck types.ChannelKeeper,
+	bk types.BankKeeper,
+) *Keeper {
+	return &Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		transferKeeper: tk,
+		channelKeeper:  ck,
+		bankKeeper:     bk,
+	}
+}
+
+// SetICS4Wrapper sets the ICS4 wrapper to the keeper.
+// It panics if already set
+func (k *Keeper) SetICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) {
+	if k.ics4Wrapper != nil {
+		panic("ICS4 wrapper already set")
+	}
+
+	k.ics4Wrapper = ics4Wrapper
+}
+
+// Logger returns logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
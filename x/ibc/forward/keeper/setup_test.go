@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/testutil"
+	feemarkettypes "github.com/evmos/evmos/v12/x/feemarket/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.Evmos
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, feemarkettypes.DefaultGenesisState())
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), "evmos_9001-1", sdk.ConsAddress{}, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+}
@@ -0,0 +1,920 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/forward/v1/forward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket defines a received packet that was forwarded to the next hop
+// and whose acknowledgement is pending. It is stored under the identifiers of
+// the forwarded packet and holds what is needed to retry the transfer and to
+// write the acknowledgement of the received packet.
+type InFlightPacket struct {
+	// original_sender is the sender of the received packet on the counterparty chain
+	OriginalSender string `protobuf:"bytes,1,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+	// refund_port_id is the destination port of the received packet on this chain
+	RefundPortId string `protobuf:"bytes,2,opt,name=refund_port_id,json=refundPortId,proto3" json:"refund_port_id,omitempty"`
+	// refund_channel_id is the destination channel of the received packet on this chain
+	RefundChannelId string `protobuf:"bytes,3,opt,name=refund_channel_id,json=refundChannelId,proto3" json:"refund_channel_id,omitempty"`
+	// refund_sequence is the sequence of the received packet
+	RefundSequence uint64 `protobuf:"varint,4,opt,name=refund_sequence,json=refundSequence,proto3" json:"refund_sequence,omitempty"`
+	// packet_src_port_id is the source port of the received packet
+	PacketSrcPortId string `protobuf:"bytes,5,opt,name=packet_src_port_id,json=packetSrcPortId,proto3" json:"packet_src_port_id,omitempty"`
+	// packet_src_channel_id is the source channel of the received packet
+	PacketSrcChannelId string `protobuf:"bytes,6,opt,name=packet_src_channel_id,json=packetSrcChannelId,proto3" json:"packet_src_channel_id,omitempty"`
+	// packet_data is the data of the received packet
+	PacketData []byte `protobuf:"bytes,7,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"`
+	// packet_timeout_revision_number is the revision number of the received packet timeout height
+	PacketTimeoutRevisionNumber uint64 `protobuf:"varint,8,opt,name=packet_timeout_revision_number,json=packetTimeoutRevisionNumber,proto3" json:"packet_timeout_revision_number,omitempty"`
+	// packet_timeout_revision_height is the revision height of the received packet timeout height
+	PacketTimeoutRevisionHeight uint64 `protobuf:"varint,9,opt,name=packet_timeout_revision_height,json=packetTimeoutRevisionHeight,proto3" json:"packet_timeout_revision_height,omitempty"`
+	// packet_timeout_timestamp is the timeout timestamp of the received packet
+	PacketTimeoutTimestamp uint64 `protobuf:"varint,10,opt,name=packet_timeout_timestamp,json=packetTimeoutTimestamp,proto3" json:"packet_timeout_timestamp,omitempty"`
+	// forward_receiver is the receiver of the forwarded transfer
+	ForwardReceiver string `protobuf:"bytes,11,opt,name=forward_receiver,json=forwardReceiver,proto3" json:"forward_receiver,omitempty"`
+	// forward_memo is the memo of the forwarded transfer, i.e. the next hops
+	ForwardMemo string `protobuf:"bytes,12,opt,name=forward_memo,json=forwardMemo,proto3" json:"forward_memo,omitempty"`
+	// timeout is the relative timeout in nanoseconds of the forwarded transfer
+	Timeout uint64 `protobuf:"varint,13,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// retries_remaining is the number of times the transfer is retried on timeout
+	RetriesRemaining uint32 `protobuf:"varint,14,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68db2b475342e61, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundPortId() string {
+	if m != nil {
+		return m.RefundPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundChannelId() string {
+	if m != nil {
+		return m.RefundChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundSequence() uint64 {
+	if m != nil {
+		return m.RefundSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetPacketSrcPortId() string {
+	if m != nil {
+		return m.PacketSrcPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketSrcChannelId() string {
+	if m != nil {
+		return m.PacketSrcChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketData() []byte {
+	if m != nil {
+		return m.PacketData
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetPacketTimeoutRevisionNumber() uint64 {
+	if m != nil {
+		return m.PacketTimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetPacketTimeoutRevisionHeight() uint64 {
+	if m != nil {
+		return m.PacketTimeoutRevisionHeight
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetPacketTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.PacketTimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetForwardReceiver() string {
+	if m != nil {
+		return m.ForwardReceiver
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardMemo() string {
+	if m != nil {
+		return m.ForwardMemo
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "evmos.forward.v1.InFlightPacket")
+}
+
+func init() { proto.RegisterFile("evmos/forward/v1/forward.proto", fileDescriptor_a68db2b475342e61) }
+
+var fileDescriptor_a68db2b475342e61 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x8e, 0xd3, 0x30,
+	0x10, 0xc7, 0x1b, 0xd8, 0x0f, 0xd6, 0xed, 0x76, 0x8b, 0x25, 0x90, 0x25, 0xa4, 0x50, 0x10, 0x12,
+	0x85, 0x95, 0x1a, 0x15, 0x2e, 0x9c, 0x59, 0x84, 0xb6, 0x07, 0xd0, 0x2a, 0xdd, 0x13, 0x97, 0xc8,
+	0x4d, 0x66, 0x5b, 0x8b, 0xda, 0x0e, 0x63, 0x27, 0xc0, 0x4b, 0x20, 0x1e, 0x8b, 0xe3, 0x1e, 0x39,
+	0xa2, 0xf6, 0x45, 0x50, 0x6c, 0xa7, 0x2c, 0x7b, 0xe8, 0x25, 0x1f, 0xbf, 0xf9, 0x79, 0xe6, 0xaf,
+	0x64, 0x48, 0x0c, 0xb5, 0xd4, 0x26, 0xb9, 0xd2, 0xf8, 0x95, 0x63, 0x91, 0xd4, 0x93, 0xf6, 0x71,
+	0x5c, 0xa2, 0xb6, 0x9a, 0x0e, 0x5c, 0x7d, 0xdc, 0xc2, 0x7a, 0xf2, 0xf4, 0xc7, 0x3e, 0xe9, 0x4f,
+	0xd5, 0xfb, 0x95, 0x58, 0x2c, 0xed, 0x05, 0xcf, 0x3f, 0x83, 0xa5, 0xcf, 0xc9, 0x89, 0x46, 0xb1,
+	0x10, 0x8a, 0xaf, 0x32, 0x03, 0xaa, 0x00, 0x64, 0xd1, 0x30, 0x1a, 0x1d, 0xa5, 0xfd, 0x16, 0xcf,
+	0x1c, 0xa5, 0xcf, 0x48, 0x1f, 0xe1, 0xaa, 0x52, 0x45, 0x56, 0x6a, 0xb4, 0x99, 0x28, 0xd8, 0x1d,
+	0xe7, 0xf5, 0x3c, 0xbd, 0xd0, 0x68, 0xa7, 0x05, 0x7d, 0x49, 0xee, 0x07, 0x2b, 0x5f, 0x72, 0xa5,
+	0x60, 0xd5, 0x88, 0x77, 0x9d, 0x78, 0xe2, 0x0b, 0x67, 0x9e, 0x4f, 0x8b, 0x66, 0x74, 0x70, 0x0d,
+	0x7c, 0xa9, 0x40, 0xe5, 0xc0, 0xf6, 0x86, 0xd1, 0x68, 0x2f, 0x0d, 0x83, 0x66, 0x81, 0xd2, 0x53,
+	0x42, 0x4b, 0x97, 0x36, 0x33, 0x98, 0x6f, 0xc7, 0xef, 0xfb, 0xae, 0xbe, 0x32, 0xc3, 0x3c, 0x24,
+	0x98, 0x90, 0x07, 0x37, 0xe4, 0x1b, 0x29, 0x0e, 0x9c, 0x4f, 0xb7, 0xfe, 0xbf, 0x20, 0x8f, 0x49,
+	0x37, 0x1c, 0x29, 0xb8, 0xe5, 0xec, 0x70, 0x18, 0x8d, 0x7a, 0x29, 0xf1, 0xe8, 0x1d, 0xb7, 0x9c,
+	0x9e, 0x91, 0x38, 0x08, 0x56, 0x48, 0xd0, 0x95, 0xcd, 0x10, 0x6a, 0x61, 0x84, 0x56, 0x99, 0xaa,
+	0xe4, 0x1c, 0x90, 0xdd, 0x73, 0xc1, 0x1f, 0x79, 0xeb, 0xd2, 0x4b, 0x69, 0x70, 0x3e, 0x3a, 0x65,
+	0x57, 0x93, 0x25, 0x34, 0x7f, 0x84, 0x1d, 0xed, 0x68, 0x72, 0xee, 0x14, 0xfa, 0x86, 0xb0, 0x5b,
+	0x4d, 0x9a, 0xbb, 0xb1, 0x5c, 0x96, 0x8c, 0xb8, 0xe3, 0x0f, 0xff, 0x3b, 0x7e, 0xd9, 0x56, 0xe9,
+	0x0b, 0x32, 0x08, 0x9b, 0x90, 0x21, 0xe4, 0x20, 0x6a, 0x40, 0xd6, 0xf5, 0x9f, 0x30, 0xf0, 0x34,
+	0x60, 0xfa, 0x84, 0xf4, 0x5a, 0x55, 0x82, 0xd4, 0xac, 0xe7, 0xb4, 0x6e, 0x60, 0x1f, 0x40, 0x6a,
+	0xca, 0xc8, 0x61, 0x08, 0xc0, 0x8e, 0xdd, 0xd8, 0xf6, 0x95, 0x9e, 0x36, 0x1b, 0x60, 0x51, 0x80,
+	0xc9, 0x10, 0x24, 0x17, 0x4a, 0xa8, 0x05, 0xeb, 0x0f, 0xa3, 0xd1, 0x71, 0x3a, 0x08, 0x85, 0xb4,
+	0xe5, 0x6f, 0xcf, 0x7f, 0xad, 0xe3, 0xe8, 0x7a, 0x1d, 0x47, 0x7f, 0xd6, 0x71, 0xf4, 0x73, 0x13,
+	0x77, 0xae, 0x37, 0x71, 0xe7, 0xf7, 0x26, 0xee, 0x7c, 0x1a, 0x2f, 0x84, 0x5d, 0x56, 0xf3, 0x71,
+	0xae, 0x65, 0xe2, 0xf7, 0xdc, 0x5f, 0xeb, 0xc9, 0xab, 0xe4, 0x5b, 0x22, 0xe6, 0xf9, 0x76, 0xef,
+	0xed, 0xf7, 0x12, 0xcc, 0xfc, 0xc0, 0xed, 0xfc, 0xeb, 0xbf, 0x03, 0x00, 0xc4, 0x21, 0x43, 0x97,
+	0x15, 0x03, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ForwardMemo) > 0 {
+		i -= len(m.ForwardMemo)
+		copy(dAtA[i:], m.ForwardMemo)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.ForwardMemo)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ForwardReceiver) > 0 {
+		i -= len(m.ForwardReceiver)
+		copy(dAtA[i:], m.ForwardReceiver)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.ForwardReceiver)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.PacketTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PacketTimeoutRevisionHeight != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.PacketTimeoutRevisionHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PacketTimeoutRevisionNumber != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.PacketTimeoutRevisionNumber))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PacketData) > 0 {
+		i -= len(m.PacketData)
+		copy(dAtA[i:], m.PacketData)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.PacketData)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PacketSrcChannelId) > 0 {
+		i -= len(m.PacketSrcChannelId)
+		copy(dAtA[i:], m.PacketSrcChannelId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.PacketSrcChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PacketSrcPortId) > 0 {
+		i -= len(m.PacketSrcPortId)
+		copy(dAtA[i:], m.PacketSrcPortId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.PacketSrcPortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RefundSequence != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.RefundSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RefundChannelId) > 0 {
+		i -= len(m.RefundChannelId)
+		copy(dAtA[i:], m.RefundChannelId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.RefundChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RefundPortId) > 0 {
+		i -= len(m.RefundPortId)
+		copy(dAtA[i:], m.RefundPortId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.RefundPortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.RefundPortId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.RefundChannelId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.RefundSequence != 0 {
+		n += 1 + sovForward(uint64(m.RefundSequence))
+	}
+	l = len(m.PacketSrcPortId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.PacketSrcChannelId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.PacketData)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.PacketTimeoutRevisionNumber != 0 {
+		n += 1 + sovForward(uint64(m.PacketTimeoutRevisionNumber))
+	}
+	if m.PacketTimeoutRevisionHeight != 0 {
+		n += 1 + sovForward(uint64(m.PacketTimeoutRevisionHeight))
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		n += 1 + sovForward(uint64(m.PacketTimeoutTimestamp))
+	}
+	l = len(m.ForwardReceiver)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.ForwardMemo)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovForward(uint64(m.Timeout))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovForward(uint64(m.RetriesRemaining))
+	}
+	return n
+}
+
+func sovForward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForward(x uint64) (n int) {
+	return sovForward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+			}
+			m.RefundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
+			if m.PacketData == nil {
+				m.PacketData = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutRevisionNumber", wireType)
+			}
+			m.PacketTimeoutRevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketTimeoutRevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutRevisionHeight", wireType)
+			}
+			m.PacketTimeoutRevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketTimeoutRevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutTimestamp", wireType)
+			}
+			m.PacketTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardMemo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardMemo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupForward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthForward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthForward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupForward = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,63 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// NewInFlightPacket returns the in-flight packet for the received packet
+// forwarded with the given metadata
+func NewInFlightPacket(
+	packet channeltypes.Packet,
+	originalSender string,
+	metadata ForwardMetadata,
+	forwardMemo string,
+) InFlightPacket {
+	return InFlightPacket{
+		OriginalSender:              originalSender,
+		RefundPortId:                packet.DestinationPort,
+		RefundChannelId:             packet.DestinationChannel,
+		RefundSequence:              packet.Sequence,
+		PacketSrcPortId:             packet.SourcePort,
+		PacketSrcChannelId:          packet.SourceChannel,
+		PacketData:                  packet.Data,
+		PacketTimeoutRevisionNumber: packet.TimeoutHeight.RevisionNumber,
+		PacketTimeoutRevisionHeight: packet.TimeoutHeight.RevisionHeight,
+		PacketTimeoutTimestamp:      packet.TimeoutTimestamp,
+		ForwardReceiver:             metadata.Receiver,
+		ForwardMemo:                 forwardMemo,
+		Timeout:                     uint64(metadata.GetTimeout().Nanoseconds()),
+		RetriesRemaining:            metadata.GetRetries(),
+	}
+}
+
+// ReceivedPacket returns the packet received by this chain, used to write its
+// acknowledgement once the forwarded transfer completes
+func (p InFlightPacket) ReceivedPacket() channeltypes.Packet {
+	return channeltypes.NewPacket(
+		p.PacketData,
+		p.RefundSequence,
+		p.PacketSrcPortId,
+		p.PacketSrcChannelId,
+		p.RefundPortId,
+		p.RefundChannelId,
+		clienttypes.NewHeight(p.PacketTimeoutRevisionNumber, p.PacketTimeoutRevisionHeight),
+		p.PacketTimeoutTimestamp,
+	)
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the name of the packet forward middleware
+	ModuleName = "forward"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// MemoKey is the key of the JSON memo object that forwards a received
+	// packet to the next hop
+	MemoKey = "forward"
+
+	// DefaultTimeout is the relative timeout of a forwarded transfer if the
+	// memo doesn't define one
+	DefaultTimeout = 10 * time.Minute
+
+	// DefaultRetries is the number of times a timed out forwarded transfer is
+	// retried if the memo doesn't define it
+	DefaultRetries uint32 = 3
+
+	// MaxRetries is the maximum number of times a forwarded transfer can be
+	// retried
+	MaxRetries uint32 = 10
+)
+
+// prefix bytes for the forward persistent store
+const (
+	prefixInFlightPacket = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixInFlightPacket = []byte{prefixInFlightPacket}
+)
+
+// InFlightPacketKey returns the store key of the in-flight packet forwarded
+// with the given source port, source channel and sequence.
+func InFlightPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/", portID, channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,159 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ForwardMetadata defines the forward memo object of a received ICS20 packet.
+// The received coins are transferred to the receiver over the given channel,
+// with the next hops, if any, as the memo of the forwarded transfer.
+type ForwardMetadata struct {
+	// Receiver is the receiver of the forwarded transfer on the next chain
+	Receiver string `json:"receiver"`
+	// Port is the port of the forwarded transfer, it defaults to the transfer port
+	Port string `json:"port,omitempty"`
+	// Channel is the channel of the forwarded transfer on this chain
+	Channel string `json:"channel"`
+	// Timeout is the relative timeout of the forwarded transfer as a duration
+	// string, e.g. "10m"
+	Timeout string `json:"timeout,omitempty"`
+	// Retries is the number of times the forwarded transfer is retried on timeout
+	Retries *uint32 `json:"retries,omitempty"`
+	// Next is the memo of the forwarded transfer, either as a JSON object or
+	// as a JSON string
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata returns the forward metadata defined in the packet
+// memo. The boolean return value is false if the memo doesn't forward the
+// packet, in which case it is received as a regular transfer.
+func ParseForwardMetadata(memo string) (ForwardMetadata, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return ForwardMetadata{}, false, nil
+	}
+
+	raw, found := fields[MemoKey]
+	if !found {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var metadata ForwardMetadata
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return ForwardMetadata{}, true, errorsmod.Wrap(ErrInvalidForwardMemo, err.Error())
+	}
+
+	if metadata.Port == "" {
+		metadata.Port = transfertypes.PortID
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return ForwardMetadata{}, true, errorsmod.Wrap(ErrInvalidForwardMemo, err.Error())
+	}
+
+	return metadata, true, nil
+}
+
+// Validate performs a stateless validation of the forward metadata
+func (m ForwardMetadata) Validate() error {
+	if m.Receiver == "" {
+		return fmt.Errorf("receiver cannot be empty")
+	}
+
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return fmt.Errorf("invalid port: %w", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return fmt.Errorf("invalid channel: %w", err)
+	}
+
+	if m.Timeout != "" {
+		timeout, err := time.ParseDuration(m.Timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout: %w", err)
+		}
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be positive, got %s", m.Timeout)
+		}
+	}
+
+	if m.Retries != nil && *m.Retries > MaxRetries {
+		return fmt.Errorf("retries cannot exceed %d, got %d", MaxRetries, *m.Retries)
+	}
+
+	if _, err := m.NextMemo(); err != nil {
+		return fmt.Errorf("invalid next memo: %w", err)
+	}
+
+	return nil
+}
+
+// GetTimeout returns the relative timeout of the forwarded transfer
+func (m ForwardMetadata) GetTimeout() time.Duration {
+	if m.Timeout == "" {
+		return DefaultTimeout
+	}
+	// error is checked on validation
+	timeout, _ := time.ParseDuration(m.Timeout)
+	return timeout
+}
+
+// GetRetries returns the number of times the forwarded transfer is retried
+func (m ForwardMetadata) GetRetries() uint32 {
+	if m.Retries == nil {
+		return DefaultRetries
+	}
+	return *m.Retries
+}
+
+// NextMemo returns the memo of the forwarded transfer
+func (m ForwardMetadata) NextMemo() (string, error) {
+	if len(m.Next) == 0 {
+		return "", nil
+	}
+
+	var memo string
+	if err := json.Unmarshal(m.Next, &memo); err == nil {
+		return memo, nil
+	}
+
+	var next map[string]json.RawMessage
+	if err := json.Unmarshal(m.Next, &next); err != nil {
+		return "", err
+	}
+
+	return string(m.Next), nil
+}
+
+// DeriveIntermediateAddress returns the address that receives the coins of a
+// forwarded packet on this chain. It is derived from the destination channel
+// and the original sender so that it can't be controlled by any user.
+func DeriveIntermediateAddress(channelID, originalSender string) sdk.AccAddress {
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("%s/%s/%s", ModuleName, channelID, originalSender)))
+	return sdk.AccAddress(hash[:20])
+}
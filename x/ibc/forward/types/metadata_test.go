@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	receiver := "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueuhnecd2"
+
+	testCases := []struct {
+		name       string
+		memo       string
+		expFound   bool
+		expPass    bool
+		expTimeout time.Duration
+		expRetries uint32
+		expNext    string
+	}{
+		{"empty memo", "", false, true, 0, 0, ""},
+		{"non JSON memo", "hello", false, true, 0, 0, ""},
+		{"no forward", `{"src_callback":{}}`, false, true, 0, 0, ""},
+		{"invalid object", `{"forward":"channel-0"}`, true, false, 0, 0, ""},
+		{"empty receiver", `{"forward":{"channel":"channel-0"}}`, true, false, 0, 0, ""},
+		{"invalid channel", `{"forward":{"receiver":"` + receiver + `","channel":"c"}}`, true, false, 0, 0, ""},
+		{"invalid timeout", `{"forward":{"receiver":"` + receiver + `","channel":"channel-0","timeout":"1x"}}`, true, false, 0, 0, ""},
+		{"negative timeout", `{"forward":{"receiver":"` + receiver + `","channel":"channel-0","timeout":"-1m"}}`, true, false, 0, 0, ""},
+		{"too many retries", `{"forward":{"receiver":"` + receiver + `","channel":"channel-0","retries":11}}`, true, false, 0, 0, ""},
+		{"invalid next", `{"forward":{"receiver":"` + receiver + `","channel":"channel-0","next":1}}`, true, false, 0, 0, ""},
+		{
+			"defaults",
+			`{"forward":{"receiver":"` + receiver + `","channel":"channel-0"}}`,
+			true, true, DefaultTimeout, DefaultRetries, "",
+		},
+		{
+			"custom timeout and retries",
+			`{"forward":{"receiver":"` + receiver + `","channel":"channel-0","timeout":"1h","retries":0}}`,
+			true, true, time.Hour, 0, "",
+		},
+		{
+			"next as object",
+			`{"forward":{"receiver":"` + receiver + `","channel":"channel-0","next":{"forward":{"receiver":"a","channel":"channel-1"}}}}`,
+			true, true, DefaultTimeout, DefaultRetries, `{"forward":{"receiver":"a","channel":"channel-1"}}`,
+		},
+		{
+			"next as string",
+			`{"forward":{"receiver":"` + receiver + `","channel":"channel-0","next":"{\"forward\":{}}"}}`,
+			true, true, DefaultTimeout, DefaultRetries, `{"forward":{}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		metadata, found, err := ParseForwardMetadata(tc.memo)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		if !found {
+			continue
+		}
+
+		require.Equal(t, receiver, metadata.Receiver, tc.name)
+		require.Equal(t, "transfer", metadata.Port, tc.name)
+		require.Equal(t, tc.expTimeout, metadata.GetTimeout(), tc.name)
+		require.Equal(t, tc.expRetries, metadata.GetRetries(), tc.name)
+
+		next, err := metadata.NextMemo()
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expNext, next, tc.name)
+	}
+}
+
+func TestDeriveIntermediateAddress(t *testing.T) {
+	addr := DeriveIntermediateAddress("channel-0", "cosmos1sender")
+	require.Len(t, addr, 20)
+	require.Equal(t, addr, DeriveIntermediateAddress("channel-0", "cosmos1sender"))
+	require.NotEqual(t, addr, DeriveIntermediateAddress("channel-1", "cosmos1sender"))
+	require.NotEqual(t, addr, DeriveIntermediateAddress("channel-0", "cosmos1other"))
+}
@@ -246,6 +246,14 @@ func (suite *KeeperTestSuite) MintERC20Token(contractAddr, from, to common.Addre
 	return suite.sendTx(contractAddr, from, transferData)
 }
 
+// EscrowERC20Token mints ERC20 tokens to the erc20 module address to back the
+// coins minted by the tests. The tokens are minted through a keeper call so
+// that the EVM hooks don't convert them.
+func (suite *KeeperTestSuite) EscrowERC20Token(contractAddr common.Address, amount *big.Int) {
+	_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, suite.address, contractAddr, true, "mint", types.ModuleAddress, amount)
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) sendTx(contractAddr, from common.Address, transferData []byte) *evm.MsgEthereumTx {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	chainID := suite.app.EvmKeeper.ChainID()
@@ -61,6 +61,13 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	// use native denom or contract address
 	denom := strings.TrimPrefix(msg.Token.Denom, erc20types.ModuleName+"/")
 
+	// tokens of a legacy contract are sent through the token pair of its denom
+	if common.IsHexAddress(denom) {
+		if legacyContract, found := k.erc20Keeper.GetLegacyContract(ctx, common.HexToAddress(denom)); found {
+			denom = legacyContract.Denom
+		}
+	}
+
 	pairID := k.erc20Keeper.GetTokenPairID(ctx, denom)
 	if len(pairID) == 0 {
 		// no-op: token is not registered so we can proceed with regular transfer
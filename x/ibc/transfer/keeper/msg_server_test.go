@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
@@ -77,6 +78,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 
 				err = suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins)
 				suite.Require().NoError(err)
+				suite.EscrowERC20Token(contractAddr, big.NewInt(10))
 				suite.Commit()
 
 				err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, erc20types.ModuleName, senderAcc, coins)
@@ -187,6 +189,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 
 				err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, senderAcc.GetName(), sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(10))))
 				suite.Require().NoError(err)
+				suite.EscrowERC20Token(contractAddr, big.NewInt(10))
 				transferMsg := types.NewMsgTransfer("transfer", "channel-0", sdk.NewCoin(pair.Denom, sdk.NewInt(10)), senderAcc.GetAddress().String(), "", timeoutHeight, 0, "")
 
 				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
@@ -234,6 +237,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(10)))
 				err = suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins)
 				suite.Require().NoError(err)
+				suite.EscrowERC20Token(contractAddr, big.NewInt(10))
 				err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, erc20types.ModuleName, senderAcc, coins)
 				suite.Require().NoError(err)
 				suite.Commit()
@@ -242,6 +246,44 @@ func (suite *KeeperTestSuite) TestTransfer() {
 			},
 			true,
 		},
+		{
+			"pass - legacy contract - migrate the balance and convert",
+			func() *types.MsgTransfer {
+				metadata := banktypes.Metadata{
+					Description: "description of the token",
+					Base:        "acoin",
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: "acoin", Exponent: 0},
+						{Denom: "coin", Exponent: 18},
+					},
+					Name:    "coin",
+					Symbol:  "COIN",
+					Display: "coin",
+				}
+				pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata)
+				suite.Require().NoError(err)
+				legacy := pair.GetERC20Contract()
+
+				senderAcc := sdk.AccAddress(suite.address.Bytes())
+				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(10)))
+				err = suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins)
+				suite.Require().NoError(err)
+				err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, erc20types.ModuleName, senderAcc, coins)
+				suite.Require().NoError(err)
+
+				msg := erc20types.NewMsgConvertCoin(coins[0], suite.address, senderAcc)
+				_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				_, err = suite.app.Erc20Keeper.MigrateTokenPairContract(suite.ctx, pair.Denom, "", "")
+				suite.Require().NoError(err)
+				suite.Commit()
+
+				transferMsg := types.NewMsgTransfer("transfer", "channel-0", sdk.NewCoin("erc20/"+legacy.String(), sdk.NewInt(10)), senderAcc.String(), "", timeoutHeight, 0, "")
+				return transferMsg
+			},
+			true,
+		},
 		{
 			"error - fail conversion - no balance in erc20",
 			func() *types.MsgTransfer {
@@ -48,5 +48,6 @@ type ERC20Keeper interface {
 	IsERC20Registered(ctx sdk.Context, contractAddr common.Address) bool
 	GetTokenPairID(ctx sdk.Context, token string) []byte
 	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	GetLegacyContract(ctx sdk.Context, erc20 common.Address) (erc20types.LegacyContract, bool)
 	ConvertERC20(ctx context.Context, msg *erc20types.MsgConvertERC20) (*erc20types.MsgConvertERC20Response, error)
 }
@@ -45,6 +45,8 @@ func GetQueryCmd() *cobra.Command {
 		GetGasMeterCmd(),
 		GetAllocationMetersCmd(),
 		GetAllocationMeterCmd(),
+		GetSponsorshipsCmd(),
+		GetEstimatedRewardsCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -165,6 +167,91 @@ func GetGasMetersCmd() *cobra.Command {
 	return cmd
 }
 
+// GetSponsorshipsCmd queries the sponsorships of a contract
+func GetSponsorshipsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorships CONTRACT_ADDRESS",
+		Short: "Gets the sponsor-funded incentives for a given contract",
+		Long:  "Gets the sponsor-funded incentives for a given contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySponsorshipsRequest{
+				Contract:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Sponsorships(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sponsorships")
+	return cmd
+}
+
+// GetEstimatedRewardsCmd queries the projected rewards of a participant for
+// the current epoch
+func GetEstimatedRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimated-rewards CONTRACT_ADDRESS PARTICIPANT_ADDRESS",
+		Short: "Gets the projected rewards of a user for a given incentive in the current epoch",
+		Long:  "Gets the projected rewards of a user for a given incentive if the current epoch ended at the latest block",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("invalid user address: %s", args[1])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEstimatedRewardsRequest{
+				Contract:    args[0],
+				Participant: args[1],
+			}
+
+			res, err := queryClient.EstimatedRewards(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetGasMeterCmd queries the list of incentives
 func GetGasMeterCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -33,6 +34,126 @@ import (
 	"github.com/evmos/evmos/v12/x/incentives/types"
 )
 
+// NewTxCmd returns a root CLI command handler for certain modules/incentives
+// transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "incentives subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSponsorIncentiveCmd(),
+		NewWithdrawSponsorshipCmd(),
+	)
+	return txCmd
+}
+
+// NewSponsorIncentiveCmd returns a CLI command handler for funding a
+// sponsor-funded incentive
+func NewSponsorIncentiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor CONTRACT_ADDRESS DEPOSIT [EPOCH_BUDGET] [EPOCHS]",
+		Short: "Deposit rewards for the participants of a contract",
+		Long: `Deposit rewards into the escrow of a sponsor-funded incentive for a contract.
+The epoch budget and epochs are required to create a sponsorship and optional to top it up.
+Coins backed by a registered ERC20 token pair are converted from the ERC20 balance if needed.`,
+		Example: fmt.Sprintf(
+			"%s tx %s sponsor 0x... 1000000ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 100000ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 10 --from=<key_or_address>",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid contract address: %s", contract)
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			epochBudget := sdk.Coins{}
+			if len(args) > 2 {
+				epochBudget, err = sdk.ParseCoinsNormalized(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			var epochs uint64
+			if len(args) > 3 {
+				epochs, err = strconv.ParseUint(args[3], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSponsorIncentive(
+				cliCtx.GetFromAddress(),
+				common.HexToAddress(contract),
+				deposit,
+				epochBudget,
+				uint32(epochs),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewWithdrawSponsorshipCmd returns a CLI command handler for withdrawing the
+// unspent escrow of a finished sponsorship
+func NewWithdrawSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-sponsorship CONTRACT_ADDRESS",
+		Short: "Withdraw the unspent escrow of a finished sponsorship",
+		Long:  "Withdraw the unspent escrow of a sponsorship without remaining epochs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid contract address: %s", contract)
+			}
+
+			msg := types.NewMsgWithdrawSponsorship(
+				cliCtx.GetFromAddress(),
+				common.HexToAddress(contract),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterIncentiveProposalCmd implements the command to submit a register
 //
 //	incentive proposal
@@ -76,13 +76,29 @@ func InitGenesis(
 	for _, gasMeter := range data.GasMeters {
 		k.SetGasMeter(ctx, gasMeter)
 	}
+
+	// Ensure sponsorship escrow module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.SponsorshipEscrowName); acc == nil {
+		panic("the incentives escrow module account has not been set")
+	}
+
+	// Set sponsorships and their gas meters
+	for _, sponsorship := range data.Sponsorships {
+		k.SetSponsorship(ctx, sponsorship)
+	}
+
+	for _, gasMeter := range data.SponsoredGasMeters {
+		k.SetSponsoredGasMeter(ctx, gasMeter)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		Incentives: k.GetAllIncentives(ctx),
-		GasMeters:  k.GetIncentivesGasMeters(ctx),
+		Params:             k.GetParams(ctx),
+		Incentives:         k.GetAllIncentives(ctx),
+		GasMeters:          k.GetIncentivesGasMeters(ctx),
+		Sponsorships:       k.GetAllSponsorships(ctx),
+		SponsoredGasMeters: k.GetSponsoredGasMeters(ctx),
 	}
 }
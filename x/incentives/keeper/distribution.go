@@ -201,7 +201,6 @@ func (k Keeper) rewardParticipants(
 		return sdk.Coins{}, 0
 	}
 
-	totalGasDec := sdk.NewDecFromBigInt(new(big.Int).SetUint64(totalGas))
 	mintDenom := k.evmKeeper.GetParams(ctx).EvmDenom
 	rewardScaler := k.GetParams(ctx).RewardScaler
 
@@ -210,30 +209,13 @@ func (k Keeper) rewardParticipants(
 		ctx,
 		contract,
 		func(gm types.GasMeter) (stop bool) {
-			// Get participant's ratio of `gas spent / total gas spent`
-			cumulativeGas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gm.CumulativeGas))
-			gasRatio := cumulativeGas.Quo(totalGasDec)
-			coins := sdk.Coins{}
-
-			// Allocate rewards according to gasRatio
-			for _, allocation := range incentive.Allocations {
-				coinAllocated := contractAllocation.AmountOf(allocation.Denom)
-				reward := gasRatio.MulInt(coinAllocated)
-				if !reward.IsPositive() {
-					continue
-				}
-
-				// Cap rewards in mint denom (i.e. aevmos) to receive only up to 100% of
-				// the participant's gas spent and prevent gaming
-				if mintDenom == allocation.Denom {
-					rewardCap := cumulativeGas.Mul(rewardScaler)
-					reward = sdk.MinDec(reward, rewardCap)
-				}
-
-				// NOTE: ignore denom validation
-				coin := sdk.Coin{Denom: allocation.Denom, Amount: reward.TruncateInt()}
-				coins = coins.Add(coin)
-			}
+			coins := participantRewards(
+				incentive,
+				contractAllocation,
+				gm.CumulativeGas,
+				mintDenom,
+				rewardScaler,
+			)
 
 			rewards = rewards.Add(coins...)
 
@@ -266,3 +248,47 @@ func (k Keeper) rewardParticipants(
 
 	return rewards, count
 }
+
+// participantRewards returns the rewards of a participant of a given Incentive
+// for the cumulative gas spent during the epoch
+//   - Allocate rewards according to the participant's gasRatio
+//   - Cap rewards in mint denom at the participant's gas spent times the reward scaler
+func participantRewards(
+	incentive types.Incentive,
+	contractAllocation sdk.Coins,
+	gasUsed uint64,
+	mintDenom string,
+	rewardScaler sdk.Dec,
+) sdk.Coins {
+	coins := sdk.Coins{}
+	if incentive.TotalGas == 0 {
+		return coins
+	}
+
+	// Get participant's ratio of `gas spent / total gas spent`
+	totalGasDec := sdk.NewDecFromBigInt(new(big.Int).SetUint64(incentive.TotalGas))
+	cumulativeGas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gasUsed))
+	gasRatio := cumulativeGas.Quo(totalGasDec)
+
+	// Allocate rewards according to gasRatio
+	for _, allocation := range incentive.Allocations {
+		coinAllocated := contractAllocation.AmountOf(allocation.Denom)
+		reward := gasRatio.MulInt(coinAllocated)
+		if !reward.IsPositive() {
+			continue
+		}
+
+		// Cap rewards in mint denom (i.e. aevmos) to receive only up to 100% of
+		// the participant's gas spent and prevent gaming
+		if mintDenom == allocation.Denom {
+			rewardCap := cumulativeGas.Mul(rewardScaler)
+			reward = sdk.MinDec(reward, rewardCap)
+		}
+
+		// NOTE: ignore denom validation
+		coin := sdk.Coin{Denom: allocation.Denom, Amount: reward.TruncateInt()}
+		coins = coins.Add(coin)
+	}
+
+	return coins
+}
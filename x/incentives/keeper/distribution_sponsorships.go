@@ -0,0 +1,181 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/x/incentives/types"
+)
+
+// DistributeSponsorships transfers the epoch rewards of the sponsor-funded
+// incentives to the participants of the sponsored contracts.
+//   - distributes the epoch budget of each sponsorship, capped by its escrow
+//   - updates the escrow and the remaining epochs of each sponsorship
+//   - removes finished sponsorships without any escrow left
+//   - deletes the gas meters of the sponsored contracts
+func (k Keeper) DistributeSponsorships(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+
+	var (
+		sponsorships []types.Sponsorship
+		contracts    []common.Address
+	)
+
+	seenContracts := make(map[common.Address]bool)
+	k.IterateSponsorships(ctx, func(sponsorship types.Sponsorship) (stop bool) {
+		if !sponsorship.IsActive() {
+			return false
+		}
+
+		contract := common.HexToAddress(sponsorship.Contract)
+		if !seenContracts[contract] {
+			seenContracts[contract] = true
+			contracts = append(contracts, contract)
+		}
+
+		sponsorships = append(sponsorships, sponsorship)
+		return false
+	})
+
+	for _, sponsorship := range sponsorships {
+		rewards := k.rewardSponsoredParticipants(ctx, sponsorship)
+
+		sponsorship.Escrow = sponsorship.Escrow.Sub(rewards...)
+		sponsorship.Epochs--
+
+		// Remove the sponsorship if it has no remaining epochs and escrow
+		// left. Otherwise, the sponsor can withdraw the unspent escrow once it
+		// has finished.
+		if !sponsorship.IsActive() && sponsorship.Escrow.IsZero() {
+			k.DeleteSponsorship(ctx, sponsorship)
+			logger.Info(
+				"sponsorship finalized",
+				"contract", sponsorship.Contract,
+				"sponsor", sponsorship.Sponsor,
+			)
+		} else {
+			k.SetSponsorship(ctx, sponsorship)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeSponsorship,
+				sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+				sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+				sdk.NewAttribute(
+					types.AttributeKeyEpochs,
+					strconv.FormatUint(uint64(sponsorship.Epochs), 10),
+				),
+			),
+		)
+	}
+
+	for _, contract := range contracts {
+		k.resetSponsoredGasMeters(ctx, contract)
+	}
+}
+
+// rewardSponsoredParticipants sends the epoch rewards of a sponsorship to the
+// participants of the sponsored contract according to their gas ratio, and
+// returns the total amount distributed
+func (k Keeper) rewardSponsoredParticipants(
+	ctx sdk.Context,
+	sponsorship types.Sponsorship,
+) sdk.Coins {
+	logger := k.Logger(ctx)
+	rewards := sdk.Coins{}
+
+	// Check if participants spent gas on interacting with the contract
+	contract := common.HexToAddress(sponsorship.Contract)
+	totalGas := k.GetSponsoredTotalGas(ctx, contract)
+	if totalGas == 0 {
+		logger.Debug(
+			"no gas spent on sponsored contract during epoch",
+			"contract", sponsorship.Contract,
+		)
+		return rewards
+	}
+
+	epochRewards := sponsorship.EpochRewards()
+	if epochRewards.IsZero() {
+		return rewards
+	}
+
+	k.IterateSponsoredGasMeters(
+		ctx,
+		contract,
+		func(gm types.GasMeter) (stop bool) {
+			coins := sponsoredParticipantRewards(epochRewards, gm.CumulativeGas, totalGas)
+			if coins.IsZero() {
+				return false
+			}
+
+			participant := common.HexToAddress(gm.Participant)
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(
+				ctx,
+				types.SponsorshipEscrowName,
+				sdk.AccAddress(participant.Bytes()),
+				coins,
+			)
+			if err != nil {
+				logger.Debug(
+					"failed to distribute sponsored incentive",
+					"address", gm.Participant,
+					"allocation", coins.String(),
+					"contract", gm.Contract,
+					"sponsor", sponsorship.Sponsor,
+					"error", err.Error(),
+				)
+				return false
+			}
+
+			rewards = rewards.Add(coins...)
+			return false
+		},
+	)
+
+	return rewards
+}
+
+// sponsoredParticipantRewards returns the share of the epoch rewards of a
+// sponsorship for a participant, according to its gas ratio
+func sponsoredParticipantRewards(epochRewards sdk.Coins, gasUsed, totalGas uint64) sdk.Coins {
+	coins := sdk.Coins{}
+	if totalGas == 0 {
+		return coins
+	}
+
+	// Get participant's ratio of `gas spent / total gas spent`
+	totalGasDec := sdk.NewDecFromBigInt(new(big.Int).SetUint64(totalGas))
+	cumulativeGas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gasUsed))
+	gasRatio := cumulativeGas.Quo(totalGasDec)
+
+	for _, coin := range epochRewards {
+		amount := gasRatio.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			coins = coins.Add(sdk.Coin{Denom: coin.Denom, Amount: amount})
+		}
+	}
+
+	return coins
+}
@@ -17,6 +17,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	epochstypes "github.com/evmos/evmos/v12/x/epochs/types"
@@ -25,7 +26,10 @@ import (
 // BeforeEpochStart performs a no-op
 func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
 
-// AfterEpochEnd distributes the contract incentives at the end of each epoch
+// AfterEpochEnd distributes the contract incentives and the sponsor-funded
+// incentives at the end of each epoch. Sponsorships are distributed even if the
+// incentives are disabled by governance, as they are funded by the escrow of
+// their sponsors instead of the inflation.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
 	params := k.GetParams(ctx)
 
@@ -35,13 +39,25 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64)
 	}
 
 	// check if the Incentives are globally enabled
-	if !params.EnableIncentives {
-		return
+	if params.EnableIncentives {
+		if err := k.DistributeRewards(ctx); err != nil {
+			panic(err)
+		}
 	}
 
-	if err := k.DistributeRewards(ctx); err != nil {
-		panic(err)
+	k.DistributeSponsorships(ctx)
+}
+
+// AfterEpochScheduleChanged performs a no-op
+func (k Keeper) AfterEpochScheduleChanged(_ sdk.Context, _ epochstypes.EpochInfo, _ epochstypes.ScheduleChange) {
+}
+
+// BeforeEpochDeleted returns an error if the epoch is the incentives epoch
+func (k Keeper) BeforeEpochDeleted(ctx sdk.Context, epochIdentifier string) error {
+	if epochIdentifier == k.GetParams(ctx).IncentivesEpochIdentifier {
+		return errorsmod.Wrapf(epochstypes.ErrEpochInUse, "%s is the incentives epoch identifier", epochIdentifier)
 	}
+	return nil
 }
 
 // ___________________________________________________________________________________________________
@@ -67,3 +83,15 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+var _ epochstypes.EpochScheduleHooks = Hooks{}
+
+// AfterEpochScheduleChanged implements EpochScheduleHooks
+func (h Hooks) AfterEpochScheduleChanged(ctx sdk.Context, epochInfo epochstypes.EpochInfo, change epochstypes.ScheduleChange) {
+	h.k.AfterEpochScheduleChanged(ctx, epochInfo, change)
+}
+
+// BeforeEpochDeleted implements EpochScheduleHooks
+func (h Hooks) BeforeEpochDeleted(ctx sdk.Context, epochIdentifier string) error {
+	return h.k.BeforeEpochDeleted(ctx, epochIdentifier)
+}
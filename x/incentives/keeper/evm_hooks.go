@@ -40,19 +40,22 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 
 // PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
 // interaction with an incentivized contract, the participants's GasUsed is
-// added to its gasMeter.
+// added to its gasMeter. Sponsored contracts track the gas on separate gas
+// meters, which are updated even if the incentives are disabled by governance.
 func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
-	// check if the Incentives are globally enabled
-	params := k.GetParams(ctx)
-	if !params.EnableIncentives {
-		return nil
-	}
-
 	contract := msg.To()
 	participant := msg.From()
 
-	// If theres no incentive registered for the contract, do nothing
-	if contract == nil || !k.IsIncentiveRegistered(ctx, *contract) {
+	// If theres no incentive registered or sponsored for the contract, do
+	// nothing
+	if contract == nil {
+		return nil
+	}
+
+	// check if the Incentives are globally enabled
+	registered := k.GetParams(ctx).EnableIncentives && k.IsIncentiveRegistered(ctx, *contract)
+	sponsored := k.HasActiveSponsorship(ctx, *contract)
+	if !registered && !sponsored {
 		return nil
 	}
 
@@ -67,8 +70,14 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 		return nil
 	}
 
-	k.addGasToIncentive(ctx, *contract, receipt.GasUsed)
-	k.addGasToParticipant(ctx, *contract, participant, receipt.GasUsed)
+	if registered {
+		k.addGasToIncentive(ctx, *contract, receipt.GasUsed)
+		k.addGasToParticipant(ctx, *contract, participant, receipt.GasUsed)
+	}
+
+	if sponsored {
+		k.addGasToSponsoredParticipant(ctx, *contract, participant, receipt.GasUsed)
+	}
 
 	defer func() {
 		telemetry.IncrCounter(
@@ -114,3 +123,19 @@ func (k Keeper) addGasToParticipant(
 	gm := types.NewGasMeter(contract, participant, gasUsed)
 	k.SetGasMeter(ctx, gm)
 }
+
+// addGasToSponsoredParticipant adds gasUsed to a participant's gas meter of a
+// sponsored contract
+func (k Keeper) addGasToSponsoredParticipant(
+	ctx sdk.Context,
+	contract, participant common.Address,
+	gasUsed uint64,
+) {
+	previousGas, found := k.GetSponsoredGasMeter(ctx, contract, participant)
+	if found {
+		gasUsed += previousGas
+	}
+
+	gm := types.NewGasMeter(contract, participant, gasUsed)
+	k.SetSponsoredGasMeter(ctx, gm)
+}
@@ -295,3 +295,143 @@ func (k Keeper) Params(
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// Sponsorships returns the sponsor-funded incentives of a given contract
+func (k Keeper) Sponsorships(
+	c context.Context,
+	req *types.QuerySponsorshipsRequest,
+) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Contract) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"contract address is empty",
+		)
+	}
+
+	// check if the contract is a hex address
+	if err := evmostypes.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be hex ('0x...')", req.Contract,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contract := common.HexToAddress(req.Contract)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixSponsorship, contract.Bytes()...))
+
+	var sponsorships []types.Sponsorship
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_, value []byte) error {
+			var sponsorship types.Sponsorship
+			if err := k.cdc.Unmarshal(value, &sponsorship); err != nil {
+				return err
+			}
+			sponsorships = append(sponsorships, sponsorship)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySponsorshipsResponse{
+		Sponsorships: sponsorships,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// EstimatedRewards returns the projected rewards of a participant of an
+// incentivized contract if the current epoch ended at the current block
+func (k Keeper) EstimatedRewards(
+	c context.Context,
+	req *types.QueryEstimatedRewardsRequest,
+) (*types.QueryEstimatedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// check if the contract is a hex address
+	if err := evmostypes.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be hex ('0x...')", req.Contract,
+		)
+	}
+
+	// check if the participant is a hex address
+	if err := evmostypes.ValidateAddress(req.Participant); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for participant %s, should be hex ('0x...')", req.Participant,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	contract := common.HexToAddress(req.Contract)
+	participant := common.HexToAddress(req.Participant)
+
+	incentive, registered := k.GetIncentive(ctx, contract)
+	sponsored := k.HasActiveSponsorship(ctx, contract)
+	if !registered && !sponsored {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"incentive with contract '%s'",
+			req.Contract,
+		)
+	}
+
+	res := &types.QueryEstimatedRewardsResponse{
+		Rewards:          sdk.Coins{},
+		SponsoredRewards: sdk.Coins{},
+	}
+
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, params.IncentivesEpochIdentifier)
+	if found {
+		res.EpochEndTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+		if res.EpochEndTime.After(ctx.BlockTime()) {
+			res.EpochTimeRemaining = res.EpochEndTime.Sub(ctx.BlockTime())
+		}
+	}
+
+	// the inflation rewards aren't distributed while the incentives are
+	// disabled, unlike the sponsor-funded rewards
+	if registered && params.EnableIncentives {
+		rewardAllocations, _, err := k.rewardAllocations(ctx)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		res.GasMeter, _ = k.GetGasMeter(ctx, contract, participant)
+		res.TotalGas = incentive.TotalGas
+		res.Rewards = participantRewards(
+			incentive,
+			rewardAllocations[contract],
+			res.GasMeter,
+			k.evmKeeper.GetParams(ctx).EvmDenom,
+			params.RewardScaler,
+		)
+	}
+
+	if sponsored {
+		gasUsed, _ := k.GetSponsoredGasMeter(ctx, contract, participant)
+		totalGas := k.GetSponsoredTotalGas(ctx, contract)
+
+		k.IterateContractSponsorships(ctx, contract, func(sponsorship types.Sponsorship) (stop bool) {
+			if sponsorship.IsActive() {
+				rewards := sponsoredParticipantRewards(sponsorship.EpochRewards(), gasUsed, totalGas)
+				res.SponsoredRewards = res.SponsoredRewards.Add(rewards...)
+			}
+			return false
+		})
+	}
+
+	return res, nil
+}
@@ -466,6 +466,147 @@ func (suite *KeeperTestSuite) TestAllocationMeter() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEstimatedRewards() {
+	const (
+		mintAmount   int64  = 1000000
+		gasUsed      uint64 = 500
+		totalGasUsed uint64 = 1000
+	)
+
+	var req *types.QueryEstimatedRewardsRequest
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expRewards func() sdk.Coins
+		expPass    bool
+	}{
+		{
+			"invalid contract address",
+			func() {
+				req = &types.QueryEstimatedRewardsRequest{
+					Contract:    "1234",
+					Participant: participant.String(),
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"invalid participant address",
+			func() {
+				req = &types.QueryEstimatedRewardsRequest{
+					Contract:    contract.String(),
+					Participant: "1234",
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"incentive not found",
+			func() {
+				req = &types.QueryEstimatedRewardsRequest{
+					Contract:    contract2.String(),
+					Participant: participant.String(),
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"participant without gas meter",
+			func() {
+				req = &types.QueryEstimatedRewardsRequest{
+					Contract:    contract.String(),
+					Participant: participant2.String(),
+				}
+			},
+			func() sdk.Coins { return nil },
+			true,
+		},
+		{
+			"rewards match the distributed rewards",
+			func() {
+				req = &types.QueryEstimatedRewardsRequest{
+					Contract:    contract.String(),
+					Participant: participant.String(),
+				}
+			},
+			func() sdk.Coins {
+				err := suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+				suite.Require().NoError(err)
+				return suite.app.BankKeeper.GetAllBalances(suite.ctx, participant.Bytes())
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+
+			err := suite.app.BankKeeper.MintCoins(
+				suite.ctx,
+				types.ModuleName,
+				sdk.NewCoins(sdk.NewInt64Coin(denomMint, mintAmount), sdk.NewInt64Coin(denomCoin, mintAmount)),
+			)
+			suite.Require().NoError(err)
+
+			incentive, err := suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, contract, allocations, epochs)
+			suite.Require().NoError(err)
+
+			suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, gasUsed))
+			suite.app.IncentivesKeeper.SetIncentiveTotalGas(suite.ctx, *incentive, totalGasUsed)
+			suite.Commit()
+
+			tc.malleate()
+
+			res, err := suite.queryClient.EstimatedRewards(sdk.WrapSDKContext(suite.ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(totalGasUsed, res.TotalGas)
+			suite.Require().Equal(tc.expRewards(), res.Rewards)
+			suite.Require().True(res.SponsoredRewards.IsZero())
+
+			epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DefaultParams().IncentivesEpochIdentifier)
+			suite.Require().True(found)
+			expEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+			suite.Require().True(expEndTime.Equal(res.EpochEndTime))
+			suite.Require().Equal(expEndTime.Sub(suite.ctx.BlockTime()), res.EpochTimeRemaining)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimatedSponsoredRewards() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	sponsor := sdk.AccAddress(participant2.Bytes())
+	budget := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+	suite.app.IncentivesKeeper.SetSponsorship(
+		suite.ctx,
+		types.NewSponsorship(contract, sponsor, sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 600)), budget, epochs),
+	)
+	suite.app.IncentivesKeeper.SetSponsoredGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 250))
+	suite.app.IncentivesKeeper.SetSponsoredGasMeter(suite.ctx, types.NewGasMeter(contract, participant2, 750))
+	suite.Commit()
+
+	res, err := suite.queryClient.EstimatedRewards(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryEstimatedRewardsRequest{Contract: contract.String(), Participant: participant.String()},
+	)
+	suite.Require().NoError(err)
+
+	// the budget is capped by the remaining escrow
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 150)), res.SponsoredRewards)
+	suite.Require().True(res.Rewards.IsZero())
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	expParams := types.DefaultParams()
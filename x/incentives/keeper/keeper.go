@@ -41,8 +41,10 @@ type Keeper struct {
 	// Currently not used, but added to prevent breaking change s in case we want
 	// to allocate incentives to staking instead of transferring the deferred
 	// rewards to the user's wallet
-	stakeKeeper types.StakeKeeper
-	evmKeeper   types.EVMKeeper
+	stakeKeeper  types.StakeKeeper
+	evmKeeper    types.EVMKeeper
+	erc20Keeper  types.ERC20Keeper
+	epochsKeeper types.EpochsKeeper
 }
 
 // NewKeeper creates new instances of the incentives Keeper
@@ -55,6 +57,8 @@ func NewKeeper(
 	ik types.InflationKeeper,
 	sk types.StakeKeeper,
 	evmKeeper types.EVMKeeper,
+	erc20Keeper types.ERC20Keeper,
+	epochsKeeper types.EpochsKeeper,
 ) Keeper {
 	// ensure gov module account is set and is not nil
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -70,6 +74,8 @@ func NewKeeper(
 		inflationKeeper: ik,
 		stakeKeeper:     sk,
 		evmKeeper:       evmKeeper,
+		erc20Keeper:     erc20Keeper,
+		epochsKeeper:    epochsKeeper,
 	}
 }
 
@@ -18,10 +18,15 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
 	"github.com/evmos/evmos/v12/x/incentives/types"
 )
 
@@ -41,3 +46,187 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SponsorIncentive deposits rewards into the escrow of a sponsor-funded
+// incentive. The sponsorship is created if it doesn't exist yet. Otherwise, the
+// deposit is added to its escrow and the epoch budget and remaining epochs are
+// updated if set.
+func (k Keeper) SponsorIncentive(
+	goCtx context.Context,
+	msg *types.MsgSponsorIncentive,
+) (*types.MsgSponsorIncentiveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the Incentives are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableIncentives {
+		return nil, errorsmod.Wrap(
+			types.ErrInternalIncentive,
+			"incentives are currently disabled by governance",
+		)
+	}
+
+	sponsor := sdk.MustAccAddressFromBech32(msg.Sponsor)
+	contract := common.HexToAddress(msg.Contract)
+
+	// Check if contract exists
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInternalIncentive,
+			"contract doesn't exist: %s", contract,
+		)
+	}
+
+	sponsorship, found := k.GetSponsorship(ctx, contract, sponsor)
+	if !found {
+		sponsorship = types.NewSponsorship(contract, sponsor, sdk.Coins{}, sdk.Coins{}, 0)
+	}
+
+	if !msg.EpochBudget.IsZero() {
+		sponsorship.EpochBudget = msg.EpochBudget
+	}
+
+	if msg.Epochs > 0 {
+		sponsorship.Epochs = msg.Epochs
+	}
+
+	if !sponsorship.IsActive() {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"sponsorship for contract %s has no remaining epochs", contract,
+		)
+	}
+
+	if sponsorship.EpochBudget.IsZero() {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"sponsorship for contract %s has no epoch budget", contract,
+		)
+	}
+
+	if !msg.Deposit.IsZero() {
+		if err := k.convertSponsorDeposit(ctx, sponsor, msg.Deposit); err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			sponsor,
+			types.SponsorshipEscrowName,
+			msg.Deposit,
+		); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to escrow deposit %s", msg.Deposit)
+		}
+
+		sponsorship.Escrow = sponsorship.Escrow.Add(msg.Deposit...)
+	}
+
+	k.SetSponsorship(ctx, sponsorship)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSponsorIncentive,
+				sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+				sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Deposit.String()),
+				sdk.NewAttribute(types.AttributeKeyEpochs, strconv.FormatUint(uint64(sponsorship.Epochs), 10)),
+			),
+		},
+	)
+
+	return &types.MsgSponsorIncentiveResponse{}, nil
+}
+
+// WithdrawSponsorship returns the unspent escrow of a sponsorship without
+// remaining epochs to the sponsor and removes the sponsorship.
+func (k Keeper) WithdrawSponsorship(
+	goCtx context.Context,
+	msg *types.MsgWithdrawSponsorship,
+) (*types.MsgWithdrawSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsor := sdk.MustAccAddressFromBech32(msg.Sponsor)
+	contract := common.HexToAddress(msg.Contract)
+
+	sponsorship, found := k.GetSponsorship(ctx, contract, sponsor)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrSponsorshipNotFound,
+			"contract %s, sponsor %s", contract, msg.Sponsor,
+		)
+	}
+
+	if sponsorship.IsActive() {
+		return nil, errorsmod.Wrapf(
+			types.ErrSponsorshipActive,
+			"%d remaining epochs", sponsorship.Epochs,
+		)
+	}
+
+	if !sponsorship.Escrow.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.SponsorshipEscrowName,
+			sponsor,
+			sponsorship.Escrow,
+		); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to withdraw escrow %s", sponsorship.Escrow)
+		}
+	}
+
+	k.DeleteSponsorship(ctx, sponsorship)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeWithdrawSponsorship,
+				sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+				sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sponsorship.Escrow.String()),
+			),
+		},
+	)
+
+	return &types.MsgWithdrawSponsorshipResponse{Amount: sponsorship.Escrow}, nil
+}
+
+// convertSponsorDeposit converts the ERC20 tokens of the sponsor into the
+// deposited coins backed by a registered token pair, for the amount that
+// exceeds the sponsor's coin balance
+func (k Keeper) convertSponsorDeposit(
+	ctx sdk.Context,
+	sponsor sdk.AccAddress,
+	deposit sdk.Coins,
+) error {
+	for _, coin := range deposit {
+		balance := k.bankKeeper.GetBalance(ctx, sponsor, coin.Denom)
+		if balance.Amount.GTE(coin.Amount) {
+			continue
+		}
+
+		id := k.erc20Keeper.GetTokenPairID(ctx, coin.Denom)
+		if len(id) == 0 {
+			continue
+		}
+
+		pair, found := k.erc20Keeper.GetTokenPair(ctx, id)
+		if !found || !pair.Enabled {
+			continue
+		}
+
+		msg := &erc20types.MsgConvertERC20{
+			ContractAddress: pair.Erc20Address,
+			Amount:          coin.Amount.Sub(balance.Amount),
+			Receiver:        sponsor.String(),
+			Sender:          common.BytesToAddress(sponsor).Hex(),
+		}
+
+		if _, err := k.erc20Keeper.ConvertERC20(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return errorsmod.Wrapf(err, "failed to convert ERC20 deposit %s", coin)
+		}
+	}
+
+	return nil
+}
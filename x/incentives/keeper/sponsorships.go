@@ -0,0 +1,237 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/x/incentives/types"
+)
+
+// GetAllSponsorships - get all sponsor-funded incentives
+func (k Keeper) GetAllSponsorships(ctx sdk.Context) []types.Sponsorship {
+	sponsorships := []types.Sponsorship{}
+
+	k.IterateSponsorships(ctx, func(sponsorship types.Sponsorship) (stop bool) {
+		sponsorships = append(sponsorships, sponsorship)
+		return false
+	})
+
+	return sponsorships
+}
+
+// IterateSponsorships iterates over all the sponsor-funded incentives and
+// performs a callback.
+func (k Keeper) IterateSponsorships(
+	ctx sdk.Context,
+	handlerFn func(sponsorship types.Sponsorship) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixSponsorship)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.Sponsorship
+		k.cdc.MustUnmarshal(iterator.Value(), &sponsorship)
+
+		if handlerFn(sponsorship) {
+			break
+		}
+	}
+}
+
+// IterateContractSponsorships iterates over the sponsor-funded incentives of a
+// given contract and performs a callback.
+func (k Keeper) IterateContractSponsorships(
+	ctx sdk.Context,
+	contract common.Address,
+	handlerFn func(sponsorship types.Sponsorship) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	iterator := sdk.KVStorePrefixIterator(store, contract.Bytes())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.Sponsorship
+		k.cdc.MustUnmarshal(iterator.Value(), &sponsorship)
+
+		if handlerFn(sponsorship) {
+			break
+		}
+	}
+}
+
+// GetSponsorship - get the sponsorship of a contract funded by a sponsor
+func (k Keeper) GetSponsorship(
+	ctx sdk.Context,
+	contract common.Address,
+	sponsor sdk.AccAddress,
+) (types.Sponsorship, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	bz := store.Get(append(contract.Bytes(), sponsor.Bytes()...))
+	if len(bz) == 0 {
+		return types.Sponsorship{}, false
+	}
+
+	var sponsorship types.Sponsorship
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// SetSponsorship stores a sponsorship
+func (k Keeper) SetSponsorship(ctx sdk.Context, sponsorship types.Sponsorship) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	key := sponsorshipKey(sponsorship)
+	bz := k.cdc.MustMarshal(&sponsorship)
+	store.Set(key, bz)
+}
+
+// DeleteSponsorship removes a sponsorship
+func (k Keeper) DeleteSponsorship(ctx sdk.Context, sponsorship types.Sponsorship) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	store.Delete(sponsorshipKey(sponsorship))
+}
+
+// HasActiveSponsorship returns true if the contract has at least one
+// sponsorship with remaining epochs
+func (k Keeper) HasActiveSponsorship(ctx sdk.Context, contract common.Address) bool {
+	active := false
+	k.IterateContractSponsorships(ctx, contract, func(sponsorship types.Sponsorship) (stop bool) {
+		active = sponsorship.IsActive()
+		return active
+	})
+	return active
+}
+
+// sponsorshipKey returns the `<contract_address>|<sponsor_address>` store key
+// of a sponsorship
+func sponsorshipKey(sponsorship types.Sponsorship) []byte {
+	contract := common.HexToAddress(sponsorship.Contract)
+	sponsor := sdk.MustAccAddressFromBech32(sponsorship.Sponsor)
+	return append(contract.Bytes(), sponsor.Bytes()...)
+}
+
+// GetSponsoredGasMeters - get all the gas meters of sponsored contracts
+func (k Keeper) GetSponsoredGasMeters(ctx sdk.Context) []types.GasMeter {
+	gms := []types.GasMeter{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixSponsoredGasMeter)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contract, userAddress := types.SplitGasMeterKey(iterator.Key())
+		gas := sdk.BigEndianToUint64(iterator.Value())
+		gms = append(gms, types.NewGasMeter(contract, userAddress, gas))
+	}
+
+	return gms
+}
+
+// IterateSponsoredGasMeters iterates over the gas meters of a sponsored
+// contract and performs a callback.
+func (k Keeper) IterateSponsoredGasMeters(
+	ctx sdk.Context,
+	contract common.Address,
+	handlerFn func(gm types.GasMeter) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsoredGasMeter)
+	iterator := sdk.KVStorePrefixIterator(store, contract.Bytes())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contract, userAddress := types.SplitGasMeterKey(iterator.Key())
+		gas := sdk.BigEndianToUint64(iterator.Value())
+
+		if handlerFn(types.NewGasMeter(contract, userAddress, gas)) {
+			break
+		}
+	}
+}
+
+// GetSponsoredGasMeter - get cumulativeGas from the gas meter of a sponsored
+// contract
+func (k Keeper) GetSponsoredGasMeter(
+	ctx sdk.Context,
+	contract, participant common.Address,
+) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsoredGasMeter)
+	bz := store.Get(append(contract.Bytes(), participant.Bytes()...))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetSponsoredGasMeter stores the gas meter of a sponsored contract and adds
+// the difference to the total gas of the contract
+func (k Keeper) SetSponsoredGasMeter(ctx sdk.Context, gm types.GasMeter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsoredGasMeter)
+	contract := common.HexToAddress(gm.Contract)
+	participant := common.HexToAddress(gm.Participant)
+
+	previousGas, _ := k.GetSponsoredGasMeter(ctx, contract, participant)
+	totalGas := k.GetSponsoredTotalGas(ctx, contract) - previousGas + gm.CumulativeGas
+	k.setSponsoredTotalGas(ctx, contract, totalGas)
+
+	store.Set(append(contract.Bytes(), participant.Bytes()...), sdk.Uint64ToBigEndian(gm.CumulativeGas))
+}
+
+// GetSponsoredTotalGas returns the cumulative gas spent by all the
+// participants of a sponsored contract during the epoch
+func (k Keeper) GetSponsoredTotalGas(ctx sdk.Context, contract common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsoredTotalGas)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setSponsoredTotalGas stores the cumulative gas spent on a sponsored contract
+func (k Keeper) setSponsoredTotalGas(ctx sdk.Context, contract common.Address, totalGas uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsoredTotalGas)
+	if totalGas == 0 {
+		store.Delete(contract.Bytes())
+		return
+	}
+
+	store.Set(contract.Bytes(), sdk.Uint64ToBigEndian(totalGas))
+}
+
+// resetSponsoredGasMeters removes the gas meters and the total gas of a
+// sponsored contract
+func (k Keeper) resetSponsoredGasMeters(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsoredGasMeter)
+	iterator := sdk.KVStorePrefixIterator(store, contract.Bytes())
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	k.setSponsoredTotalGas(ctx, contract, 0)
+}
@@ -0,0 +1,308 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/incentives/types"
+)
+
+func (suite *KeeperTestSuite) TestSponsorIncentive() {
+	var (
+		sponsor     sdk.AccAddress
+		msg         *types.MsgSponsorIncentive
+		deposit     = sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+		epochBudget = sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100))
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expEscrow sdk.Coins
+		expEpochs uint32
+		expPass   bool
+	}{
+		{
+			"fail - incentives are disabled",
+			func() {
+				params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+				params.EnableIncentives = false
+				err := suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			nil,
+			0,
+			false,
+		},
+		{
+			"fail - contract doesn't exist",
+			func() {
+				msg.Contract = utiltx.GenerateAddress().String()
+			},
+			nil,
+			0,
+			false,
+		},
+		{
+			"fail - new sponsorship without epochs",
+			func() {
+				msg.Epochs = 0
+			},
+			nil,
+			0,
+			false,
+		},
+		{
+			"fail - new sponsorship without epoch budget",
+			func() {
+				msg.EpochBudget = sdk.Coins{}
+			},
+			nil,
+			0,
+			false,
+		},
+		{
+			"fail - insufficient funds",
+			func() {
+				msg.Deposit = sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 2000))
+			},
+			nil,
+			0,
+			false,
+		},
+		{
+			"pass - create sponsorship",
+			func() {},
+			deposit,
+			epochs,
+			true,
+		},
+		{
+			"pass - top up sponsorship without updating the budget",
+			func() {
+				_, err := suite.app.IncentivesKeeper.SponsorIncentive(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+				err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, deposit)
+				suite.Require().NoError(err)
+
+				msg = types.NewMsgSponsorIncentive(sponsor, contract, deposit, nil, 0)
+			},
+			deposit.Add(deposit...),
+			epochs,
+			true,
+		},
+		{
+			"pass - extend finished sponsorship",
+			func() {
+				suite.app.IncentivesKeeper.SetSponsorship(
+					suite.ctx,
+					types.NewSponsorship(contract, sponsor, sdk.Coins{}, epochBudget, 0),
+				)
+				msg.EpochBudget = nil
+				msg.Epochs = 2
+			},
+			deposit,
+			2,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+
+			sponsor = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, deposit)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgSponsorIncentive(sponsor, contract, deposit, epochBudget, epochs)
+
+			tc.malleate()
+
+			_, err = suite.app.IncentivesKeeper.SponsorIncentive(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			sponsorship, found := suite.app.IncentivesKeeper.GetSponsorship(suite.ctx, contract, sponsor)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expEscrow, sponsorship.Escrow)
+			suite.Require().Equal(epochBudget, sponsorship.EpochBudget)
+			suite.Require().Equal(tc.expEpochs, sponsorship.Epochs)
+
+			escrowAddr := suite.app.AccountKeeper.GetModuleAddress(types.SponsorshipEscrowName)
+			suite.Require().Equal(tc.expEscrow, suite.app.BankKeeper.GetAllBalances(suite.ctx, escrowAddr))
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sponsor, denomCoin).IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSponsorIncentiveERC20() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
+	suite.Require().NoError(err)
+
+	suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(1000))
+	suite.Commit()
+
+	sponsor := sdk.AccAddress(suite.address.Bytes())
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 600))
+	msg := types.NewMsgSponsorIncentive(sponsor, contract2, deposit, deposit, 1)
+
+	_, err = suite.app.IncentivesKeeper.SponsorIncentive(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	// the deposit is converted from the sponsor's ERC20 balance
+	suite.Require().Equal(int64(400), suite.BalanceOf(contract, suite.address).Int64())
+
+	escrowAddr := suite.app.AccountKeeper.GetModuleAddress(types.SponsorshipEscrowName)
+	suite.Require().Equal(deposit, suite.app.BankKeeper.GetAllBalances(suite.ctx, escrowAddr))
+}
+
+func (suite *KeeperTestSuite) TestDistributeSponsorships() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	var (
+		sponsor1 = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		sponsor2 = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		deposit  = sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1500))
+	)
+
+	for _, sponsor := range []sdk.AccAddress{sponsor1, sponsor2} {
+		err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, deposit)
+		suite.Require().NoError(err)
+	}
+
+	// sponsor 1 pays 1000 per epoch for 2 epochs, sponsor 2 pays 100 per epoch
+	// for a single epoch
+	_, err := suite.app.IncentivesKeeper.SponsorIncentive(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgSponsorIncentive(sponsor1, contract, deposit, sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000)), 2),
+	)
+	suite.Require().NoError(err)
+	_, err = suite.app.IncentivesKeeper.SponsorIncentive(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgSponsorIncentive(sponsor2, contract, deposit, sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100)), 1),
+	)
+	suite.Require().NoError(err)
+
+	suite.app.IncentivesKeeper.SetSponsoredGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 300))
+	suite.app.IncentivesKeeper.SetSponsoredGasMeter(suite.ctx, types.NewGasMeter(contract, participant2, 700))
+	suite.Require().Equal(uint64(1000), suite.app.IncentivesKeeper.GetSponsoredTotalGas(suite.ctx, contract))
+
+	// first epoch
+	suite.app.IncentivesKeeper.DistributeSponsorships(suite.ctx)
+
+	suite.Require().Equal(int64(330), suite.app.BankKeeper.GetBalance(suite.ctx, participant.Bytes(), denomCoin).Amount.Int64())
+	suite.Require().Equal(int64(770), suite.app.BankKeeper.GetBalance(suite.ctx, participant2.Bytes(), denomCoin).Amount.Int64())
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetSponsoredGasMeters(suite.ctx))
+	suite.Require().Zero(suite.app.IncentivesKeeper.GetSponsoredTotalGas(suite.ctx, contract))
+
+	sponsorship, found := suite.app.IncentivesKeeper.GetSponsorship(suite.ctx, contract, sponsor1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), sponsorship.Epochs)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 500)), sponsorship.Escrow)
+
+	// sponsor 2 finished and withdraws its unspent escrow
+	_, err = suite.app.IncentivesKeeper.WithdrawSponsorship(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgWithdrawSponsorship(sponsor2, contract),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1400), suite.app.BankKeeper.GetBalance(suite.ctx, sponsor2, denomCoin).Amount.Int64())
+
+	// the sponsor 1 can't withdraw an active sponsorship
+	_, err = suite.app.IncentivesKeeper.WithdrawSponsorship(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgWithdrawSponsorship(sponsor1, contract),
+	)
+	suite.Require().ErrorIs(err, types.ErrSponsorshipActive)
+
+	// second epoch, the budget is capped by the remaining escrow
+	suite.app.IncentivesKeeper.SetSponsoredGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 100))
+	suite.app.IncentivesKeeper.DistributeSponsorships(suite.ctx)
+
+	suite.Require().Equal(int64(830), suite.app.BankKeeper.GetBalance(suite.ctx, participant.Bytes(), denomCoin).Amount.Int64())
+
+	// finished sponsorships without escrow are removed
+	_, found = suite.app.IncentivesKeeper.GetSponsorship(suite.ctx, contract, sponsor1)
+	suite.Require().False(found)
+
+	escrowAddr := suite.app.AccountKeeper.GetModuleAddress(types.SponsorshipEscrowName)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, escrowAddr).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessingSponsored() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	suite.app.IncentivesKeeper.SetSponsorship(
+		suite.ctx,
+		types.NewSponsorship(contract, sponsor, sdk.Coins{}, sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100)), epochs),
+	)
+
+	suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(1000))
+
+	gas, found := suite.app.IncentivesKeeper.GetSponsoredGasMeter(suite.ctx, contract, suite.address)
+	suite.Require().True(found)
+	suite.Require().NotZero(gas)
+	suite.Require().Equal(gas, suite.app.IncentivesKeeper.GetSponsoredTotalGas(suite.ctx, contract))
+
+	// contracts without sponsorships or incentives are not metered
+	_, found = suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, suite.address)
+	suite.Require().False(found)
+
+	suite.MintERC20Token(contract2, suite.address, suite.address, big.NewInt(1000))
+	_, found = suite.app.IncentivesKeeper.GetSponsoredGasMeter(suite.ctx, contract2, suite.address)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSponsorshipsWithIncentivesDisabled() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 200))
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, deposit)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.SponsorIncentive(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgSponsorIncentive(sponsor, contract, deposit, sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100)), 2),
+	)
+	suite.Require().NoError(err)
+
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	params.EnableIncentives = false
+	err = suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(err)
+
+	// the sponsored gas is still metered
+	suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(1000))
+	gas, found := suite.app.IncentivesKeeper.GetSponsoredGasMeter(suite.ctx, contract, suite.address)
+	suite.Require().True(found)
+	suite.Require().NotZero(gas)
+
+	// and the sponsorship epochs are distributed
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), denomCoin)
+	suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, params.IncentivesEpochIdentifier, 1)
+
+	sponsorship, found := suite.app.IncentivesKeeper.GetSponsorship(suite.ctx, contract, sponsor)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), sponsorship.Epochs)
+	suite.Require().Equal(
+		balance.AddAmount(sdk.NewInt(100)),
+		suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), denomCoin),
+	)
+}
@@ -36,7 +36,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "evmos/incentives/MsgUpdateParams"
+	updateParamsName        = "evmos/incentives/MsgUpdateParams"
+	sponsorIncentiveName    = "evmos/incentives/MsgSponsorIncentive"
+	withdrawSponsorshipName = "evmos/incentives/MsgWithdrawSponsorship"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -50,6 +52,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSponsorIncentive{},
+		&MsgWithdrawSponsorship{},
 	)
 
 	registry.RegisterImplementations(
@@ -64,4 +68,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgSponsorIncentive{}, sponsorIncentiveName, nil)
+	cdc.RegisterConcrete(&MsgWithdrawSponsorship{}, withdrawSponsorshipName, nil)
 }
@@ -22,5 +22,7 @@ import (
 
 // errors
 var (
-	ErrInternalIncentive = errorsmod.Register(ModuleName, 2, "internal incentives error")
+	ErrInternalIncentive   = errorsmod.Register(ModuleName, 2, "internal incentives error")
+	ErrSponsorshipNotFound = errorsmod.Register(ModuleName, 3, "sponsorship not found")
+	ErrSponsorshipActive   = errorsmod.Register(ModuleName, 4, "sponsorship is still active")
 )
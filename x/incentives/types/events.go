@@ -18,10 +18,14 @@ package types
 
 // incentives events
 const (
-	EventTypeRegisterIncentive    = "register_incentive"
-	EventTypeCancelIncentive      = "cancel_incentive"
-	EventTypeDistributeIncentives = "distribute_incentives"
+	EventTypeRegisterIncentive     = "register_incentive"
+	EventTypeCancelIncentive       = "cancel_incentive"
+	EventTypeDistributeIncentives  = "distribute_incentives"
+	EventTypeSponsorIncentive      = "sponsor_incentive"
+	EventTypeWithdrawSponsorship   = "withdraw_sponsorship"
+	EventTypeDistributeSponsorship = "distribute_sponsorship"
 
 	AttributeKeyContract = "contract"
 	AttributeKeyEpochs   = "epochs"
+	AttributeKeySponsor  = "sponsor"
 )
@@ -73,5 +73,39 @@ func (gs GenesisState) Validate() error {
 		seenGasMeters[gm.Contract+gm.Participant] = true
 	}
 
+	seenSponsorships := make(map[string]bool)
+	for _, s := range gs.Sponsorships {
+		// only one sponsorship per contract+sponsor combination
+		if seenSponsorships[s.Contract+s.Sponsor] {
+			return fmt.Errorf(
+				"sponsorship duplicated on genesis contract: '%s', sponsor: '%s'",
+				s.Contract, s.Sponsor,
+			)
+		}
+
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		seenSponsorships[s.Contract+s.Sponsor] = true
+	}
+
+	seenSponsoredGasMeters := make(map[string]bool)
+	for _, gm := range gs.SponsoredGasMeters {
+		// only one sponsored gas meter per contract+participant combination
+		if seenSponsoredGasMeters[gm.Contract+gm.Participant] {
+			return fmt.Errorf(
+				"sponsored gas meter duplicated on genesis contract: '%s', participant: '%s'",
+				gm.Contract, gm.Participant,
+			)
+		}
+
+		if err := gm.Validate(); err != nil {
+			return err
+		}
+
+		seenSponsoredGasMeters[gm.Contract+gm.Participant] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -32,6 +32,10 @@ type GenesisState struct {
 	Incentives []Incentive `protobuf:"bytes,2,rep,name=incentives,proto3" json:"incentives"`
 	// gas_meters is a slice of active Gasmeters
 	GasMeters []GasMeter `protobuf:"bytes,3,rep,name=gas_meters,json=gasMeters,proto3" json:"gas_meters"`
+	// sponsorships is a slice of sponsor-funded incentives
+	Sponsorships []Sponsorship `protobuf:"bytes,4,rep,name=sponsorships,proto3" json:"sponsorships"`
+	// sponsored_gas_meters is a slice of the gas meters of sponsored contracts
+	SponsoredGasMeters []GasMeter `protobuf:"bytes,5,rep,name=sponsored_gas_meters,json=sponsoredGasMeters,proto3" json:"sponsored_gas_meters"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +92,20 @@ func (m *GenesisState) GetGasMeters() []GasMeter {
 	return nil
 }
 
+func (m *GenesisState) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsoredGasMeters() []GasMeter {
+	if m != nil {
+		return m.SponsoredGasMeters
+	}
+	return nil
+}
+
 // Params defines the incentives module params
 type Params struct {
 	// enable_incentives is the parameter to enable incentives
@@ -155,33 +173,35 @@ func init() {
 func init() { proto.RegisterFile("evmos/incentives/v1/genesis.proto", fileDescriptor_7bb1f7c7e8ad160b) }
 
 var fileDescriptor_7bb1f7c7e8ad160b = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0xca, 0xd3, 0x40,
-	0x10, 0xc7, 0x93, 0xf6, 0xa3, 0xd8, 0x6d, 0xc5, 0x1a, 0x3d, 0xc4, 0x16, 0xd3, 0x5a, 0x44, 0x0a,
-	0xd2, 0x0d, 0xad, 0x27, 0x2f, 0x1e, 0x42, 0xa5, 0x14, 0x14, 0x24, 0x3d, 0xe9, 0x25, 0x6c, 0xd3,
-	0x31, 0x5d, 0x4c, 0xb2, 0x21, 0xbb, 0x46, 0x7d, 0x0b, 0x5f, 0xc2, 0x77, 0xe9, 0xb1, 0xe0, 0x45,
-	0x3c, 0x14, 0x69, 0x5f, 0x44, 0x76, 0x37, 0x9a, 0x1c, 0x72, 0xfa, 0x2e, 0xc9, 0x4e, 0xf2, 0x9b,
-	0xdf, 0x7f, 0x12, 0x06, 0x3d, 0x81, 0x22, 0x61, 0xdc, 0xa5, 0x69, 0x08, 0xa9, 0xa0, 0x05, 0x70,
-	0xb7, 0x58, 0xb8, 0x11, 0xa4, 0xc0, 0x29, 0xc7, 0x59, 0xce, 0x04, 0xb3, 0x1e, 0x28, 0x04, 0x57,
-	0x08, 0x2e, 0x16, 0xc3, 0xa7, 0x4d, 0x7d, 0x35, 0x44, 0xb5, 0x0e, 0x1f, 0x46, 0x2c, 0x62, 0xea,
-	0xe8, 0xca, 0x93, 0x7e, 0x3a, 0xfd, 0x69, 0xa2, 0xfe, 0x5a, 0x47, 0x6c, 0x05, 0x11, 0x60, 0xbd,
-	0x44, 0x9d, 0x8c, 0xe4, 0x24, 0xe1, 0xb6, 0x39, 0x31, 0x67, 0xbd, 0xe5, 0x08, 0x37, 0x44, 0xe2,
-	0x77, 0x0a, 0xf1, 0x6e, 0x8e, 0xe7, 0xb1, 0xe1, 0x97, 0x0d, 0xd6, 0x0a, 0xa1, 0x8a, 0xb2, 0x5b,
-	0x93, 0xf6, 0xac, 0xb7, 0x74, 0x1a, 0xdb, 0x37, 0xff, 0xaa, 0xd2, 0x50, 0xeb, 0xb3, 0x3c, 0x84,
-	0x22, 0xc2, 0x83, 0x04, 0x04, 0xe4, 0xdc, 0x6e, 0x2b, 0xcb, 0xe3, 0x46, 0xcb, 0x9a, 0xf0, 0xb7,
-	0x92, 0x2a, 0x25, 0xdd, 0xa8, 0xac, 0xf9, 0xf4, 0x47, 0x0b, 0x75, 0xf4, 0x88, 0xd6, 0x73, 0x74,
-	0x1f, 0x52, 0xb2, 0x8b, 0x21, 0xa8, 0xcd, 0x26, 0x3f, 0xed, 0x8e, 0x3f, 0xd0, 0x2f, 0x36, 0x55,
-	0xf6, 0x7b, 0x34, 0x20, 0x71, 0xcc, 0x42, 0x22, 0x28, 0x4b, 0x83, 0x98, 0x26, 0x54, 0xd8, 0xad,
-	0x89, 0x39, 0xeb, 0x7a, 0x58, 0x46, 0xfc, 0x3e, 0x8f, 0x9f, 0x45, 0x54, 0x1c, 0x3e, 0xef, 0x70,
-	0xc8, 0x12, 0x37, 0x64, 0x5c, 0xfe, 0x77, 0x7d, 0x9b, 0xf3, 0xfd, 0x27, 0x57, 0x7c, 0xcb, 0x80,
-	0xe3, 0x15, 0x84, 0xfe, 0xbd, 0xca, 0xf3, 0x46, 0x6a, 0xac, 0x57, 0x68, 0x54, 0x0d, 0x10, 0x40,
-	0xc6, 0xc2, 0x43, 0x40, 0xf7, 0xb2, 0xfe, 0x48, 0x21, 0xb7, 0xdb, 0x32, 0xc5, 0x7f, 0x54, 0x21,
-	0xaf, 0x25, 0xb1, 0xf9, 0x0f, 0x58, 0x5b, 0x74, 0x37, 0x87, 0x2f, 0x24, 0xdf, 0x07, 0x3c, 0x24,
-	0x31, 0xe4, 0xf6, 0xcd, 0xad, 0xe6, 0xea, 0x6b, 0xc9, 0x56, 0x39, 0xbc, 0xf5, 0xf1, 0xe2, 0x98,
-	0xa7, 0x8b, 0x63, 0xfe, 0xb9, 0x38, 0xe6, 0xf7, 0xab, 0x63, 0x9c, 0xae, 0x8e, 0xf1, 0xeb, 0xea,
-	0x18, 0x1f, 0xe6, 0x35, 0x9f, 0x5e, 0x2f, 0x7d, 0x2d, 0x16, 0x4b, 0xf7, 0x6b, 0x7d, 0xd5, 0x94,
-	0x7a, 0xd7, 0x51, 0xdb, 0xf4, 0xe2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0x34, 0xa5, 0x13,
-	0xc3, 0x02, 0x00, 0x00,
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0x80, 0x9b, 0xb6, 0x16, 0x77, 0xb6, 0xe2, 0x3a, 0xee, 0x21, 0xee, 0x62, 0xb6, 0x2e, 0x22,
+	0x05, 0xd9, 0x84, 0xd6, 0x93, 0x17, 0x0f, 0x65, 0xa5, 0x54, 0x14, 0xa4, 0xc5, 0x83, 0x5e, 0xc2,
+	0x34, 0x7d, 0xa6, 0x83, 0x49, 0x26, 0xcc, 0x1b, 0xa3, 0xfe, 0x0b, 0xff, 0x84, 0xff, 0x65, 0x8f,
+	0x7b, 0x14, 0x0f, 0x8b, 0xb4, 0xff, 0xc1, 0xb3, 0xcc, 0x4c, 0xb6, 0x89, 0x90, 0x83, 0xec, 0x25,
+	0x99, 0x37, 0xf3, 0xbd, 0xef, 0x3d, 0x1e, 0x33, 0xe4, 0x11, 0x14, 0xa9, 0xc0, 0x80, 0x67, 0x11,
+	0x64, 0x8a, 0x17, 0x80, 0x41, 0x31, 0x0a, 0x62, 0xc8, 0x00, 0x39, 0xfa, 0xb9, 0x14, 0x4a, 0xd0,
+	0xfb, 0x06, 0xf1, 0x2b, 0xc4, 0x2f, 0x46, 0x47, 0x8f, 0x9b, 0xf2, 0x6a, 0x88, 0x49, 0x3d, 0x3a,
+	0x8c, 0x45, 0x2c, 0xcc, 0x32, 0xd0, 0x2b, 0xbb, 0x7b, 0xfa, 0xa7, 0x4d, 0xfa, 0x53, 0x5b, 0x62,
+	0xa1, 0x98, 0x02, 0xfa, 0x9c, 0xf4, 0x72, 0x26, 0x59, 0x8a, 0xae, 0x33, 0x70, 0x86, 0xfb, 0xe3,
+	0x63, 0xbf, 0xa1, 0xa4, 0xff, 0xd6, 0x20, 0x93, 0xee, 0xc5, 0xd5, 0x49, 0x6b, 0x5e, 0x26, 0xd0,
+	0x73, 0x42, 0x2a, 0xca, 0x6d, 0x0f, 0x3a, 0xc3, 0xfd, 0xb1, 0xd7, 0x98, 0x3e, 0xbb, 0x8e, 0x4a,
+	0x43, 0x2d, 0x8f, 0x4e, 0x08, 0x89, 0x19, 0x86, 0x29, 0x28, 0x90, 0xe8, 0x76, 0x8c, 0xe5, 0x61,
+	0xa3, 0x65, 0xca, 0xf0, 0x8d, 0xa6, 0x4a, 0xc9, 0x5e, 0x5c, 0xc6, 0x48, 0x5f, 0x91, 0x3e, 0xe6,
+	0x22, 0x43, 0x21, 0x71, 0xcd, 0x73, 0x74, 0xbb, 0xc6, 0x32, 0x68, 0xb4, 0x2c, 0x2a, 0xb0, 0x14,
+	0xfd, 0x93, 0x4b, 0xdf, 0x91, 0xc3, 0x32, 0x86, 0x55, 0x58, 0xeb, 0xec, 0xd6, 0xff, 0x77, 0x46,
+	0x77, 0x82, 0xeb, 0x03, 0x3c, 0xfd, 0xd1, 0x26, 0x3d, 0x3b, 0x45, 0xfa, 0x94, 0xdc, 0x83, 0x8c,
+	0x2d, 0x13, 0x08, 0x6b, 0xe3, 0xd3, 0xd3, 0xbf, 0x3d, 0x3f, 0xb0, 0x07, 0xb3, 0x6a, 0x3c, 0xef,
+	0xc9, 0x01, 0x4b, 0x12, 0x11, 0x31, 0xc5, 0x45, 0x16, 0x26, 0x3c, 0xe5, 0xca, 0x6d, 0x0f, 0x9c,
+	0xe1, 0xde, 0xc4, 0xd7, 0xb5, 0x7e, 0x5d, 0x9d, 0x3c, 0x89, 0xb9, 0x5a, 0x7f, 0x5e, 0xfa, 0x91,
+	0x48, 0x83, 0x48, 0xa0, 0xbe, 0x1a, 0xf6, 0x77, 0x86, 0xab, 0x4f, 0x81, 0xfa, 0x96, 0x03, 0xfa,
+	0xe7, 0x10, 0xcd, 0xef, 0x56, 0x9e, 0xd7, 0x5a, 0x43, 0x5f, 0x90, 0xe3, 0xaa, 0x81, 0x10, 0x72,
+	0x11, 0xad, 0x43, 0xbe, 0xd2, 0xf1, 0x47, 0x0e, 0xd2, 0xed, 0xe8, 0x2a, 0xf3, 0x07, 0x15, 0xf2,
+	0x52, 0x13, 0xb3, 0x1d, 0x40, 0x17, 0xe4, 0x8e, 0x84, 0x2f, 0x4c, 0xae, 0x42, 0x8c, 0x58, 0x02,
+	0xd2, 0xed, 0xde, 0xa8, 0xaf, 0xbe, 0x95, 0x2c, 0x8c, 0x63, 0x32, 0xbd, 0xd8, 0x78, 0xce, 0xe5,
+	0xc6, 0x73, 0x7e, 0x6f, 0x3c, 0xe7, 0xfb, 0xd6, 0x6b, 0x5d, 0x6e, 0xbd, 0xd6, 0xcf, 0xad, 0xd7,
+	0xfa, 0x70, 0x56, 0xf3, 0xd9, 0x17, 0x60, 0xbf, 0xc5, 0x68, 0x1c, 0x7c, 0xad, 0xbf, 0x06, 0xa3,
+	0x5e, 0xf6, 0xcc, 0x85, 0x7f, 0xf6, 0x77, 0x00, 0x7f, 0x06, 0xf6, 0xde, 0x66, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -204,6 +224,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SponsoredGasMeters) > 0 {
+		for iNdEx := len(m.SponsoredGasMeters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsoredGasMeters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.GasMeters) > 0 {
 		for iNdEx := len(m.GasMeters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -336,6 +384,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsoredGasMeters) > 0 {
+		for _, e := range m.SponsoredGasMeters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -495,6 +555,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredGasMeters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsoredGasMeters = append(m.SponsoredGasMeters, GasMeter{})
+			if err := m.SponsoredGasMeters[len(m.SponsoredGasMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -176,6 +176,91 @@ func (m *GasMeter) GetCumulativeGas() uint64 {
 	return 0
 }
 
+// Sponsorship defines a sponsor-funded incentive for a given smart contract.
+// The sponsor escrows the rewards that are distributed each epoch to the
+// contract participants in proportion to their gas meters, up to the budget
+// per epoch.
+type Sponsorship struct {
+	// contract address of the smart contract to be incentivized
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// sponsor is the bech32 address of the account that funds the incentive
+	Sponsor string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// escrow is the remaining balance deposited by the sponsor
+	Escrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=escrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrow"`
+	// epoch_budget is the maximum amount distributed to the participants per epoch
+	EpochBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=epoch_budget,json=epochBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_budget"`
+	// epochs defines the number of remaining epochs for the sponsorship
+	Epochs uint32 `protobuf:"varint,5,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{2}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+func (m *Sponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrow
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetEpochBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochBudget
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
 // RegisterIncentiveProposal is a gov Content type to register an incentive
 type RegisterIncentiveProposal struct {
 	// title of the proposal
@@ -194,7 +279,7 @@ func (m *RegisterIncentiveProposal) Reset()         { *m = RegisterIncentiveProp
 func (m *RegisterIncentiveProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterIncentiveProposal) ProtoMessage()    {}
 func (*RegisterIncentiveProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{2}
+	return fileDescriptor_95b81e40854aec77, []int{3}
 }
 func (m *RegisterIncentiveProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +357,7 @@ func (m *CancelIncentiveProposal) Reset()         { *m = CancelIncentiveProposal
 func (m *CancelIncentiveProposal) String() string { return proto.CompactTextString(m) }
 func (*CancelIncentiveProposal) ProtoMessage()    {}
 func (*CancelIncentiveProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{3}
+	return fileDescriptor_95b81e40854aec77, []int{4}
 }
 func (m *CancelIncentiveProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,6 +410,7 @@ func (m *CancelIncentiveProposal) GetContract() string {
 func init() {
 	proto.RegisterType((*Incentive)(nil), "evmos.incentives.v1.Incentive")
 	proto.RegisterType((*GasMeter)(nil), "evmos.incentives.v1.GasMeter")
+	proto.RegisterType((*Sponsorship)(nil), "evmos.incentives.v1.Sponsorship")
 	proto.RegisterType((*RegisterIncentiveProposal)(nil), "evmos.incentives.v1.RegisterIncentiveProposal")
 	proto.RegisterType((*CancelIncentiveProposal)(nil), "evmos.incentives.v1.CancelIncentiveProposal")
 }
@@ -334,38 +420,43 @@ func init() {
 }
 
 var fileDescriptor_95b81e40854aec77 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xbf, 0x6f, 0xd4, 0x30,
-	0x18, 0x4d, 0xee, 0x47, 0x75, 0xe7, 0x53, 0x19, 0x42, 0x05, 0xe1, 0x40, 0x49, 0x74, 0x02, 0x29,
-	0x12, 0xaa, 0xad, 0x5c, 0x37, 0xc6, 0x3b, 0xa4, 0x13, 0x03, 0x12, 0x8a, 0x98, 0x58, 0x2a, 0xc7,
-	0x35, 0xa9, 0x45, 0x12, 0x47, 0xf1, 0x77, 0x11, 0xac, 0xfc, 0x05, 0x9d, 0x98, 0x99, 0xf9, 0x4b,
-	0x3a, 0x76, 0x64, 0xa2, 0xe8, 0x6e, 0xe1, 0xcf, 0x40, 0x76, 0x92, 0x12, 0x96, 0x8e, 0xb7, 0x24,
-	0x7e, 0xcf, 0xfe, 0xf4, 0xde, 0xfb, 0x3e, 0x1b, 0x3d, 0xe7, 0x75, 0x2e, 0x15, 0x11, 0x05, 0xe3,
-	0x05, 0x88, 0x9a, 0x2b, 0x52, 0x47, 0x3d, 0x84, 0xcb, 0x4a, 0x82, 0x74, 0x1e, 0x9a, 0x53, 0xb8,
-	0xc7, 0xd7, 0xd1, 0xdc, 0x63, 0x52, 0xe9, 0xda, 0x84, 0x2a, 0x4e, 0xea, 0x28, 0xe1, 0x40, 0x23,
-	0xc2, 0xa4, 0x28, 0x9a, 0xa2, 0xf9, 0x49, 0x2a, 0x53, 0x69, 0x96, 0x44, 0xaf, 0x5a, 0xd6, 0x4f,
-	0xa5, 0x4c, 0x33, 0x4e, 0x0c, 0x4a, 0xb6, 0x1f, 0x09, 0x88, 0x9c, 0x2b, 0xa0, 0x79, 0xd9, 0x1c,
-	0x58, 0x7c, 0x1b, 0xa0, 0xe9, 0x9b, 0x4e, 0xc8, 0x99, 0xa3, 0x09, 0x93, 0x05, 0x54, 0x94, 0x81,
-	0x6b, 0x07, 0x76, 0x38, 0x8d, 0xef, 0xb0, 0xa3, 0xd0, 0x8c, 0x66, 0x99, 0x64, 0x14, 0x84, 0x2c,
-	0x94, 0x3b, 0x08, 0x86, 0xe1, 0x6c, 0xf9, 0x0c, 0x37, 0xb6, 0xb0, 0xb6, 0x85, 0x5b, 0x5b, 0xf8,
-	0x35, 0x67, 0x6b, 0x29, 0x8a, 0xd5, 0xd9, 0xf5, 0x2f, 0xdf, 0xfa, 0x71, 0xeb, 0xbf, 0x4c, 0x05,
-	0x5c, 0x6e, 0x13, 0xcc, 0x64, 0x4e, 0xda, 0x18, 0xcd, 0xef, 0x54, 0x5d, 0x7c, 0x22, 0xf0, 0xa5,
-	0xe4, 0xaa, 0xab, 0x51, 0x71, 0x5f, 0xc5, 0x79, 0x84, 0x8e, 0x78, 0x29, 0xd9, 0xa5, 0x72, 0x87,
-	0x81, 0x1d, 0x1e, 0xc7, 0x2d, 0x72, 0xd6, 0x08, 0x29, 0xa0, 0x15, 0x9c, 0xeb, 0x3c, 0xee, 0x28,
-	0xb0, 0xc3, 0xd9, 0x72, 0x8e, 0x9b, 0xb0, 0xb8, 0x0b, 0x8b, 0xdf, 0x77, 0x61, 0x57, 0x13, 0xed,
-	0xe4, 0xea, 0xd6, 0xb7, 0xe3, 0xa9, 0xa9, 0xd3, 0x3b, 0xce, 0x53, 0x34, 0x05, 0x09, 0x34, 0x3b,
-	0x4f, 0xa9, 0x72, 0xc7, 0x81, 0x1d, 0x8e, 0xe2, 0x89, 0x21, 0x36, 0x54, 0x2d, 0x24, 0x9a, 0x6c,
-	0xa8, 0x7a, 0xcb, 0x81, 0x57, 0xf7, 0xb6, 0x25, 0x40, 0xb3, 0x92, 0x56, 0x20, 0x98, 0x28, 0x69,
-	0x01, 0xee, 0xc0, 0x6c, 0xf7, 0x29, 0xe7, 0x05, 0x7a, 0xc0, 0xb6, 0xf9, 0x36, 0xa3, 0xba, 0xc5,
-	0x46, 0x6b, 0x68, 0xb4, 0x8e, 0xff, 0xb1, 0x5a, 0xf0, 0xeb, 0x00, 0x3d, 0x89, 0x79, 0x2a, 0x14,
-	0xf0, 0xea, 0x6e, 0x22, 0xef, 0x2a, 0x59, 0x4a, 0x45, 0x33, 0xe7, 0x04, 0x8d, 0x41, 0x40, 0xc6,
-	0x5b, 0xfd, 0x06, 0x68, 0xf1, 0x0b, 0xae, 0x58, 0x25, 0x4a, 0xdd, 0xae, 0x4e, 0xbc, 0x47, 0xfd,
-	0x67, 0x7d, 0x78, 0xff, 0x44, 0x47, 0x07, 0x9e, 0xe8, 0xb8, 0x3f, 0xd1, 0x57, 0xa3, 0x3f, 0xdf,
-	0x7d, 0x6b, 0xa1, 0xd0, 0xe3, 0x35, 0x2d, 0x18, 0xcf, 0x0e, 0xd2, 0x81, 0x46, 0x74, 0xb5, 0xb9,
-	0xde, 0x79, 0xf6, 0xcd, 0xce, 0xb3, 0x7f, 0xef, 0x3c, 0xfb, 0x6a, 0xef, 0x59, 0x37, 0x7b, 0xcf,
-	0xfa, 0xb9, 0xf7, 0xac, 0x0f, 0xa7, 0xbd, 0x98, 0xcd, 0xd3, 0x6d, 0xbe, 0x75, 0xb4, 0x24, 0x9f,
-	0xfb, 0xcf, 0xd8, 0x24, 0x4e, 0x8e, 0xcc, 0xcd, 0x3b, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x25,
-	0x13, 0x43, 0xfa, 0xe7, 0x03, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbd, 0x8e, 0xd3, 0x40,
+	0x10, 0x8e, 0xf3, 0x47, 0xb2, 0xe6, 0x28, 0xcc, 0x09, 0x7c, 0x01, 0x39, 0x56, 0x04, 0x92, 0x25,
+	0x74, 0x36, 0xc9, 0x75, 0x94, 0x09, 0x52, 0x44, 0x81, 0x84, 0x0c, 0x15, 0x4d, 0xb4, 0xde, 0x2c,
+	0xce, 0x0a, 0xc7, 0x63, 0x79, 0x36, 0x06, 0x5a, 0x9e, 0xe0, 0x2a, 0x6a, 0x6a, 0x2a, 0x1e, 0xe3,
+	0xca, 0x2b, 0xa9, 0x38, 0x94, 0x34, 0x3c, 0x06, 0xf2, 0xda, 0x3e, 0x7c, 0x12, 0x8a, 0x28, 0x4e,
+	0xd7, 0xd8, 0x3b, 0xb3, 0x3b, 0xfb, 0xcd, 0x37, 0xdf, 0xcc, 0x92, 0x47, 0x3c, 0x5b, 0x03, 0x7a,
+	0x22, 0x66, 0x3c, 0x96, 0x22, 0xe3, 0xe8, 0x65, 0xe3, 0x9a, 0xe5, 0x26, 0x29, 0x48, 0x30, 0xee,
+	0xaa, 0x53, 0x6e, 0xcd, 0x9f, 0x8d, 0x07, 0x16, 0x03, 0xcc, 0x63, 0x03, 0x8a, 0xdc, 0xcb, 0xc6,
+	0x01, 0x97, 0x74, 0xec, 0x31, 0x10, 0x71, 0x11, 0x34, 0x38, 0x0c, 0x21, 0x04, 0xb5, 0xf4, 0xf2,
+	0x55, 0xe9, 0x1d, 0x86, 0x00, 0x61, 0xc4, 0x3d, 0x65, 0x05, 0x9b, 0x77, 0x9e, 0x14, 0x6b, 0x8e,
+	0x92, 0xae, 0x93, 0xe2, 0xc0, 0xe8, 0x4b, 0x93, 0xf4, 0x5f, 0x54, 0x40, 0xc6, 0x80, 0xf4, 0x18,
+	0xc4, 0x32, 0xa5, 0x4c, 0x9a, 0x9a, 0xad, 0x39, 0x7d, 0xff, 0xd2, 0x36, 0x90, 0xe8, 0x34, 0x8a,
+	0x80, 0x51, 0x29, 0x20, 0x46, 0xb3, 0x69, 0xb7, 0x1c, 0x7d, 0xf2, 0xd0, 0x2d, 0xd2, 0x72, 0xf3,
+	0xb4, 0xdc, 0x32, 0x2d, 0xf7, 0x39, 0x67, 0x33, 0x10, 0xf1, 0xf4, 0xe4, 0xec, 0xe7, 0xb0, 0xf1,
+	0xed, 0x62, 0xf8, 0x24, 0x14, 0x72, 0xb5, 0x09, 0x5c, 0x06, 0x6b, 0xaf, 0xa4, 0x51, 0xfc, 0x8e,
+	0x71, 0xf9, 0xde, 0x93, 0x9f, 0x12, 0x8e, 0x55, 0x0c, 0xfa, 0x75, 0x14, 0xe3, 0x1e, 0xe9, 0xf2,
+	0x04, 0xd8, 0x0a, 0xcd, 0x96, 0xad, 0x39, 0x07, 0x7e, 0x69, 0x19, 0x33, 0x42, 0x50, 0xd2, 0x54,
+	0x2e, 0x72, 0x3e, 0x66, 0xdb, 0xd6, 0x1c, 0x7d, 0x32, 0x70, 0x0b, 0xb2, 0x6e, 0x45, 0xd6, 0x7d,
+	0x53, 0x91, 0x9d, 0xf6, 0xf2, 0x4c, 0x4e, 0x2f, 0x86, 0x9a, 0xdf, 0x57, 0x71, 0xf9, 0x8e, 0xf1,
+	0x80, 0xf4, 0x25, 0x48, 0x1a, 0x2d, 0x42, 0x8a, 0x66, 0xc7, 0xd6, 0x9c, 0xb6, 0xdf, 0x53, 0x8e,
+	0x39, 0xc5, 0x11, 0x90, 0xde, 0x9c, 0xe2, 0x4b, 0x2e, 0x79, 0xba, 0xb7, 0x2c, 0x36, 0xd1, 0x13,
+	0x9a, 0x4a, 0xc1, 0x44, 0x42, 0x63, 0x69, 0x36, 0xd5, 0x76, 0xdd, 0x65, 0x3c, 0x26, 0x77, 0xd8,
+	0x66, 0xbd, 0x89, 0x68, 0x5e, 0x62, 0x85, 0xd5, 0x52, 0x58, 0x07, 0x7f, 0xbd, 0x39, 0xe0, 0xf7,
+	0x26, 0xd1, 0x5f, 0x27, 0x10, 0x23, 0xa4, 0xb8, 0x12, 0xc9, 0x5e, 0x50, 0x93, 0xdc, 0xc2, 0xe2,
+	0x68, 0x09, 0x58, 0x99, 0x06, 0x23, 0x5d, 0x8e, 0x2c, 0x85, 0x0f, 0x66, 0x4b, 0x09, 0x74, 0xf4,
+	0x4f, 0x81, 0x94, 0x3a, 0x4f, 0x4b, 0x75, 0x9c, 0xff, 0x50, 0xa7, 0x90, 0xa6, 0xbc, 0xda, 0x88,
+	0xc9, 0x6d, 0xa5, 0xc3, 0x22, 0xd8, 0x2c, 0x43, 0x2e, 0xcd, 0xf6, 0xf5, 0x43, 0xe9, 0x0a, 0x60,
+	0xaa, 0xee, 0xaf, 0x75, 0x41, 0xa7, 0xde, 0x05, 0xa3, 0xcf, 0x4d, 0x72, 0xe4, 0xf3, 0x50, 0xa0,
+	0xe4, 0xe9, 0x65, 0x13, 0xbf, 0x4a, 0x21, 0x01, 0xa4, 0x91, 0x71, 0x48, 0x3a, 0x52, 0xc8, 0x88,
+	0x97, 0xd5, 0x2b, 0x8c, 0x5c, 0xaf, 0x65, 0x4e, 0x43, 0x24, 0x79, 0x87, 0x55, 0x7a, 0xd5, 0x5c,
+	0x57, 0x0a, 0xdf, 0xda, 0x3f, 0x04, 0xed, 0x1b, 0x1e, 0x82, 0x2b, 0xf4, 0x9f, 0xb5, 0x7f, 0x7f,
+	0x1d, 0x36, 0x46, 0x48, 0xee, 0xcf, 0x68, 0xcc, 0x78, 0x74, 0x23, 0x15, 0x28, 0x40, 0xa7, 0xf3,
+	0xb3, 0xad, 0xa5, 0x9d, 0x6f, 0x2d, 0xed, 0xd7, 0xd6, 0xd2, 0x4e, 0x77, 0x56, 0xe3, 0x7c, 0x67,
+	0x35, 0x7e, 0xec, 0xac, 0xc6, 0xdb, 0xe3, 0x1a, 0xcd, 0xe2, 0xb5, 0x2b, 0xbe, 0xd9, 0x78, 0xe2,
+	0x7d, 0xac, 0xbf, 0x7c, 0x8a, 0x71, 0xd0, 0x55, 0xc3, 0x7a, 0xf2, 0x67, 0x00, 0x67, 0x48, 0x30,
+	0x87, 0x1a, 0x05, 0x00, 0x00,
 }
 
 func (m *Incentive) Marshal() (dAtA []byte, err error) {
@@ -472,6 +563,76 @@ func (m *GasMeter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EpochBudget) > 0 {
+		for iNdEx := len(m.EpochBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Escrow) > 0 {
+		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterIncentiveProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -637,6 +798,38 @@ func (m *GasMeter) Size() (n int) {
 	return n
 }
 
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if len(m.EpochBudget) > 0 {
+		for _, e := range m.EpochBudget {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovIncentives(uint64(m.Epochs))
+	}
+	return n
+}
+
 func (m *RegisterIncentiveProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1014,6 +1207,207 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrow = append(m.Escrow, types.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochBudget = append(m.EpochBudget, types.Coin{})
+			if err := m.EpochBudget[len(m.EpochBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
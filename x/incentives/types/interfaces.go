@@ -17,6 +17,7 @@
 package types
 
 import (
+	"context"
 	"time"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -29,6 +30,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	epochstypes "github.com/evmos/evmos/v12/x/epochs/types"
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
 	"github.com/evmos/evmos/v12/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
 
@@ -76,6 +79,20 @@ type EVMKeeper interface {
 	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
 }
 
+// ERC20Keeper defines the expected ERC20 keeper interface used to convert the
+// ERC20 tokens deposited into sponsorships
+type ERC20Keeper interface {
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	ConvertERC20(goCtx context.Context, msg *erc20types.MsgConvertERC20) (*erc20types.MsgConvertERC20Response, error)
+}
+
+// EpochsKeeper defines the expected epochs keeper interface used to estimate
+// the rewards of the current epoch
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
+}
+
 // Stakekeeper defines the expected staking keeper interface used on incentives
 type StakeKeeper interface{}
 
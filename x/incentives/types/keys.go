@@ -31,6 +31,10 @@ const (
 
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
+
+	// SponsorshipEscrowName is the name of the module account that holds the
+	// escrowed rewards of sponsor-funded incentives
+	SponsorshipEscrowName = "incentives_escrow"
 )
 
 // ModuleAddress is the native module address for incentives module
@@ -45,13 +49,19 @@ const (
 	prefixIncentive = iota + 1
 	prefixGasMeter
 	prefixAllocationMeter
+	prefixSponsorship
+	prefixSponsoredGasMeter
+	prefixSponsoredTotalGas
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixIncentive       = []byte{prefixIncentive}
-	KeyPrefixGasMeter        = []byte{prefixGasMeter}
-	KeyPrefixAllocationMeter = []byte{prefixAllocationMeter}
+	KeyPrefixIncentive         = []byte{prefixIncentive}
+	KeyPrefixGasMeter          = []byte{prefixGasMeter}
+	KeyPrefixAllocationMeter   = []byte{prefixAllocationMeter}
+	KeyPrefixSponsorship       = []byte{prefixSponsorship}
+	KeyPrefixSponsoredGasMeter = []byte{prefixSponsoredGasMeter}
+	KeyPrefixSponsoredTotalGas = []byte{prefixSponsoredTotalGas}
 )
 
 // SplitGasMeterKey is a helper to split up KV-store keys in a
@@ -19,6 +19,10 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
 )
 
 var _ sdk.Msg = &MsgUpdateParams{}
@@ -42,3 +46,112 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+var (
+	_ sdk.Msg = &MsgSponsorIncentive{}
+	_ sdk.Msg = &MsgWithdrawSponsorship{}
+)
+
+const (
+	TypeMsgSponsorIncentive    = "sponsor_incentive"
+	TypeMsgWithdrawSponsorship = "withdraw_sponsorship"
+)
+
+// NewMsgSponsorIncentive creates new instance of MsgSponsorIncentive
+func NewMsgSponsorIncentive(
+	sponsor sdk.AccAddress,
+	contract common.Address,
+	deposit, epochBudget sdk.Coins,
+	epochs uint32,
+) *MsgSponsorIncentive {
+	return &MsgSponsorIncentive{
+		Sponsor:     sponsor.String(),
+		Contract:    contract.String(),
+		Deposit:     deposit,
+		EpochBudget: epochBudget,
+		Epochs:      epochs,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSponsorIncentive) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgSponsorIncentive) Type() string { return TypeMsgSponsorIncentive }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSponsorIncentive) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errorsmod.Wrapf(err, "invalid sponsor address %s", msg.Sponsor)
+	}
+
+	if err := evmostypes.ValidateNonZeroAddress(msg.Contract); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.Contract)
+	}
+
+	if !msg.Deposit.IsValid() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid deposit %s", msg.Deposit)
+	}
+
+	if err := msg.EpochBudget.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid epoch budget %s: %s", msg.EpochBudget, err)
+	}
+
+	if msg.Deposit.IsZero() && msg.EpochBudget.IsZero() && msg.Epochs == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "sponsorship update cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSponsorIncentive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSponsorIncentive) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sponsor)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgWithdrawSponsorship creates new instance of MsgWithdrawSponsorship
+func NewMsgWithdrawSponsorship(
+	sponsor sdk.AccAddress,
+	contract common.Address,
+) *MsgWithdrawSponsorship {
+	return &MsgWithdrawSponsorship{
+		Sponsor:  sponsor.String(),
+		Contract: contract.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgWithdrawSponsorship) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgWithdrawSponsorship) Type() string { return TypeMsgWithdrawSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errorsmod.Wrapf(err, "invalid sponsor address %s", msg.Sponsor)
+	}
+
+	if err := evmostypes.ValidateNonZeroAddress(msg.Contract); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.Contract)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgWithdrawSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawSponsorship) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sponsor)
+	return []sdk.AccAddress{addr}
+}
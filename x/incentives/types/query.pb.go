@@ -12,19 +12,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -639,6 +644,271 @@ func (m *QueryAllocationMeterResponse) GetAllocationMeter() types.DecCoin {
 	return types.DecCoin{}
 }
 
+// QuerySponsorshipsRequest is the request type for the Query/Sponsorships RPC
+// method.
+type QuerySponsorshipsRequest struct {
+	// contract is the hex contract address of a sponsored smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorshipsRequest) Reset()         { *m = QuerySponsorshipsRequest{} }
+func (m *QuerySponsorshipsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipsRequest) ProtoMessage()    {}
+func (*QuerySponsorshipsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{12}
+}
+func (m *QuerySponsorshipsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipsRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipsRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QuerySponsorshipsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySponsorshipsResponse is the response type for the Query/Sponsorships
+// RPC method.
+type QuerySponsorshipsResponse struct {
+	// sponsorships is a slice of the sponsorships of the contract
+	Sponsorships []Sponsorship `protobuf:"bytes,1,rep,name=sponsorships,proto3" json:"sponsorships"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorshipsResponse) Reset()         { *m = QuerySponsorshipsResponse{} }
+func (m *QuerySponsorshipsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipsResponse) ProtoMessage()    {}
+func (*QuerySponsorshipsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{13}
+}
+func (m *QuerySponsorshipsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipsResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipsResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipsResponse) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *QuerySponsorshipsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEstimatedRewardsRequest is the request type for the
+// Query/EstimatedRewards RPC method.
+type QueryEstimatedRewardsRequest struct {
+	// contract is the hex contract address of an incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// participant is the hex address of a user
+	Participant string `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryEstimatedRewardsRequest) Reset()         { *m = QueryEstimatedRewardsRequest{} }
+func (m *QueryEstimatedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedRewardsRequest) ProtoMessage()    {}
+func (*QueryEstimatedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{14}
+}
+func (m *QueryEstimatedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimatedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedRewardsRequest.Merge(m, src)
+}
+func (m *QueryEstimatedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimatedRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryEstimatedRewardsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryEstimatedRewardsRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// QueryEstimatedRewardsResponse is the response type for the
+// Query/EstimatedRewards RPC method.
+type QueryEstimatedRewardsResponse struct {
+	// rewards is the projected reward per allocation denom of the incentive if
+	// the epoch ended at the current block
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// sponsored_rewards is the projected reward of the sponsorships of the
+	// contract if the epoch ended at the current block
+	SponsoredRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=sponsored_rewards,json=sponsoredRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sponsored_rewards"`
+	// gas_meter is the cumulative gas spent by the participant on the incentive
+	// during the epoch
+	GasMeter uint64 `protobuf:"varint,3,opt,name=gas_meter,json=gasMeter,proto3" json:"gas_meter,omitempty"`
+	// total_gas is the cumulative gas spent by all participants on the incentive
+	// during the epoch
+	TotalGas uint64 `protobuf:"varint,4,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// epoch_end_time is the time at which the current incentives epoch ends
+	EpochEndTime time.Time `protobuf:"bytes,5,opt,name=epoch_end_time,json=epochEndTime,proto3,stdtime" json:"epoch_end_time"`
+	// epoch_time_remaining is the time remaining until the end of the current
+	// incentives epoch
+	EpochTimeRemaining time.Duration `protobuf:"bytes,6,opt,name=epoch_time_remaining,json=epochTimeRemaining,proto3,stdduration" json:"epoch_time_remaining"`
+}
+
+func (m *QueryEstimatedRewardsResponse) Reset()         { *m = QueryEstimatedRewardsResponse{} }
+func (m *QueryEstimatedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedRewardsResponse) ProtoMessage()    {}
+func (*QueryEstimatedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{15}
+}
+func (m *QueryEstimatedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimatedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedRewardsResponse.Merge(m, src)
+}
+func (m *QueryEstimatedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimatedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryEstimatedRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryEstimatedRewardsResponse) GetSponsoredRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SponsoredRewards
+	}
+	return nil
+}
+
+func (m *QueryEstimatedRewardsResponse) GetGasMeter() uint64 {
+	if m != nil {
+		return m.GasMeter
+	}
+	return 0
+}
+
+func (m *QueryEstimatedRewardsResponse) GetTotalGas() uint64 {
+	if m != nil {
+		return m.TotalGas
+	}
+	return 0
+}
+
+func (m *QueryEstimatedRewardsResponse) GetEpochEndTime() time.Time {
+	if m != nil {
+		return m.EpochEndTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryEstimatedRewardsResponse) GetEpochTimeRemaining() time.Duration {
+	if m != nil {
+		return m.EpochTimeRemaining
+	}
+	return 0
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -647,7 +917,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{12}
+	return fileDescriptor_ee5d2766935e7631, []int{16}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +957,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{13}
+	return fileDescriptor_ee5d2766935e7631, []int{17}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,6 +1006,10 @@ func init() {
 	proto.RegisterType((*QueryAllocationMetersResponse)(nil), "evmos.incentives.v1.QueryAllocationMetersResponse")
 	proto.RegisterType((*QueryAllocationMeterRequest)(nil), "evmos.incentives.v1.QueryAllocationMeterRequest")
 	proto.RegisterType((*QueryAllocationMeterResponse)(nil), "evmos.incentives.v1.QueryAllocationMeterResponse")
+	proto.RegisterType((*QuerySponsorshipsRequest)(nil), "evmos.incentives.v1.QuerySponsorshipsRequest")
+	proto.RegisterType((*QuerySponsorshipsResponse)(nil), "evmos.incentives.v1.QuerySponsorshipsResponse")
+	proto.RegisterType((*QueryEstimatedRewardsRequest)(nil), "evmos.incentives.v1.QueryEstimatedRewardsRequest")
+	proto.RegisterType((*QueryEstimatedRewardsResponse)(nil), "evmos.incentives.v1.QueryEstimatedRewardsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.incentives.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.incentives.v1.QueryParamsResponse")
 }
@@ -743,60 +1017,79 @@ func init() {
 func init() { proto.RegisterFile("evmos/incentives/v1/query.proto", fileDescriptor_ee5d2766935e7631) }
 
 var fileDescriptor_ee5d2766935e7631 = []byte{
-	// 836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0x3b, 0xfc, 0x80, 0xb4, 0xc3, 0x01, 0x7e, 0x43, 0x55, 0xd2, 0xc2, 0x16, 0x56, 0x03,
-	0xb5, 0xc0, 0x2e, 0x2d, 0xc6, 0xa8, 0x27, 0xad, 0x44, 0xe2, 0xc1, 0x04, 0x1b, 0x4f, 0xc6, 0x44,
-	0xa7, 0xcb, 0xb8, 0x6e, 0xa4, 0x3b, 0x4b, 0x67, 0x69, 0x24, 0x15, 0x63, 0x7c, 0x05, 0x24, 0x5e,
-	0x3c, 0x78, 0x33, 0x26, 0xea, 0xc1, 0xd7, 0xc1, 0x91, 0xc4, 0xc4, 0x78, 0x52, 0x03, 0x1e, 0x7c,
-	0x19, 0xa6, 0xb3, 0x33, 0xdb, 0xdd, 0x65, 0x4b, 0x17, 0x83, 0x17, 0x68, 0x67, 0x9f, 0x3f, 0x9f,
-	0xef, 0xf3, 0x30, 0xdf, 0x05, 0x16, 0x48, 0xab, 0x41, 0x99, 0x6e, 0xd9, 0x06, 0xb1, 0x5d, 0xab,
-	0x45, 0x98, 0xde, 0x2a, 0xeb, 0x9b, 0x5b, 0xa4, 0xb9, 0xad, 0x39, 0x4d, 0xea, 0x52, 0x34, 0xce,
-	0x03, 0xb4, 0x6e, 0x80, 0xd6, 0x2a, 0xe7, 0x4a, 0x06, 0x65, 0x9d, 0xb4, 0x3a, 0x66, 0xc4, 0x8b,
-	0xd6, 0x5b, 0xe5, 0x3a, 0x71, 0x71, 0x59, 0x77, 0xb0, 0x69, 0xd9, 0xd8, 0xb5, 0xa8, 0xed, 0x15,
-	0xc8, 0x29, 0xc1, 0x58, 0x19, 0x65, 0x50, 0x4b, 0x3e, 0x9f, 0x89, 0x23, 0x30, 0x89, 0x4d, 0x98,
-	0xc5, 0x44, 0xc8, 0x85, 0xb8, 0x90, 0x00, 0x91, 0x17, 0x95, 0x35, 0xa9, 0x49, 0xf9, 0x47, 0xbd,
-	0xf3, 0x49, 0x9c, 0x4e, 0x9a, 0x94, 0x9a, 0x1b, 0x44, 0xc7, 0x8e, 0xa5, 0x63, 0xdb, 0xa6, 0x2e,
-	0x67, 0x13, 0x39, 0xea, 0x23, 0x78, 0xf6, 0x6e, 0x07, 0xff, 0xb6, 0x5f, 0xac, 0x46, 0x36, 0xb7,
-	0x08, 0x73, 0xd1, 0x2d, 0x08, 0xbb, 0x52, 0x26, 0xc0, 0x34, 0x28, 0x8e, 0x54, 0x66, 0x35, 0x4f,
-	0x8b, 0xd6, 0xd1, 0xa2, 0x79, 0x53, 0x12, 0x8a, 0xb4, 0x35, 0x6c, 0x12, 0x91, 0x5b, 0x0b, 0x64,
-	0xaa, 0x1f, 0x00, 0x3c, 0x77, 0xa4, 0x05, 0x73, 0xa8, 0xcd, 0x08, 0x5a, 0x81, 0xb0, 0xab, 0x62,
-	0x02, 0x4c, 0xff, 0x57, 0x1c, 0xa9, 0x28, 0x5a, 0xcc, 0xc0, 0x35, 0x3f, 0xb9, 0x3a, 0xb8, 0xf7,
-	0xbd, 0x90, 0xaa, 0x05, 0xf2, 0xd0, 0x6a, 0x88, 0x74, 0x80, 0x93, 0xce, 0xf5, 0x25, 0xf5, 0x10,
-	0x42, 0xa8, 0xcb, 0xf0, 0x4c, 0x98, 0x54, 0xce, 0x22, 0x07, 0xd3, 0x06, 0xb5, 0xdd, 0x26, 0x36,
-	0x5c, 0x3e, 0x89, 0x4c, 0xcd, 0xff, 0xae, 0x3e, 0x88, 0x4e, 0xd0, 0x57, 0x57, 0x85, 0x19, 0x9f,
-	0x52, 0x0c, 0x30, 0x99, 0xb8, 0x6e, 0x9a, 0xda, 0x16, 0x48, 0xab, 0x98, 0xdd, 0x21, 0x2e, 0x69,
-	0xb2, 0x04, 0x48, 0x91, 0xd5, 0x0d, 0xfc, 0xf5, 0xea, 0xde, 0x03, 0xa1, 0x2d, 0xd0, 0xdd, 0xd7,
-	0x06, 0x4d, 0xcc, 0x1e, 0x36, 0xf8, 0xa9, 0xd8, 0xdc, 0x54, 0xac, 0x38, 0x99, 0x2b, 0xb5, 0x99,
-	0xb2, 0xd6, 0xe9, 0xed, 0xed, 0x1e, 0xcc, 0x86, 0x30, 0x93, 0xcc, 0x68, 0x1a, 0x8e, 0x38, 0xb8,
-	0xe9, 0x5a, 0x86, 0xe5, 0x60, 0xdb, 0xe5, 0xdd, 0x33, 0xb5, 0xe0, 0x91, 0x7a, 0x29, 0x32, 0x7a,
-	0x5f, 0x7b, 0x1e, 0x66, 0x7c, 0xed, 0xbc, 0xee, 0x60, 0x2d, 0x2d, 0x55, 0xa9, 0x8f, 0xe1, 0x24,
-	0xcf, 0xba, 0xb1, 0xb1, 0x41, 0x0d, 0x8e, 0x17, 0xde, 0xdb, 0x69, 0x5d, 0xab, 0xdf, 0x00, 0x4e,
-	0xf5, 0x68, 0x24, 0x30, 0x5f, 0xc0, 0xff, 0xb1, 0xff, 0x2c, 0xbc, 0xa9, 0xc9, 0x50, 0x43, 0xd9,
-	0x6a, 0x85, 0x18, 0x37, 0xa9, 0x65, 0x57, 0x97, 0x3b, 0x8b, 0xfa, 0xf4, 0xa3, 0x30, 0x6f, 0x5a,
-	0xee, 0x93, 0xad, 0xba, 0x66, 0xd0, 0x86, 0x2e, 0x3c, 0xcc, 0xfb, 0xb5, 0xc8, 0xd6, 0x9f, 0xea,
-	0xee, 0xb6, 0x43, 0x98, 0xcc, 0x61, 0xb5, 0x31, 0x1c, 0xe1, 0x38, 0xcd, 0x6b, 0x99, 0x8f, 0x53,
-	0x2a, 0x27, 0x9a, 0x85, 0x43, 0xeb, 0xc4, 0xa6, 0x0d, 0xb1, 0x62, 0xef, 0x8b, 0xfa, 0x16, 0xc4,
-	0x2f, 0xc2, 0x1f, 0xcf, 0x73, 0x38, 0x16, 0x1d, 0x8f, 0x58, 0xc7, 0x3f, 0x98, 0xce, 0x68, 0x64,
-	0x3a, 0x6a, 0x16, 0x22, 0x4e, 0xb7, 0x86, 0x9b, 0xb8, 0x21, 0xff, 0x38, 0xd4, 0x35, 0x38, 0x1e,
-	0x3a, 0x15, 0xa8, 0x57, 0xe1, 0xb0, 0xc3, 0x4f, 0x04, 0x60, 0x3e, 0xf6, 0xa2, 0x79, 0x49, 0xe2,
-	0x9a, 0x89, 0x84, 0xca, 0xd7, 0x34, 0x1c, 0xe2, 0x25, 0xd1, 0x2e, 0x80, 0xb0, 0x6b, 0xc1, 0x68,
-	0x3e, 0xb6, 0x46, 0xfc, 0xbb, 0x20, 0xb7, 0x90, 0x2c, 0xd8, 0xc3, 0x55, 0xe7, 0x5e, 0x7d, 0xf9,
-	0xf5, 0x7a, 0x60, 0x06, 0x15, 0xf4, 0xe3, 0x5f, 0x5b, 0xe8, 0x0d, 0x80, 0x19, 0x3f, 0x1f, 0x95,
-	0x12, 0x34, 0x91, 0x40, 0xf3, 0x89, 0x62, 0x05, 0x4f, 0x85, 0xf3, 0x2c, 0xa0, 0x52, 0x1f, 0x1e,
-	0xbd, 0x2d, 0xdd, 0x61, 0x87, 0xa3, 0xf9, 0xae, 0x77, 0x1c, 0x5a, 0xd4, 0x98, 0x8f, 0x43, 0x3b,
-	0x62, 0xa3, 0x7d, 0xd0, 0xba, 0x0e, 0x1b, 0x44, 0x7b, 0x07, 0x60, 0x5a, 0x56, 0x42, 0x17, 0xfb,
-	0x77, 0x93, 0x60, 0xa5, 0x24, 0xa1, 0x82, 0xeb, 0x3a, 0xe7, 0xba, 0x86, 0xae, 0x24, 0xe7, 0xd2,
-	0xdb, 0x01, 0xf3, 0xdc, 0x41, 0x1f, 0x01, 0x1c, 0x8b, 0x5a, 0x13, 0x2a, 0xf7, 0x46, 0xe8, 0xe1,
-	0x97, 0xb9, 0xca, 0x49, 0x52, 0x04, 0xbd, 0xc6, 0xe9, 0x8b, 0x68, 0x36, 0x96, 0xfe, 0x88, 0x29,
-	0xa2, 0xcf, 0x00, 0x8e, 0x46, 0x8a, 0xa1, 0xa5, 0xc4, 0x7d, 0x25, 0x69, 0xf9, 0x04, 0x19, 0x02,
-	0xf4, 0x32, 0x07, 0x5d, 0x42, 0x5a, 0x32, 0x50, 0xbd, 0xcd, 0xbd, 0x6d, 0x07, 0xbd, 0x04, 0x70,
-	0xd8, 0xbb, 0xee, 0x68, 0xae, 0x77, 0xd7, 0x90, 0xb7, 0xe4, 0x8a, 0xfd, 0x03, 0x05, 0xd5, 0x79,
-	0x4e, 0x35, 0x85, 0xf2, 0xb1, 0x54, 0x9e, 0xb1, 0x54, 0x57, 0xf7, 0x0e, 0x14, 0xb0, 0x7f, 0xa0,
-	0x80, 0x9f, 0x07, 0x0a, 0xd8, 0x3d, 0x54, 0x52, 0xfb, 0x87, 0x4a, 0xea, 0xdb, 0xa1, 0x92, 0xba,
-	0xbf, 0x18, 0x30, 0x46, 0xaf, 0x80, 0xf7, 0xb3, 0x55, 0xae, 0xe8, 0xcf, 0x82, 0xc5, 0xb8, 0x47,
-	0xd6, 0x87, 0xf9, 0x3f, 0xa2, 0xcb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xec, 0x06, 0x66, 0x23,
-	0x89, 0x0b, 0x00, 0x00,
+	// 1148 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcb, 0x6f, 0xdc, 0x44,
+	0x18, 0xc0, 0xe3, 0xe6, 0x41, 0xf2, 0x25, 0xa2, 0xe9, 0x34, 0x94, 0xcd, 0x26, 0xd9, 0x4d, 0x0d,
+	0x6a, 0x42, 0x1e, 0x76, 0x76, 0x53, 0x21, 0xe0, 0x02, 0x0d, 0x69, 0x23, 0x2a, 0x21, 0x85, 0xa5,
+	0x5c, 0x50, 0xa5, 0x65, 0xb2, 0x3b, 0x75, 0x2c, 0x62, 0x8f, 0xeb, 0x99, 0x2c, 0xad, 0x42, 0x2b,
+	0xc4, 0x5f, 0x10, 0x89, 0x4b, 0x0f, 0xdc, 0x10, 0x12, 0x70, 0x40, 0xfc, 0x0b, 0x70, 0xea, 0xb1,
+	0x12, 0x17, 0x2e, 0x50, 0x94, 0x70, 0xe0, 0xcf, 0x40, 0x9e, 0x87, 0xd7, 0x76, 0xbc, 0x89, 0x83,
+	0x16, 0x2e, 0xc9, 0x7a, 0xe6, 0x7b, 0xfc, 0xbe, 0xc7, 0xcc, 0x7c, 0x50, 0x25, 0x1d, 0x8f, 0x32,
+	0xdb, 0xf5, 0x5b, 0xc4, 0xe7, 0x6e, 0x87, 0x30, 0xbb, 0x53, 0xb3, 0xef, 0xef, 0x93, 0xf0, 0xa1,
+	0x15, 0x84, 0x94, 0x53, 0x74, 0x59, 0x08, 0x58, 0x5d, 0x01, 0xab, 0x53, 0x2b, 0x2f, 0xb5, 0x28,
+	0x8b, 0xd4, 0x76, 0x30, 0x23, 0x52, 0xda, 0xee, 0xd4, 0x76, 0x08, 0xc7, 0x35, 0x3b, 0xc0, 0x8e,
+	0xeb, 0x63, 0xee, 0x52, 0x5f, 0x1a, 0x28, 0x57, 0x92, 0xb2, 0x5a, 0xaa, 0x45, 0x5d, 0xbd, 0x7f,
+	0x35, 0x8f, 0xc0, 0x21, 0x3e, 0x61, 0x2e, 0x53, 0x22, 0xaf, 0xe6, 0x89, 0x74, 0xbf, 0x94, 0xd4,
+	0x94, 0x43, 0x1d, 0x2a, 0x7e, 0xda, 0xd1, 0x2f, 0xb5, 0x3a, 0xeb, 0x50, 0xea, 0xec, 0x11, 0x1b,
+	0x07, 0xae, 0x8d, 0x7d, 0x9f, 0x72, 0xc1, 0xa6, 0x75, 0x2a, 0x6a, 0x57, 0x7c, 0xed, 0xec, 0xdf,
+	0xb3, 0xdb, 0xfb, 0x61, 0x12, 0xbe, 0x9a, 0xdd, 0xe7, 0xae, 0x47, 0x18, 0xc7, 0x5e, 0x20, 0x05,
+	0xcc, 0x4f, 0xe0, 0xca, 0x07, 0x51, 0xfc, 0xef, 0xc5, 0x34, 0x0d, 0x72, 0x7f, 0x9f, 0x30, 0x8e,
+	0x6e, 0x01, 0x74, 0x73, 0x51, 0x32, 0xe6, 0x8d, 0xc5, 0xf1, 0xfa, 0x35, 0x4b, 0x26, 0xc3, 0x8a,
+	0x92, 0x61, 0xc9, 0x34, 0xab, 0x94, 0x58, 0xdb, 0xd8, 0x21, 0x4a, 0xb7, 0x91, 0xd0, 0x34, 0xbf,
+	0x33, 0xe0, 0xe5, 0x13, 0x2e, 0x58, 0x40, 0x7d, 0x46, 0xd0, 0x26, 0x40, 0x37, 0x0d, 0x25, 0x63,
+	0x7e, 0x70, 0x71, 0xbc, 0x5e, 0xb1, 0x72, 0x2a, 0x66, 0xc5, 0xca, 0x1b, 0x43, 0x4f, 0xff, 0xa8,
+	0x0e, 0x34, 0x12, 0x7a, 0x68, 0x2b, 0x45, 0x7a, 0x41, 0x90, 0x2e, 0x9c, 0x49, 0x2a, 0x11, 0x52,
+	0xa8, 0xeb, 0xf0, 0x52, 0x9a, 0x54, 0xe7, 0xa2, 0x0c, 0xa3, 0x2d, 0xea, 0xf3, 0x10, 0xb7, 0xb8,
+	0xc8, 0xc4, 0x58, 0x23, 0xfe, 0x36, 0xef, 0x66, 0x33, 0x18, 0x47, 0xb7, 0x01, 0x63, 0x31, 0xa5,
+	0x4a, 0x60, 0xb1, 0xe0, 0xba, 0x6a, 0xe6, 0x81, 0x42, 0xda, 0xc2, 0xec, 0x7d, 0xc2, 0x49, 0xc8,
+	0x0a, 0x20, 0xa1, 0x5b, 0x39, 0x09, 0xf9, 0x37, 0xa5, 0xfb, 0xd6, 0x80, 0x2b, 0x59, 0xef, 0x71,
+	0x6c, 0xe0, 0x60, 0xd6, 0xf4, 0xc4, 0xaa, 0xaa, 0xdc, 0x5c, 0x6e, 0x70, 0x5a, 0x57, 0xc7, 0xe6,
+	0x68, 0x5b, 0xfd, 0xab, 0xdb, 0x1d, 0x98, 0x4a, 0x61, 0x16, 0xc9, 0xd1, 0x3c, 0x8c, 0x07, 0x38,
+	0xe4, 0x6e, 0xcb, 0x0d, 0xb0, 0xcf, 0x85, 0xf7, 0xb1, 0x46, 0x72, 0xc9, 0xbc, 0x9e, 0x49, 0x7d,
+	0x1c, 0xfb, 0x0c, 0x8c, 0xc5, 0xb1, 0x0b, 0xbb, 0x43, 0x8d, 0x51, 0x1d, 0x95, 0x79, 0x0f, 0x66,
+	0x85, 0xd6, 0x8d, 0xbd, 0x3d, 0xda, 0x12, 0x78, 0xe9, 0xba, 0xf5, 0xeb, 0x58, 0xfd, 0x6d, 0xc0,
+	0x5c, 0x0f, 0x47, 0x0a, 0xf3, 0x31, 0x5c, 0xc2, 0xf1, 0x5e, 0xba, 0x52, 0xb3, 0x29, 0x87, 0xda,
+	0xd5, 0x26, 0x69, 0xbd, 0x4b, 0x5d, 0x7f, 0x63, 0x3d, 0x2a, 0xd4, 0x0f, 0xcf, 0xab, 0xcb, 0x8e,
+	0xcb, 0x77, 0xf7, 0x77, 0xac, 0x16, 0xf5, 0x6c, 0x75, 0x09, 0xca, 0x7f, 0xab, 0xac, 0xfd, 0xa9,
+	0xcd, 0x1f, 0x06, 0x84, 0x69, 0x1d, 0xd6, 0x98, 0xc4, 0x19, 0x8e, 0x7e, 0x1e, 0xcb, 0x99, 0xbc,
+	0x48, 0x75, 0x46, 0xa7, 0x60, 0xb8, 0x4d, 0x7c, 0xea, 0xa9, 0x12, 0xcb, 0x0f, 0xf3, 0x6b, 0x23,
+	0xbf, 0x10, 0x71, 0x7a, 0x3e, 0x87, 0xc9, 0x6c, 0x7a, 0x54, 0x39, 0xfe, 0x83, 0xec, 0x5c, 0xcc,
+	0x64, 0xc7, 0x7c, 0x0c, 0x25, 0x41, 0xf7, 0x61, 0x04, 0x43, 0x43, 0xb6, 0xeb, 0x06, 0xff, 0xeb,
+	0xd1, 0xfe, 0xc9, 0x80, 0xe9, 0x1c, 0x00, 0x95, 0x9b, 0xdb, 0x30, 0xc1, 0x12, 0xeb, 0xaa, 0x6b,
+	0xe6, 0x73, 0xcf, 0x77, 0xc2, 0x80, 0x3a, 0xe2, 0x29, 0xdd, 0xfe, 0xb5, 0xc1, 0x5d, 0x55, 0xd0,
+	0x9b, 0x8c, 0xbb, 0x1e, 0xe6, 0xa4, 0xdd, 0x20, 0x9f, 0xe1, 0xb0, 0xcd, 0xfa, 0x73, 0xda, 0x7f,
+	0x1f, 0x84, 0xb9, 0x1e, 0xe6, 0x55, 0x52, 0x08, 0xbc, 0x10, 0xca, 0x25, 0x95, 0x8f, 0xe9, 0xdc,
+	0x3e, 0x11, 0x4d, 0xb2, 0xa6, 0x9a, 0x64, 0xb1, 0x40, 0x93, 0xc8, 0x0e, 0xd1, 0xb6, 0xd1, 0x03,
+	0xb8, 0xa4, 0xf2, 0x47, 0xda, 0x4d, 0xed, 0xf0, 0x42, 0xff, 0x1d, 0x4e, 0xc6, 0x5e, 0x54, 0xa0,
+	0xe9, 0x7b, 0x6d, 0x30, 0x7d, 0xaf, 0x45, 0x9b, 0x9c, 0x72, 0xbc, 0xd7, 0x74, 0x30, 0x2b, 0x0d,
+	0xc9, 0x4d, 0xb1, 0xb0, 0x85, 0x19, 0xba, 0x0d, 0x2f, 0x92, 0x80, 0xb6, 0x76, 0x9b, 0xc4, 0x6f,
+	0x37, 0xb9, 0xeb, 0x91, 0xd2, 0xb0, 0xa8, 0x73, 0xd9, 0x92, 0xf3, 0x87, 0xa5, 0xe7, 0x0f, 0xeb,
+	0x8e, 0x9e, 0x3f, 0x36, 0x46, 0x23, 0xe2, 0xc3, 0xe7, 0x55, 0xa3, 0x31, 0x21, 0x74, 0x6f, 0xfa,
+	0xed, 0x68, 0x13, 0x7d, 0x04, 0x53, 0xd2, 0x56, 0x64, 0xa7, 0x19, 0x12, 0x0f, 0xbb, 0xbe, 0xeb,
+	0x3b, 0xa5, 0x11, 0x61, 0x71, 0xfa, 0x84, 0xc5, 0x4d, 0x35, 0xf1, 0x48, 0x83, 0x4f, 0x22, 0x83,
+	0x48, 0x18, 0x88, 0xac, 0x35, 0xb4, 0xba, 0x39, 0x05, 0x48, 0x94, 0x77, 0x1b, 0x87, 0xd8, 0xd3,
+	0x3d, 0x63, 0x6e, 0xc3, 0xe5, 0xd4, 0xaa, 0x2a, 0xf5, 0x9b, 0x30, 0x12, 0x88, 0x15, 0x75, 0x23,
+	0xcc, 0xe4, 0x76, 0xbe, 0x54, 0x52, 0x4d, 0xaf, 0x14, 0xea, 0x3f, 0x8f, 0xc3, 0xb0, 0x30, 0x89,
+	0x0e, 0x0d, 0x80, 0xee, 0xcc, 0x83, 0x96, 0x73, 0x6d, 0xe4, 0x0f, 0x5f, 0xe5, 0x95, 0x62, 0xc2,
+	0x12, 0xd7, 0x5c, 0xf8, 0xf2, 0xd7, 0xbf, 0xbe, 0xba, 0x70, 0x15, 0x55, 0xed, 0xd3, 0x07, 0x4d,
+	0xf4, 0xc4, 0x80, 0xb1, 0x58, 0x1f, 0x2d, 0x15, 0x70, 0xa2, 0x81, 0x96, 0x0b, 0xc9, 0x2a, 0x9e,
+	0xba, 0xe0, 0x59, 0x41, 0x4b, 0x67, 0xf0, 0xd8, 0x07, 0xfa, 0x80, 0x3e, 0x12, 0x68, 0xf1, 0x98,
+	0x71, 0x1a, 0x5a, 0x76, 0x12, 0x2a, 0x2f, 0x17, 0x92, 0x2d, 0x84, 0xd6, 0x1d, 0x69, 0x92, 0x68,
+	0xdf, 0x18, 0x30, 0xaa, 0x2d, 0xa1, 0xd7, 0xce, 0xf6, 0xa6, 0xc1, 0x96, 0x8a, 0x88, 0x2a, 0xae,
+	0x77, 0x04, 0xd7, 0x5b, 0xe8, 0x8d, 0xe2, 0x5c, 0xf6, 0x41, 0xe2, 0xfe, 0x7a, 0x84, 0xbe, 0x37,
+	0x60, 0x32, 0x3b, 0x0b, 0xa0, 0x5a, 0x6f, 0x84, 0x1e, 0x03, 0x4a, 0xb9, 0x7e, 0x1e, 0x15, 0x45,
+	0x6f, 0x09, 0xfa, 0x45, 0x74, 0x2d, 0x97, 0xfe, 0xc4, 0x14, 0x82, 0x7e, 0x34, 0xe0, 0x62, 0xc6,
+	0x18, 0x5a, 0x2b, 0xec, 0x57, 0x93, 0xd6, 0xce, 0xa1, 0xa1, 0x40, 0x5f, 0x17, 0xa0, 0x6b, 0xc8,
+	0x2a, 0x06, 0x6a, 0x1f, 0x88, 0x61, 0x42, 0xb4, 0xc0, 0x44, 0xf2, 0xa5, 0x44, 0xab, 0xbd, 0x7d,
+	0xe7, 0x3c, 0xe9, 0x65, 0xab, 0xa8, 0xb8, 0xe2, 0xbc, 0x2e, 0x38, 0x2d, 0xb4, 0x92, 0xcb, 0x99,
+	0x7c, 0x5f, 0x93, 0x8d, 0xfa, 0x8b, 0x01, 0x93, 0xd9, 0xe7, 0xeb, 0xb4, 0x16, 0xe8, 0xf1, 0x92,
+	0x96, 0xeb, 0xe7, 0x51, 0x51, 0xc4, 0x5b, 0x82, 0xf8, 0x06, 0x7a, 0x3b, 0x97, 0x98, 0x68, 0x35,
+	0xfd, 0xa2, 0xf5, 0xee, 0xe3, 0x2f, 0x0c, 0x18, 0x91, 0x37, 0x2b, 0x5a, 0xe8, 0xcd, 0x91, 0xba,
+	0xc6, 0xcb, 0x8b, 0x67, 0x0b, 0x2a, 0xcc, 0x57, 0x04, 0xe6, 0x1c, 0x9a, 0xc9, 0xc5, 0x94, 0x77,
+	0xf8, 0xc6, 0xd6, 0xd3, 0xa3, 0x8a, 0xf1, 0xec, 0xa8, 0x62, 0xfc, 0x79, 0x54, 0x31, 0x0e, 0x8f,
+	0x2b, 0x03, 0xcf, 0x8e, 0x2b, 0x03, 0xbf, 0x1d, 0x57, 0x06, 0x3e, 0x5e, 0x4d, 0x3c, 0xaf, 0xd2,
+	0x80, 0xfc, 0xdb, 0xa9, 0xd5, 0xed, 0x07, 0x49, 0x63, 0xe2, 0xa5, 0xdd, 0x19, 0x11, 0xaf, 0xd4,
+	0xfa, 0x3f, 0x03, 0x00, 0xa1, 0x07, 0xfb, 0xdd, 0xa6, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -824,6 +1117,11 @@ type QueryClient interface {
 	AllocationMeters(ctx context.Context, in *QueryAllocationMetersRequest, opts ...grpc.CallOption) (*QueryAllocationMetersResponse, error)
 	// AllocationMeter retrieves a active gas meter
 	AllocationMeter(ctx context.Context, in *QueryAllocationMeterRequest, opts ...grpc.CallOption) (*QueryAllocationMeterResponse, error)
+	// Sponsorships retrieves the sponsor-funded incentives of a given contract
+	Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error)
+	// EstimatedRewards retrieves the projected rewards of a participant of an
+	// incentivized contract for the current epoch
+	EstimatedRewards(ctx context.Context, in *QueryEstimatedRewardsRequest, opts ...grpc.CallOption) (*QueryEstimatedRewardsResponse, error)
 	// Params retrieves the incentives module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -890,6 +1188,24 @@ func (c *queryClient) AllocationMeter(ctx context.Context, in *QueryAllocationMe
 	return out, nil
 }
 
+func (c *queryClient) Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error) {
+	out := new(QuerySponsorshipsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/Sponsorships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimatedRewards(ctx context.Context, in *QueryEstimatedRewardsRequest, opts ...grpc.CallOption) (*QueryEstimatedRewardsResponse, error) {
+	out := new(QueryEstimatedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/EstimatedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/Params", in, out, opts...)
@@ -914,6 +1230,11 @@ type QueryServer interface {
 	AllocationMeters(context.Context, *QueryAllocationMetersRequest) (*QueryAllocationMetersResponse, error)
 	// AllocationMeter retrieves a active gas meter
 	AllocationMeter(context.Context, *QueryAllocationMeterRequest) (*QueryAllocationMeterResponse, error)
+	// Sponsorships retrieves the sponsor-funded incentives of a given contract
+	Sponsorships(context.Context, *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error)
+	// EstimatedRewards retrieves the projected rewards of a participant of an
+	// incentivized contract for the current epoch
+	EstimatedRewards(context.Context, *QueryEstimatedRewardsRequest) (*QueryEstimatedRewardsResponse, error)
 	// Params retrieves the incentives module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -940,6 +1261,12 @@ func (*UnimplementedQueryServer) AllocationMeters(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) AllocationMeter(ctx context.Context, req *QueryAllocationMeterRequest) (*QueryAllocationMeterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllocationMeter not implemented")
 }
+func (*UnimplementedQueryServer) Sponsorships(ctx context.Context, req *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorships not implemented")
+}
+func (*UnimplementedQueryServer) EstimatedRewards(ctx context.Context, req *QueryEstimatedRewardsRequest) (*QueryEstimatedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimatedRewards not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1056,6 +1383,42 @@ func _Query_AllocationMeter_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Sponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/Sponsorships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorships(ctx, req.(*QuerySponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimatedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimatedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimatedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/EstimatedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimatedRewards(ctx, req.(*QueryEstimatedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1102,6 +1465,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllocationMeter",
 			Handler:    _Query_AllocationMeter_Handler,
 		},
+		{
+			MethodName: "Sponsorships",
+			Handler:    _Query_Sponsorships_Handler,
+		},
+		{
+			MethodName: "EstimatedRewards",
+			Handler:    _Query_EstimatedRewards_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1561,7 +1932,7 @@ func (m *QueryAllocationMeterResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySponsorshipsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1571,20 +1942,39 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySponsorshipsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySponsorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySponsorshipsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1594,35 +1984,221 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySponsorshipsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySponsorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochTimeRemaining, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochTimeRemaining):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EpochEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochEndTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	if m.TotalGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasMeter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasMeter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SponsoredRewards) > 0 {
+		for iNdEx := len(m.SponsoredRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsoredRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -1805,6 +2381,90 @@ func (m *QueryAllocationMeterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySponsorshipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimatedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimatedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SponsoredRewards) > 0 {
+		for _, e := range m.SponsoredRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasMeter != 0 {
+		n += 1 + sovQuery(uint64(m.GasMeter))
+	}
+	if m.TotalGas != 0 {
+		n += 1 + sovQuery(uint64(m.TotalGas))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochEndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochTimeRemaining)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2994,6 +3654,580 @@ func (m *QueryAllocationMeterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySponsorshipsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorshipsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorshipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorshipsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorshipsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorshipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimatedRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimatedRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimatedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimatedRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimatedRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimatedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsoredRewards = append(m.SponsoredRewards, types.Coin{})
+			if err := m.SponsoredRewards[len(m.SponsoredRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMeter", wireType)
+			}
+			m.GasMeter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMeter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+			}
+			m.TotalGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EpochEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTimeRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EpochTimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
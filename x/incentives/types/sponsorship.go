@@ -0,0 +1,84 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+// NewSponsorship returns an instance of Sponsorship
+func NewSponsorship(
+	contract common.Address,
+	sponsor sdk.AccAddress,
+	escrow, epochBudget sdk.Coins,
+	epochs uint32,
+) Sponsorship {
+	return Sponsorship{
+		Contract:    contract.String(),
+		Sponsor:     sponsor.String(),
+		Escrow:      escrow,
+		EpochBudget: epochBudget,
+		Epochs:      epochs,
+	}
+}
+
+// Validate performs a stateless validation of a Sponsorship
+func (s Sponsorship) Validate() error {
+	if err := evmostypes.ValidateAddress(s.Contract); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Sponsor); err != nil {
+		return fmt.Errorf("invalid sponsor address %s: %w", s.Sponsor, err)
+	}
+
+	if err := s.Escrow.Validate(); err != nil {
+		return fmt.Errorf("invalid escrow: %w", err)
+	}
+
+	if err := s.EpochBudget.Validate(); err != nil {
+		return fmt.Errorf("invalid epoch budget: %w", err)
+	}
+
+	if s.IsActive() && s.EpochBudget.IsZero() {
+		return fmt.Errorf("epoch budget cannot be empty")
+	}
+
+	return nil
+}
+
+// IsActive returns true if the Sponsorship has remaining Epochs
+func (s Sponsorship) IsActive() bool {
+	return s.Epochs > 0
+}
+
+// EpochRewards returns the amount distributed to the participants in the
+// current epoch, i.e. the epoch budget capped by the remaining escrow
+func (s Sponsorship) EpochRewards() sdk.Coins {
+	rewards := sdk.Coins{}
+	for _, coin := range s.EpochBudget {
+		amount := sdk.MinInt(coin.Amount, s.Escrow.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			rewards = rewards.Add(sdk.Coin{Denom: coin.Denom, Amount: amount})
+		}
+	}
+	return rewards
+}
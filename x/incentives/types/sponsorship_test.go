@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/incentives/types"
+)
+
+func TestSponsorshipValidate(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	budget := sdk.NewCoins(sdk.NewInt64Coin("acoin", 100))
+
+	testCases := []struct {
+		name        string
+		sponsorship types.Sponsorship
+		expPass     bool
+	}{
+		{
+			"pass - active sponsorship",
+			types.NewSponsorship(contract, sponsor, budget, budget, 10),
+			true,
+		},
+		{
+			"pass - finished sponsorship without budget",
+			types.NewSponsorship(contract, sponsor, budget, sdk.Coins{}, 0),
+			true,
+		},
+		{
+			"fail - active sponsorship without budget",
+			types.NewSponsorship(contract, sponsor, budget, sdk.Coins{}, 10),
+			false,
+		},
+		{
+			"fail - invalid contract",
+			types.Sponsorship{Contract: "0xinvalid", Sponsor: sponsor.String(), EpochBudget: budget, Epochs: 10},
+			false,
+		},
+		{
+			"fail - invalid sponsor",
+			types.Sponsorship{Contract: contract.String(), Sponsor: "evmos1invalid", EpochBudget: budget, Epochs: 10},
+			false,
+		},
+		{
+			"fail - invalid escrow",
+			types.Sponsorship{
+				Contract:    contract.String(),
+				Sponsor:     sponsor.String(),
+				Escrow:      sdk.Coins{{Denom: "acoin", Amount: sdk.NewInt(-1)}},
+				EpochBudget: budget,
+				Epochs:      10,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.sponsorship.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSponsorshipEpochRewards(t *testing.T) {
+	sponsorship := types.Sponsorship{
+		Escrow: sdk.NewCoins(sdk.NewInt64Coin("acoin", 50), sdk.NewInt64Coin("atoken", 500)),
+		EpochBudget: sdk.NewCoins(
+			sdk.NewInt64Coin("acoin", 100),
+			sdk.NewInt64Coin("atoken", 100),
+			sdk.NewInt64Coin("aunfunded", 100),
+		),
+	}
+
+	// the budget is capped by the remaining escrow
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("acoin", 50), sdk.NewInt64Coin("atoken", 100)),
+		sponsorship.EpochRewards(),
+	)
+}
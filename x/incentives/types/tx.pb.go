@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -124,35 +126,282 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSponsorIncentive defines a message that deposits rewards for the
+// participants of a contract. Coins backed by a registered ERC20 token pair are
+// converted from the sponsor's ERC20 balance if the coin balance is
+// insufficient.
+type MsgSponsorIncentive struct {
+	// sponsor is the bech32 address of the account that funds the incentive
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// contract is the hex address of the incentivized smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// deposit is the amount added to the escrow of the sponsorship
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// epoch_budget is the maximum amount distributed per epoch. If empty, the
+	// budget of an existing sponsorship is kept.
+	EpochBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=epoch_budget,json=epochBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_budget"`
+	// epochs is the number of remaining epochs. If zero, the remaining epochs of
+	// an existing sponsorship are kept.
+	Epochs uint32 `protobuf:"varint,5,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *MsgSponsorIncentive) Reset()         { *m = MsgSponsorIncentive{} }
+func (m *MsgSponsorIncentive) String() string { return proto.CompactTextString(m) }
+func (*MsgSponsorIncentive) ProtoMessage()    {}
+func (*MsgSponsorIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{2}
+}
+func (m *MsgSponsorIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSponsorIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSponsorIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSponsorIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSponsorIncentive.Merge(m, src)
+}
+func (m *MsgSponsorIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSponsorIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSponsorIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSponsorIncentive proto.InternalMessageInfo
+
+func (m *MsgSponsorIncentive) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgSponsorIncentive) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgSponsorIncentive) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *MsgSponsorIncentive) GetEpochBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochBudget
+	}
+	return nil
+}
+
+func (m *MsgSponsorIncentive) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+// MsgSponsorIncentiveResponse defines the MsgSponsorIncentive response type
+type MsgSponsorIncentiveResponse struct {
+}
+
+func (m *MsgSponsorIncentiveResponse) Reset()         { *m = MsgSponsorIncentiveResponse{} }
+func (m *MsgSponsorIncentiveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSponsorIncentiveResponse) ProtoMessage()    {}
+func (*MsgSponsorIncentiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{3}
+}
+func (m *MsgSponsorIncentiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSponsorIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSponsorIncentiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSponsorIncentiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSponsorIncentiveResponse.Merge(m, src)
+}
+func (m *MsgSponsorIncentiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSponsorIncentiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSponsorIncentiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSponsorIncentiveResponse proto.InternalMessageInfo
+
+// MsgWithdrawSponsorship defines a message that withdraws the unspent escrow
+// of a sponsorship once it has no remaining epochs
+type MsgWithdrawSponsorship struct {
+	// sponsor is the bech32 address of the account that funded the incentive
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// contract is the hex address of the incentivized smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgWithdrawSponsorship) Reset()         { *m = MsgWithdrawSponsorship{} }
+func (m *MsgWithdrawSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorship) ProtoMessage()    {}
+func (*MsgWithdrawSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{4}
+}
+func (m *MsgWithdrawSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorship.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorship proto.InternalMessageInfo
+
+func (m *MsgWithdrawSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgWithdrawSponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// MsgWithdrawSponsorshipResponse defines the MsgWithdrawSponsorship response
+// type
+type MsgWithdrawSponsorshipResponse struct {
+	// amount is the unspent escrow returned to the sponsor
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawSponsorshipResponse) Reset()         { *m = MsgWithdrawSponsorshipResponse{} }
+func (m *MsgWithdrawSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorshipResponse) ProtoMessage()    {}
+func (*MsgWithdrawSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{5}
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorshipResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawSponsorshipResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.incentives.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.incentives.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSponsorIncentive)(nil), "evmos.incentives.v1.MsgSponsorIncentive")
+	proto.RegisterType((*MsgSponsorIncentiveResponse)(nil), "evmos.incentives.v1.MsgSponsorIncentiveResponse")
+	proto.RegisterType((*MsgWithdrawSponsorship)(nil), "evmos.incentives.v1.MsgWithdrawSponsorship")
+	proto.RegisterType((*MsgWithdrawSponsorshipResponse)(nil), "evmos.incentives.v1.MsgWithdrawSponsorshipResponse")
 }
 
 func init() { proto.RegisterFile("evmos/incentives/v1/tx.proto", fileDescriptor_8acb8c4fd5b75ea3) }
 
 var fileDescriptor_8acb8c4fd5b75ea3 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06, 0xcb, 0xea, 0x21, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x41, 0x7a, 0x72, 0x8b, 0xd3, 0x41, 0x8a, 0x73, 0x8b,
-	0xd3, 0x21, 0xaa, 0xa5, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x52, 0xc4,
-	0x66, 0x4d, 0x7a, 0x6a, 0x5e, 0x6a, 0x71, 0x26, 0x4c, 0x89, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x44,
-	0x2b, 0x88, 0x05, 0x11, 0x55, 0x9a, 0xc2, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92,
-	0x58, 0x92, 0x1a, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92,
-	0x91, 0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b,
-	0xae, 0x08, 0xd4, 0x46, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc,
-	0xf4, 0x20, 0x84, 0x52, 0x21, 0x4b, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a, 0x8c, 0x1a,
-	0xdc, 0x46, 0xd2, 0x7a, 0x58, 0xbc, 0xa7, 0x07, 0xb1, 0xc4, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86,
-	0x20, 0xa8, 0x06, 0x2b, 0xbe, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x46, 0x29, 0x49, 0x72, 0x89, 0xa3,
-	0xb9, 0x2a, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5, 0x28, 0x93, 0x8b, 0xd9, 0xb7, 0x38,
-	0x5d, 0x28, 0x89, 0x8b, 0x07, 0xc5, 0xd1, 0x2a, 0x58, 0x2d, 0x43, 0x33, 0x44, 0x4a, 0x87, 0x18,
-	0x55, 0x30, 0xab, 0x9c, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x12, 0xf4, 0x10, 0xb2,
-	0xcc, 0xd0, 0x48, 0xbf, 0x02, 0x39, 0x1a, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81,
-	0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x07, 0xb4, 0xff, 0x0e, 0x02, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xdb, 0x92, 0x92, 0x4d, 0xf8, 0x90, 0x53, 0xb5, 0x8e, 0x0b, 0x6e, 0x88, 0x38, 0x58,
+	0x40, 0xec, 0x26, 0x95, 0x90, 0xe8, 0x8d, 0x70, 0x40, 0x1c, 0x22, 0x21, 0x57, 0x08, 0x09, 0x21,
+	0x55, 0xfe, 0x58, 0x6d, 0x56, 0xc8, 0xbb, 0x96, 0x67, 0xe3, 0xb6, 0x57, 0x24, 0xee, 0x1c, 0xf8,
+	0x15, 0x9c, 0x38, 0xf0, 0x23, 0x72, 0xac, 0x7a, 0xe2, 0x04, 0x28, 0x39, 0xf0, 0x37, 0x90, 0xed,
+	0x75, 0x52, 0x82, 0x2b, 0x15, 0xd4, 0x8b, 0xed, 0xb7, 0xf3, 0xe6, 0xbd, 0xd9, 0x99, 0xf5, 0xa2,
+	0x3b, 0x38, 0x09, 0x39, 0xd8, 0x94, 0xf9, 0x98, 0x09, 0x9a, 0x60, 0xb0, 0x93, 0x9e, 0x2d, 0x8e,
+	0xad, 0x28, 0xe6, 0x82, 0xab, 0xcd, 0x2c, 0x6a, 0x2d, 0xa2, 0x56, 0xd2, 0xd3, 0x0d, 0x9f, 0x43,
+	0x9a, 0xe3, 0xb9, 0x80, 0xed, 0xa4, 0xe7, 0x61, 0xe1, 0xf6, 0x6c, 0x9f, 0x53, 0x96, 0x27, 0xe9,
+	0x5b, 0x32, 0x1e, 0x02, 0x49, 0xc5, 0x42, 0x20, 0x32, 0xd0, 0xca, 0x03, 0x87, 0x19, 0xb2, 0x73,
+	0x20, 0x43, 0xf7, 0xca, 0xca, 0x20, 0x98, 0x61, 0xa0, 0x05, 0x65, 0x83, 0x70, 0xc2, 0xf3, 0xd4,
+	0xf4, 0x2b, 0x5f, 0xed, 0x7c, 0x52, 0xd0, 0xad, 0x21, 0x90, 0x57, 0x51, 0xe0, 0x0a, 0xfc, 0xd2,
+	0x8d, 0xdd, 0x10, 0xd4, 0xc7, 0xa8, 0xe6, 0x8e, 0xc5, 0x88, 0xc7, 0x54, 0x9c, 0x68, 0x4a, 0x5b,
+	0x31, 0x6b, 0x03, 0xed, 0xec, 0x6b, 0x77, 0x43, 0x3a, 0x3e, 0x0d, 0x82, 0x18, 0x03, 0x1c, 0x88,
+	0x98, 0x32, 0xe2, 0x2c, 0xa8, 0xea, 0x13, 0x54, 0x8d, 0x32, 0x05, 0x6d, 0xa5, 0xad, 0x98, 0xf5,
+	0xfe, 0xb6, 0x55, 0xb2, 0x7d, 0x2b, 0x37, 0x19, 0xac, 0x4d, 0xbe, 0xef, 0x54, 0x1c, 0x99, 0xb0,
+	0x7f, 0xf3, 0xfd, 0xaf, 0x2f, 0x0f, 0x16, 0x52, 0x9d, 0x16, 0xda, 0x5a, 0xaa, 0xca, 0xc1, 0x10,
+	0x71, 0x06, 0xb8, 0x33, 0x59, 0x41, 0xcd, 0x21, 0x90, 0x83, 0x14, 0xf1, 0xf8, 0x45, 0xa1, 0xad,
+	0x6a, 0x68, 0x1d, 0xf2, 0xb5, 0xbc, 0x66, 0xa7, 0x80, 0xaa, 0x8e, 0xae, 0xfb, 0x9c, 0x89, 0xd8,
+	0xf5, 0x45, 0x56, 0x59, 0xcd, 0x99, 0x63, 0x15, 0xa3, 0xf5, 0x00, 0x47, 0x1c, 0xa8, 0xd0, 0x56,
+	0xdb, 0xab, 0x66, 0xbd, 0xdf, 0xb2, 0xe4, 0x36, 0xd3, 0xf1, 0x58, 0x72, 0x3c, 0xd6, 0x33, 0x4e,
+	0xd9, 0x60, 0x37, 0x2d, 0xf9, 0xf3, 0x8f, 0x1d, 0x93, 0x50, 0x31, 0x1a, 0x7b, 0x96, 0xcf, 0x43,
+	0x39, 0x05, 0xf9, 0xea, 0x42, 0xf0, 0xce, 0x16, 0x27, 0x11, 0x86, 0x2c, 0x01, 0x9c, 0x42, 0x5b,
+	0x65, 0xa8, 0x81, 0x23, 0xee, 0x8f, 0x0e, 0xbd, 0x71, 0x40, 0xb0, 0xd0, 0xd6, 0xae, 0xde, 0xab,
+	0x9e, 0x19, 0x0c, 0x32, 0x7d, 0x75, 0x13, 0x55, 0x33, 0x08, 0xda, 0xb5, 0xb6, 0x62, 0xde, 0x70,
+	0x24, 0xda, 0x6f, 0xa4, 0x7d, 0x2e, 0x1a, 0xd3, 0xb9, 0x8b, 0xb6, 0x4b, 0x3a, 0x39, 0xef, 0xf4,
+	0x5b, 0xb4, 0x39, 0x04, 0xf2, 0x9a, 0x8a, 0x51, 0x10, 0xbb, 0x47, 0x92, 0x06, 0x23, 0x1a, 0xfd,
+	0x5f, 0xaf, 0x97, 0xcc, 0x3f, 0x28, 0xc8, 0x28, 0x97, 0x2f, 0x0a, 0x50, 0x7d, 0x54, 0x75, 0x43,
+	0x3e, 0x66, 0x42, 0x53, 0xae, 0xbe, 0x5f, 0x52, 0xba, 0x7f, 0xb6, 0x82, 0x56, 0x87, 0x40, 0x54,
+	0x0f, 0x35, 0xfe, 0xf8, 0x0b, 0xee, 0x97, 0x9e, 0xde, 0xa5, 0x53, 0xa9, 0x3f, 0xba, 0x0c, 0x6b,
+	0xbe, 0x21, 0x86, 0x6e, 0xff, 0x75, 0x6e, 0xcd, 0x8b, 0x14, 0x96, 0x99, 0xfa, 0xee, 0x65, 0x99,
+	0x73, 0xbf, 0x23, 0xd4, 0x2c, 0x1b, 0xdf, 0xc3, 0x8b, 0x84, 0x4a, 0xc8, 0xfa, 0xde, 0x3f, 0x90,
+	0x0b, 0xe3, 0xc1, 0xf3, 0xc9, 0xd4, 0x50, 0x4e, 0xa7, 0x86, 0xf2, 0x73, 0x6a, 0x28, 0x1f, 0x67,
+	0x46, 0xe5, 0x74, 0x66, 0x54, 0xbe, 0xcd, 0x8c, 0xca, 0x9b, 0xee, 0xb9, 0x01, 0xe5, 0x97, 0x56,
+	0xfe, 0x4c, 0x7a, 0x7d, 0xfb, 0xf8, 0xfc, 0x05, 0x96, 0xcd, 0xca, 0xab, 0x66, 0xd7, 0xd4, 0xde,
+	0xef, 0x01, 0x00, 0x7d, 0x4d, 0x23, 0x55, 0x68, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +419,12 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SponsorIncentive deposits rewards into the escrow of a sponsor-funded
+	// incentive for a contract, creating or extending the sponsorship.
+	SponsorIncentive(ctx context.Context, in *MsgSponsorIncentive, opts ...grpc.CallOption) (*MsgSponsorIncentiveResponse, error)
+	// WithdrawSponsorship returns the unspent escrow of a finished sponsorship to
+	// the sponsor.
+	WithdrawSponsorship(ctx context.Context, in *MsgWithdrawSponsorship, opts ...grpc.CallOption) (*MsgWithdrawSponsorshipResponse, error)
 }
 
 type msgClient struct {
@@ -189,11 +444,35 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SponsorIncentive(ctx context.Context, in *MsgSponsorIncentive, opts ...grpc.CallOption) (*MsgSponsorIncentiveResponse, error) {
+	out := new(MsgSponsorIncentiveResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/SponsorIncentive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSponsorship(ctx context.Context, in *MsgWithdrawSponsorship, opts ...grpc.CallOption) (*MsgWithdrawSponsorshipResponse, error) {
+	out := new(MsgWithdrawSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/WithdrawSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SponsorIncentive deposits rewards into the escrow of a sponsor-funded
+	// incentive for a contract, creating or extending the sponsorship.
+	SponsorIncentive(context.Context, *MsgSponsorIncentive) (*MsgSponsorIncentiveResponse, error)
+	// WithdrawSponsorship returns the unspent escrow of a finished sponsorship to
+	// the sponsor.
+	WithdrawSponsorship(context.Context, *MsgWithdrawSponsorship) (*MsgWithdrawSponsorshipResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -203,6 +482,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SponsorIncentive(ctx context.Context, req *MsgSponsorIncentive) (*MsgSponsorIncentiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorIncentive not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSponsorship(ctx context.Context, req *MsgWithdrawSponsorship) (*MsgWithdrawSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSponsorship not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -226,6 +511,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SponsorIncentive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSponsorIncentive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SponsorIncentive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/SponsorIncentive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SponsorIncentive(ctx, req.(*MsgSponsorIncentive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/WithdrawSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSponsorship(ctx, req.(*MsgWithdrawSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.incentives.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -234,6 +555,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SponsorIncentive",
+			Handler:    _Msg_SponsorIncentive_Handler,
+		},
+		{
+			MethodName: "WithdrawSponsorship",
+			Handler:    _Msg_WithdrawSponsorship_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/incentives/v1/tx.proto",
@@ -302,56 +631,296 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSponsorIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSponsorIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSponsorIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Epochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.EpochBudget) > 0 {
+		for iNdEx := len(m.EpochBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSponsorIncentiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSponsorIncentiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSponsorIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgWithdrawSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgWithdrawSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (m *MsgWithdrawSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSponsorIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EpochBudget) > 0 {
+		for _, e := range m.EpochBudget {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovTx(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *MsgSponsorIncentiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -508,6 +1077,455 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSponsorIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSponsorIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSponsorIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochBudget = append(m.EpochBudget, types.Coin{})
+			if err := m.EpochBudget[len(m.EpochBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSponsorIncentiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSponsorIncentiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSponsorIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,6 +19,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -43,6 +44,8 @@ func GetQueryCmd() *cobra.Command {
 		GetSkippedEpochs(),
 		GetCirculatingSupply(),
 		GetInflationRate(),
+		GetActiveScheduleSegment(),
+		GetSupplyProjection(),
 		GetParams(),
 	)
 
@@ -197,6 +200,75 @@ func GetInflationRate() *cobra.Command {
 	return cmd
 }
 
+// GetActiveScheduleSegment implements a command to return the inflation
+// schedule segment that applies to the current period.
+func GetActiveScheduleSegment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active-schedule-segment",
+		Short: "Query the inflation schedule segment of the current period",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryActiveScheduleSegmentRequest{}
+			res, err := queryClient.ActiveScheduleSegment(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSupplyProjection implements a command to return the projected inflation
+// of the upcoming epochs.
+func GetSupplyProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-projection EPOCHS",
+		Short: "Query the projected minted supply, circulating supply and inflation rate of the upcoming epochs",
+		Long: fmt.Sprintf(
+			"Simulate up to %d upcoming epochs with the current params and bonded ratio and return the projected minted supply, circulating supply and inflation rate of each epoch",
+			types.MaxSupplyProjectionEpochs,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			epochs, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid number of epochs %s: %w", args[0], err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySupplyProjectionRequest{Epochs: epochs}
+			res, err := queryClient.SupplyProjection(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetParams implements a command to return the current inflation
 // parameters.
 func GetParams() *cobra.Command {
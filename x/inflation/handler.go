@@ -32,6 +32,9 @@ func NewHandler(server types.MsgServer) sdk.Handler {
 		case *types.MsgUpdateParams:
 			res, err := server.UpdateParams(ctx, msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateInflationSchedule:
+			res, err := server.UpdateInflationSchedule(ctx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			err := errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, err
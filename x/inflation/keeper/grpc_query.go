@@ -20,6 +20,9 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/evmos/evmos/v12/x/inflation/types"
 )
 
@@ -95,3 +98,63 @@ func (k Keeper) Params(
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// ActiveScheduleSegment returns the inflation schedule type and, for the
+// piecewise schedule, the segment that applies to the current period.
+func (k Keeper) ActiveScheduleSegment(
+	c context.Context,
+	_ *types.QueryActiveScheduleSegmentRequest,
+) (*types.QueryActiveScheduleSegmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	period := k.GetPeriod(ctx)
+
+	res := &types.QueryActiveScheduleSegmentResponse{
+		ScheduleType: params.ScheduleType,
+		Period:       period,
+	}
+
+	if params.ScheduleType != types.INFLATION_SCHEDULE_TYPE_PIECEWISE {
+		return res, nil
+	}
+
+	segment, index, found := params.PiecewiseCalculation.ActiveSegment(period)
+	if !found {
+		return res, nil
+	}
+
+	res.Segment = &segment
+	res.SegmentIndex = uint64(index)
+	if next := index + 1; next < len(params.PiecewiseCalculation.Segments) {
+		res.EndPeriod = params.PiecewiseCalculation.Segments[next].StartPeriod
+	}
+
+	return res, nil
+}
+
+// SupplyProjection returns the projected mint provision, minted supply,
+// circulating supply and inflation rate of the requested number of upcoming
+// epochs.
+func (k Keeper) SupplyProjection(
+	c context.Context,
+	req *types.QuerySupplyProjectionRequest,
+) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Epochs == 0 || req.Epochs > types.MaxSupplyProjectionEpochs {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epochs must be between 1 and %d, got %d", types.MaxSupplyProjectionEpochs, req.Epochs,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	projections, err := k.ProjectSupply(ctx, req.Epochs)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QuerySupplyProjectionResponse{Projections: projections}, nil
+}
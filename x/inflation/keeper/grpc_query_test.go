@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	evmostypes "github.com/evmos/evmos/v12/types"
+	epochstypes "github.com/evmos/evmos/v12/x/epochs/types"
 	"github.com/evmos/evmos/v12/x/inflation/types"
 )
 
@@ -204,3 +205,146 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryActiveScheduleSegment() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.ActiveScheduleSegment(ctx, &types.QueryActiveScheduleSegmentRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.INFLATION_SCHEDULE_TYPE_EXPONENTIAL, res.ScheduleType)
+	suite.Require().Nil(res.Segment)
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.ScheduleType = types.INFLATION_SCHEDULE_TYPE_PIECEWISE
+	params.PiecewiseCalculation.Segments = []types.ScheduleSegment{
+		{StartPeriod: 0, PeriodProvision: sdk.NewDec(365), TargetRate: sdk.ZeroDec()},
+		{StartPeriod: 2, PeriodProvision: sdk.ZeroDec(), TargetRate: sdk.NewDecWithPrec(2, 2)},
+		{StartPeriod: 5, PeriodProvision: sdk.NewDec(100), TargetRate: sdk.ZeroDec()},
+	}
+	err = suite.app.InflationKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(err)
+	suite.app.InflationKeeper.SetPeriod(suite.ctx, 3)
+	suite.Commit()
+
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	res, err = suite.queryClient.ActiveScheduleSegment(ctx, &types.QueryActiveScheduleSegmentRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.INFLATION_SCHEDULE_TYPE_PIECEWISE, res.ScheduleType)
+	suite.Require().Equal(uint64(3), res.Period)
+	suite.Require().Equal(params.PiecewiseCalculation.Segments[1], *res.Segment)
+	suite.Require().Equal(uint64(1), res.SegmentIndex)
+	suite.Require().Equal(uint64(5), res.EndPeriod)
+}
+
+func (suite *KeeperTestSuite) TestQuerySupplyProjection() {
+	testCases := []struct {
+		name       string
+		malleate   func()
+		epochs     uint64
+		expPeriods []uint64
+		expPass    bool
+	}{
+		{
+			"fail - zero epochs",
+			func() {},
+			0,
+			nil,
+			false,
+		},
+		{
+			"fail - more than max epochs",
+			func() {},
+			types.MaxSupplyProjectionEpochs + 1,
+			nil,
+			false,
+		},
+		{
+			"pass - inflation disabled",
+			func() {
+				params := suite.app.InflationKeeper.GetParams(suite.ctx)
+				params.EnableInflation = false
+				err := suite.app.InflationKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			3,
+			[]uint64{0, 0, 0},
+			true,
+		},
+		{
+			"pass - period changes after epochs per period",
+			func() {
+				suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 2)
+			},
+			5,
+			[]uint64{0, 0, 0, 1, 1},
+			true,
+		},
+		{
+			"pass - with skipped epochs",
+			func() {
+				suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 2)
+				suite.app.InflationKeeper.SetSkippedEpochs(suite.ctx, 1)
+			},
+			5,
+			[]uint64{0, 0, 0, 0, 1},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, epochstypes.DayEpochID)
+			suite.Require().True(found)
+			epochInfo.CurrentEpoch = 0
+			suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epochInfo)
+
+			tc.malleate()
+			suite.Commit()
+
+			params := suite.app.InflationKeeper.GetParams(suite.ctx)
+			epochsPerPeriod := suite.app.InflationKeeper.GetEpochsPerPeriod(suite.ctx)
+			bondedRatio := suite.app.InflationKeeper.BondedRatio(suite.ctx)
+			circulatingSupply := suite.app.InflationKeeper.GetCirculatingSupply(suite.ctx, params.MintDenom)
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, params.MintDenom)
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.SupplyProjection(ctx, &types.QuerySupplyProjectionRequest{Epochs: tc.epochs})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Projections, int(tc.epochs))
+
+			mintedSupply := sdk.ZeroInt()
+			for i, projection := range res.Projections {
+				suite.Require().Equal(int64(i+1), projection.EpochNumber)
+				suite.Require().Equal(tc.expPeriods[i], projection.Period)
+
+				expProvision := sdk.ZeroDec()
+				if params.EnableInflation {
+					expProvision = types.CalculateEpochMintProvision(params, projection.Period, epochsPerPeriod, bondedRatio)
+				}
+				suite.Require().Equal(expProvision.TruncateInt(), projection.EpochMintProvision.Amount)
+
+				if params.EnableInflation {
+					expRate := expProvision.MulInt64(epochsPerPeriod).Quo(circulatingSupply).MulInt64(100)
+					suite.Require().Equal(expRate, projection.InflationRate)
+				} else {
+					suite.Require().True(projection.InflationRate.IsZero())
+				}
+
+				mintedSupply = mintedSupply.Add(projection.EpochMintProvision.Amount)
+				circulatingSupply = circulatingSupply.Add(sdk.NewDecFromInt(projection.EpochMintProvision.Amount))
+				suite.Require().Equal(mintedSupply, projection.MintedSupply.Amount)
+				suite.Require().Equal(circulatingSupply, projection.CirculatingSupply.Amount)
+			}
+
+			// the projection doesn't modify the state
+			suite.Require().Equal(supply, suite.app.BankKeeper.GetSupply(suite.ctx, params.MintDenom))
+			suite.Require().Equal(uint64(0), suite.app.InflationKeeper.GetPeriod(suite.ctx))
+		})
+	}
+}
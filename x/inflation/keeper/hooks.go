@@ -19,6 +19,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -64,7 +65,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
 	bondedRatio := k.BondedRatio(ctx)
 
-	epochMintProvision := types.CalculateEpochMintProvision(
+	epochMintProvision := k.CalculateEpochMintProvision(
+		ctx,
 		params,
 		period,
 		epochsPerPeriod,
@@ -149,6 +151,18 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	)
 }
 
+// AfterEpochScheduleChanged performs a no-op
+func (k Keeper) AfterEpochScheduleChanged(_ sdk.Context, _ epochstypes.EpochInfo, _ epochstypes.ScheduleChange) {
+}
+
+// BeforeEpochDeleted returns an error if the epoch is the inflation epoch
+func (k Keeper) BeforeEpochDeleted(ctx sdk.Context, epochIdentifier string) error {
+	if epochIdentifier == k.GetEpochIdentifier(ctx) {
+		return errorsmod.Wrapf(epochstypes.ErrEpochInUse, "%s is the inflation epoch identifier", epochIdentifier)
+	}
+	return nil
+}
+
 // ___________________________________________________________________________________________________
 
 // Hooks wrapper struct for incentives keeper
@@ -171,3 +185,15 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+var _ epochstypes.EpochScheduleHooks = Hooks{}
+
+// AfterEpochScheduleChanged implements EpochScheduleHooks
+func (h Hooks) AfterEpochScheduleChanged(ctx sdk.Context, epochInfo epochstypes.EpochInfo, change epochstypes.ScheduleChange) {
+	h.k.AfterEpochScheduleChanged(ctx, epochInfo, change)
+}
+
+// BeforeEpochDeleted implements EpochScheduleHooks
+func (h Hooks) BeforeEpochDeleted(ctx sdk.Context, epochIdentifier string) error {
+	return h.k.BeforeEpochDeleted(ctx, epochIdentifier)
+}
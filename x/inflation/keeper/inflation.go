@@ -174,13 +174,19 @@ func (k Keeper) GetInflationRate(ctx sdk.Context, mintDenom string) sdk.Dec {
 		return sdk.ZeroDec()
 	}
 
-	epochsPerPeriod := sdk.NewDec(epp)
-
 	circulatingSupply := k.GetCirculatingSupply(ctx, mintDenom)
-	if circulatingSupply.IsZero() {
+	return calculateInflationRate(epochMintProvision, epp, circulatingSupply)
+}
+
+// calculateInflationRate returns the percentage by which the circulating supply
+// increases within one period for the given epoch mint provision
+func calculateInflationRate(epochMintProvision sdk.Dec, epp int64, circulatingSupply sdk.Dec) sdk.Dec {
+	if epp == 0 || epochMintProvision.IsZero() || circulatingSupply.IsZero() {
 		return sdk.ZeroDec()
 	}
 
+	epochsPerPeriod := sdk.NewDec(epp)
+
 	// EpochMintProvision * 365 / circulatingSupply * 100
 	return epochMintProvision.Mul(epochsPerPeriod).Quo(circulatingSupply).Mul(sdk.NewDec(100))
 }
@@ -188,10 +194,57 @@ func (k Keeper) GetInflationRate(ctx sdk.Context, mintDenom string) sdk.Dec {
 // GetEpochMintProvision retrieves necessary params KV storage
 // and calculate EpochMintProvision
 func (k Keeper) GetEpochMintProvision(ctx sdk.Context) sdk.Dec {
-	return types.CalculateEpochMintProvision(
+	return k.CalculateEpochMintProvision(
+		ctx,
 		k.GetParams(ctx),
 		k.GetPeriod(ctx),
 		k.GetEpochsPerPeriod(ctx),
 		k.BondedRatio(ctx),
 	)
 }
+
+// CalculateEpochMintProvision calculates the mint provision per epoch with the
+// inflation schedule defined in the params
+func (k Keeper) CalculateEpochMintProvision(
+	ctx sdk.Context,
+	params types.Params,
+	period uint64,
+	epochsPerPeriod int64,
+	bondedRatio sdk.Dec,
+) sdk.Dec {
+	var circulatingSupply sdk.Dec
+	if params.ScheduleType == types.INFLATION_SCHEDULE_TYPE_PIECEWISE {
+		circulatingSupply = k.GetCirculatingSupply(ctx, params.MintDenom)
+	}
+
+	return calculateEpochMintProvision(params, period, epochsPerPeriod, bondedRatio, circulatingSupply)
+}
+
+// calculateEpochMintProvision calculates the mint provision per epoch for the
+// schedule type of the params. The circulating supply is only used by the
+// piecewise schedule.
+func calculateEpochMintProvision(
+	params types.Params,
+	period uint64,
+	epochsPerPeriod int64,
+	bondedRatio sdk.Dec,
+	circulatingSupply sdk.Dec,
+) sdk.Dec {
+	switch params.ScheduleType {
+	case types.INFLATION_SCHEDULE_TYPE_PIECEWISE:
+		return types.CalculatePiecewiseEpochMintProvision(
+			params.PiecewiseCalculation,
+			period,
+			epochsPerPeriod,
+			bondedRatio,
+			circulatingSupply,
+		)
+	default:
+		return types.CalculateEpochMintProvision(
+			params,
+			period,
+			epochsPerPeriod,
+			bondedRatio,
+		)
+	}
+}
@@ -40,3 +40,44 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateInflationSchedule defines a method for switching the inflation schedule
+// type and updating its calculation. The new schedule applies from the next
+// epoch on, starting at the segment that contains the current period.
+func (k Keeper) UpdateInflationSchedule(
+	goCtx context.Context,
+	req *types.MsgUpdateInflationSchedule,
+) (*types.MsgUpdateInflationScheduleResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	params.ScheduleType = req.ScheduleType
+	if req.ExponentialCalculation != nil {
+		params.ExponentialCalculation = *req.ExponentialCalculation
+	}
+	if req.PiecewiseCalculation != nil {
+		params.PiecewiseCalculation = *req.PiecewiseCalculation
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid inflation schedule")
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, errorsmod.Wrapf(err, "error setting params")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateInflationSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleType, params.ScheduleType.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochProvisions, k.GetEpochMintProvision(ctx).String()),
+		),
+	)
+
+	return &types.MsgUpdateInflationScheduleResponse{}, nil
+}
@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	evmostypes "github.com/evmos/evmos/v12/types"
 	"github.com/evmos/evmos/v12/x/inflation/types"
 )
 
@@ -39,3 +41,69 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateInflationSchedule() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	piecewise := types.PiecewiseCalculation{
+		Segments: []types.ScheduleSegment{
+			{StartPeriod: 0, PeriodProvision: sdk.NewDec(365), TargetRate: sdk.ZeroDec()},
+			{StartPeriod: 2, PeriodProvision: sdk.NewDec(730), TargetRate: sdk.ZeroDec()},
+		},
+		BondingTarget: sdk.NewDecWithPrec(66, 2),
+		MaxVariance:   sdk.ZeroDec(),
+	}
+
+	testCases := []struct {
+		name         string
+		request      *types.MsgUpdateInflationSchedule
+		expProvision sdk.Dec
+		expectErr    bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateInflationSchedule{Authority: "foobar"},
+			sdk.Dec{},
+			true,
+		},
+		{
+			"fail - piecewise schedule without segments",
+			&types.MsgUpdateInflationSchedule{
+				Authority:    authority,
+				ScheduleType: types.INFLATION_SCHEDULE_TYPE_PIECEWISE,
+			},
+			sdk.Dec{},
+			true,
+		},
+		{
+			"pass - switch to piecewise schedule",
+			&types.MsgUpdateInflationSchedule{
+				Authority:            authority,
+				ScheduleType:         types.INFLATION_SCHEDULE_TYPE_PIECEWISE,
+				PiecewiseCalculation: &piecewise,
+			},
+			sdk.NewDecFromInt(evmostypes.PowerReduction),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 365)
+
+			_, err := suite.app.InflationKeeper.UpdateInflationSchedule(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				params := suite.app.InflationKeeper.GetParams(suite.ctx)
+				suite.Require().Equal(types.INFLATION_SCHEDULE_TYPE_EXPONENTIAL, params.ScheduleType)
+				return
+			}
+
+			suite.Require().NoError(err)
+			params := suite.app.InflationKeeper.GetParams(suite.ctx)
+			suite.Require().Equal(tc.request.ScheduleType, params.ScheduleType)
+			suite.Require().Equal(tc.expProvision, suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/evmos/evmos/v12/x/epochs/types"
+	"github.com/evmos/evmos/v12/x/inflation/types"
+)
+
+// ProjectSupply simulates the inflation of the given number of upcoming epochs
+// following the same steps as the AfterEpochEnd hook. The params and the
+// bonded ratio are assumed to remain unchanged and no state is modified.
+func (k Keeper) ProjectSupply(ctx sdk.Context, epochs uint64) ([]types.EpochProjection, error) {
+	params := k.GetParams(ctx)
+	epochIdentifier := k.GetEpochIdentifier(ctx)
+
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, epochIdentifier)
+	if !found {
+		return nil, fmt.Errorf("epoch info not found for identifier %s", epochIdentifier)
+	}
+
+	var (
+		epochNumber       = epochInfo.CurrentEpoch
+		period            = k.GetPeriod(ctx)
+		skippedEpochs     = k.GetSkippedEpochs(ctx)
+		epochsPerPeriod   = k.GetEpochsPerPeriod(ctx)
+		bondedRatio       = k.BondedRatio(ctx)
+		circulatingSupply = k.GetCirculatingSupply(ctx, params.MintDenom)
+		mintedSupply      = sdk.ZeroInt()
+	)
+
+	projections := make([]types.EpochProjection, 0, epochs)
+	for i := uint64(0); i < epochs; i++ {
+		epochNumber++
+
+		projection := types.EpochProjection{
+			EpochNumber:        epochNumber,
+			Period:             period,
+			EpochMintProvision: sdk.NewCoin(params.MintDenom, sdk.ZeroInt()),
+			InflationRate:      sdk.ZeroDec(),
+		}
+
+		epochMintProvision := sdk.ZeroDec()
+		if params.EnableInflation && epochsPerPeriod > 0 {
+			epochMintProvision = calculateEpochMintProvision(
+				params,
+				period,
+				epochsPerPeriod,
+				bondedRatio,
+				circulatingSupply,
+			)
+		}
+
+		switch {
+		case !params.EnableInflation:
+			if epochIdentifier == epochstypes.DayEpochID {
+				skippedEpochs++
+			}
+		case epochMintProvision.IsPositive():
+			minted := epochMintProvision.TruncateInt()
+			projection.EpochMintProvision.Amount = minted
+			projection.InflationRate = calculateInflationRate(epochMintProvision, epochsPerPeriod, circulatingSupply)
+
+			mintedSupply = mintedSupply.Add(minted)
+			circulatingSupply = circulatingSupply.Add(sdk.NewDecFromInt(minted))
+
+			if epochNumber-epochsPerPeriod*int64(period)-int64(skippedEpochs) > epochsPerPeriod {
+				period++
+			}
+		}
+
+		projection.MintedSupply = sdk.NewCoin(params.MintDenom, mintedSupply)
+		projection.CirculatingSupply = sdk.DecCoin{Denom: params.MintDenom, Amount: circulatingSupply}
+		projections = append(projections, projection)
+	}
+
+	return projections, nil
+}
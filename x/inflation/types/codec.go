@@ -35,7 +35,8 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "evmos/inflation/MsgUpdateParams"
+	updateParamsName            = "evmos/inflation/MsgUpdateParams"
+	updateInflationScheduleName = "evmos/inflation/MsgUpdateInflationSchedule"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -49,6 +50,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateInflationSchedule{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -57,4 +59,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateInflationSchedule{}, updateInflationScheduleName, nil)
 }
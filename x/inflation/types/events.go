@@ -18,8 +18,10 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint                    = ModuleName
+	EventTypeUpdateInflationSchedule = "update_inflation_schedule"
 
 	AttributeKeyEpochProvisions = "epoch_provisions"
 	AttributeEpochNumber        = "epoch_number"
+	AttributeKeyScheduleType    = "schedule_type"
 )
@@ -115,6 +115,10 @@ type Params struct {
 	InflationDistribution InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// schedule_type defines the schedule used to calculate the epoch mint provision
+	ScheduleType InflationScheduleType `protobuf:"varint,5,opt,name=schedule_type,json=scheduleType,proto3,enum=evmos.inflation.v1.InflationScheduleType" json:"schedule_type,omitempty"`
+	// piecewise_calculation takes in the segments of the piecewise schedule
+	PiecewiseCalculation PiecewiseCalculation `protobuf:"bytes,6,opt,name=piecewise_calculation,json=piecewiseCalculation,proto3" json:"piecewise_calculation"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -178,6 +182,20 @@ func (m *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (m *Params) GetScheduleType() InflationScheduleType {
+	if m != nil {
+		return m.ScheduleType
+	}
+	return INFLATION_SCHEDULE_TYPE_EXPONENTIAL
+}
+
+func (m *Params) GetPiecewiseCalculation() PiecewiseCalculation {
+	if m != nil {
+		return m.PiecewiseCalculation
+	}
+	return PiecewiseCalculation{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.inflation.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.inflation.v1.Params")
@@ -186,33 +204,36 @@ func init() {
 func init() { proto.RegisterFile("evmos/inflation/v1/genesis.proto", fileDescriptor_1cb8eee530db1235) }
 
 var fileDescriptor_1cb8eee530db1235 = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x36, 0xc1, 0x22, 0x5b, 0xa0, 0xb0, 0x82, 0x60, 0x45, 0xc2, 0x58, 0x91, 0x90, 0xdc,
-	0x0a, 0xd9, 0x4a, 0xb8, 0x70, 0x2e, 0x2d, 0xa8, 0xb7, 0xc8, 0xdc, 0xb8, 0xac, 0xfc, 0x31, 0x71,
-	0x57, 0xd8, 0xbb, 0x2b, 0xef, 0xc6, 0x2a, 0xff, 0x82, 0x3f, 0xc1, 0x7f, 0xe9, 0xb1, 0x47, 0x4e,
-	0x15, 0x4a, 0xfe, 0x08, 0xf2, 0xae, 0x71, 0x2a, 0xe1, 0x8b, 0xe5, 0x7d, 0xef, 0xcd, 0x7b, 0x33,
-	0xa3, 0xc1, 0x3e, 0x34, 0x95, 0x50, 0x11, 0xe3, 0x9b, 0x32, 0xd1, 0x4c, 0xf0, 0xa8, 0x59, 0x46,
-	0x05, 0x70, 0x50, 0x4c, 0x85, 0xb2, 0x16, 0x5a, 0x10, 0x62, 0x14, 0x61, 0xaf, 0x08, 0x9b, 0xe5,
-	0xfc, 0x65, 0x21, 0x0a, 0x61, 0xe8, 0xa8, 0xfd, 0xb3, 0xca, 0xf9, 0x62, 0xc0, 0xeb, 0x50, 0x66,
-	0x34, 0x8b, 0x7b, 0x84, 0x9f, 0x7c, 0xb1, 0xfe, 0x5f, 0x75, 0xa2, 0x81, 0x7c, 0xc4, 0x8e, 0x4c,
-	0xea, 0xa4, 0x52, 0x2e, 0xf2, 0x51, 0x70, 0xbc, 0x9a, 0x87, 0xff, 0xe7, 0x85, 0x6b, 0xa3, 0x38,
-	0x9f, 0xdc, 0xde, 0xbf, 0x1d, 0xc5, 0x9d, 0x9e, 0xcc, 0xb0, 0x23, 0xa1, 0x66, 0x22, 0x77, 0x8f,
-	0x7c, 0x14, 0x4c, 0xe2, 0xee, 0x45, 0x4e, 0xf1, 0x73, 0x90, 0x22, 0xbb, 0xa6, 0x2c, 0x07, 0xae,
-	0xd9, 0x86, 0x41, 0xed, 0x8e, 0x7d, 0x14, 0x4c, 0xe3, 0x13, 0x83, 0x5f, 0xf5, 0x30, 0x39, 0xc3,
-	0x2f, 0x0c, 0xa4, 0xa8, 0x84, 0x9a, 0x76, 0x6e, 0x13, 0x1f, 0x05, 0xe3, 0x4e, 0xab, 0xd6, 0x50,
-	0xaf, 0xad, 0xed, 0x3b, 0xfc, 0x4c, 0x7d, 0x67, 0x52, 0x42, 0x4e, 0x2d, 0xe5, 0x3e, 0x32, 0xb1,
-	0x4f, 0x3b, 0xf4, 0xd2, 0x80, 0x8b, 0x5f, 0x47, 0xd8, 0xb1, 0xed, 0x92, 0x37, 0x18, 0x57, 0x8c,
-	0x6b, 0x9a, 0x03, 0x17, 0x95, 0x19, 0x6f, 0x1a, 0x4f, 0x5b, 0xe4, 0xa2, 0x05, 0x08, 0xc3, 0xaf,
-	0xe1, 0x46, 0x0a, 0xde, 0x76, 0x93, 0x94, 0x34, 0x4b, 0xca, 0x6c, 0x6b, 0x47, 0x36, 0x03, 0x1d,
-	0xaf, 0xce, 0x86, 0x56, 0x71, 0x79, 0x28, 0xf9, 0x74, 0xa8, 0xe8, 0x56, 0x33, 0x83, 0x41, 0x96,
-	0x6c, 0xf0, 0xac, 0x37, 0xa1, 0x39, 0x53, 0xba, 0x66, 0xe9, 0xd6, 0x24, 0x8d, 0x4d, 0xd2, 0xe9,
-	0x50, 0xd2, 0xd5, 0xbf, 0xc7, 0xc5, 0x83, 0x82, 0x2e, 0xe8, 0x15, 0x1b, 0x22, 0xcd, 0xea, 0x79,
-	0x92, 0x96, 0x40, 0x7b, 0xde, 0xac, 0xf3, 0x71, 0x7c, 0x62, 0xf1, 0xde, 0xf3, 0xfc, 0xf3, 0xed,
-	0xce, 0x43, 0x77, 0x3b, 0x0f, 0xfd, 0xd9, 0x79, 0xe8, 0xe7, 0xde, 0x1b, 0xdd, 0xed, 0xbd, 0xd1,
-	0xef, 0xbd, 0x37, 0xfa, 0xf6, 0xbe, 0x60, 0xfa, 0x7a, 0x9b, 0x86, 0x99, 0xa8, 0x22, 0x7b, 0x51,
-	0xf6, 0xdb, 0x2c, 0x57, 0xd1, 0xcd, 0x83, 0xeb, 0xd2, 0x3f, 0x24, 0xa8, 0xd4, 0x31, 0x77, 0xf5,
-	0xe1, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x20, 0x8b, 0xb4, 0x49, 0xc9, 0x02, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0x6e, 0x6c, 0x0d, 0x76, 0xf6, 0x4b, 0x87, 0xdd, 0x5a, 0x0a, 0xc6, 0x50, 0x10, 0xb2, 0x8b,
+	0x24, 0xb4, 0x5e, 0x3c, 0xeb, 0xae, 0xb2, 0x17, 0x29, 0x59, 0x4f, 0x5e, 0x86, 0x34, 0x79, 0xdb,
+	0x0e, 0x26, 0x99, 0x21, 0x33, 0xad, 0xbb, 0xff, 0xc2, 0x9f, 0xb5, 0xc7, 0x3d, 0x8a, 0x87, 0x45,
+	0xda, 0x3f, 0x22, 0x79, 0x27, 0x9b, 0x56, 0x36, 0xe0, 0xa5, 0x74, 0x9e, 0xf7, 0xf9, 0x98, 0xf7,
+	0x21, 0x43, 0x5c, 0x58, 0x65, 0x42, 0x05, 0x3c, 0x9f, 0xa5, 0x91, 0xe6, 0x22, 0x0f, 0x56, 0xa3,
+	0x60, 0x0e, 0x39, 0x28, 0xae, 0x7c, 0x59, 0x08, 0x2d, 0x28, 0x45, 0x86, 0x5f, 0x33, 0xfc, 0xd5,
+	0x68, 0x70, 0x3c, 0x17, 0x73, 0x81, 0xe3, 0xa0, 0xfc, 0x67, 0x98, 0x83, 0x61, 0x83, 0xd7, 0x56,
+	0x86, 0x9c, 0xe1, 0xbd, 0x45, 0xf6, 0x3f, 0x1b, 0xff, 0x2b, 0x1d, 0x69, 0xa0, 0xef, 0x89, 0x2d,
+	0xa3, 0x22, 0xca, 0x54, 0xdf, 0x72, 0x2d, 0x6f, 0x6f, 0x3c, 0xf0, 0x1f, 0xe7, 0xf9, 0x13, 0x64,
+	0x7c, 0xe8, 0xdc, 0xde, 0xbf, 0x6e, 0x85, 0x15, 0x9f, 0xf6, 0x88, 0x2d, 0xa1, 0xe0, 0x22, 0xe9,
+	0x3f, 0x71, 0x2d, 0xaf, 0x13, 0x56, 0x27, 0x7a, 0x4a, 0x9e, 0x83, 0x14, 0xf1, 0x82, 0xf1, 0x04,
+	0x72, 0xcd, 0x67, 0x1c, 0x8a, 0x7e, 0xdb, 0xb5, 0xbc, 0x6e, 0x78, 0x84, 0xf8, 0x65, 0x0d, 0xd3,
+	0x33, 0xf2, 0x02, 0x21, 0xc5, 0x24, 0x14, 0xac, 0x72, 0xeb, 0xb8, 0x96, 0xd7, 0xae, 0xb8, 0x6a,
+	0x02, 0xc5, 0xc4, 0xd8, 0xbe, 0x21, 0x87, 0xea, 0x3b, 0x97, 0x12, 0x12, 0x66, 0x46, 0xfd, 0xa7,
+	0x18, 0x7b, 0x50, 0xa1, 0x17, 0x08, 0x0e, 0x7f, 0xb7, 0x89, 0x6d, 0xae, 0x4b, 0x5f, 0x11, 0x92,
+	0xf1, 0x5c, 0xb3, 0x04, 0x72, 0x91, 0xe1, 0x7a, 0xdd, 0xb0, 0x5b, 0x22, 0xe7, 0x25, 0x40, 0x39,
+	0x79, 0x09, 0xd7, 0x52, 0xe4, 0xe5, 0x6d, 0xa2, 0x94, 0xc5, 0x51, 0x1a, 0x2f, 0xcd, 0xca, 0xb8,
+	0xd0, 0xde, 0xf8, 0xac, 0xa9, 0x8a, 0x8b, 0xad, 0xe4, 0xe3, 0x56, 0x51, 0x55, 0xd3, 0x83, 0xc6,
+	0x29, 0x9d, 0x91, 0x5e, 0x6d, 0xc2, 0x12, 0xae, 0x74, 0xc1, 0xa7, 0x4b, 0x4c, 0x6a, 0x63, 0xd2,
+	0x69, 0x53, 0xd2, 0xe5, 0xc3, 0xe1, 0x7c, 0x47, 0x50, 0x05, 0x9d, 0xf0, 0xa6, 0x21, 0x56, 0x9f,
+	0x47, 0xd3, 0x14, 0x58, 0x3d, 0xc7, 0x3a, 0x9f, 0x85, 0x47, 0x06, 0xaf, 0x3d, 0xe9, 0x17, 0x72,
+	0xa0, 0xe2, 0x05, 0x24, 0xcb, 0x14, 0x98, 0xbe, 0x91, 0x80, 0x6d, 0x1e, 0xfe, 0xe7, 0x26, 0x57,
+	0x95, 0xe2, 0xeb, 0x8d, 0x84, 0x70, 0x5f, 0xed, 0x9c, 0x68, 0x4c, 0x4e, 0x24, 0x87, 0x18, 0x7e,
+	0x70, 0x05, 0xff, 0x74, 0x69, 0xe3, 0x86, 0x5e, 0xe3, 0x67, 0xf5, 0x20, 0x78, 0xdc, 0xe4, 0xb1,
+	0x6c, 0x9a, 0x7d, 0xba, 0x5d, 0x3b, 0xd6, 0xdd, 0xda, 0xb1, 0xfe, 0xac, 0x1d, 0xeb, 0xe7, 0xc6,
+	0x69, 0xdd, 0x6d, 0x9c, 0xd6, 0xaf, 0x8d, 0xd3, 0xfa, 0xf6, 0x76, 0xce, 0xf5, 0x62, 0x39, 0xf5,
+	0x63, 0x91, 0x05, 0xe6, 0x19, 0x98, 0xdf, 0xd5, 0x68, 0x1c, 0x5c, 0xef, 0x3c, 0x89, 0x72, 0x55,
+	0x35, 0xb5, 0xf1, 0x31, 0xbc, 0xfb, 0x3b, 0x00, 0x19, 0xfe, 0xbb, 0x68, 0x7e, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -290,6 +311,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PiecewiseCalculation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ScheduleType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.EnableInflation {
 		i--
 		if m.EnableInflation {
@@ -382,6 +418,11 @@ func (m *Params) Size() (n int) {
 	if m.EnableInflation {
 		n += 2
 	}
+	if m.ScheduleType != 0 {
+		n += 1 + sovGenesis(uint64(m.ScheduleType))
+	}
+	l = m.PiecewiseCalculation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -710,6 +751,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableInflation = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
+			}
+			m.ScheduleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleType |= InflationScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseCalculation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PiecewiseCalculation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -24,6 +24,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationScheduleType enumerates the schedules used to calculate the
+// provision minted on each epoch
+type InflationScheduleType int32
+
+const (
+	// INFLATION_SCHEDULE_TYPE_EXPONENTIAL calculates the provision with the
+	// exponential calculation
+	INFLATION_SCHEDULE_TYPE_EXPONENTIAL InflationScheduleType = 0
+	// INFLATION_SCHEDULE_TYPE_PIECEWISE calculates the provision with the
+	// segment of the piecewise calculation that contains the current period
+	INFLATION_SCHEDULE_TYPE_PIECEWISE InflationScheduleType = 1
+)
+
+var InflationScheduleType_name = map[int32]string{
+	0: "INFLATION_SCHEDULE_TYPE_EXPONENTIAL",
+	1: "INFLATION_SCHEDULE_TYPE_PIECEWISE",
+}
+
+var InflationScheduleType_value = map[string]int32{
+	"INFLATION_SCHEDULE_TYPE_EXPONENTIAL": 0,
+	"INFLATION_SCHEDULE_TYPE_PIECEWISE":   1,
+}
+
+func (x InflationScheduleType) String() string {
+	return proto.EnumName(InflationScheduleType_name, int32(x))
+}
+
+func (InflationScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{0}
+}
+
 // InflationDistribution defines the distribution in which inflation is
 // allocated through minting on each epoch (staking, incentives, community). It
 // excludes the team vesting distribution, as this is minted once at genesis.
@@ -127,9 +158,128 @@ func (m *ExponentialCalculation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
 
+// ScheduleSegment defines the provision minted during a range of periods. The
+// segment starts at its start period and lasts until the start period of the
+// next segment. Either the period provision or the target rate is set.
+type ScheduleSegment struct {
+	// start_period is the first period of the segment
+	StartPeriod uint64 `protobuf:"varint,1,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	// period_provision is the amount of tokens (in evmos) minted during each
+	// period of the segment
+	PeriodProvision github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=period_provision,json=periodProvision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"period_provision"`
+	// target_rate is the inflation rate, over the circulating supply, minted
+	// during each period of the segment
+	TargetRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_rate,json=targetRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_rate"`
+	// bonding_aware applies the bonding incentive of the piecewise calculation
+	// to the provision of the segment
+	BondingAware bool `protobuf:"varint,4,opt,name=bonding_aware,json=bondingAware,proto3" json:"bonding_aware,omitempty"`
+}
+
+func (m *ScheduleSegment) Reset()         { *m = ScheduleSegment{} }
+func (m *ScheduleSegment) String() string { return proto.CompactTextString(m) }
+func (*ScheduleSegment) ProtoMessage()    {}
+func (*ScheduleSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{2}
+}
+func (m *ScheduleSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleSegment.Merge(m, src)
+}
+func (m *ScheduleSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleSegment proto.InternalMessageInfo
+
+func (m *ScheduleSegment) GetStartPeriod() uint64 {
+	if m != nil {
+		return m.StartPeriod
+	}
+	return 0
+}
+
+func (m *ScheduleSegment) GetBondingAware() bool {
+	if m != nil {
+		return m.BondingAware
+	}
+	return false
+}
+
+// PiecewiseCalculation holds the segments of a piecewise inflation schedule.
+// The bonding incentive of bonding aware segments is calculated as:
+// bondingIncentive = 1 + max_variance - bondedRatio * (max_variance / bonding_target)
+type PiecewiseCalculation struct {
+	// segments is the list of segments, ordered by start period
+	Segments []ScheduleSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments"`
+	// bonding_target
+	BondingTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=bonding_target,json=bondingTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonding_target"`
+	// max_variance
+	MaxVariance github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_variance,json=maxVariance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_variance"`
+}
+
+func (m *PiecewiseCalculation) Reset()         { *m = PiecewiseCalculation{} }
+func (m *PiecewiseCalculation) String() string { return proto.CompactTextString(m) }
+func (*PiecewiseCalculation) ProtoMessage()    {}
+func (*PiecewiseCalculation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{3}
+}
+func (m *PiecewiseCalculation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PiecewiseCalculation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PiecewiseCalculation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PiecewiseCalculation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PiecewiseCalculation.Merge(m, src)
+}
+func (m *PiecewiseCalculation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PiecewiseCalculation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PiecewiseCalculation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PiecewiseCalculation proto.InternalMessageInfo
+
+func (m *PiecewiseCalculation) GetSegments() []ScheduleSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("evmos.inflation.v1.InflationScheduleType", InflationScheduleType_name, InflationScheduleType_value)
 	proto.RegisterType((*InflationDistribution)(nil), "evmos.inflation.v1.InflationDistribution")
 	proto.RegisterType((*ExponentialCalculation)(nil), "evmos.inflation.v1.ExponentialCalculation")
+	proto.RegisterType((*ScheduleSegment)(nil), "evmos.inflation.v1.ScheduleSegment")
+	proto.RegisterType((*PiecewiseCalculation)(nil), "evmos.inflation.v1.PiecewiseCalculation")
 }
 
 func init() {
@@ -137,31 +287,44 @@ func init() {
 }
 
 var fileDescriptor_d064cb35c3ff7df8 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x4e, 0xe3, 0x30,
-	0x10, 0xc7, 0xe3, 0xee, 0x87, 0xb4, 0xde, 0xdd, 0x76, 0x15, 0xed, 0xae, 0xa2, 0x3d, 0xa4, 0xab,
-	0x1e, 0x10, 0x07, 0x48, 0x54, 0xb8, 0x72, 0x2a, 0x05, 0xa9, 0x37, 0xa8, 0xf8, 0x10, 0x5c, 0x22,
-	0xc7, 0x35, 0xc1, 0x6a, 0xe2, 0x89, 0x6c, 0x27, 0xa4, 0x6f, 0xc1, 0x33, 0xf0, 0x34, 0x3d, 0xf6,
-	0x88, 0x38, 0x54, 0xa8, 0x7d, 0x0d, 0x0e, 0xc8, 0x49, 0x69, 0x7b, 0xce, 0x25, 0x19, 0xdb, 0xff,
-	0xf9, 0xd9, 0xf3, 0xb7, 0x07, 0x77, 0x58, 0x9e, 0x80, 0xf2, 0xb9, 0xb8, 0x8b, 0x89, 0xe6, 0x20,
-	0xfc, 0xbc, 0xbb, 0x19, 0x78, 0xa9, 0x04, 0x0d, 0xb6, 0x5d, 0x6a, 0xbc, 0xcd, 0x74, 0xde, 0xfd,
-	0xf7, 0x3b, 0x82, 0x08, 0xca, 0x65, 0xdf, 0x44, 0x95, 0xb2, 0xf3, 0xd4, 0xc0, 0x7f, 0x06, 0x1f,
-	0xb2, 0x3e, 0x57, 0x5a, 0xf2, 0x30, 0x33, 0xb1, 0x7d, 0x8d, 0x5b, 0x4a, 0x93, 0x31, 0x17, 0x51,
-	0x20, 0xd9, 0x03, 0x91, 0x23, 0xe5, 0xa0, 0xff, 0x68, 0xf7, 0x5b, 0xcf, 0x9b, 0xce, 0xdb, 0xd6,
-	0xcb, 0xbc, 0xbd, 0x13, 0x71, 0x7d, 0x9f, 0x85, 0x1e, 0x85, 0xc4, 0xa7, 0xa0, 0xcc, 0xa1, 0xaa,
-	0xdf, 0xbe, 0x1a, 0x8d, 0x7d, 0x3d, 0x49, 0x99, 0xf2, 0xfa, 0x8c, 0x0e, 0x9b, 0x2b, 0xcc, 0xb0,
-	0xa2, 0xd8, 0x37, 0xf8, 0x57, 0xa6, 0x48, 0xc4, 0x02, 0x2e, 0x28, 0x13, 0x9a, 0xe7, 0x4c, 0x39,
-	0x8d, 0x5a, 0xe4, 0x56, 0xc9, 0x19, 0xac, 0x31, 0xf6, 0x25, 0x6e, 0x52, 0x48, 0x92, 0x4c, 0x70,
-	0x3d, 0x09, 0x52, 0x80, 0xd8, 0xf9, 0x54, 0x0b, 0xfc, 0x73, 0x4d, 0x39, 0x03, 0x88, 0x3b, 0x6f,
-	0x0d, 0xfc, 0xf7, 0xa4, 0x48, 0x41, 0x98, 0x7d, 0x48, 0x7c, 0x4c, 0x62, 0x9a, 0x55, 0x8e, 0xd9,
-	0x47, 0x18, 0x91, 0x9a, 0xbe, 0x20, 0x62, 0xb2, 0x65, 0xcd, 0xda, 0x91, 0x34, 0xd9, 0xb4, 0x66,
-	0x81, 0x88, 0x1a, 0xaf, 0x42, 0x10, 0x23, 0x73, 0xbf, 0x9a, 0xc8, 0x88, 0x69, 0xe7, 0x73, 0x3d,
-	0xaf, 0x56, 0x94, 0x8b, 0x12, 0x62, 0x9f, 0xe3, 0x1f, 0x09, 0x29, 0x82, 0x9c, 0x48, 0x4e, 0x04,
-	0x65, 0xce, 0x97, 0x5a, 0xd0, 0xef, 0x09, 0x29, 0xae, 0x56, 0x88, 0xde, 0xe9, 0x74, 0xe1, 0xa2,
-	0xd9, 0xc2, 0x45, 0xaf, 0x0b, 0x17, 0x3d, 0x2e, 0x5d, 0x6b, 0xb6, 0x74, 0xad, 0xe7, 0xa5, 0x6b,
-	0xdd, 0xee, 0x6d, 0xe1, 0xaa, 0xb6, 0xa8, 0xbe, 0x79, 0xf7, 0xc0, 0x2f, 0xb6, 0x5a, 0xa4, 0x04,
-	0x87, 0x5f, 0xcb, 0x27, 0x7f, 0xf8, 0x1e, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x67, 0xdb, 0xfb, 0x42,
-	0x03, 0x00, 0x00,
+	// 586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x93, 0xac, 0xa0, 0xe1, 0x8e, 0x6d, 0xb2, 0x06, 0xaa, 0x76, 0xc8, 0x58, 0x27, 0x60,
+	0x42, 0x90, 0x68, 0xe3, 0xca, 0x65, 0x5b, 0x33, 0x11, 0xa9, 0x6a, 0x43, 0xda, 0x31, 0xc6, 0x25,
+	0x72, 0x53, 0x93, 0x59, 0x4b, 0xe2, 0xc8, 0x76, 0xd2, 0xf6, 0x03, 0x20, 0x38, 0xf2, 0x19, 0xe0,
+	0xcb, 0xec, 0xb8, 0x23, 0xe2, 0x30, 0xa1, 0xf6, 0x6b, 0x70, 0x40, 0x4e, 0xda, 0xae, 0xe2, 0xcf,
+	0x25, 0x88, 0x4b, 0xeb, 0xbc, 0x7e, 0xfd, 0x8b, 0xdf, 0xe7, 0x7d, 0xf2, 0x82, 0x3a, 0xce, 0x22,
+	0xca, 0x4d, 0x12, 0xbf, 0x0b, 0x91, 0x20, 0x34, 0x36, 0xb3, 0xbd, 0x9b, 0x07, 0x23, 0x61, 0x54,
+	0x50, 0x08, 0xf3, 0x1c, 0xe3, 0x26, 0x9c, 0xed, 0x6d, 0x6e, 0x04, 0x34, 0xa0, 0xf9, 0xb6, 0x29,
+	0x57, 0x45, 0x66, 0xfd, 0xb3, 0x06, 0xee, 0xd9, 0xb3, 0xb4, 0x06, 0xe1, 0x82, 0x91, 0x5e, 0x2a,
+	0xd7, 0xf0, 0x14, 0xac, 0x71, 0x81, 0x2e, 0x48, 0x1c, 0x78, 0x0c, 0x0f, 0x10, 0xeb, 0xf3, 0x9a,
+	0xfa, 0x40, 0xdd, 0xbd, 0x73, 0x68, 0x5c, 0x5e, 0x6f, 0x29, 0xdf, 0xae, 0xb7, 0x1e, 0x05, 0x44,
+	0x9c, 0xa7, 0x3d, 0xc3, 0xa7, 0x91, 0xe9, 0x53, 0x2e, 0x2f, 0x55, 0xfc, 0x3d, 0xe3, 0xfd, 0x0b,
+	0x53, 0x8c, 0x12, 0xcc, 0x8d, 0x06, 0xf6, 0xdd, 0xd5, 0x29, 0xc6, 0x2d, 0x28, 0xf0, 0x0c, 0xac,
+	0xa7, 0x1c, 0x05, 0xd8, 0x23, 0xb1, 0x8f, 0x63, 0x41, 0x32, 0xcc, 0x6b, 0x5a, 0x29, 0xf2, 0x5a,
+	0xce, 0xb1, 0xe7, 0x18, 0x78, 0x02, 0x56, 0x7d, 0x1a, 0x45, 0x69, 0x4c, 0xc4, 0xc8, 0x4b, 0x28,
+	0x0d, 0x6b, 0x4b, 0xa5, 0xc0, 0x77, 0xe7, 0x14, 0x87, 0xd2, 0xb0, 0xfe, 0x43, 0x03, 0xf7, 0xad,
+	0x61, 0x42, 0x63, 0xf9, 0x1e, 0x14, 0x1e, 0xa1, 0xd0, 0x4f, 0x0b, 0xc5, 0xe0, 0x0b, 0xa0, 0xa2,
+	0x92, 0xba, 0xa8, 0x48, 0x9e, 0x66, 0x25, 0x6b, 0x57, 0x99, 0x3c, 0xed, 0x97, 0x2c, 0x50, 0xf5,
+	0xa5, 0x56, 0x3d, 0x1a, 0xf7, 0x65, 0x7f, 0x05, 0x62, 0x01, 0x16, 0xb5, 0x4a, 0x39, 0xad, 0xa6,
+	0x94, 0x6e, 0x0e, 0x81, 0xaf, 0xc0, 0x4a, 0x84, 0x86, 0x5e, 0x86, 0x18, 0x41, 0xb1, 0x8f, 0x6b,
+	0xb7, 0x4a, 0x41, 0xab, 0x11, 0x1a, 0xbe, 0x9e, 0x22, 0xea, 0xef, 0x35, 0xb0, 0xd6, 0xf1, 0xcf,
+	0x71, 0x3f, 0x0d, 0x71, 0x07, 0x07, 0x11, 0x8e, 0x05, 0xdc, 0x06, 0x2b, 0x5c, 0x20, 0x26, 0xbc,
+	0x04, 0x33, 0x42, 0xfb, 0x79, 0x0b, 0x2a, 0x6e, 0x35, 0x8f, 0x39, 0x79, 0x48, 0xfa, 0xac, 0xd8,
+	0xf4, 0x12, 0x46, 0x33, 0xc2, 0x09, 0x8d, 0xcb, 0xfa, 0xac, 0xe0, 0x38, 0x33, 0x0c, 0x6c, 0x83,
+	0x6a, 0xa1, 0x99, 0xc7, 0x90, 0xc0, 0x25, 0x7b, 0x00, 0x0a, 0x84, 0x8b, 0x04, 0x86, 0x3b, 0x60,
+	0x26, 0xa3, 0x87, 0x06, 0x88, 0xe1, 0xbc, 0x17, 0xcb, 0xee, 0xca, 0x34, 0x78, 0x20, 0x63, 0xf5,
+	0x0f, 0x1a, 0xd8, 0x70, 0x08, 0xf6, 0xf1, 0x80, 0x70, 0xbc, 0x68, 0x42, 0x0b, 0x2c, 0xf3, 0x42,
+	0x17, 0xf9, 0x8d, 0x2e, 0xed, 0x56, 0xf7, 0x77, 0x8c, 0xdf, 0x27, 0x80, 0xf1, 0x8b, 0x86, 0x87,
+	0x15, 0x79, 0x61, 0x77, 0x7e, 0xf4, 0x0f, 0x8e, 0xd0, 0xfe, 0x87, 0x23, 0x96, 0xfe, 0xd9, 0x11,
+	0x4f, 0xa2, 0x85, 0xa1, 0x35, 0xab, 0xaa, 0x3b, 0x4a, 0x30, 0x7c, 0x0c, 0x76, 0xec, 0xd6, 0x71,
+	0xf3, 0xa0, 0x6b, 0xb7, 0x5b, 0x5e, 0xe7, 0xe8, 0xa5, 0xd5, 0x38, 0x69, 0x5a, 0x5e, 0xf7, 0xcc,
+	0xb1, 0x3c, 0xeb, 0x8d, 0xd3, 0x6e, 0x59, 0xad, 0xae, 0x7d, 0xd0, 0x5c, 0x57, 0xe0, 0x43, 0xb0,
+	0xfd, 0xb7, 0x44, 0xc7, 0xb6, 0x8e, 0xac, 0x53, 0xbb, 0x63, 0xad, 0xab, 0x9b, 0x95, 0x8f, 0x5f,
+	0x74, 0xe5, 0xf0, 0xf8, 0x72, 0xac, 0xab, 0x57, 0x63, 0x5d, 0xfd, 0x3e, 0xd6, 0xd5, 0x4f, 0x13,
+	0x5d, 0xb9, 0x9a, 0xe8, 0xca, 0xd7, 0x89, 0xae, 0xbc, 0x7d, 0xba, 0x70, 0xfb, 0x62, 0x2e, 0x17,
+	0xbf, 0xd9, 0xde, 0xbe, 0x39, 0x5c, 0x98, 0xd1, 0x79, 0x1d, 0xbd, 0xdb, 0xf9, 0xcc, 0x7d, 0xfe,
+	0x73, 0x00, 0x43, 0xbd, 0xca, 0x94, 0xc3, 0x05, 0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -290,6 +453,121 @@ func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduleSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BondingAware {
+		i--
+		if m.BondingAware {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TargetRate.Size()
+		i -= size
+		if _, err := m.TargetRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PeriodProvision.Size()
+		i -= size
+		if _, err := m.PeriodProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartPeriod != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.StartPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PiecewiseCalculation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PiecewiseCalculation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PiecewiseCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxVariance.Size()
+		i -= size
+		if _, err := m.MaxVariance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BondingTarget.Size()
+		i -= size
+		if _, err := m.BondingTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Segments) > 0 {
+		for iNdEx := len(m.Segments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Segments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInflation(v)
 	base := offset
@@ -335,6 +613,44 @@ func (m *ExponentialCalculation) Size() (n int) {
 	return n
 }
 
+func (m *ScheduleSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartPeriod != 0 {
+		n += 1 + sovInflation(uint64(m.StartPeriod))
+	}
+	l = m.PeriodProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.TargetRate.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if m.BondingAware {
+		n += 2
+	}
+	return n
+}
+
+func (m *PiecewiseCalculation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	l = m.BondingTarget.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.MaxVariance.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
 func sovInflation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -713,6 +1029,315 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduleSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPeriod", wireType)
+			}
+			m.StartPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingAware", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BondingAware = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PiecewiseCalculation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PiecewiseCalculation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PiecewiseCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Segments = append(m.Segments, ScheduleSegment{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondingTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVariance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipInflation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
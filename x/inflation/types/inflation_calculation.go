@@ -61,3 +61,79 @@ func CalculateEpochMintProvision(
 	epochProvision = epochProvision.Mul(sdk.NewDecFromInt(evmostypes.PowerReduction))
 	return epochProvision
 }
+
+// CalculatePiecewiseEpochMintProvision returns mint provision per epoch for
+// the segment of the piecewise calculation that contains the given period
+func CalculatePiecewiseEpochMintProvision(
+	calculation PiecewiseCalculation,
+	period uint64,
+	epochsPerPeriod int64,
+	bondedRatio sdk.Dec,
+	circulatingSupply sdk.Dec,
+) sdk.Dec {
+	segment, _, found := calculation.ActiveSegment(period)
+	if !found || epochsPerPeriod <= 0 {
+		return sdk.ZeroDec()
+	}
+
+	var periodProvision sdk.Dec
+	if isPositive(segment.TargetRate) {
+		// periodProvision = targetRate * circulatingSupply, already in `aevmos`
+		periodProvision = segment.TargetRate.Mul(circulatingSupply)
+	} else {
+		// Multiply the period provision with power reduction (10^18 for evmos)
+		// as the provision is based on `evmos` and the issued tokens need to
+		// be given in `aevmos`
+		periodProvision = decOrZero(segment.PeriodProvision).Mul(sdk.NewDecFromInt(evmostypes.PowerReduction))
+	}
+
+	if segment.BondingAware {
+		bTarget := calculation.BondingTarget
+		maxVariance := decOrZero(calculation.MaxVariance)
+
+		// bondingIncentive doesn't increase beyond bonding target (0 < b < bonding_target)
+		if bondedRatio.GTE(bTarget) {
+			bondedRatio = bTarget
+		}
+
+		// bondingIncentive = 1 + max_variance - bondingRatio * (max_variance / bonding_target)
+		sub := bondedRatio.Mul(maxVariance.Quo(bTarget))
+		bondingIncentive := sdk.OneDec().Add(maxVariance).Sub(sub)
+		periodProvision = periodProvision.Mul(bondingIncentive)
+	}
+
+	// epochProvision = periodProvision / epochsPerPeriod
+	return periodProvision.Quo(sdk.NewDec(epochsPerPeriod))
+}
+
+// ActiveSegment returns the segment of the piecewise calculation that contains
+// the given period, i.e. the last segment that starts at or before the period,
+// along with its index
+func (pc PiecewiseCalculation) ActiveSegment(period uint64) (ScheduleSegment, int, bool) {
+	index := -1
+	for i, segment := range pc.Segments {
+		if segment.StartPeriod > period {
+			break
+		}
+		index = i
+	}
+
+	if index < 0 {
+		return ScheduleSegment{}, 0, false
+	}
+
+	return pc.Segments[index], index, true
+}
+
+// decOrZero returns zero for unset decimals
+func decOrZero(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
+}
+
+// isPositive returns true if the decimal is set and positive
+func isPositive(d sdk.Dec) bool {
+	return !d.IsNil() && d.IsPositive()
+}
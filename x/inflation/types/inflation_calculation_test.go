@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
 )
 
 type InflationTestSuite struct {
@@ -140,3 +142,112 @@ func (suite *InflationTestSuite) TestCalculateEpochMintProvision() {
 		})
 	}
 }
+
+func (suite *InflationTestSuite) TestCalculatePiecewiseEpochMintProvision() {
+	calculation := PiecewiseCalculation{
+		Segments: []ScheduleSegment{
+			{StartPeriod: 0, PeriodProvision: sdk.NewDec(365), TargetRate: sdk.ZeroDec()},
+			{StartPeriod: 2, PeriodProvision: sdk.ZeroDec(), TargetRate: sdk.NewDecWithPrec(365, 4)},
+			{StartPeriod: 5, PeriodProvision: sdk.NewDec(730), TargetRate: sdk.ZeroDec(), BondingAware: true},
+		},
+		BondingTarget: sdk.NewDecWithPrec(5, 1),
+		MaxVariance:   sdk.NewDecWithPrec(5, 1),
+	}
+	epochsPerPeriod := int64(365)
+	circulatingSupply := sdk.NewDec(1000).MulInt(evmostypes.PowerReduction)
+
+	testCases := []struct {
+		name              string
+		calculation       PiecewiseCalculation
+		period            uint64
+		bondedRatio       sdk.Dec
+		expEpochProvision sdk.Dec
+	}{
+		{
+			"pass - no segments",
+			PiecewiseCalculation{},
+			uint64(0),
+			sdk.OneDec(),
+			sdk.ZeroDec(),
+		},
+		{
+			"pass - fixed provision segment",
+			calculation,
+			uint64(1),
+			sdk.OneDec(),
+			sdk.NewDecFromInt(evmostypes.PowerReduction),
+		},
+		{
+			"pass - target rate segment",
+			calculation,
+			uint64(2),
+			sdk.OneDec(),
+			sdk.NewDecWithPrec(1, 1).MulInt(evmostypes.PowerReduction),
+		},
+		{
+			"pass - bonding aware segment - 0 percent bonding",
+			calculation,
+			uint64(5),
+			sdk.ZeroDec(),
+			sdk.NewDec(3).MulInt(evmostypes.PowerReduction),
+		},
+		{
+			"pass - bonding aware segment - 25 percent bonding",
+			calculation,
+			uint64(10),
+			sdk.NewDecWithPrec(25, 2),
+			sdk.NewDecWithPrec(25, 1).MulInt(evmostypes.PowerReduction),
+		},
+		{
+			"pass - bonding aware segment - bonding above target",
+			calculation,
+			uint64(10),
+			sdk.OneDec(),
+			sdk.NewDec(2).MulInt(evmostypes.PowerReduction),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			epochMintProvisions := CalculatePiecewiseEpochMintProvision(
+				tc.calculation,
+				tc.period,
+				epochsPerPeriod,
+				tc.bondedRatio,
+				circulatingSupply,
+			)
+
+			suite.Require().Equal(tc.expEpochProvision, epochMintProvisions)
+		})
+	}
+}
+
+func (suite *InflationTestSuite) TestActiveSegment() {
+	calculation := PiecewiseCalculation{
+		Segments: []ScheduleSegment{
+			{StartPeriod: 0},
+			{StartPeriod: 3},
+			{StartPeriod: 7},
+		},
+	}
+
+	testCases := []struct {
+		period   uint64
+		expIndex int
+	}{
+		{0, 0},
+		{2, 0},
+		{3, 1},
+		{6, 1},
+		{7, 2},
+		{100, 2},
+	}
+	for _, tc := range testCases {
+		segment, index, found := calculation.ActiveSegment(tc.period)
+		suite.Require().True(found)
+		suite.Require().Equal(tc.expIndex, index)
+		suite.Require().Equal(calculation.Segments[tc.expIndex], segment)
+	}
+
+	_, _, found := PiecewiseCalculation{}.ActiveSegment(0)
+	suite.Require().False(found)
+}
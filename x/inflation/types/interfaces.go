@@ -21,6 +21,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochstypes "github.com/evmos/evmos/v12/x/epochs/types"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -57,6 +59,12 @@ type StakingKeeper interface {
 	TotalBondedTokens(ctx sdk.Context) math.Int
 }
 
+// EpochsKeeper defines the expected epochs keeper interface used to simulate
+// the upcoming epochs
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -26,6 +26,10 @@ const (
 
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
+
+	// MaxSupplyProjectionEpochs is the maximum number of epochs that can be
+	// simulated by a single supply projection query
+	MaxSupplyProjectionEpochs = 3650
 )
 
 // prefix bytes for the inflation persistent store
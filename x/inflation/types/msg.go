@@ -42,3 +42,42 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+var _ sdk.Msg = &MsgUpdateInflationSchedule{}
+
+// GetSigners returns the expected signers for a MsgUpdateInflationSchedule message.
+func (m *MsgUpdateInflationSchedule) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateInflationSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := validateScheduleType(m.ScheduleType); err != nil {
+		return err
+	}
+
+	if m.ExponentialCalculation != nil {
+		if err := validateExponentialCalculation(*m.ExponentialCalculation); err != nil {
+			return err
+		}
+	}
+
+	if m.PiecewiseCalculation != nil {
+		return ValidatePiecewiseCalculation(
+			*m.PiecewiseCalculation,
+			m.ScheduleType == INFLATION_SCHEDULE_TYPE_PIECEWISE,
+		)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateInflationSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -52,3 +53,115 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgUpdateInflationScheduleValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	piecewise := PiecewiseCalculation{
+		Segments: []ScheduleSegment{
+			{StartPeriod: 0, PeriodProvision: sdk.NewDec(300_000_000), TargetRate: sdk.ZeroDec()},
+			{StartPeriod: 4, PeriodProvision: sdk.ZeroDec(), TargetRate: sdk.NewDecWithPrec(2, 2)},
+		},
+		BondingTarget: sdk.NewDecWithPrec(66, 2),
+		MaxVariance:   sdk.ZeroDec(),
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateInflationSchedule
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUpdateInflationSchedule{
+				Authority:    "invalid",
+				ScheduleType: INFLATION_SCHEDULE_TYPE_EXPONENTIAL,
+			},
+			false,
+		},
+		{
+			"fail - piecewise schedule without segments",
+			&MsgUpdateInflationSchedule{
+				Authority:            authority,
+				ScheduleType:         INFLATION_SCHEDULE_TYPE_PIECEWISE,
+				PiecewiseCalculation: &PiecewiseCalculation{},
+			},
+			false,
+		},
+		{
+			"fail - first segment doesn't start at period 0",
+			&MsgUpdateInflationSchedule{
+				Authority:    authority,
+				ScheduleType: INFLATION_SCHEDULE_TYPE_PIECEWISE,
+				PiecewiseCalculation: &PiecewiseCalculation{
+					Segments: []ScheduleSegment{{StartPeriod: 1}},
+				},
+			},
+			false,
+		},
+		{
+			"fail - unordered segments",
+			&MsgUpdateInflationSchedule{
+				Authority:    authority,
+				ScheduleType: INFLATION_SCHEDULE_TYPE_PIECEWISE,
+				PiecewiseCalculation: &PiecewiseCalculation{
+					Segments: []ScheduleSegment{{StartPeriod: 0}, {StartPeriod: 3}, {StartPeriod: 3}},
+				},
+			},
+			false,
+		},
+		{
+			"fail - segment with provision and target rate",
+			&MsgUpdateInflationSchedule{
+				Authority:    authority,
+				ScheduleType: INFLATION_SCHEDULE_TYPE_PIECEWISE,
+				PiecewiseCalculation: &PiecewiseCalculation{
+					Segments: []ScheduleSegment{
+						{StartPeriod: 0, PeriodProvision: sdk.OneDec(), TargetRate: sdk.NewDecWithPrec(1, 2)},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"fail - bonding aware segment without bonding target",
+			&MsgUpdateInflationSchedule{
+				Authority:    authority,
+				ScheduleType: INFLATION_SCHEDULE_TYPE_PIECEWISE,
+				PiecewiseCalculation: &PiecewiseCalculation{
+					Segments: []ScheduleSegment{
+						{StartPeriod: 0, PeriodProvision: sdk.OneDec(), BondingAware: true},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"pass - exponential schedule",
+			&MsgUpdateInflationSchedule{
+				Authority:    authority,
+				ScheduleType: INFLATION_SCHEDULE_TYPE_EXPONENTIAL,
+			},
+			true,
+		},
+		{
+			"pass - piecewise schedule",
+			&MsgUpdateInflationSchedule{
+				Authority:            authority,
+				ScheduleType:         INFLATION_SCHEDULE_TYPE_PIECEWISE,
+				PiecewiseCalculation: &piecewise,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -42,6 +42,11 @@ var (
 		UsageIncentives: sdk.NewDecWithPrec(333333333, 9), // 0.33 = 25% / (1 - 25%)
 		CommunityPool:   sdk.NewDecWithPrec(133333333, 9), // 0.13 = 10% / (1 - 25%)
 	}
+	DefaultScheduleType         = INFLATION_SCHEDULE_TYPE_EXPONENTIAL
+	DefaultPiecewiseCalculation = PiecewiseCalculation{
+		BondingTarget: sdk.NewDecWithPrec(66, 2), // 66%
+		MaxVariance:   sdk.ZeroDec(),             // 0%
+	}
 )
 
 func NewParams(
@@ -49,12 +54,16 @@ func NewParams(
 	exponentialCalculation ExponentialCalculation,
 	inflationDistribution InflationDistribution,
 	enableInflation bool,
+	scheduleType InflationScheduleType,
+	piecewiseCalculation PiecewiseCalculation,
 ) Params {
 	return Params{
 		MintDenom:              mintDenom,
 		ExponentialCalculation: exponentialCalculation,
 		InflationDistribution:  inflationDistribution,
 		EnableInflation:        enableInflation,
+		ScheduleType:           scheduleType,
+		PiecewiseCalculation:   piecewiseCalculation,
 	}
 }
 
@@ -65,6 +74,8 @@ func DefaultParams() Params {
 		ExponentialCalculation: DefaultExponentialCalculation,
 		InflationDistribution:  DefaultInflationDistribution,
 		EnableInflation:        DefaultInflation,
+		ScheduleType:           DefaultScheduleType,
+		PiecewiseCalculation:   DefaultPiecewiseCalculation,
 	}
 }
 
@@ -123,6 +134,79 @@ func validateExponentialCalculation(i interface{}) error {
 	return nil
 }
 
+func validateScheduleType(i interface{}) error {
+	v, ok := i.(InflationScheduleType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationScheduleType_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation schedule type: %d", v)
+	}
+
+	return nil
+}
+
+// ValidatePiecewiseCalculation validates the segments of a piecewise
+// calculation. The segments are required if the piecewise schedule is used.
+func ValidatePiecewiseCalculation(v PiecewiseCalculation, required bool) error {
+	if required && len(v.Segments) == 0 {
+		return errors.New("piecewise calculation must have at least one segment")
+	}
+
+	bondingAware := false
+	for i, segment := range v.Segments {
+		if i == 0 && segment.StartPeriod != 0 {
+			return errors.New("first segment must start at period 0")
+		}
+
+		if i > 0 && segment.StartPeriod <= v.Segments[i-1].StartPeriod {
+			return fmt.Errorf("segment %d must start after period %d", i, v.Segments[i-1].StartPeriod)
+		}
+
+		provision := decOrZero(segment.PeriodProvision)
+		rate := decOrZero(segment.TargetRate)
+
+		if provision.IsNegative() {
+			return fmt.Errorf("segment %d period provision cannot be negative", i)
+		}
+
+		if rate.IsNegative() {
+			return fmt.Errorf("segment %d target rate cannot be negative", i)
+		}
+
+		if rate.GT(sdk.OneDec()) {
+			return fmt.Errorf("segment %d target rate cannot be greater than 1", i)
+		}
+
+		if provision.IsPositive() && rate.IsPositive() {
+			return fmt.Errorf("segment %d cannot set both a period provision and a target rate", i)
+		}
+
+		bondingAware = bondingAware || segment.BondingAware
+	}
+
+	if !bondingAware {
+		return nil
+	}
+
+	// validate bonded target
+	if v.BondingTarget.IsNil() || !v.BondingTarget.IsPositive() {
+		return fmt.Errorf("bonded target cannot be zero or negative")
+	}
+
+	if v.BondingTarget.GT(sdk.NewDec(1)) {
+		return fmt.Errorf("bonded target cannot be greater than 1")
+	}
+
+	// validate max variance
+	if decOrZero(v.MaxVariance).IsNegative() {
+		return fmt.Errorf("max variance cannot be negative")
+	}
+
+	return nil
+}
+
 func validateInflationDistribution(i interface{}) error {
 	v, ok := i.(InflationDistribution)
 	if !ok {
@@ -169,5 +253,15 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateScheduleType(p.ScheduleType); err != nil {
+		return err
+	}
+	if err := ValidatePiecewiseCalculation(
+		p.PiecewiseCalculation,
+		p.ScheduleType == INFLATION_SCHEDULE_TYPE_PIECEWISE,
+	); err != nil {
+		return err
+	}
+
 	return validateBool(p.EnableInflation)
 }
@@ -30,6 +30,15 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		CommunityPool:   sdk.NewDecWithPrec(133333, 6),
 	}
 
+	validPiecewiseCalculation := PiecewiseCalculation{
+		Segments: []ScheduleSegment{
+			{StartPeriod: 0, PeriodProvision: sdk.NewDec(300_000_000), TargetRate: sdk.ZeroDec()},
+			{StartPeriod: 4, PeriodProvision: sdk.ZeroDec(), TargetRate: sdk.NewDecWithPrec(2, 2), BondingAware: true},
+		},
+		BondingTarget: sdk.NewDecWithPrec(66, 2),
+		MaxVariance:   sdk.ZeroDec(),
+	}
+
 	testCases := []struct {
 		name     string
 		params   Params
@@ -40,6 +49,42 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			DefaultParams(),
 			false,
 		},
+		{
+			"valid - piecewise schedule",
+			NewParams(
+				"aevmos",
+				validExponentialCalculation,
+				validInflationDistribution,
+				true,
+				INFLATION_SCHEDULE_TYPE_PIECEWISE,
+				validPiecewiseCalculation,
+			),
+			false,
+		},
+		{
+			"invalid - unknown schedule type",
+			NewParams(
+				"aevmos",
+				validExponentialCalculation,
+				validInflationDistribution,
+				true,
+				InflationScheduleType(2),
+				validPiecewiseCalculation,
+			),
+			true,
+		},
+		{
+			"invalid - piecewise schedule without segments",
+			NewParams(
+				"aevmos",
+				validExponentialCalculation,
+				validInflationDistribution,
+				true,
+				INFLATION_SCHEDULE_TYPE_PIECEWISE,
+				DefaultPiecewiseCalculation,
+			),
+			true,
+		},
 		{
 			"valid",
 			NewParams(
@@ -47,6 +92,8 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				validExponentialCalculation,
 				validInflationDistribution,
 				true,
+				DefaultScheduleType,
+				DefaultPiecewiseCalculation,
 			),
 			false,
 		},
@@ -67,6 +114,8 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				validExponentialCalculation,
 				validInflationDistribution,
 				true,
+				DefaultScheduleType,
+				DefaultPiecewiseCalculation,
 			),
 			true,
 		},
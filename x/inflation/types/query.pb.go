@@ -447,6 +447,310 @@ func (m *QueryInflationRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryInflationRateResponse proto.InternalMessageInfo
 
+// QueryActiveScheduleSegmentRequest is the request type for the
+// Query/ActiveScheduleSegment RPC method.
+type QueryActiveScheduleSegmentRequest struct {
+}
+
+func (m *QueryActiveScheduleSegmentRequest) Reset()         { *m = QueryActiveScheduleSegmentRequest{} }
+func (m *QueryActiveScheduleSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveScheduleSegmentRequest) ProtoMessage()    {}
+func (*QueryActiveScheduleSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{10}
+}
+func (m *QueryActiveScheduleSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveScheduleSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveScheduleSegmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveScheduleSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveScheduleSegmentRequest.Merge(m, src)
+}
+func (m *QueryActiveScheduleSegmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveScheduleSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveScheduleSegmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveScheduleSegmentRequest proto.InternalMessageInfo
+
+// QueryActiveScheduleSegmentResponse is the response type for the
+// Query/ActiveScheduleSegment RPC method.
+type QueryActiveScheduleSegmentResponse struct {
+	// schedule_type is the schedule used to calculate the epoch mint provision
+	ScheduleType InflationScheduleType `protobuf:"varint,1,opt,name=schedule_type,json=scheduleType,proto3,enum=evmos.inflation.v1.InflationScheduleType" json:"schedule_type,omitempty"`
+	// period is the current period
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// segment is the piecewise schedule segment of the current period. It's
+	// only set for the piecewise schedule.
+	Segment *ScheduleSegment `protobuf:"bytes,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	// segment_index is the index of the segment in the piecewise calculation
+	SegmentIndex uint64 `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	// end_period is the start period of the next segment, or zero if the active
+	// segment is the last one
+	EndPeriod uint64 `protobuf:"varint,5,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+}
+
+func (m *QueryActiveScheduleSegmentResponse) Reset()         { *m = QueryActiveScheduleSegmentResponse{} }
+func (m *QueryActiveScheduleSegmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveScheduleSegmentResponse) ProtoMessage()    {}
+func (*QueryActiveScheduleSegmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{11}
+}
+func (m *QueryActiveScheduleSegmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveScheduleSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveScheduleSegmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveScheduleSegmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveScheduleSegmentResponse.Merge(m, src)
+}
+func (m *QueryActiveScheduleSegmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveScheduleSegmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveScheduleSegmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveScheduleSegmentResponse proto.InternalMessageInfo
+
+func (m *QueryActiveScheduleSegmentResponse) GetScheduleType() InflationScheduleType {
+	if m != nil {
+		return m.ScheduleType
+	}
+	return INFLATION_SCHEDULE_TYPE_EXPONENTIAL
+}
+
+func (m *QueryActiveScheduleSegmentResponse) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *QueryActiveScheduleSegmentResponse) GetSegment() *ScheduleSegment {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
+}
+
+func (m *QueryActiveScheduleSegmentResponse) GetSegmentIndex() uint64 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *QueryActiveScheduleSegmentResponse) GetEndPeriod() uint64 {
+	if m != nil {
+		return m.EndPeriod
+	}
+	return 0
+}
+
+// QuerySupplyProjectionRequest is the request type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionRequest struct {
+	// epochs is the number of upcoming epochs to simulate
+	Epochs uint64 `protobuf:"varint,1,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *QuerySupplyProjectionRequest) Reset()         { *m = QuerySupplyProjectionRequest{} }
+func (m *QuerySupplyProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionRequest) ProtoMessage()    {}
+func (*QuerySupplyProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{12}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionRequest.Merge(m, src)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionRequest) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+// QuerySupplyProjectionResponse is the response type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionResponse struct {
+	// projections of each simulated epoch, in ascending epoch order
+	Projections []EpochProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QuerySupplyProjectionResponse) Reset()         { *m = QuerySupplyProjectionResponse{} }
+func (m *QuerySupplyProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionResponse) ProtoMessage()    {}
+func (*QuerySupplyProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{13}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionResponse.Merge(m, src)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionResponse) GetProjections() []EpochProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// EpochProjection defines the projected inflation of a single epoch, assuming
+// the params and the bonded ratio remain unchanged.
+type EpochProjection struct {
+	// epoch_number is the number of the simulated epoch
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// period in which the epoch is minted
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// epoch_mint_provision is the amount minted at the end of the epoch
+	EpochMintProvision types.Coin `protobuf:"bytes,3,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision"`
+	// minted_supply is the cumulative amount minted from the first simulated
+	// epoch up to and including this epoch
+	MintedSupply types.Coin `protobuf:"bytes,4,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply"`
+	// circulating_supply is the circulating supply after the epoch is minted
+	CirculatingSupply types.DecCoin `protobuf:"bytes,5,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply"`
+	// inflation_rate by which the circulating supply increases within the
+	// period of the epoch
+	InflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=inflation_rate,json=inflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate"`
+}
+
+func (m *EpochProjection) Reset()         { *m = EpochProjection{} }
+func (m *EpochProjection) String() string { return proto.CompactTextString(m) }
+func (*EpochProjection) ProtoMessage()    {}
+func (*EpochProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{14}
+}
+func (m *EpochProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochProjection.Merge(m, src)
+}
+func (m *EpochProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochProjection proto.InternalMessageInfo
+
+func (m *EpochProjection) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochProjection) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *EpochProjection) GetEpochMintProvision() types.Coin {
+	if m != nil {
+		return m.EpochMintProvision
+	}
+	return types.Coin{}
+}
+
+func (m *EpochProjection) GetMintedSupply() types.Coin {
+	if m != nil {
+		return m.MintedSupply
+	}
+	return types.Coin{}
+}
+
+func (m *EpochProjection) GetCirculatingSupply() types.DecCoin {
+	if m != nil {
+		return m.CirculatingSupply
+	}
+	return types.DecCoin{}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -455,7 +759,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b9f1b5d47c7fd7, []int{10}
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{15}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,7 +798,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b9f1b5d47c7fd7, []int{11}
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{16}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,6 +845,11 @@ func init() {
 	proto.RegisterType((*QueryCirculatingSupplyResponse)(nil), "evmos.inflation.v1.QueryCirculatingSupplyResponse")
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "evmos.inflation.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "evmos.inflation.v1.QueryInflationRateResponse")
+	proto.RegisterType((*QueryActiveScheduleSegmentRequest)(nil), "evmos.inflation.v1.QueryActiveScheduleSegmentRequest")
+	proto.RegisterType((*QueryActiveScheduleSegmentResponse)(nil), "evmos.inflation.v1.QueryActiveScheduleSegmentResponse")
+	proto.RegisterType((*QuerySupplyProjectionRequest)(nil), "evmos.inflation.v1.QuerySupplyProjectionRequest")
+	proto.RegisterType((*QuerySupplyProjectionResponse)(nil), "evmos.inflation.v1.QuerySupplyProjectionResponse")
+	proto.RegisterType((*EpochProjection)(nil), "evmos.inflation.v1.EpochProjection")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.inflation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.inflation.v1.QueryParamsResponse")
 }
@@ -548,50 +857,71 @@ func init() {
 func init() { proto.RegisterFile("evmos/inflation/v1/query.proto", fileDescriptor_91b9f1b5d47c7fd7) }
 
 var fileDescriptor_91b9f1b5d47c7fd7 = []byte{
-	// 681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xbf, 0x6e, 0xd3, 0x40,
-	0x1c, 0xc7, 0x73, 0xa8, 0x04, 0x71, 0xa8, 0x95, 0x7a, 0x54, 0x08, 0x4c, 0x71, 0x2a, 0x0b, 0x4a,
-	0x55, 0xe8, 0x1d, 0x49, 0x17, 0xe6, 0x16, 0x90, 0x18, 0x10, 0x25, 0x15, 0x0b, 0x4b, 0xe4, 0xb8,
-	0x87, 0x7b, 0x6a, 0x73, 0x77, 0xf5, 0x39, 0x16, 0x1d, 0x90, 0x10, 0xbc, 0x00, 0x12, 0x1b, 0x03,
-	0x0b, 0x03, 0x52, 0x17, 0x5e, 0xa3, 0x63, 0x25, 0x16, 0xc4, 0x50, 0x50, 0xc3, 0x83, 0x20, 0x9f,
-	0xcf, 0x69, 0x5c, 0x9f, 0xd3, 0x76, 0x60, 0x49, 0x9c, 0xdf, 0xdf, 0xaf, 0xbf, 0xbe, 0x8f, 0x03,
-	0x5d, 0x9a, 0xf4, 0x84, 0x22, 0x8c, 0xbf, 0xde, 0xf6, 0x63, 0x26, 0x38, 0x49, 0x9a, 0x64, 0xa7,
-	0x4f, 0xa3, 0x5d, 0x2c, 0x23, 0x11, 0x0b, 0x84, 0x74, 0x1e, 0x0f, 0xf3, 0x38, 0x69, 0x3a, 0x6e,
-	0x20, 0x54, 0xda, 0xd4, 0xf5, 0x15, 0x25, 0x49, 0xb3, 0x4b, 0x63, 0xbf, 0x49, 0x02, 0xc1, 0x78,
-	0xd6, 0xe3, 0xcc, 0x59, 0x66, 0x86, 0x94, 0x53, 0xc5, 0x94, 0xa9, 0x98, 0x09, 0x45, 0x28, 0xf4,
-	0x25, 0x49, 0xaf, 0x4c, 0x74, 0x36, 0x14, 0x22, 0xdc, 0xa6, 0xc4, 0x97, 0x8c, 0xf8, 0x9c, 0x8b,
-	0x58, 0x77, 0x9b, 0x1e, 0x6f, 0x06, 0xa2, 0x17, 0xa9, 0xb0, 0x35, 0x1a, 0x31, 0xb1, 0xd1, 0xa6,
-	0x3b, 0x7d, 0xaa, 0x62, 0x6f, 0x09, 0x5e, 0x2d, 0x44, 0x95, 0x14, 0x5c, 0x51, 0x74, 0x0d, 0xd6,
-	0xa5, 0x8e, 0x5c, 0x07, 0x73, 0x60, 0x61, 0xa2, 0x6d, 0x7e, 0x79, 0x73, 0xd0, 0xd5, 0xe5, 0x8f,
-	0xa5, 0x08, 0x36, 0x9f, 0x31, 0x1e, 0xaf, 0x45, 0x22, 0x61, 0x8a, 0x09, 0x9e, 0x0f, 0xfc, 0x06,
-	0x60, 0xa3, 0xb2, 0xc4, 0x4c, 0xff, 0x00, 0xe0, 0x0c, 0x4d, 0xd3, 0x9d, 0x1e, 0xe3, 0x71, 0x47,
-	0xe6, 0x05, 0x7a, 0xd9, 0x95, 0xd6, 0x2c, 0xce, 0x0c, 0xc2, 0xa9, 0x41, 0xd8, 0x18, 0x84, 0x1f,
-	0xd1, 0x60, 0x55, 0x30, 0xbe, 0xb2, 0xbc, 0x7f, 0xd8, 0xa8, 0xed, 0xfd, 0x6e, 0xdc, 0x0b, 0x59,
-	0xbc, 0xd9, 0xef, 0xe2, 0x40, 0xf4, 0x88, 0x31, 0x34, 0xfb, 0x5a, 0x52, 0x1b, 0x5b, 0x24, 0xde,
-	0x95, 0x54, 0xe5, 0x3d, 0xaa, 0x8d, 0x68, 0x49, 0x8d, 0x77, 0x13, 0xde, 0xd0, 0x42, 0xd7, 0xb7,
-	0x98, 0x94, 0x74, 0x43, 0xeb, 0x55, 0xf9, 0x6d, 0xac, 0x42, 0xc7, 0x96, 0x34, 0x37, 0x70, 0x07,
-	0x4e, 0xa9, 0x2c, 0xd1, 0xd1, 0x83, 0x95, 0xb1, 0x69, 0x52, 0x8d, 0x96, 0x7b, 0x0d, 0x78, 0x4b,
-	0x0f, 0x59, 0x65, 0x51, 0xd0, 0x4f, 0x9f, 0x25, 0x0f, 0xd7, 0xfb, 0x52, 0x6e, 0xef, 0xe6, 0x5b,
-	0xbe, 0x02, 0xe3, 0xa7, 0xa5, 0xc2, 0xac, 0x7a, 0x07, 0x20, 0x0a, 0x8e, 0xb3, 0x1d, 0xa5, 0xd3,
-	0xff, 0xcf, 0xa9, 0xe9, 0xe0, 0xa4, 0x94, 0xa1, 0x51, 0x4f, 0xf3, 0x03, 0xd9, 0xf6, 0x63, 0x9a,
-	0xdf, 0x82, 0x32, 0x46, 0x9d, 0x48, 0x1a, 0xf5, 0x2f, 0xe1, 0xd4, 0xf0, 0x18, 0x77, 0x22, 0x3f,
-	0xa6, 0x5a, 0xf8, 0xe5, 0x15, 0x9c, 0x4a, 0xfb, 0x75, 0xd8, 0x98, 0x3f, 0x9b, 0xb4, 0xf6, 0x24,
-	0x1b, 0x1d, 0x7f, 0x7c, 0x96, 0xfd, 0xc8, 0xef, 0x0d, 0x9f, 0xd9, 0xf3, 0xfc, 0x2c, 0x9b, 0xa8,
-	0xd1, 0xf0, 0x10, 0xd6, 0xa5, 0x8e, 0x18, 0xd3, 0x1c, 0x5c, 0x66, 0x12, 0x67, 0x3d, 0x2b, 0x13,
-	0xa9, 0xae, 0xb6, 0xa9, 0x6f, 0x7d, 0xb9, 0x04, 0x2f, 0xea, 0x89, 0xe8, 0x2d, 0xac, 0x67, 0x84,
-	0xa0, 0x79, 0x5b, 0x77, 0x19, 0x2c, 0xe7, 0xee, 0xa9, 0x75, 0x99, 0x3c, 0xcf, 0x7b, 0xff, 0xe3,
-	0xef, 0xa7, 0x0b, 0xb3, 0xc8, 0x21, 0x16, 0xec, 0x33, 0xec, 0xd0, 0x77, 0x00, 0x51, 0x99, 0x27,
-	0xd4, 0xaa, 0xdc, 0x51, 0xc9, 0xa7, 0xb3, 0x7c, 0xae, 0x1e, 0xa3, 0xf1, 0x81, 0xd6, 0xb8, 0x88,
-	0x16, 0x6c, 0x1a, 0x6d, 0x24, 0xa3, 0xcf, 0x00, 0x4e, 0x16, 0xd8, 0x41, 0x4b, 0x95, 0x8b, 0x6d,
-	0x00, 0x3a, 0xf8, 0xac, 0xe5, 0x46, 0xe2, 0xa2, 0x96, 0x78, 0x1b, 0x79, 0x36, 0x89, 0x45, 0x58,
-	0xd1, 0x1e, 0x80, 0xd3, 0x25, 0xe2, 0x50, 0xb3, 0x72, 0x63, 0x15, 0xbf, 0x4e, 0xeb, 0x3c, 0x2d,
-	0x46, 0x28, 0xd6, 0x42, 0x17, 0xd0, 0xbc, 0x4d, 0x68, 0x99, 0x74, 0xed, 0x64, 0x01, 0xae, 0x31,
-	0x4e, 0xda, 0x08, 0x1d, 0xe3, 0xa4, 0x95, 0xd9, 0xf1, 0x4e, 0x16, 0x69, 0xd6, 0x5c, 0x68, 0x56,
-	0xc6, 0x71, 0x31, 0x0a, 0xe9, 0x38, 0x2e, 0x0a, 0xd8, 0x9e, 0xc2, 0x45, 0x86, 0xeb, 0x93, 0xfd,
-	0x23, 0x17, 0x1c, 0x1c, 0xb9, 0xe0, 0xcf, 0x91, 0x0b, 0x3e, 0x0e, 0xdc, 0xda, 0xc1, 0xc0, 0xad,
-	0xfd, 0x1c, 0xb8, 0xb5, 0x57, 0xf7, 0x47, 0x5e, 0x2c, 0x59, 0x7f, 0xf6, 0x99, 0x34, 0x5b, 0xe4,
-	0xcd, 0xc8, 0x2c, 0xfd, 0x8a, 0xe9, 0xd6, 0xf5, 0x5f, 0xe4, 0xf2, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x68, 0xc2, 0xf7, 0x19, 0xce, 0x07, 0x00, 0x00,
+	// 1010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xe6, 0x8f, 0x51, 0x5f, 0xe2, 0x40, 0xa7, 0x01, 0xa5, 0x4b, 0xb2, 0x4e, 0x37, 0x34,
+	0x09, 0x2d, 0xde, 0xad, 0x1d, 0x51, 0x71, 0xe1, 0x40, 0x52, 0x90, 0x2a, 0x44, 0x49, 0x1c, 0xb8,
+	0x70, 0x59, 0xad, 0xd7, 0x83, 0xb3, 0xd4, 0x9e, 0xd9, 0xee, 0xac, 0xad, 0xe6, 0x80, 0x84, 0x40,
+	0x9c, 0x8b, 0xc4, 0x8d, 0x2b, 0x12, 0x48, 0xbd, 0xf0, 0x01, 0xf8, 0x02, 0x3d, 0x56, 0xe2, 0x82,
+	0x38, 0x14, 0x94, 0xf0, 0x41, 0xd0, 0xbe, 0x99, 0xb5, 0xd7, 0xf1, 0x6c, 0x62, 0x4b, 0xf4, 0x92,
+	0xec, 0xbe, 0x79, 0xbf, 0xf7, 0x7e, 0xf3, 0xf6, 0xbd, 0xf7, 0x33, 0x58, 0xb4, 0xdf, 0xe5, 0xc2,
+	0x0d, 0xd9, 0x97, 0x1d, 0x3f, 0x09, 0x39, 0x73, 0xfb, 0x35, 0xf7, 0x51, 0x8f, 0xc6, 0x27, 0x4e,
+	0x14, 0xf3, 0x84, 0x13, 0x82, 0xe7, 0xce, 0xe0, 0xdc, 0xe9, 0xd7, 0x4c, 0x2b, 0xe0, 0x22, 0x05,
+	0x35, 0x7d, 0x41, 0xdd, 0x7e, 0xad, 0x49, 0x13, 0xbf, 0xe6, 0x06, 0x3c, 0x64, 0x12, 0x63, 0x6e,
+	0x68, 0x62, 0xb6, 0x29, 0xa3, 0x22, 0x14, 0xca, 0xc3, 0xd6, 0x78, 0x0c, 0x53, 0x48, 0x9f, 0x95,
+	0x36, 0x6f, 0x73, 0x7c, 0x74, 0xd3, 0x27, 0x65, 0x5d, 0x6b, 0x73, 0xde, 0xee, 0x50, 0xd7, 0x8f,
+	0x42, 0xd7, 0x67, 0x8c, 0x27, 0x08, 0x51, 0x71, 0xed, 0x15, 0x20, 0x87, 0x29, 0xf9, 0x03, 0x1a,
+	0x87, 0xbc, 0xd5, 0xa0, 0x8f, 0x7a, 0x54, 0x24, 0x76, 0x15, 0xae, 0x8d, 0x58, 0x45, 0xc4, 0x99,
+	0xa0, 0xe4, 0x0d, 0x28, 0x45, 0x68, 0x59, 0x35, 0x36, 0x8c, 0x9d, 0xf9, 0x86, 0x7a, 0xb3, 0x37,
+	0xc0, 0x42, 0xf7, 0x0f, 0x23, 0x1e, 0x1c, 0x7f, 0x12, 0xb2, 0xe4, 0x20, 0xe6, 0xfd, 0x50, 0x84,
+	0x9c, 0x65, 0x01, 0x7f, 0x35, 0xa0, 0x52, 0xe8, 0xa2, 0xa2, 0x7f, 0x67, 0xc0, 0x0a, 0x4d, 0x8f,
+	0xbd, 0x6e, 0xc8, 0x12, 0x2f, 0xca, 0x1c, 0x30, 0xd9, 0x62, 0x7d, 0xcd, 0x91, 0x45, 0x74, 0xd2,
+	0x22, 0x3a, 0xaa, 0x88, 0xce, 0x3d, 0x1a, 0xec, 0xf3, 0x90, 0xed, 0xed, 0x3e, 0x7b, 0x51, 0x99,
+	0x79, 0xfa, 0x77, 0xe5, 0x76, 0x3b, 0x4c, 0x8e, 0x7b, 0x4d, 0x27, 0xe0, 0x5d, 0x57, 0x15, 0x5d,
+	0xfe, 0xab, 0x8a, 0xd6, 0x43, 0x37, 0x39, 0x89, 0xa8, 0xc8, 0x30, 0xa2, 0x41, 0xe8, 0x18, 0x1b,
+	0xfb, 0x4d, 0xb8, 0x8e, 0x44, 0x8f, 0x1e, 0x86, 0x51, 0x44, 0x5b, 0xc8, 0x57, 0x64, 0xd7, 0xd8,
+	0x07, 0x53, 0x77, 0xa8, 0x2e, 0x70, 0x13, 0x96, 0x85, 0x3c, 0xf0, 0x30, 0xb0, 0x50, 0x65, 0x2a,
+	0x8b, 0xbc, 0xbb, 0x5d, 0x81, 0x75, 0x0c, 0xb2, 0x1f, 0xc6, 0x41, 0x2f, 0xfd, 0x80, 0xac, 0x7d,
+	0xd4, 0x8b, 0xa2, 0xce, 0x49, 0x96, 0xe5, 0x67, 0x03, 0xac, 0x22, 0x0f, 0x95, 0xea, 0x1b, 0x03,
+	0x48, 0x30, 0x3c, 0xf5, 0x04, 0x1e, 0xbf, 0xbc, 0x4a, 0x5d, 0x0d, 0xce, 0x53, 0x19, 0x14, 0xea,
+	0x7e, 0xd6, 0x85, 0x0d, 0x3f, 0xa1, 0xd9, 0x15, 0x04, 0x98, 0xba, 0x43, 0xc5, 0xfe, 0x73, 0x58,
+	0x1e, 0xf4, 0xae, 0x17, 0xfb, 0x09, 0x45, 0xe2, 0x57, 0xf6, 0x9c, 0x94, 0xda, 0x5f, 0x2f, 0x2a,
+	0x5b, 0x93, 0x51, 0x6b, 0x94, 0xc3, 0x7c, 0x78, 0x7b, 0x13, 0x6e, 0x60, 0xd2, 0x0f, 0x82, 0x24,
+	0xec, 0xd3, 0xa3, 0xe0, 0x98, 0xb6, 0x7a, 0x1d, 0x7a, 0x44, 0xdb, 0x5d, 0xca, 0x92, 0x8c, 0xd9,
+	0x93, 0x59, 0xb0, 0x2f, 0xf2, 0x52, 0x14, 0x1f, 0x40, 0x59, 0xa8, 0x23, 0x2f, 0x4d, 0x88, 0x0c,
+	0x97, 0xeb, 0x6f, 0x3b, 0xe3, 0xd3, 0xed, 0x0c, 0x2e, 0x99, 0x05, 0xfb, 0xec, 0x24, 0xa2, 0x8d,
+	0x25, 0x91, 0x7b, 0xcb, 0x8d, 0xce, 0x6c, 0x7e, 0x74, 0xc8, 0xfb, 0xf0, 0x8a, 0x90, 0xa9, 0x57,
+	0xe7, 0xf0, 0xe3, 0x6d, 0xea, 0x32, 0x9c, 0x67, 0x99, 0x61, 0xc8, 0x26, 0x94, 0xd5, 0xa3, 0x17,
+	0xb2, 0x16, 0x7d, 0xbc, 0x3a, 0x8f, 0xd1, 0x97, 0x94, 0xf1, 0x7e, 0x6a, 0x23, 0xeb, 0x00, 0x94,
+	0xb5, 0x3c, 0x95, 0x7f, 0x01, 0x3d, 0xae, 0x50, 0xd6, 0x92, 0xd3, 0x6d, 0xdf, 0x85, 0x35, 0xd9,
+	0xd4, 0xf8, 0x5d, 0x0f, 0x62, 0xfe, 0x15, 0x0d, 0x92, 0xe1, 0xec, 0xa6, 0xd4, 0x47, 0xda, 0x59,
+	0xbd, 0xd9, 0x1d, 0x58, 0x2f, 0xc0, 0xa9, 0x1a, 0x7e, 0x0c, 0x8b, 0xd1, 0xc0, 0x9a, 0xa2, 0xe7,
+	0x8a, 0xee, 0x87, 0x93, 0x31, 0x8c, 0xb0, 0x37, 0x9f, 0x36, 0x42, 0x23, 0x8f, 0xb6, 0x9f, 0xcc,
+	0xc1, 0xab, 0xe7, 0xdc, 0xc8, 0x0d, 0x58, 0x92, 0x0b, 0x83, 0xf5, 0xba, 0x4d, 0x1a, 0x23, 0xbf,
+	0xb9, 0xc6, 0x22, 0xda, 0x1e, 0xa0, 0xa9, 0xb0, 0xee, 0x87, 0x05, 0xbb, 0x46, 0x7e, 0x84, 0xeb,
+	0xda, 0x09, 0xc2, 0xf1, 0x91, 0xd4, 0x34, 0x9b, 0x83, 0xdc, 0x83, 0x72, 0x1a, 0x8c, 0xb6, 0xb2,
+	0x69, 0x9c, 0x9f, 0x2c, 0xd6, 0x92, 0x44, 0xc9, 0x32, 0x92, 0x43, 0xed, 0x60, 0x2f, 0x4c, 0x30,
+	0xd8, 0x32, 0xda, 0xf8, 0xa4, 0x6a, 0xc6, 0xad, 0xf4, 0x7f, 0x8c, 0xdb, 0x40, 0x3a, 0xfc, 0xd8,
+	0xef, 0x0e, 0x56, 0xe4, 0xa7, 0x70, 0x6d, 0xc4, 0xaa, 0x7a, 0xe1, 0x3d, 0x28, 0x45, 0x68, 0x51,
+	0x3b, 0xca, 0xd4, 0xb5, 0x81, 0xc4, 0xa8, 0x8b, 0x28, 0xff, 0xfa, 0xf7, 0x00, 0x0b, 0x18, 0x91,
+	0x7c, 0x0d, 0x25, 0xd9, 0xb2, 0x64, 0x4b, 0x87, 0x1e, 0xd7, 0x31, 0x73, 0xfb, 0x52, 0x3f, 0x49,
+	0xcf, 0xb6, 0xbf, 0xfd, 0xe3, 0xdf, 0x1f, 0x67, 0xd7, 0x88, 0xe9, 0x6a, 0x74, 0x56, 0xb5, 0xcc,
+	0x6f, 0x06, 0x90, 0x71, 0xf9, 0x22, 0xf5, 0xc2, 0x1c, 0x85, 0x72, 0x68, 0xee, 0x4e, 0x85, 0x51,
+	0x1c, 0xef, 0x20, 0xc7, 0x5b, 0x64, 0x47, 0xc7, 0x51, 0xd7, 0xcc, 0xe4, 0x27, 0x03, 0xca, 0x23,
+	0x52, 0x45, 0xaa, 0x85, 0x89, 0x75, 0x7a, 0x67, 0x3a, 0x93, 0xba, 0x2b, 0x8a, 0xb7, 0x90, 0xe2,
+	0x5b, 0xc4, 0xd6, 0x51, 0x1c, 0xd5, 0x46, 0xf2, 0xd4, 0x80, 0xab, 0x63, 0x02, 0x47, 0x6a, 0x85,
+	0x19, 0x8b, 0xe4, 0xd2, 0xac, 0x4f, 0x03, 0x51, 0x44, 0x1d, 0x24, 0xba, 0x43, 0xb6, 0x74, 0x44,
+	0xc7, 0xe7, 0x0f, 0x2b, 0x39, 0xa2, 0x65, 0x17, 0x54, 0x52, 0x27, 0x88, 0xa6, 0x33, 0xa9, 0xfb,
+	0x24, 0x95, 0x1c, 0x9d, 0x66, 0xf2, 0xbb, 0x01, 0xaf, 0x6b, 0xd5, 0x8c, 0xbc, 0x5b, 0x98, 0xf5,
+	0x22, 0x8d, 0x34, 0xef, 0x4e, 0x0b, 0x53, 0xa4, 0x77, 0x91, 0x74, 0x95, 0xdc, 0xd6, 0x91, 0xf6,
+	0x11, 0xea, 0x0d, 0x54, 0x35, 0x93, 0xb0, 0x5f, 0x0c, 0x78, 0xed, 0xbc, 0x84, 0x90, 0x3b, 0xc5,
+	0x8d, 0xa7, 0x57, 0x29, 0xb3, 0x36, 0x05, 0x42, 0xd1, 0xad, 0x22, 0xdd, 0x6d, 0x72, 0x53, 0xdb,
+	0xad, 0x88, 0xf2, 0x86, 0x12, 0x84, 0xeb, 0x07, 0x57, 0xd2, 0x45, 0xeb, 0x27, 0xbf, 0x0b, 0xcd,
+	0xed, 0x4b, 0xfd, 0x26, 0x5a, 0x3f, 0x72, 0x2b, 0x7e, 0xf4, 0xec, 0xd4, 0x32, 0x9e, 0x9f, 0x5a,
+	0xc6, 0x3f, 0xa7, 0x96, 0xf1, 0xc3, 0x99, 0x35, 0xf3, 0xfc, 0xcc, 0x9a, 0xf9, 0xf3, 0xcc, 0x9a,
+	0xf9, 0xe2, 0x9d, 0xdc, 0xfe, 0x96, 0x78, 0xf9, 0xb7, 0x5f, 0xab, 0xbb, 0x8f, 0x73, 0xb1, 0x70,
+	0x93, 0x37, 0x4b, 0xf8, 0xc3, 0x7f, 0xf7, 0xbf, 0x01, 0x00, 0x18, 0x6b, 0x48, 0x50, 0xc8, 0x0c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -617,6 +947,12 @@ type QueryClient interface {
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// ActiveScheduleSegment retrieves the segment of the piecewise inflation
+	// schedule for the current period.
+	ActiveScheduleSegment(ctx context.Context, in *QueryActiveScheduleSegmentRequest, opts ...grpc.CallOption) (*QueryActiveScheduleSegmentResponse, error)
+	// SupplyProjection simulates the inflation of the upcoming epochs from the
+	// current params and state without modifying it.
+	SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -674,6 +1010,24 @@ func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateR
 	return out, nil
 }
 
+func (c *queryClient) ActiveScheduleSegment(ctx context.Context, in *QueryActiveScheduleSegmentRequest, opts ...grpc.CallOption) (*QueryActiveScheduleSegmentResponse, error) {
+	out := new(QueryActiveScheduleSegmentResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/ActiveScheduleSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error) {
+	out := new(QuerySupplyProjectionResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/SupplyProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/Params", in, out, opts...)
@@ -696,6 +1050,12 @@ type QueryServer interface {
 	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// ActiveScheduleSegment retrieves the segment of the piecewise inflation
+	// schedule for the current period.
+	ActiveScheduleSegment(context.Context, *QueryActiveScheduleSegmentRequest) (*QueryActiveScheduleSegmentResponse, error)
+	// SupplyProjection simulates the inflation of the upcoming epochs from the
+	// current params and state without modifying it.
+	SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -719,6 +1079,12 @@ func (*UnimplementedQueryServer) CirculatingSupply(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
 }
+func (*UnimplementedQueryServer) ActiveScheduleSegment(ctx context.Context, req *QueryActiveScheduleSegmentRequest) (*QueryActiveScheduleSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveScheduleSegment not implemented")
+}
+func (*UnimplementedQueryServer) SupplyProjection(ctx context.Context, req *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyProjection not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -817,6 +1183,42 @@ func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ActiveScheduleSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveScheduleSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveScheduleSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Query/ActiveScheduleSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveScheduleSegment(ctx, req.(*QueryActiveScheduleSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Query/SupplyProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyProjection(ctx, req.(*QuerySupplyProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -859,6 +1261,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InflationRate",
 			Handler:    _Query_InflationRate_Handler,
 		},
+		{
+			MethodName: "ActiveScheduleSegment",
+			Handler:    _Query_ActiveScheduleSegment_Handler,
+		},
+		{
+			MethodName: "SupplyProjection",
+			Handler:    _Query_SupplyProjection_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1138,7 +1548,7 @@ func (m *QueryInflationRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryActiveScheduleSegmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1148,12 +1558,12 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryActiveScheduleSegmentRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryActiveScheduleSegmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1161,7 +1571,7 @@ func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryActiveScheduleSegmentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1171,46 +1581,262 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryActiveScheduleSegmentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryActiveScheduleSegmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.EndPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SegmentIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SegmentIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Segment != nil {
+		{
+			size, err := m.Segment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduleType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySupplyProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPeriodRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *QuerySupplyProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.CirculatingSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.MintedSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.EpochMintProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -1307,6 +1933,90 @@ func (m *QueryInflationRateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryActiveScheduleSegmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveScheduleSegmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleType != 0 {
+		n += 1 + sovQuery(uint64(m.ScheduleType))
+	}
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	if m.Segment != nil {
+		l = m.Segment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SegmentIndex != 0 {
+		n += 1 + sovQuery(uint64(m.SegmentIndex))
+	}
+	if m.EndPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.EndPeriod))
+	}
+	return n
+}
+
+func (m *QuerySupplyProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *QuerySupplyProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1971,6 +2681,592 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryActiveScheduleSegmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveScheduleSegmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveScheduleSegmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveScheduleSegmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveScheduleSegmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveScheduleSegmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
+			}
+			m.ScheduleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleType |= InflationScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Segment == nil {
+				m.Segment = &ScheduleSegment{}
+			}
+			if err := m.Segment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndex", wireType)
+			}
+			m.SegmentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPeriod", wireType)
+			}
+			m.EndPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, EpochProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
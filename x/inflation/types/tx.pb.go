@@ -124,35 +124,159 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateInflationSchedule defines a Msg for switching the inflation schedule
+// without updating the other x/inflation module parameters.
+type MsgUpdateInflationSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// schedule_type defines the schedule used from the next epoch on
+	ScheduleType InflationScheduleType `protobuf:"varint,2,opt,name=schedule_type,json=scheduleType,proto3,enum=evmos.inflation.v1.InflationScheduleType" json:"schedule_type,omitempty"`
+	// exponential_calculation replaces the exponential calculation if set
+	ExponentialCalculation *ExponentialCalculation `protobuf:"bytes,3,opt,name=exponential_calculation,json=exponentialCalculation,proto3" json:"exponential_calculation,omitempty"`
+	// piecewise_calculation replaces the piecewise calculation if set
+	PiecewiseCalculation *PiecewiseCalculation `protobuf:"bytes,4,opt,name=piecewise_calculation,json=piecewiseCalculation,proto3" json:"piecewise_calculation,omitempty"`
+}
+
+func (m *MsgUpdateInflationSchedule) Reset()         { *m = MsgUpdateInflationSchedule{} }
+func (m *MsgUpdateInflationSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInflationSchedule) ProtoMessage()    {}
+func (*MsgUpdateInflationSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f254d33a26438a9, []int{2}
+}
+func (m *MsgUpdateInflationSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInflationSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInflationSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInflationSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInflationSchedule.Merge(m, src)
+}
+func (m *MsgUpdateInflationSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInflationSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInflationSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInflationSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateInflationSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateInflationSchedule) GetScheduleType() InflationScheduleType {
+	if m != nil {
+		return m.ScheduleType
+	}
+	return INFLATION_SCHEDULE_TYPE_EXPONENTIAL
+}
+
+func (m *MsgUpdateInflationSchedule) GetExponentialCalculation() *ExponentialCalculation {
+	if m != nil {
+		return m.ExponentialCalculation
+	}
+	return nil
+}
+
+func (m *MsgUpdateInflationSchedule) GetPiecewiseCalculation() *PiecewiseCalculation {
+	if m != nil {
+		return m.PiecewiseCalculation
+	}
+	return nil
+}
+
+// MsgUpdateInflationScheduleResponse defines the response structure for
+// executing a MsgUpdateInflationSchedule message.
+type MsgUpdateInflationScheduleResponse struct {
+}
+
+func (m *MsgUpdateInflationScheduleResponse) Reset()         { *m = MsgUpdateInflationScheduleResponse{} }
+func (m *MsgUpdateInflationScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInflationScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateInflationScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f254d33a26438a9, []int{3}
+}
+func (m *MsgUpdateInflationScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInflationScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInflationScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInflationScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInflationScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateInflationScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInflationScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInflationScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInflationScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.inflation.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.inflation.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateInflationSchedule)(nil), "evmos.inflation.v1.MsgUpdateInflationSchedule")
+	proto.RegisterType((*MsgUpdateInflationScheduleResponse)(nil), "evmos.inflation.v1.MsgUpdateInflationScheduleResponse")
 }
 
 func init() { proto.RegisterFile("evmos/inflation/v1/tx.proto", fileDescriptor_2f254d33a26438a9) }
 
 var fileDescriptor_2f254d33a26438a9 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x2f,
-	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x02, 0x4b, 0xea, 0xc1, 0x25, 0xf5, 0xca,
-	0x0c, 0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x41, 0x3a, 0x72, 0x8b, 0xd3, 0x41, 0x6a, 0x73, 0x8b, 0xd3,
-	0x21, 0x8a, 0xa5, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x52, 0xc0, 0x62,
-	0x49, 0x7a, 0x6a, 0x5e, 0x6a, 0x71, 0x26, 0x4c, 0x85, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x44, 0x27,
-	0x88, 0x05, 0x11, 0x55, 0x9a, 0xcc, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58,
-	0x92, 0x1a, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91,
-	0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae,
-	0x08, 0xd4, 0x42, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4,
-	0x20, 0x84, 0x52, 0x21, 0x0b, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc,
-	0x46, 0x52, 0x7a, 0x98, 0x9e, 0xd3, 0x83, 0xd8, 0xe1, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10,
-	0x54, 0xbd, 0x15, 0x5f, 0xd3, 0xf3, 0x0d, 0x5a, 0x08, 0x93, 0x94, 0x24, 0xb9, 0xc4, 0xd1, 0x1c,
-	0x15, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x94, 0xce, 0xc5, 0xec, 0x5b, 0x9c, 0x2e,
-	0x94, 0xc0, 0xc5, 0x83, 0xe2, 0x66, 0x65, 0x6c, 0x76, 0xa1, 0x99, 0x21, 0xa5, 0x4d, 0x84, 0x22,
-	0x98, 0x45, 0x4e, 0x6e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x93,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x09, 0x76, 0x08, 0x59, 0x66,
-	0x68, 0xa4, 0x5f, 0x81, 0x14, 0x05, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x80, 0x36,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x3e, 0x80, 0x19, 0x07, 0x02, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xb6, 0x55, 0xa5, 0x2e, 0xa5, 0x48, 0x56, 0x20, 0xa9, 0x91, 0x4c, 0x64, 0x38, 0x84,
+	0x02, 0xb6, 0x12, 0xa4, 0x0a, 0x71, 0x23, 0x08, 0x24, 0x0e, 0x45, 0xc8, 0x85, 0x0b, 0x12, 0x0a,
+	0xae, 0x33, 0x6c, 0x56, 0x8a, 0xbd, 0x2b, 0xcf, 0x26, 0x24, 0x47, 0x78, 0x02, 0x24, 0x5e, 0x84,
+	0x03, 0x0f, 0xd1, 0x63, 0xc5, 0x89, 0x13, 0x42, 0xc9, 0x81, 0x03, 0x2f, 0xc0, 0x11, 0xc5, 0x1b,
+	0xd7, 0x69, 0xbd, 0x41, 0xc0, 0xc5, 0xf2, 0xcc, 0x7c, 0xf3, 0x7d, 0xb3, 0xf3, 0x43, 0xaf, 0xc2,
+	0x28, 0x16, 0xe8, 0xf3, 0xe4, 0xcd, 0x20, 0x54, 0x5c, 0x24, 0xfe, 0xa8, 0xe5, 0xab, 0xb1, 0x27,
+	0x53, 0xa1, 0x84, 0x65, 0x65, 0x41, 0xef, 0x34, 0xe8, 0x8d, 0x5a, 0x76, 0x2d, 0x12, 0x38, 0xcf,
+	0x88, 0x91, 0xcd, 0xb1, 0x31, 0x32, 0x0d, 0xb6, 0x77, 0x75, 0xa0, 0x9b, 0x59, 0xbe, 0x36, 0x16,
+	0xa1, 0x86, 0x41, 0x84, 0x41, 0x02, 0xc8, 0x73, 0x84, 0x6b, 0x40, 0x14, 0xb2, 0x1a, 0x53, 0x65,
+	0x82, 0x09, 0xcd, 0x3e, 0xff, 0xd3, 0x5e, 0xf7, 0x23, 0xa1, 0x97, 0x0e, 0x90, 0xbd, 0x90, 0xbd,
+	0x50, 0xc1, 0xb3, 0x30, 0x0d, 0x63, 0xb4, 0xf6, 0xe9, 0x56, 0x38, 0x54, 0x7d, 0x91, 0x72, 0x35,
+	0xa9, 0x93, 0x06, 0x69, 0x6e, 0x75, 0xea, 0x5f, 0x3e, 0xdf, 0xa9, 0x2e, 0x8a, 0x7a, 0xd0, 0xeb,
+	0xa5, 0x80, 0x78, 0xa8, 0x52, 0x9e, 0xb0, 0xa0, 0x80, 0x5a, 0xf7, 0xe8, 0xa6, 0xcc, 0x18, 0xea,
+	0x6b, 0x0d, 0xd2, 0xbc, 0xd0, 0xb6, 0xbd, 0x72, 0x03, 0x3c, 0xad, 0xd1, 0xd9, 0x38, 0xfe, 0x76,
+	0xad, 0x12, 0x2c, 0xf0, 0xf7, 0x77, 0xde, 0xff, 0xf8, 0xb4, 0x57, 0x30, 0xb9, 0xbb, 0xb4, 0x76,
+	0xae, 0xa8, 0x00, 0x50, 0x8a, 0x04, 0xc1, 0xfd, 0xb5, 0x46, 0xed, 0xd3, 0xd8, 0x93, 0x9c, 0xf9,
+	0x30, 0xea, 0x43, 0x6f, 0x38, 0x80, 0xff, 0xae, 0xfd, 0x29, 0xbd, 0x88, 0x0b, 0x8e, 0xae, 0x9a,
+	0x48, 0xc8, 0x9e, 0xb0, 0xd3, 0xbe, 0x69, 0x7a, 0x42, 0x49, 0xf5, 0xf9, 0x44, 0x42, 0xb0, 0x8d,
+	0x4b, 0x96, 0x15, 0xd1, 0x1a, 0x8c, 0xa5, 0x48, 0x20, 0x51, 0x3c, 0x1c, 0x74, 0xa3, 0x70, 0x10,
+	0x0d, 0x75, 0x52, 0x7d, 0x3d, 0x6b, 0xce, 0x9e, 0x89, 0xf9, 0x51, 0x91, 0xf2, 0xb0, 0xc8, 0x08,
+	0xae, 0x80, 0xd1, 0x6f, 0xbd, 0xa2, 0x97, 0x25, 0x87, 0x08, 0xde, 0x72, 0x84, 0x33, 0x12, 0x1b,
+	0x99, 0x44, 0xd3, 0xd8, 0xff, 0x3c, 0x61, 0x59, 0xa0, 0x2a, 0x0d, 0xde, 0xd2, 0x54, 0x6e, 0x50,
+	0x77, 0x75, 0xe7, 0xf3, 0x01, 0xb5, 0x7f, 0x12, 0xba, 0x7e, 0x80, 0xcc, 0x7a, 0x4d, 0xb7, 0xcf,
+	0x6c, 0xd5, 0x75, 0x53, 0x35, 0xe7, 0xa6, 0x6c, 0xdf, 0xfa, 0x0b, 0x50, 0xae, 0x64, 0xbd, 0x23,
+	0xb4, 0xb6, 0x6a, 0x0f, 0xbc, 0x3f, 0x12, 0x95, 0xf0, 0xf6, 0xfe, 0xbf, 0xe1, 0xf3, 0x1a, 0x3a,
+	0x8f, 0x8f, 0xa7, 0x0e, 0x39, 0x99, 0x3a, 0xe4, 0xfb, 0xd4, 0x21, 0x1f, 0x66, 0x4e, 0xe5, 0x64,
+	0xe6, 0x54, 0xbe, 0xce, 0x9c, 0xca, 0xcb, 0xdb, 0x8c, 0xab, 0xfe, 0xf0, 0xc8, 0x8b, 0x44, 0xec,
+	0xeb, 0xf3, 0xd4, 0xdf, 0x51, 0xab, 0xed, 0x8f, 0x97, 0x4e, 0x75, 0xbe, 0x6d, 0x78, 0xb4, 0x99,
+	0x9d, 0xe3, 0xdd, 0xdf, 0x03, 0x00, 0xf7, 0x3c, 0x87, 0x8c, 0x51, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +294,10 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/inflation module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateInflationSchedule defined a governance operation for switching the
+	// inflation schedule type and updating its calculation.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateInflationSchedule(ctx context.Context, in *MsgUpdateInflationSchedule, opts ...grpc.CallOption) (*MsgUpdateInflationScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -189,11 +317,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateInflationSchedule(ctx context.Context, in *MsgUpdateInflationSchedule, opts ...grpc.CallOption) (*MsgUpdateInflationScheduleResponse, error) {
+	out := new(MsgUpdateInflationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Msg/UpdateInflationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/inflation module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateInflationSchedule defined a governance operation for switching the
+	// inflation schedule type and updating its calculation.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateInflationSchedule(context.Context, *MsgUpdateInflationSchedule) (*MsgUpdateInflationScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -203,6 +344,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateInflationSchedule(ctx context.Context, req *MsgUpdateInflationSchedule) (*MsgUpdateInflationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInflationSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -226,6 +370,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateInflationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInflationSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInflationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Msg/UpdateInflationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInflationSchedule(ctx, req.(*MsgUpdateInflationSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.inflation.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -234,6 +396,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateInflationSchedule",
+			Handler:    _Msg_UpdateInflationSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/inflation/v1/tx.proto",
@@ -302,6 +468,88 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateInflationSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInflationSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInflationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PiecewiseCalculation != nil {
+		{
+			size, err := m.PiecewiseCalculation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExponentialCalculation != nil {
+		{
+			size, err := m.ExponentialCalculation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScheduleType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInflationScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInflationScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInflationScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -337,6 +585,39 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateInflationSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduleType != 0 {
+		n += 1 + sovTx(uint64(m.ScheduleType))
+	}
+	if m.ExponentialCalculation != nil {
+		l = m.ExponentialCalculation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PiecewiseCalculation != nil {
+		l = m.PiecewiseCalculation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateInflationScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -508,6 +789,229 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateInflationSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInflationSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInflationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
+			}
+			m.ScheduleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleType |= InflationScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExponentialCalculation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExponentialCalculation == nil {
+				m.ExponentialCalculation = &ExponentialCalculation{}
+			}
+			if err := m.ExponentialCalculation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseCalculation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PiecewiseCalculation == nil {
+				m.PiecewiseCalculation = &PiecewiseCalculation{}
+			}
+			if err := m.PiecewiseCalculation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInflationScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInflationScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInflationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v12/x/ratelimit/types"
+)
+
+func (suite *KeeperTestSuite) TestRateLimitQueries() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.RateLimits)
+
+	_, err = suite.queryClient.RateLimit(ctx, &types.QueryRateLimitRequest{ChannelID: channelID, Denom: denom})
+	suite.Require().Error(err)
+
+	suite.setRateLimit(types.NewQuota(sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()), sdk.NewInt(1000))
+	_, err = suite.app.RateLimitKeeper.UpdateFlow(suite.ctx, types.FlowOut, channelID, denom, sdk.NewInt(60))
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 1)
+
+	rateLimitRes, err := suite.queryClient.RateLimit(ctx, &types.QueryRateLimitRequest{ChannelID: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(60), rateLimitRes.RateLimit.Flow.Outflow)
+	suite.Require().Equal(sdk.NewInt(1000), rateLimitRes.RateLimit.Flow.ChannelValue)
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/evmos/evmos/v12/ibc"
+	"github.com/evmos/evmos/v12/x/ratelimit/types"
+)
+
+// SendPacket implements the ICS4Wrapper interface from the transfer module.
+// It adds the sent amount to the outflow of the rate limit of the denomination
+// over the source channel and rejects the transfer if the send quota is
+// exceeded. The packet is tracked until it is acknowledged so that the outflow
+// can be restored if the transfer fails.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	var (
+		packetData transfertypes.FungibleTokenPacketData
+		coin       sdk.Coin
+		updated    bool
+	)
+
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err == nil && packetData.ValidateBasic() == nil {
+		coin = ibc.GetSentCoin(packetData.Denom, packetData.Amount)
+		updated, err = k.UpdateFlow(ctx, types.FlowOut, sourceChannel, coin.Denom, coin.Amount)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	sequence, err = k.ics4Wrapper.SendPacket(
+		ctx,
+		channelCap,
+		sourcePort,
+		sourceChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+		data,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if updated {
+		k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+			ChannelID: sourceChannel,
+			Sequence:  sequence,
+			Denom:     coin.Denom,
+			Amount:    coin.Amount,
+		})
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface from the transfer module.
+// It calls the underlying WriteAcknowledgement function directly to move down the middleware stack.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, packet, ack)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnRecvPacket adds the received amount to the inflow of the rate limit of the
+// received denomination over the destination channel. It returns an error if
+// the receive quota is exceeded, in which case the packet must be rejected
+// with an error acknowledgement.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+) error {
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	_, err := k.UpdateFlow(ctx, types.FlowIn, packet.DestinationChannel, coin.Denom, coin.Amount)
+	return err
+}
+
+// OnAcknowledgementPacket stops tracking the acknowledged packet and, if the
+// transfer failed, removes its amount from the outflow of the rate limit.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack channeltypes.Acknowledgement,
+) {
+	if ack.Success() {
+		k.DeletePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+		return
+	}
+
+	k.undoSendPacket(ctx, packet)
+}
+
+// OnTimeoutPacket stops tracking the timed out packet and removes its amount
+// from the outflow of the rate limit.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.undoSendPacket(ctx, packet)
+}
+
+// undoSendPacket removes the amount of a failed packet from the outflow of
+// its rate limit. Packets sent in a previous window are not tracked anymore,
+// so they don't affect the current window.
+func (k Keeper) undoSendPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	pending, found := k.GetPendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	k.DeletePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	rateLimit, found := k.GetRateLimit(ctx, pending.ChannelID, pending.Denom)
+	if !found {
+		return
+	}
+
+	rateLimit.Flow.UndoOutflow(pending.Amount)
+	k.SetRateLimit(ctx, rateLimit)
+}
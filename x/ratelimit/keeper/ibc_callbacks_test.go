@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v12/x/ratelimit/types"
+)
+
+var timeoutHeight = clienttypes.NewHeight(1000, 1000)
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	// packet sent back by the counterparty, received as the native denom
+	newPacket := func(amount int64) (channeltypes.Packet, transfertypes.FungibleTokenPacketData) {
+		data := transfertypes.NewFungibleTokenPacketData(
+			transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-1", denom),
+			sdk.NewInt(amount).String(), "sender", "receiver", "",
+		)
+		packet := channeltypes.NewPacket(
+			data.GetBytes(), 1, transfertypes.PortID, "channel-1", transfertypes.PortID, channelID, timeoutHeight, 0,
+		)
+		return packet, data
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		amount     int64
+		expPass    bool
+		expInflow  sdk.Int
+		expLimited bool
+	}{
+		{
+			"pass - no rate limit",
+			func() {},
+			1000,
+			true,
+			sdk.Int{},
+			false,
+		},
+		{
+			"pass - within percent quota",
+			func() {
+				suite.setRateLimit(types.NewQuota(sdk.ZeroDec(), sdk.NewDec(10), sdk.ZeroInt(), sdk.ZeroInt()), sdk.NewInt(1000))
+			},
+			100,
+			true,
+			sdk.NewInt(100),
+			true,
+		},
+		{
+			"fail - exceeds percent quota",
+			func() {
+				suite.setRateLimit(types.NewQuota(sdk.ZeroDec(), sdk.NewDec(10), sdk.ZeroInt(), sdk.ZeroInt()), sdk.NewInt(1000))
+			},
+			101,
+			false,
+			sdk.ZeroInt(),
+			true,
+		},
+		{
+			"fail - exceeds amount quota",
+			func() {
+				suite.setRateLimit(types.NewQuota(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroInt(), sdk.NewInt(50)), sdk.NewInt(1000))
+			},
+			51,
+			false,
+			sdk.ZeroInt(),
+			true,
+		},
+		{
+			"pass - previous outflow is netted",
+			func() {
+				suite.setRateLimit(types.NewQuota(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroInt(), sdk.NewInt(50)), sdk.NewInt(1000))
+				_, err := suite.app.RateLimitKeeper.UpdateFlow(suite.ctx, types.FlowOut, channelID, denom, sdk.NewInt(30))
+				suite.Require().NoError(err)
+			},
+			80,
+			true,
+			sdk.NewInt(80),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			packet, data := newPacket(tc.amount)
+
+			err := suite.app.RateLimitKeeper.OnRecvPacket(suite.ctx, packet, data)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().True(errorsmod.IsOf(err, types.ErrQuotaExceeded))
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			rateLimit, found := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, denom)
+			suite.Require().Equal(tc.expLimited, found)
+			if found {
+				suite.Require().Equal(tc.expInflow, rateLimit.Flow.Inflow)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendPacketQuotaExceeded() {
+	suite.setRateLimit(types.NewQuota(sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()), sdk.NewInt(1000))
+
+	data := transfertypes.NewFungibleTokenPacketData(denom, "101", "sender", "receiver", "")
+	_, err := suite.app.RateLimitKeeper.SendPacket(
+		suite.ctx, nil, transfertypes.PortID, channelID, timeoutHeight, 0, data.GetBytes(),
+	)
+	suite.Require().Error(err)
+	suite.Require().True(errorsmod.IsOf(err, types.ErrQuotaExceeded))
+
+	rateLimit, _ := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestUndoSendPacket() {
+	packet := channeltypes.NewPacket(
+		nil, 1, transfertypes.PortID, channelID, transfertypes.PortID, "channel-1", timeoutHeight, 0,
+	)
+
+	testCases := []struct {
+		name       string
+		onComplete func()
+		expOutflow sdk.Int
+	}{
+		{
+			"successful acknowledgement keeps the outflow",
+			func() {
+				ack := channeltypes.NewResultAcknowledgement([]byte{1})
+				suite.app.RateLimitKeeper.OnAcknowledgementPacket(suite.ctx, packet, ack)
+			},
+			sdk.NewInt(100),
+		},
+		{
+			"error acknowledgement restores the outflow",
+			func() {
+				ack := channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrQuotaExceeded, "test"))
+				suite.app.RateLimitKeeper.OnAcknowledgementPacket(suite.ctx, packet, ack)
+			},
+			sdk.ZeroInt(),
+		},
+		{
+			"timeout restores the outflow",
+			func() {
+				suite.app.RateLimitKeeper.OnTimeoutPacket(suite.ctx, packet)
+			},
+			sdk.ZeroInt(),
+		},
+		{
+			"packet of a previous window is ignored",
+			func() {
+				rateLimit, _ := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, denom)
+				suite.app.RateLimitKeeper.ResetFlow(suite.ctx, rateLimit)
+				_, err := suite.app.RateLimitKeeper.UpdateFlow(suite.ctx, types.FlowOut, channelID, denom, sdk.NewInt(40))
+				suite.Require().NoError(err)
+				suite.app.RateLimitKeeper.OnTimeoutPacket(suite.ctx, packet)
+			},
+			sdk.NewInt(40),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.setRateLimit(types.NewQuota(sdk.NewDec(50), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()), sdk.NewInt(1000))
+			_, err := suite.app.RateLimitKeeper.UpdateFlow(suite.ctx, types.FlowOut, channelID, denom, sdk.NewInt(100))
+			suite.Require().NoError(err)
+			suite.app.RateLimitKeeper.SetPendingSendPacket(suite.ctx, types.PendingSendPacket{
+				ChannelID: channelID,
+				Sequence:  packet.Sequence,
+				Denom:     denom,
+				Amount:    sdk.NewInt(100),
+			})
+
+			tc.onComplete()
+
+			_, found := suite.app.RateLimitKeeper.GetPendingSendPacket(suite.ctx, channelID, packet.Sequence)
+			suite.Require().False(found)
+
+			rateLimit, _ := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, denom)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEpochReset() {
+	suite.setRateLimit(types.NewQuota(sdk.NewDec(50), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()), sdk.NewInt(1000))
+	_, err := suite.app.RateLimitKeeper.UpdateFlow(suite.ctx, types.FlowOut, channelID, denom, sdk.NewInt(100))
+	suite.Require().NoError(err)
+
+	// other epochs don't reset the window
+	suite.app.RateLimitKeeper.AfterEpochEnd(suite.ctx, "week", 1)
+	rateLimit, _ := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Require().Equal(sdk.NewInt(100), rateLimit.Flow.Outflow)
+
+	suite.app.RateLimitKeeper.AfterEpochEnd(suite.ctx, "day", 1)
+	rateLimit, _ = suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	// the channel value is updated to the current supply
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount
+	suite.Require().Equal(supply, rateLimit.Flow.ChannelValue)
+}
@@ -0,0 +1,185 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/evmos/evmos/v12/x/ratelimit/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// AddRateLimit implements the gRPC MsgServer interface. When an AddRateLimit
+// proposal passes, it adds a rate limit to a denomination over a transfer
+// channel. The update can only be performed if the requested authority is the
+// Cosmos SDK governance module account.
+func (k Keeper) AddRateLimit(goCtx context.Context, req *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRateLimit(ctx, req.ChannelID, req.Denom); found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "denom %s over %s", req.Denom, req.ChannelID)
+	}
+
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, req.ChannelID); !found {
+		return nil, errorsmod.Wrapf(types.ErrChannelNotFound, "channel %s", req.ChannelID)
+	}
+
+	channelValue, err := k.channelValueForQuota(ctx, req.Denom, req.Quota)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimit := types.NewRateLimit(req.Denom, req.ChannelID, req.EpochIdentifier, req.Quota, channelValue)
+	k.SetRateLimit(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddRateLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyEpochIdentifier, req.EpochIdentifier),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, channelValue.String()),
+		),
+	)
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit implements the gRPC MsgServer interface. When an
+// UpdateRateLimit proposal passes, it updates the quota and window of an
+// existing rate limit and resets its flow. The update can only be performed
+// if the requested authority is the Cosmos SDK governance module account.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelID, req.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s over %s", req.Denom, req.ChannelID)
+	}
+
+	if _, err := k.channelValueForQuota(ctx, req.Denom, req.Quota); err != nil {
+		return nil, err
+	}
+
+	rateLimit.EpochIdentifier = req.EpochIdentifier
+	rateLimit.Quota = req.Quota
+	rateLimit = k.ResetFlow(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRateLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyEpochIdentifier, req.EpochIdentifier),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, rateLimit.Flow.ChannelValue.String()),
+		),
+	)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit implements the gRPC MsgServer interface. When a
+// RemoveRateLimit proposal passes, it removes the rate limit of a denomination
+// over a transfer channel. The update can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRateLimit(ctx, req.ChannelID, req.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s over %s", req.Denom, req.ChannelID)
+	}
+
+	k.DeleteRateLimit(ctx, req.ChannelID, req.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveRateLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelID),
+		),
+	)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit implements the gRPC MsgServer interface. When a
+// ResetRateLimit proposal passes, it resets the flow of a rate limit, resuming
+// the transfers blocked by an exhausted quota. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k Keeper) ResetRateLimit(goCtx context.Context, req *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelID, req.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s over %s", req.Denom, req.ChannelID)
+	}
+
+	rateLimit = k.ResetFlow(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetRateLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, rateLimit.Flow.ChannelValue.String()),
+		),
+	)
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// validateAuthority checks that the signer is the governance module account
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), authority)
+	}
+	return nil
+}
+
+// channelValueForQuota returns the current channel value of the denomination.
+// It fails if the quota limits a direction by percentage while the channel
+// value is zero, as every transfer would be rejected.
+func (k Keeper) channelValueForQuota(ctx sdk.Context, denom string, quota types.Quota) (sdk.Int, error) {
+	channelValue := k.GetChannelValue(ctx, denom)
+	if quota.HasPercentQuota() && channelValue.IsZero() {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrZeroChannelValue, "cannot set a percentage quota for denom %s", denom)
+	}
+	return channelValue, nil
+}
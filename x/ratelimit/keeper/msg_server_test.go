@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v12/x/ratelimit/types"
+)
+
+func (suite *KeeperTestSuite) TestAddRateLimit() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	quota := types.NewQuota(sdk.NewDec(10), sdk.NewDec(10), sdk.ZeroInt(), sdk.ZeroInt())
+	amountQuota := types.NewQuota(sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewInt(100), sdk.NewInt(100))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgAddRateLimit
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgAddRateLimit{Authority: "evmos1invalid", Denom: denom, ChannelID: channelID, EpochIdentifier: "day", Quota: quota},
+			func() {},
+			false,
+		},
+		{
+			"fail - rate limit already exists",
+			&types.MsgAddRateLimit{Authority: authority, Denom: denom, ChannelID: channelID, EpochIdentifier: "day", Quota: quota},
+			func() {
+				suite.setRateLimit(quota, sdk.NewInt(1000))
+			},
+			false,
+		},
+		{
+			"fail - channel not found",
+			&types.MsgAddRateLimit{Authority: authority, Denom: denom, ChannelID: "channel-7", EpochIdentifier: "day", Quota: quota},
+			func() {},
+			false,
+		},
+		{
+			"fail - percent quota with zero channel value",
+			&types.MsgAddRateLimit{Authority: authority, Denom: "ibc/unknown", ChannelID: channelID, EpochIdentifier: "day", Quota: quota},
+			func() {},
+			false,
+		},
+		{
+			"pass - amount quota with zero channel value",
+			&types.MsgAddRateLimit{Authority: authority, Denom: "ibc/unknown", ChannelID: channelID, EpochIdentifier: "day", Quota: amountQuota},
+			func() {},
+			true,
+		},
+		{
+			"pass - percent quota",
+			&types.MsgAddRateLimit{Authority: authority, Denom: denom, ChannelID: channelID, EpochIdentifier: "day", Quota: quota},
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			_, err := suite.app.RateLimitKeeper.AddRateLimit(sdk.WrapSDKContext(suite.ctx), tc.msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			rateLimit, found := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, tc.msg.ChannelID, tc.msg.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.msg.Quota, rateLimit.Quota)
+			suite.Require().Equal(suite.app.BankKeeper.GetSupply(suite.ctx, tc.msg.Denom).Amount, rateLimit.Flow.ChannelValue)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateRemoveAndResetRateLimit() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	quota := types.NewQuota(sdk.NewDec(10), sdk.NewDec(10), sdk.ZeroInt(), sdk.ZeroInt())
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// fail on missing rate limits
+	_, err := suite.app.RateLimitKeeper.UpdateRateLimit(ctx, &types.MsgUpdateRateLimit{
+		Authority: authority, Denom: denom, ChannelID: channelID, EpochIdentifier: "week", Quota: quota,
+	})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	_, err = suite.app.RateLimitKeeper.ResetRateLimit(ctx, &types.MsgResetRateLimit{
+		Authority: authority, Denom: denom, ChannelID: channelID,
+	})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	_, err = suite.app.RateLimitKeeper.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Authority: authority, Denom: denom, ChannelID: channelID,
+	})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	suite.setRateLimit(quota, sdk.NewInt(1000))
+	_, err = suite.app.RateLimitKeeper.UpdateFlow(suite.ctx, types.FlowOut, channelID, denom, sdk.NewInt(100))
+	suite.Require().NoError(err)
+
+	// an exhausted quota rejects further transfers until reset
+	_, err = suite.app.RateLimitKeeper.UpdateFlow(suite.ctx, types.FlowOut, channelID, denom, sdk.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	_, err = suite.app.RateLimitKeeper.ResetRateLimit(ctx, &types.MsgResetRateLimit{
+		Authority: "evmos1invalid", Denom: denom, ChannelID: channelID,
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.app.RateLimitKeeper.ResetRateLimit(ctx, &types.MsgResetRateLimit{
+		Authority: authority, Denom: denom, ChannelID: channelID,
+	})
+	suite.Require().NoError(err)
+	rateLimit, _ := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	// update the quota and window
+	newQuota := types.NewQuota(sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewInt(5), sdk.NewInt(5))
+	_, err = suite.app.RateLimitKeeper.UpdateRateLimit(ctx, &types.MsgUpdateRateLimit{
+		Authority: authority, Denom: denom, ChannelID: channelID, EpochIdentifier: "week", Quota: newQuota,
+	})
+	suite.Require().NoError(err)
+	rateLimit, _ = suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Require().Equal("week", rateLimit.EpochIdentifier)
+	suite.Require().Equal(newQuota, rateLimit.Quota)
+
+	_, err = suite.app.RateLimitKeeper.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Authority: authority, Denom: denom, ChannelID: channelID,
+	})
+	suite.Require().NoError(err)
+	_, found := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, denom)
+	suite.Require().False(found)
+}
@@ -0,0 +1,208 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v12/x/ratelimit/types"
+)
+
+// GetRateLimits returns all the rate limits
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over all the stored rate limits
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRateLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetRateLimit returns the rate limit of a denomination over a channel
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := store.Get(types.RateLimitKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores a rate limit
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.RateLimitKey(rateLimit.ChannelID, rateLimit.Denom), bz)
+}
+
+// DeleteRateLimit removes the rate limit of a denomination over a channel
+// together with its pending send packets
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	store.Delete(types.RateLimitKey(channelID, denom))
+	k.deletePendingSendPackets(ctx, channelID, denom)
+}
+
+// GetChannelValue returns the value used as the base of the percentage quotas
+// of a denomination, i.e. its total supply on this chain
+func (k Keeper) GetChannelValue(ctx sdk.Context, denom string) sdk.Int {
+	return k.bankKeeper.GetSupply(ctx, denom).Amount
+}
+
+// ResetFlow starts a new window for the rate limit by clearing its flow,
+// updating its channel value and dropping its pending send packets, so that
+// failures of packets sent in the previous window don't release quota of the
+// new one.
+func (k Keeper) ResetFlow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	rateLimit.Flow = types.NewFlow(k.GetChannelValue(ctx, rateLimit.Denom))
+	k.SetRateLimit(ctx, rateLimit)
+	k.deletePendingSendPackets(ctx, rateLimit.ChannelID, rateLimit.Denom)
+	return rateLimit
+}
+
+// resetRateLimits resets the rate limits with the given epoch identifier
+func (k Keeper) resetRateLimits(ctx sdk.Context, epochIdentifier string) {
+	for _, rateLimit := range k.GetRateLimits(ctx) {
+		if rateLimit.EpochIdentifier != epochIdentifier {
+			continue
+		}
+		k.ResetFlow(ctx, rateLimit)
+	}
+}
+
+// UpdateFlow adds a transfer of the given amount to the flow of the rate limit
+// of the denomination over the channel, if any. It fails if the resulting net
+// flow in the transfer direction exceeds the quota. Transfers that reduce the
+// net flow of a direction are always allowed.
+func (k Keeper) UpdateFlow(
+	ctx sdk.Context,
+	direction types.FlowDirection,
+	channelID, denom string,
+	amount sdk.Int,
+) (updated bool, err error) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return false, nil
+	}
+
+	rateLimit.Flow.Add(direction, amount)
+
+	threshold, limited := rateLimit.Quota.Threshold(direction, rateLimit.Flow.ChannelValue)
+	if limited {
+		if netFlow := rateLimit.Flow.NetFlow(direction); netFlow.GT(threshold) {
+			return false, errorsmod.Wrapf(
+				types.ErrQuotaExceeded,
+				"%s of %s%s over %s: net flow %s exceeds the quota %s",
+				direction, amount, denom, channelID, netFlow, threshold,
+			)
+		}
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return true, nil
+}
+
+// GetPendingSendPacket returns the pending send packet with the given source
+// channel and sequence
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	bz := store.Get(types.PendingSendPacketKey(channelID, sequence))
+	if len(bz) == 0 {
+		return types.PendingSendPacket{}, false
+	}
+
+	var packet types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// GetPendingSendPackets returns all the pending send packets
+func (k Keeper) GetPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	packets := []types.PendingSendPacket{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPendingSendPacket)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// SetPendingSendPacket stores a pending send packet
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(types.PendingSendPacketKey(packet.ChannelID, packet.Sequence), bz)
+}
+
+// DeletePendingSendPacket removes the pending send packet with the given
+// source channel and sequence
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	store.Delete(types.PendingSendPacketKey(channelID, sequence))
+}
+
+// deletePendingSendPackets removes the pending send packets of a denomination
+// over a channel
+func (k Keeper) deletePendingSendPackets(ctx sdk.Context, channelID, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingSendPacketChannelPrefix(channelID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		if packet.Denom == denom {
+			keys = append(keys, bytes.Clone(iterator.Key()))
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
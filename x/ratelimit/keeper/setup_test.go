@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	feemarkettypes "github.com/evmos/evmos/v12/x/feemarket/types"
+	"github.com/evmos/evmos/v12/x/ratelimit/types"
+)
+
+const (
+	denom     = "aevmos"
+	channelID = "channel-0"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+
+	app         *app.Evmos
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	// consensus key
+	consAddress := sdk.ConsAddress(utiltx.GenerateAddress().Bytes())
+
+	suite.app = app.Setup(false, feemarkettypes.DefaultGenesisState())
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), "evmos_9001-1", consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.RateLimitKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	suite.app.IBCKeeper.ChannelKeeper.SetChannel(
+		suite.ctx, transfertypes.PortID, channelID,
+		channeltypes.NewChannel(
+			channeltypes.OPEN, channeltypes.UNORDERED,
+			channeltypes.NewCounterparty(transfertypes.PortID, "channel-1"),
+			[]string{"connection-0"}, transfertypes.Version,
+		),
+	)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// setRateLimit stores a rate limit for the test denomination and channel
+// with the given channel value
+func (suite *KeeperTestSuite) setRateLimit(quota types.Quota, channelValue sdk.Int) {
+	suite.app.RateLimitKeeper.SetRateLimit(
+		suite.ctx, types.NewRateLimit(denom, channelID, "day", quota, channelValue),
+	)
+}
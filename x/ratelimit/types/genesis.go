@@ -0,0 +1,66 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) GenesisState {
+	return GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState sets default ratelimit genesis state without rate limits
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenRateLimits := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		key := string(RateLimitKey(rateLimit.ChannelID, rateLimit.Denom))
+		if seenRateLimits[key] {
+			return fmt.Errorf("duplicated rate limit for %s over %s", rateLimit.Denom, rateLimit.ChannelID)
+		}
+
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		seenRateLimits[key] = true
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, packet := range gs.PendingSendPackets {
+		key := string(PendingSendPacketKey(packet.ChannelID, packet.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicated pending send packet %d over %s", packet.Sequence, packet.ChannelID)
+		}
+
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		seenPackets[key] = true
+	}
+
+	return nil
+}
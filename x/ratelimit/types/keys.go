@@ -0,0 +1,65 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// ModuleName defines the ratelimit module name
+	ModuleName = "ratelimit"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the ratelimit persistent store
+const (
+	prefixRateLimit = iota + 1
+	prefixPendingSendPacket
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRateLimit         = []byte{prefixRateLimit}
+	KeyPrefixPendingSendPacket = []byte{prefixPendingSendPacket}
+)
+
+// RateLimitKey returns the store key of the rate limit of a denomination over
+// a channel. Channel identifiers can't contain slashes, so the key is
+// unambiguous for IBC denominations.
+func RateLimitKey(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", channelID, denom))
+}
+
+// PendingSendPacketChannelPrefix returns the store key prefix of the pending
+// send packets of a channel
+func PendingSendPacketChannelPrefix(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/", channelID))
+}
+
+// PendingSendPacketKey returns the store key of a pending send packet
+func PendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return append(PendingSendPacketChannelPrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
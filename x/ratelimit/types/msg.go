@@ -0,0 +1,133 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+
+	epochstypes "github.com/evmos/evmos/v12/x/epochs/types"
+)
+
+var (
+	_ sdk.Msg = &MsgAddRateLimit{}
+	_ sdk.Msg = &MsgUpdateRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgResetRateLimit{}
+)
+
+// GetSigners returns the expected signers for a MsgAddRateLimit message.
+func (m *MsgAddRateLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAddRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateRateLimit(m.Denom, m.ChannelID, m.EpochIdentifier, m.Quota)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateRateLimit message.
+func (m *MsgUpdateRateLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateRateLimit(m.Denom, m.ChannelID, m.EpochIdentifier, m.Quota)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveRateLimit message.
+func (m *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validatePath(m.Denom, m.ChannelID)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetRateLimit message.
+func (m *MsgResetRateLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgResetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validatePath(m.Denom, m.ChannelID)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// validatePath checks the denomination and channel identifier of a rate limit
+func validatePath(denom, channelID string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(channelID)
+}
+
+// validateRateLimit checks the fields of a rate limit set by governance
+func validateRateLimit(denom, channelID, epochIdentifier string, quota Quota) error {
+	if err := validatePath(denom, channelID); err != nil {
+		return err
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(epochIdentifier); err != nil {
+		return err
+	}
+
+	return quota.Validate()
+}
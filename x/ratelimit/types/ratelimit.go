@@ -0,0 +1,188 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// FlowDirection defines the direction of a transfer over a rate limited channel
+type FlowDirection int
+
+const (
+	// FlowOut is a transfer sent from this chain
+	FlowOut FlowDirection = iota
+	// FlowIn is a transfer received by this chain
+	FlowIn
+)
+
+// String returns the name of the flow direction
+func (d FlowDirection) String() string {
+	switch d {
+	case FlowOut:
+		return "send"
+	case FlowIn:
+		return "recv"
+	default:
+		return "unspecified"
+	}
+}
+
+var hundred = sdk.NewDec(100)
+
+// NewQuota returns an instance of Quota
+func NewQuota(maxPercentSend, maxPercentRecv sdk.Dec, maxAmountSend, maxAmountRecv sdk.Int) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		MaxAmountSend:  maxAmountSend,
+		MaxAmountRecv:  maxAmountRecv,
+	}
+}
+
+// Validate performs a stateless validation of the quota
+func (q Quota) Validate() error {
+	for _, percent := range []sdk.Dec{q.MaxPercentSend, q.MaxPercentRecv} {
+		if percent.IsNil() || percent.IsNegative() || percent.GT(hundred) {
+			return fmt.Errorf("quota percentage must be between 0 and 100, got %s", percent)
+		}
+	}
+
+	for _, amount := range []sdk.Int{q.MaxAmountSend, q.MaxAmountRecv} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("invalid quota amount: %s", amount)
+		}
+	}
+
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() &&
+		q.MaxAmountSend.IsZero() && q.MaxAmountRecv.IsZero() {
+		return fmt.Errorf("quota must limit at least one direction")
+	}
+
+	return nil
+}
+
+// HasPercentQuota returns true if the quota limits any direction by a
+// percentage of the channel value
+func (q Quota) HasPercentQuota() bool {
+	return q.MaxPercentSend.IsPositive() || q.MaxPercentRecv.IsPositive()
+}
+
+// Threshold returns the maximum net flow in the given direction for the given
+// channel value, taking the lowest enabled quota. The boolean return value is
+// false if the direction is not limited.
+func (q Quota) Threshold(direction FlowDirection, channelValue sdk.Int) (sdk.Int, bool) {
+	maxPercent, maxAmount := q.MaxPercentSend, q.MaxAmountSend
+	if direction == FlowIn {
+		maxPercent, maxAmount = q.MaxPercentRecv, q.MaxAmountRecv
+	}
+
+	threshold, limited := sdk.Int{}, false
+	if maxPercent.IsPositive() {
+		threshold = maxPercent.MulInt(channelValue).Quo(hundred).TruncateInt()
+		limited = true
+	}
+
+	if maxAmount.IsPositive() && (!limited || maxAmount.LT(threshold)) {
+		threshold = maxAmount
+		limited = true
+	}
+
+	return threshold, limited
+}
+
+// NewFlow returns an empty flow for the given channel value
+func NewFlow(channelValue sdk.Int) Flow {
+	return Flow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+	}
+}
+
+// Validate performs a stateless validation of the flow
+func (f Flow) Validate() error {
+	for _, amount := range []sdk.Int{f.Inflow, f.Outflow, f.ChannelValue} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("invalid flow amount: %s", amount)
+		}
+	}
+	return nil
+}
+
+// NetFlow returns the net amount transferred in the given direction. It is
+// negative if more was transferred in the opposite direction.
+func (f Flow) NetFlow(direction FlowDirection) sdk.Int {
+	if direction == FlowIn {
+		return f.Inflow.Sub(f.Outflow)
+	}
+	return f.Outflow.Sub(f.Inflow)
+}
+
+// Add adds the transferred amount to the flow of the given direction
+func (f *Flow) Add(direction FlowDirection, amount sdk.Int) {
+	if direction == FlowIn {
+		f.Inflow = f.Inflow.Add(amount)
+		return
+	}
+	f.Outflow = f.Outflow.Add(amount)
+}
+
+// UndoOutflow removes the amount of a failed transfer from the outflow
+func (f *Flow) UndoOutflow(amount sdk.Int) {
+	f.Outflow = sdk.MaxInt(f.Outflow.Sub(amount), sdk.ZeroInt())
+}
+
+// NewRateLimit returns an instance of RateLimit with an empty flow
+func NewRateLimit(denom, channelID, epochIdentifier string, quota Quota, channelValue sdk.Int) RateLimit {
+	return RateLimit{
+		Denom:           denom,
+		ChannelID:       channelID,
+		EpochIdentifier: epochIdentifier,
+		Quota:           quota,
+		Flow:            NewFlow(channelValue),
+	}
+}
+
+// Validate performs a stateless validation of the rate limit
+func (rl RateLimit) Validate() error {
+	if err := validateRateLimit(rl.Denom, rl.ChannelID, rl.EpochIdentifier, rl.Quota); err != nil {
+		return err
+	}
+
+	return rl.Flow.Validate()
+}
+
+// Validate performs a stateless validation of the pending send packet
+func (p PendingSendPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelID); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return fmt.Errorf("invalid pending packet amount: %s", p.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   Quota
+		expPass bool
+	}{
+		{"valid percent quota", NewQuota(sdk.NewDec(10), sdk.NewDec(20), sdk.ZeroInt(), sdk.ZeroInt()), true},
+		{"valid amount quota", NewQuota(sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewInt(100), sdk.ZeroInt()), true},
+		{"no direction limited", NewQuota(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()), false},
+		{"percent above 100", NewQuota(sdk.NewDec(101), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()), false},
+		{"negative percent", NewQuota(sdk.ZeroDec(), sdk.NewDec(-1), sdk.ZeroInt(), sdk.ZeroInt()), false},
+		{"negative amount", NewQuota(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroInt(), sdk.NewInt(-1)), false},
+		{"nil values", Quota{}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.quota.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestQuotaThreshold(t *testing.T) {
+	channelValue := sdk.NewInt(1000)
+
+	testCases := []struct {
+		name         string
+		quota        Quota
+		direction    FlowDirection
+		expLimited   bool
+		expThreshold sdk.Int
+	}{
+		{
+			"percent quota",
+			NewQuota(sdk.NewDecWithPrec(105, 1), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()),
+			FlowOut, true, sdk.NewInt(105),
+		},
+		{
+			"direction not limited",
+			NewQuota(sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()),
+			FlowIn, false, sdk.Int{},
+		},
+		{
+			"amount quota",
+			NewQuota(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroInt(), sdk.NewInt(50)),
+			FlowIn, true, sdk.NewInt(50),
+		},
+		{
+			"lowest of percent and amount quotas",
+			NewQuota(sdk.ZeroDec(), sdk.NewDec(10), sdk.ZeroInt(), sdk.NewInt(50)),
+			FlowIn, true, sdk.NewInt(50),
+		},
+		{
+			"percent lower than amount quota",
+			NewQuota(sdk.NewDec(1), sdk.ZeroDec(), sdk.NewInt(50), sdk.ZeroInt()),
+			FlowOut, true, sdk.NewInt(10),
+		},
+	}
+
+	for _, tc := range testCases {
+		threshold, limited := tc.quota.Threshold(tc.direction, channelValue)
+		require.Equal(t, tc.expLimited, limited, tc.name)
+		if tc.expLimited {
+			require.Equal(t, tc.expThreshold, threshold, tc.name)
+		}
+	}
+}
+
+func TestFlow(t *testing.T) {
+	flow := NewFlow(sdk.NewInt(1000))
+	flow.Add(FlowOut, sdk.NewInt(30))
+	flow.Add(FlowIn, sdk.NewInt(10))
+
+	require.Equal(t, sdk.NewInt(20), flow.NetFlow(FlowOut))
+	require.Equal(t, sdk.NewInt(-20), flow.NetFlow(FlowIn))
+
+	flow.UndoOutflow(sdk.NewInt(50))
+	require.True(t, flow.Outflow.IsZero())
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	quota := NewQuota(sdk.NewDec(10), sdk.NewDec(10), sdk.ZeroInt(), sdk.ZeroInt())
+	rateLimit := NewRateLimit("aevmos", "channel-0", "day", quota, sdk.NewInt(1000))
+	packet := PendingSendPacket{ChannelID: "channel-0", Sequence: 1, Denom: "aevmos", Amount: sdk.NewInt(10)}
+
+	testCases := []struct {
+		name    string
+		genesis GenesisState
+		expPass bool
+	}{
+		{"default genesis", *DefaultGenesisState(), true},
+		{"valid genesis", NewGenesisState([]RateLimit{rateLimit}, []PendingSendPacket{packet}), true},
+		{"duplicated rate limit", NewGenesisState([]RateLimit{rateLimit, rateLimit}, nil), false},
+		{"duplicated pending packet", NewGenesisState(nil, []PendingSendPacket{packet, packet}), false},
+		{
+			"invalid channel",
+			NewGenesisState([]RateLimit{NewRateLimit("aevmos", "", "day", quota, sdk.NewInt(1000))}, nil),
+			false,
+		},
+		{
+			"invalid epoch identifier",
+			NewGenesisState([]RateLimit{NewRateLimit("aevmos", "channel-0", "", quota, sdk.NewInt(1000))}, nil),
+			false,
+		},
+		{
+			"invalid pending packet amount",
+			NewGenesisState(nil, []PendingSendPacket{{ChannelID: "channel-0", Sequence: 1, Denom: "aevmos", Amount: sdk.ZeroInt()}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
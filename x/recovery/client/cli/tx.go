@@ -0,0 +1,110 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	"github.com/evmos/evmos/v12/x/recovery/types"
+)
+
+// NewTxCmd returns a root CLI command handler for recovery transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "recovery subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRecoverFundsCmd(),
+	)
+	return txCmd
+}
+
+// NewRecoverFundsCmd returns a CLI command handler for recovering the funds of
+// a secp256k1 account. The recovery proof is signed with the account key from
+// the keyring, while the transaction is signed and paid by the --from account.
+func NewRecoverFundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-funds ACCOUNT_KEY RECEIVER [SOURCE_CHANNEL]",
+		Short: "Recover the funds of a secp256k1 account to a local eth_secp256k1 account or, if the source channel [optional] is provided, to an account on the counterparty chain.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			record, err := cliCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+
+			account, err := record.GetAddress()
+			if err != nil {
+				return err
+			}
+
+			accountNumber, sequence, err := cliCtx.AccountRetriever.GetAccountNumberSequence(cliCtx, account)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRecoverFunds{
+				Sender:   cliCtx.GetFromAddress().String(),
+				Account:  account.String(),
+				Receiver: args[1],
+			}
+
+			if len(args) == 3 {
+				msg.SourceChannel = args[2]
+			}
+
+			proof := types.NewRecoveryProof(cliCtx.ChainID, accountNumber, sequence, msg)
+			signature, pubKey, err := cliCtx.Keyring.Sign(args[0], proof.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
+			if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+				return fmt.Errorf("key %s is not a secp256k1 key", args[0])
+			}
+
+			msg.PubKey = pubKey.Bytes()
+			msg.Signature = signature
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -49,6 +49,7 @@ type Keeper struct {
 	channelKeeper  types.ChannelKeeper
 	transferKeeper types.TransferKeeper
 	claimsKeeper   types.ClaimsKeeper
+	erc20Keeper    types.Erc20Keeper
 }
 
 // NewKeeper returns keeper
@@ -61,6 +62,7 @@ func NewKeeper(
 	ck types.ChannelKeeper,
 	tk types.TransferKeeper,
 	claimsKeeper types.ClaimsKeeper,
+	erc20Keeper types.Erc20Keeper,
 ) *Keeper {
 	// ensure gov module account is set and is not nil
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -75,6 +77,7 @@ func NewKeeper(
 		channelKeeper:  ck,
 		transferKeeper: tk,
 		claimsKeeper:   claimsKeeper,
+		erc20Keeper:    erc20Keeper,
 	}
 }
 
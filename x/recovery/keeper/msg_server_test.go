@@ -1,9 +1,17 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/utils"
 	"github.com/evmos/evmos/v12/x/recovery/types"
+	vestingtypes "github.com/evmos/evmos/v12/x/vesting/types"
 )
 
 func (suite *KeeperTestSuite) TestUpdateParams() {
@@ -39,3 +47,156 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRecoverFunds() {
+	var (
+		privKey  *secp256k1.PrivKey
+		account  sdk.AccAddress
+		receiver sdk.AccAddress
+		msg      *types.MsgRecoverFunds
+	)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000), sdk.NewInt64Coin(ibcAtomDenom, 500))
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	sign := func() {
+		acc := suite.app.AccountKeeper.GetAccount(suite.ctx, account)
+		proof := types.NewRecoveryProof(suite.ctx.ChainID(), acc.GetAccountNumber(), acc.GetSequence(), msg)
+		signature, err := privKey.Sign(proof.GetSignBytes())
+		suite.Require().NoError(err)
+		msg.Signature = signature
+	}
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expPass      bool
+		expRecovered sdk.Coins
+		expLocked    sdk.Coins
+	}{
+		{
+			"fail - recovery disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableRecovery = false
+				err := suite.app.RecoveryKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+				sign()
+			},
+			false, nil, nil,
+		},
+		{
+			"fail - account not found",
+			func() {
+				privKey = secp256k1.GenPrivKey()
+				account = sdk.AccAddress(privKey.PubKey().Address())
+				msg.Account = account.String()
+				msg.PubKey = privKey.PubKey().Bytes()
+				msg.Signature = make([]byte, 64)
+			},
+			false, nil, nil,
+		},
+		{
+			"fail - signed by another key",
+			func() {
+				msg.Signature, _ = secp256k1.GenPrivKey().Sign([]byte("proof"))
+			},
+			false, nil, nil,
+		},
+		{
+			"fail - signed for another receiver",
+			func() {
+				sign()
+				msg.Receiver = sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+			},
+			false, nil, nil,
+		},
+		{
+			"fail - receiver is a secp256k1 account",
+			func() {
+				receiverAcc := suite.app.AccountKeeper.GetAccount(suite.ctx, receiver)
+				err := receiverAcc.SetPubKey(secp256k1.GenPrivKey().PubKey())
+				suite.Require().NoError(err)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, receiverAcc)
+				sign()
+			},
+			false, nil, nil,
+		},
+		{
+			"fail - channel not found",
+			func() {
+				msg.Receiver = "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueuhnecd2"
+				msg.SourceChannel = "channel-9"
+				sign()
+			},
+			false, nil, nil,
+		},
+		{
+			"pass - recover to local account",
+			func() {
+				sign()
+			},
+			true, coins, sdk.Coins{},
+		},
+		{
+			"pass - recover spendable coins of vesting account",
+			func() {
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, account)
+				vestingCoins := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 400))
+				vestingPeriods := sdkvesting.Periods{{Length: 3600, Amount: vestingCoins}}
+				vestingAcc := vestingtypes.NewClawbackVestingAccount(
+					authtypes.NewBaseAccount(account, nil, acc.GetAccountNumber(), 0), sender, vestingCoins,
+					suite.ctx.BlockTime(), nil, vestingPeriods,
+				)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, vestingAcc)
+				sign()
+			},
+			true,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 600), sdk.NewInt64Coin(ibcAtomDenom, 500)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 400)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			privKey = secp256k1.GenPrivKey()
+			account = sdk.AccAddress(privKey.PubKey().Address())
+			receiver = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, account, coins)
+			suite.Require().NoError(err)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, receiver))
+
+			msg = &types.MsgRecoverFunds{
+				Sender:   sender.String(),
+				Account:  account.String(),
+				PubKey:   privKey.PubKey().Bytes(),
+				Receiver: receiver.String(),
+			}
+
+			tc.malleate()
+
+			res, err := suite.app.RecoveryKeeper.RecoverFunds(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRecovered, res.Recovered)
+			suite.Require().Equal(tc.expLocked, res.Locked)
+			suite.Require().Equal(tc.expRecovered, suite.app.BankKeeper.GetAllBalances(suite.ctx, receiver))
+			suite.Require().Equal(tc.expLocked, suite.app.BankKeeper.GetAllBalances(suite.ctx, account))
+
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, account)
+			suite.Require().Equal(uint64(1), acc.GetSequence())
+			suite.Require().True(privKey.PubKey().Equals(acc.GetPubKey()))
+
+			// the proof can't be replayed
+			_, err = suite.app.RecoveryKeeper.RecoverFunds(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().ErrorIs(err, types.ErrInvalidProof)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/contracts"
+	"github.com/evmos/evmos/v12/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v12/utils"
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
+	"github.com/evmos/evmos/v12/x/recovery/types"
+)
+
+// RecoverFunds implements the gRPC MsgServer interface. It recovers the funds
+// of a secp256k1 account, which can't sign transactions on Evmos, after
+// verifying the signature of the account key over the recovery proof. The
+// ERC20 balances of the account are converted to coins first. Only the
+// spendable coins are recovered, so that the locked coins of vesting accounts
+// remain in the account until they vest. The funds are sent to a local
+// eth_secp256k1 account or transferred over the given IBC channel.
+func (k *Keeper) RecoverFunds(goCtx context.Context, msg *types.MsgRecoverFunds) (*types.MsgRecoverFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableRecovery {
+		return nil, types.ErrRecoveryDisabled
+	}
+
+	// error checked during msg validation
+	account := sdk.MustAccAddressFromBech32(msg.Account)
+
+	if err := k.verifyRecoveryProof(ctx, account, msg); err != nil {
+		return nil, err
+	}
+
+	if msg.SourceChannel == "" {
+		if err := k.validateLocalReceiver(ctx, msg.Receiver); err != nil {
+			return nil, err
+		}
+	} else if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, msg.SourceChannel); !found {
+		return nil, errorsmod.Wrapf(
+			channeltypes.ErrChannelNotFound,
+			"port ID %s, channel ID %s", transfertypes.PortID, msg.SourceChannel,
+		)
+	}
+
+	if err := k.convertERC20Balances(ctx, account); err != nil {
+		return nil, err
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, account)
+	locked := k.bankKeeper.LockedCoins(ctx, account)
+
+	var recovered sdk.Coins
+	if msg.SourceChannel == "" {
+		// error checked during msg validation
+		receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+		if err := k.bankKeeper.SendCoins(ctx, account, receiver, spendable); err != nil {
+			return nil, err
+		}
+		recovered = spendable
+	} else {
+		var err error
+		recovered, err = k.transferRecoveredCoins(ctx, account, spendable, msg.Receiver, msg.SourceChannel, params)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecoverFunds,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Account),
+			sdk.NewAttribute(transfertypes.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeySourceChannel, msg.SourceChannel),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, recovered.String()),
+			sdk.NewAttribute(types.AttributeKeyLocked, locked.String()),
+		),
+	)
+
+	return &types.MsgRecoverFundsResponse{
+		Recovered: recovered,
+		Locked:    locked,
+	}, nil
+}
+
+// verifyRecoveryProof checks that the account holds stuck funds and that the
+// signature of the recovery proof was made by the account key. It sets the
+// public key of the account and increments its sequence, so that the proof
+// can't be replayed.
+func (k Keeper) verifyRecoveryProof(ctx sdk.Context, address sdk.AccAddress, msg *types.MsgRecoverFunds) error {
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if account == nil {
+		return errorsmod.Wrapf(types.ErrInvalidAccount, "account %s not found", msg.Account)
+	}
+
+	if _, isModuleAccount := account.(authtypes.ModuleAccountI); isModuleAccount {
+		return errorsmod.Wrapf(types.ErrInvalidAccount, "cannot recover module account %s", msg.Account)
+	}
+
+	pubKey := msg.GetSecp256k1PubKey()
+
+	if accountPubKey := account.GetPubKey(); accountPubKey != nil {
+		// funds are not stuck for supported keys
+		if utils.IsSupportedKey(accountPubKey) {
+			return errorsmod.Wrapf(types.ErrInvalidAccount, "account %s has a supported key", msg.Account)
+		}
+
+		if !accountPubKey.Equals(pubKey) {
+			return errorsmod.Wrapf(types.ErrInvalidProof, "public key doesn't match the key of account %s", msg.Account)
+		}
+	}
+
+	proof := types.NewRecoveryProof(ctx.ChainID(), account.GetAccountNumber(), account.GetSequence(), msg)
+	if !pubKey.VerifySignature(proof.GetSignBytes(), msg.Signature) {
+		return errorsmod.Wrapf(types.ErrInvalidProof, "signature verification failed for account %s", msg.Account)
+	}
+
+	if account.GetPubKey() == nil {
+		if err := account.SetPubKey(pubKey); err != nil {
+			return err
+		}
+	}
+
+	if err := account.SetSequence(account.GetSequence() + 1); err != nil {
+		return err
+	}
+
+	k.accountKeeper.SetAccount(ctx, account)
+	return nil
+}
+
+// validateLocalReceiver checks that the local receiver of the recovered funds
+// can't be stuck itself, i.e. that it's not blocked and that it is either a
+// new account or an eth_secp256k1 account.
+func (k Keeper) validateLocalReceiver(ctx sdk.Context, receiver string) error {
+	// error checked during msg validation
+	address := sdk.MustAccAddressFromBech32(receiver)
+
+	if k.bankKeeper.BlockedAddr(address) {
+		return errorsmod.Wrapf(types.ErrBlockedAddress, "receiver %s is in the deny list", receiver)
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if account == nil {
+		return nil
+	}
+
+	if _, isModuleAccount := account.(authtypes.ModuleAccountI); isModuleAccount {
+		return errorsmod.Wrapf(types.ErrInvalidReceiver, "receiver %s is a module account", receiver)
+	}
+
+	if pubKey := account.GetPubKey(); pubKey != nil {
+		if _, isEthAccount := pubKey.(*ethsecp256k1.PubKey); !isEthAccount {
+			return errorsmod.Wrapf(types.ErrInvalidReceiver, "receiver %s is not an eth_secp256k1 account", receiver)
+		}
+	}
+
+	return nil
+}
+
+// convertERC20Balances converts the balances of the account for all the
+// enabled token pairs to coins, as the account can't transfer them itself.
+func (k Keeper) convertERC20Balances(ctx sdk.Context, account sdk.AccAddress) error {
+	if !k.erc20Keeper.IsERC20Enabled(ctx) {
+		return nil
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	holder := common.BytesToAddress(account)
+
+	for _, pair := range k.erc20Keeper.GetTokenPairs(ctx) {
+		if !pair.Enabled {
+			continue
+		}
+
+		balance := k.erc20Keeper.BalanceOf(ctx, erc20, pair.GetERC20Contract(), holder)
+		if balance == nil || balance.Sign() <= 0 {
+			continue
+		}
+
+		msg := &erc20types.MsgConvertERC20{
+			ContractAddress: pair.Erc20Address,
+			Amount:          math.NewIntFromBigInt(balance),
+			Receiver:        account.String(),
+			Sender:          holder.Hex(),
+		}
+
+		if _, err := k.erc20Keeper.ConvertERC20(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return errorsmod.Wrapf(err, "failed to convert %s ERC20 balance", pair.Denom)
+		}
+	}
+
+	return nil
+}
+
+// transferRecoveredCoins transfers the coins to the receiver over the given
+// channel. As in the IBC recovery, only native coins and the IBC vouchers
+// received over the channel are transferred, the others remain in the account.
+func (k Keeper) transferRecoveredCoins(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	coins sdk.Coins,
+	receiver, channelID string,
+	params types.Params,
+) (sdk.Coins, error) {
+	// NOTE: Don't use the consensus state because it may become unreliable if updates slow down
+	timeout := uint64(ctx.BlockTime().Add(params.PacketTimeoutDuration).UnixNano())
+	recovered := sdk.Coins{}
+
+	for _, coin := range coins {
+		if strings.HasPrefix(coin.Denom, "ibc/") {
+			destPort, destChannel, err := k.GetIBCDenomDestinationIdentifiers(ctx, coin.Denom, receiver)
+			if err != nil || destPort != transfertypes.PortID || destChannel != channelID {
+				continue
+			}
+		}
+
+		packetTransfer := &transfertypes.MsgTransfer{
+			SourcePort:       transfertypes.PortID,
+			SourceChannel:    channelID,
+			Token:            coin,
+			Sender:           account.String(),
+			Receiver:         receiver,
+			TimeoutHeight:    clienttypes.ZeroHeight(), // timeout height disabled
+			TimeoutTimestamp: timeout,
+			Memo:             "",
+		}
+
+		if _, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), packetTransfer); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to transfer %s over channel %s", coin, channelID)
+		}
+
+		recovered = recovered.Add(coin)
+	}
+
+	return recovered, nil
+}
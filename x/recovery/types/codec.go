@@ -36,6 +36,7 @@ var (
 const (
 	// Amino names
 	updateParamsName = "evmos/recovery/MsgUpdateParams"
+	recoverFundsName = "evmos/recovery/MsgRecoverFunds"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -49,6 +50,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRecoverFunds{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -56,4 +58,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRecoverFunds{}, recoverFundsName, nil)
 }
@@ -22,5 +22,9 @@ import (
 
 // errors
 var (
-	ErrBlockedAddress = errorsmod.Register(ModuleName, 2, "blocked address")
+	ErrBlockedAddress   = errorsmod.Register(ModuleName, 2, "blocked address")
+	ErrRecoveryDisabled = errorsmod.Register(ModuleName, 3, "recovery is disabled")
+	ErrInvalidProof     = errorsmod.Register(ModuleName, 4, "invalid recovery proof")
+	ErrInvalidAccount   = errorsmod.Register(ModuleName, 5, "invalid recovery account")
+	ErrInvalidReceiver  = errorsmod.Register(ModuleName, 6, "invalid recovery receiver")
 )
@@ -18,5 +18,10 @@ package types
 
 // recovery events
 const (
-	EventTypeRecovery = "recovery"
+	EventTypeRecovery     = "recovery"
+	EventTypeRecoverFunds = "recover_funds"
+
+	AttributeKeyAccount       = "account"
+	AttributeKeySourceChannel = "source_channel"
+	AttributeKeyLocked        = "locked"
 )
@@ -18,6 +18,10 @@ package types
 
 import (
 	context "context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
@@ -29,6 +33,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 
 	claimstypes "github.com/evmos/evmos/v12/x/claims/types"
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
 )
 
 // BankKeeper defines the banking keeper that must be fulfilled when
@@ -36,11 +41,15 @@ import (
 type BankKeeper interface {
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	BlockedAddr(addr sdk.AccAddress) bool
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 // AccountKeeper defines the expected account keeper
 type AccountKeeper interface {
 	GetAccount(sdk.Context, sdk.AccAddress) authtypes.AccountI
+	SetAccount(sdk.Context, authtypes.AccountI)
 }
 
 // TransferKeeper defines the expected IBC transfer keeper.
@@ -59,6 +68,15 @@ type ClaimsKeeper interface {
 	GetParams(ctx sdk.Context) claimstypes.Params
 }
 
+// Erc20Keeper defines the expected ERC20 keeper used to convert the ERC20
+// balances of a recovered account
+type Erc20Keeper interface {
+	IsERC20Enabled(ctx sdk.Context) bool
+	GetTokenPairs(ctx sdk.Context) []erc20types.TokenPair
+	BalanceOf(ctx sdk.Context, abi abi.ABI, contract, account common.Address) *big.Int
+	ConvertERC20(goCtx context.Context, msg *erc20types.MsgConvertERC20) (*erc20types.MsgConvertERC20Response, error)
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -18,10 +18,21 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRecoverFunds{}
+)
+
+const (
+	// signatureLength is the length of a secp256k1 signature in [R || S] format
+	signatureLength = 64
+)
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -42,3 +53,64 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgRecoverFunds message.
+func (m *MsgRecoverFunds) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRecoverFunds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(m.Account)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid account address")
+	}
+
+	if len(m.PubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "expected %d bytes, got %d", secp256k1.PubKeySize, len(m.PubKey))
+	}
+
+	if !account.Equals(sdk.AccAddress(m.GetSecp256k1PubKey().Address())) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "public key doesn't match account %s", m.Account)
+	}
+
+	if len(m.Signature) != signatureLength {
+		return errorsmod.Wrapf(ErrInvalidProof, "expected %d signature bytes, got %d", signatureLength, len(m.Signature))
+	}
+
+	if m.SourceChannel == "" {
+		receiver, err := sdk.AccAddressFromBech32(m.Receiver)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid receiver address")
+		}
+		if receiver.Equals(account) {
+			return errorsmod.Wrap(ErrInvalidReceiver, "receiver cannot be the recovered account")
+		}
+		return nil
+	}
+
+	if err := host.ChannelIdentifierValidator(m.SourceChannel); err != nil {
+		return errorsmod.Wrapf(err, "invalid source channel %s", m.SourceChannel)
+	}
+
+	if m.Receiver == "" {
+		return errorsmod.Wrap(ErrInvalidReceiver, "receiver cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRecoverFunds) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSecp256k1PubKey returns the secp256k1 public key of the recovered account
+func (m MsgRecoverFunds) GetSecp256k1PubKey() *secp256k1.PubKey {
+	return &secp256k1.PubKey{Key: m.PubKey}
+}
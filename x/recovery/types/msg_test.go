@@ -0,0 +1,88 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRecoverFundsValidateBasic(t *testing.T) {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	account := sdk.AccAddress(pubKey.Address()).String()
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	signature := make([]byte, 64)
+
+	testCases := []struct {
+		name     string
+		msg      MsgRecoverFunds
+		expError bool
+	}{
+		{
+			"invalid sender",
+			MsgRecoverFunds{Sender: "evmos1", Account: account, PubKey: pubKey.Bytes(), Signature: signature, Receiver: receiver},
+			true,
+		},
+		{
+			"invalid account",
+			MsgRecoverFunds{Sender: sender, Account: "evmos1", PubKey: pubKey.Bytes(), Signature: signature, Receiver: receiver},
+			true,
+		},
+		{
+			"invalid public key",
+			MsgRecoverFunds{Sender: sender, Account: account, PubKey: []byte{1}, Signature: signature, Receiver: receiver},
+			true,
+		},
+		{
+			"public key doesn't match account",
+			MsgRecoverFunds{Sender: sender, Account: receiver, PubKey: pubKey.Bytes(), Signature: signature, Receiver: receiver},
+			true,
+		},
+		{
+			"invalid signature",
+			MsgRecoverFunds{Sender: sender, Account: account, PubKey: pubKey.Bytes(), Signature: []byte{1}, Receiver: receiver},
+			true,
+		},
+		{
+			"invalid local receiver",
+			MsgRecoverFunds{Sender: sender, Account: account, PubKey: pubKey.Bytes(), Signature: signature, Receiver: "cosmos1"},
+			true,
+		},
+		{
+			"receiver is the account",
+			MsgRecoverFunds{Sender: sender, Account: account, PubKey: pubKey.Bytes(), Signature: signature, Receiver: account},
+			true,
+		},
+		{
+			"invalid source channel",
+			MsgRecoverFunds{Sender: sender, Account: account, PubKey: pubKey.Bytes(), Signature: signature, Receiver: "cosmos1", SourceChannel: "c"},
+			true,
+		},
+		{
+			"empty IBC receiver",
+			MsgRecoverFunds{Sender: sender, Account: account, PubKey: pubKey.Bytes(), Signature: signature, SourceChannel: "channel-0"},
+			true,
+		},
+		{
+			"valid local recovery",
+			MsgRecoverFunds{Sender: sender, Account: account, PubKey: pubKey.Bytes(), Signature: signature, Receiver: receiver},
+			false,
+		},
+		{
+			"valid IBC recovery",
+			MsgRecoverFunds{Sender: sender, Account: account, PubKey: pubKey.Bytes(), Signature: signature, Receiver: "cosmos1", SourceChannel: "channel-0"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
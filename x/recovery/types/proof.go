@@ -0,0 +1,58 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecoveryProof defines the document signed by the key of a secp256k1 account
+// to authorize the recovery of its funds to the given destination. The account
+// sequence is incremented on recovery, so that a proof can't be replayed.
+type RecoveryProof struct {
+	ChainID       string `json:"chain_id"`
+	AccountNumber uint64 `json:"account_number,string"`
+	Sequence      uint64 `json:"sequence,string"`
+	Account       string `json:"account"`
+	Receiver      string `json:"receiver"`
+	SourceChannel string `json:"source_channel"`
+}
+
+// NewRecoveryProof returns the recovery proof of the account with the given
+// account number and sequence for the destination of the MsgRecoverFunds
+func NewRecoveryProof(chainID string, accountNumber, sequence uint64, msg *MsgRecoverFunds) RecoveryProof {
+	return RecoveryProof{
+		ChainID:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		Account:       msg.Account,
+		Receiver:      msg.Receiver,
+		SourceChannel: msg.SourceChannel,
+	}
+}
+
+// GetSignBytes returns the sorted JSON encoding of the proof that is signed by
+// the account key
+func (p RecoveryProof) GetSignBytes() []byte {
+	bz, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -124,35 +126,203 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRecoverFunds defines a Msg to recover the funds of a secp256k1 account,
+// which can't sign transactions on Evmos, to a local eth_secp256k1 account or
+// to an account on a counterparty chain.
+type MsgRecoverFunds struct {
+	// sender is the address that signs the transaction and pays its fees
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account is the address of the secp256k1 account holding the stuck funds
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// pub_key is the compressed secp256k1 public key of the account
+	PubKey []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signature is the signature of the recovery proof by the account key
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// receiver is the address that receives the recovered funds. It is a local
+	// eth_secp256k1 account if source_channel is empty, and an account on the
+	// counterparty chain otherwise
+	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// source_channel is the channel used to transfer the recovered funds. The
+	// funds are recovered locally if empty
+	SourceChannel string `protobuf:"bytes,6,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+}
+
+func (m *MsgRecoverFunds) Reset()         { *m = MsgRecoverFunds{} }
+func (m *MsgRecoverFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverFunds) ProtoMessage()    {}
+func (*MsgRecoverFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d25d0e60b916986f, []int{2}
+}
+func (m *MsgRecoverFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverFunds.Merge(m, src)
+}
+func (m *MsgRecoverFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverFunds proto.InternalMessageInfo
+
+func (m *MsgRecoverFunds) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRecoverFunds) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MsgRecoverFunds) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *MsgRecoverFunds) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *MsgRecoverFunds) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgRecoverFunds) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+// MsgRecoverFundsResponse defines the MsgRecoverFunds response type
+type MsgRecoverFundsResponse struct {
+	// recovered is the amount of coins recovered from the account
+	Recovered github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=recovered,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recovered"`
+	// locked is the amount of vesting coins that remain locked in the account
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+}
+
+func (m *MsgRecoverFundsResponse) Reset()         { *m = MsgRecoverFundsResponse{} }
+func (m *MsgRecoverFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverFundsResponse) ProtoMessage()    {}
+func (*MsgRecoverFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d25d0e60b916986f, []int{3}
+}
+func (m *MsgRecoverFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverFundsResponse.Merge(m, src)
+}
+func (m *MsgRecoverFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverFundsResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverFundsResponse) GetRecovered() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Recovered
+	}
+	return nil
+}
+
+func (m *MsgRecoverFundsResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.recovery.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.recovery.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRecoverFunds)(nil), "evmos.recovery.v1.MsgRecoverFunds")
+	proto.RegisterType((*MsgRecoverFundsResponse)(nil), "evmos.recovery.v1.MsgRecoverFundsResponse")
 }
 
 func init() { proto.RegisterFile("evmos/recovery/v1/tx.proto", fileDescriptor_d25d0e60b916986f) }
 
 var fileDescriptor_d25d0e60b916986f = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x2f, 0x4a, 0x4d, 0xce, 0x2f, 0x4b, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xa9,
-	0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0xcb, 0xe9, 0xc1, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x41, 0xea, 0x73, 0x8b, 0xd3, 0x41, 0x4a, 0x73, 0x8b, 0xd3, 0x21,
-	0x6a, 0xa5, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x92, 0xc7, 0xb4, 0x22,
-	0x3d, 0x35, 0x2f, 0xb5, 0x38, 0x13, 0xa6, 0x40, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xa2, 0x11, 0xc4,
-	0x82, 0x88, 0x2a, 0x4d, 0x62, 0xe4, 0xe2, 0xf7, 0x2d, 0x4e, 0x0f, 0x2d, 0x48, 0x49, 0x2c, 0x49,
-	0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x16, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f,
-	0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04,
-	0x6a, 0x9f, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10,
-	0x42, 0xa9, 0x90, 0x39, 0x17, 0x5b, 0x01, 0xd8, 0x04, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x49, 0x3d, 0x0c, 0xaf, 0xe9, 0x41, 0xac, 0x70, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa,
-	0xdc, 0x8a, 0xaf, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0x41, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x6e, 0x0a,
-	0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x35, 0x4a, 0xe5, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a,
-	0xe3, 0xe2, 0x41, 0x71, 0xb2, 0x12, 0x16, 0xab, 0xd0, 0x8c, 0x90, 0xd2, 0x22, 0xac, 0x06, 0x66,
-	0x8d, 0x93, 0xeb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa7, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x82, 0x1c, 0x42, 0x96, 0x19, 0x1a,
-	0xe9, 0x57, 0x20, 0x82, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xc8, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x13, 0xfc, 0x17, 0x00, 0x02, 0x00, 0x00,
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xb6, 0x75, 0x6b, 0xa6, 0xb1, 0xe2, 0x50, 0xc8, 0x66, 0x91, 0x4d, 0x08, 0x08, 0x21,
+	0xd2, 0xdd, 0x26, 0x82, 0x42, 0x6f, 0xa6, 0xe8, 0x45, 0x0a, 0xb2, 0xe2, 0xc5, 0x43, 0xc3, 0xee,
+	0xec, 0x63, 0xb3, 0xa4, 0x99, 0x59, 0x66, 0x66, 0x97, 0xe6, 0xea, 0x2f, 0x10, 0x7f, 0x86, 0x27,
+	0x0f, 0xfe, 0x05, 0xa1, 0xc7, 0xe2, 0xc9, 0x93, 0x4a, 0x72, 0x10, 0xff, 0x84, 0xc8, 0xee, 0x4c,
+	0x9a, 0x36, 0x55, 0x72, 0xf1, 0x92, 0xec, 0xbc, 0xef, 0x7b, 0xdf, 0x7b, 0xfb, 0xed, 0x37, 0xc8,
+	0x86, 0x7c, 0xc2, 0x84, 0xc7, 0x81, 0xb0, 0x1c, 0xf8, 0xd4, 0xcb, 0x7b, 0x9e, 0x3c, 0x73, 0x53,
+	0xce, 0x24, 0xc3, 0xf7, 0x4a, 0xcc, 0x5d, 0x60, 0x6e, 0xde, 0xb3, 0x1d, 0xc2, 0x44, 0xc1, 0x0f,
+	0x03, 0x01, 0x5e, 0xde, 0x0b, 0x41, 0x06, 0x3d, 0x8f, 0xb0, 0x84, 0xaa, 0x16, 0xbb, 0xae, 0xf1,
+	0x89, 0x88, 0x0b, 0xa9, 0x89, 0x88, 0x35, 0xd0, 0x50, 0xc0, 0xb0, 0x3c, 0x79, 0xea, 0xa0, 0xa1,
+	0xe6, 0xcd, 0x15, 0x62, 0xa0, 0x20, 0x92, 0x05, 0x61, 0x2f, 0x66, 0x31, 0x53, 0x8d, 0xc5, 0x93,
+	0xaa, 0xb6, 0xdf, 0x1b, 0xe8, 0xee, 0xb1, 0x88, 0x5f, 0xa7, 0x51, 0x20, 0xe1, 0x65, 0xc0, 0x83,
+	0x89, 0xc0, 0x8f, 0x51, 0x35, 0xc8, 0xe4, 0x88, 0xf1, 0x44, 0x4e, 0x2d, 0xa3, 0x65, 0x74, 0xaa,
+	0x03, 0xeb, 0xcb, 0xa7, 0xfd, 0x3d, 0x3d, 0xef, 0x69, 0x14, 0x71, 0x10, 0xe2, 0x95, 0xe4, 0x09,
+	0x8d, 0xfd, 0x25, 0x15, 0x3f, 0x41, 0x66, 0x5a, 0x2a, 0x58, 0x1b, 0x2d, 0xa3, 0xb3, 0xd3, 0x6f,
+	0xb8, 0x37, 0x5e, 0xdd, 0x55, 0x23, 0x06, 0x5b, 0xe7, 0xdf, 0x9a, 0x15, 0x5f, 0xd3, 0x0f, 0x77,
+	0xdf, 0xfe, 0xfc, 0xd8, 0x5d, 0x0a, 0xb5, 0x1b, 0xa8, 0xbe, 0xb2, 0x93, 0x0f, 0x22, 0x65, 0x54,
+	0x40, 0xfb, 0xb7, 0xda, 0xd7, 0x57, 0x92, 0xcf, 0x33, 0x1a, 0x09, 0x7c, 0x80, 0x4c, 0x01, 0x34,
+	0x02, 0xbe, 0x76, 0x59, 0xcd, 0xc3, 0x7d, 0xb4, 0x1d, 0x10, 0xc2, 0x32, 0x2a, 0xad, 0x8d, 0x35,
+	0x2d, 0x0b, 0x22, 0xae, 0xa3, 0xed, 0x34, 0x0b, 0x87, 0x63, 0x98, 0x5a, 0x9b, 0x2d, 0xa3, 0x53,
+	0xf3, 0xcd, 0x34, 0x0b, 0x5f, 0xc0, 0x14, 0xdf, 0x47, 0x55, 0x91, 0xc4, 0x34, 0x90, 0x19, 0x07,
+	0x6b, 0xab, 0x84, 0x96, 0x05, 0x6c, 0xa3, 0xdb, 0x1c, 0x08, 0x24, 0x39, 0x70, 0xeb, 0x56, 0x31,
+	0xcb, 0xbf, 0x3c, 0xe3, 0x07, 0x68, 0x57, 0xb0, 0x8c, 0x13, 0x18, 0x92, 0x51, 0x40, 0x29, 0x9c,
+	0x5a, 0x66, 0xc9, 0xb8, 0xa3, 0xaa, 0x47, 0xaa, 0x78, 0xb8, 0x53, 0xd8, 0xa3, 0x57, 0x6f, 0xff,
+	0x32, 0x50, 0x7d, 0xc5, 0x80, 0x85, 0x39, 0x38, 0x41, 0x55, 0xed, 0x35, 0x44, 0x96, 0xd1, 0xda,
+	0x2c, 0xbf, 0x81, 0x7e, 0xab, 0x22, 0x6b, 0xae, 0xce, 0x9a, 0x7b, 0xc4, 0x12, 0x3a, 0x38, 0x28,
+	0xbe, 0xc1, 0x87, 0xef, 0xcd, 0x4e, 0x9c, 0xc8, 0x51, 0x16, 0xba, 0x84, 0x4d, 0x74, 0xa4, 0xf4,
+	0xdf, 0xbe, 0x88, 0xc6, 0x9e, 0x9c, 0xa6, 0x20, 0xca, 0x06, 0xe1, 0x2f, 0xd5, 0x31, 0x41, 0xe6,
+	0x29, 0x23, 0x63, 0x88, 0xac, 0x8d, 0xff, 0x3f, 0x47, 0x4b, 0xf7, 0x3f, 0x1b, 0x68, 0xf3, 0x58,
+	0xc4, 0xf8, 0x04, 0xd5, 0xae, 0x05, 0xb4, 0xfd, 0x97, 0x60, 0xad, 0x04, 0xc6, 0xee, 0xae, 0xe7,
+	0x5c, 0xfa, 0x76, 0x82, 0x6a, 0xd7, 0x02, 0xf5, 0x0f, 0xfd, 0xab, 0x1c, 0xbb, 0xbb, 0x9e, 0xb3,
+	0xd0, 0x1f, 0x3c, 0x3b, 0x9f, 0x39, 0xc6, 0xc5, 0xcc, 0x31, 0x7e, 0xcc, 0x1c, 0xe3, 0xdd, 0xdc,
+	0xa9, 0x5c, 0xcc, 0x9d, 0xca, 0xd7, 0xb9, 0x53, 0x79, 0xf3, 0xf0, 0x8a, 0x27, 0xea, 0x02, 0xab,
+	0xdf, 0xbc, 0xd7, 0xf7, 0xce, 0x96, 0x97, 0xb9, 0x34, 0x27, 0x34, 0xcb, 0x2b, 0xfb, 0xe8, 0xcf,
+	0x00, 0x65, 0xfd, 0xb8, 0x84, 0x6e, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +340,10 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/recovery module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RecoverFunds defines a method to recover the funds of an account with an
+	// unsupported secp256k1 key, given a signature of the account key that
+	// proves control of the account.
+	RecoverFunds(ctx context.Context, in *MsgRecoverFunds, opts ...grpc.CallOption) (*MsgRecoverFundsResponse, error)
 }
 
 type msgClient struct {
@@ -189,11 +363,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RecoverFunds(ctx context.Context, in *MsgRecoverFunds, opts ...grpc.CallOption) (*MsgRecoverFundsResponse, error) {
+	out := new(MsgRecoverFundsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.recovery.v1.Msg/RecoverFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/recovery module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RecoverFunds defines a method to recover the funds of an account with an
+	// unsupported secp256k1 key, given a signature of the account key that
+	// proves control of the account.
+	RecoverFunds(context.Context, *MsgRecoverFunds) (*MsgRecoverFundsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -203,6 +390,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RecoverFunds(ctx context.Context, req *MsgRecoverFunds) (*MsgRecoverFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverFunds not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -226,6 +416,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RecoverFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.recovery.v1.Msg/RecoverFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverFunds(ctx, req.(*MsgRecoverFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.recovery.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -234,6 +442,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RecoverFunds",
+			Handler:    _Msg_RecoverFunds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/recovery/v1/tx.proto",
@@ -302,6 +514,122 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRecoverFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recovered) > 0 {
+		for iNdEx := len(m.Recovered) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recovered[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -337,6 +665,60 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRecoverFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recovered) > 0 {
+		for _, e := range m.Recovered {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -508,6 +890,370 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRecoverFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recovered = append(m.Recovered, types.Coin{})
+			if err := m.Recovered[len(m.Recovered)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
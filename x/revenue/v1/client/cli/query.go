@@ -45,6 +45,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryDeployerRevenues(),
 		GetCmdQueryWithdrawerRevenues(),
+		GetCmdQueryPendingRevenue(),
 	)
 
 	return feesQueryCmd
@@ -225,3 +226,35 @@ func GetCmdQueryWithdrawerRevenues() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryPendingRevenue implements a command that returns the accrued
+// revenue of a withdrawer that hasn't been withdrawn yet
+func GetCmdQueryPendingRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-revenue WITHDRAWER_ADDRESS",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the accrued revenue of a withdrawer that hasn't been withdrawn yet",
+		Long:    "Query the accrued revenue of a withdrawer that hasn't been withdrawn yet",
+		Example: fmt.Sprintf("%s query revenue pending-revenue <withdrawer-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Query store
+			res, err := queryClient.PendingRevenue(context.Background(), &types.QueryPendingRevenueRequest{
+				WithdrawerAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -19,6 +19,8 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -32,6 +34,26 @@ import (
 	"github.com/evmos/evmos/v12/x/revenue/v1/types"
 )
 
+// revenue registration flags
+const (
+	// FlagWithdrawers defines the flag for the weighted withdrawers of a revenue
+	FlagWithdrawers = "withdrawers"
+	// FlagOwnershipProof defines the flag for the contract ownership proof
+	FlagOwnershipProof = "ownership-proof"
+	// FlagCreate2Salt defines the flag for the CREATE2 salt of the contract
+	FlagCreate2Salt = "create2-salt"
+	// FlagCreate2InitCodeHash defines the flag for the CREATE2 init code hash
+	// of the contract
+	FlagCreate2InitCodeHash = "create2-init-code-hash"
+)
+
+// ownership proof flag values
+const (
+	ownershipProofAddressDerivation = "address-derivation"
+	ownershipProofEIP173Owner       = "eip173-owner"
+	ownershipProofEIP1967Admin      = "eip1967-admin"
+)
+
 // NewTxCmd returns a root CLI command handler for certain modules/revenue
 // transaction commands.
 func NewTxCmd() *cobra.Command {
@@ -47,6 +69,7 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterRevenue(),
 		NewCancelRevenue(),
 		NewUpdateRevenue(),
+		NewWithdrawRevenue(),
 	)
 	return txCmd
 }
@@ -57,8 +80,8 @@ func NewRegisterRevenue() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register CONTRACT_HEX NONCE... [WITHDRAWER_BECH32]",
 		Short: "Register a contract for fee distribution. **NOTE** Please ensure, that the deployer of the contract (or the factory that deployes the contract) is an account that is owned by your project, to avoid that an individual deployer who leaves your project becomes malicious.",
-		Long:  "Register a contract for fee distribution.\nOnly the contract deployer can register a contract.\nProvide the account nonce(s) used to derive the contract address. E.g.: you have an account nonce of 4 when you send a deployment transaction for a contract A; you use this contract as a factory, to create another contract B. If you register A, the nonces value is \"4\". If you register B, the nonces value is \"4,1\" (B is the first contract created by A). \nThe withdrawer address defaults to the deployer address if not provided.",
-		Args:  cobra.RangeArgs(2, 3),
+		Long:  "Register a contract for fee distribution.\nOnly the contract deployer can register a contract.\nProvide the account nonce(s) used to derive the contract address. E.g.: you have an account nonce of 4 when you send a deployment transaction for a contract A; you use this contract as a factory, to create another contract B. If you register A, the nonces value is \"4\". If you register B, the nonces value is \"4,1\" (B is the first contract created by A). \nIf the contract was deployed by the last factory with the CREATE2 opcode, also provide the --create2-salt and --create2-init-code-hash flags. \nContracts that implement the EIP-173 owner() method or EIP-1967 proxies can be registered by their owner or admin with --ownership-proof eip173-owner or eip1967-admin, in which case the nonces are omitted: register CONTRACT_HEX [WITHDRAWER_BECH32]. \nThe withdrawer address defaults to the deployer address if not provided. \nUse the --withdrawers flag instead of the withdrawer address to split the fees between several accounts, e.g. --withdrawers evmos1...:6000,evmos1...:4000 (weights in basis points adding up to 10000).",
+		Args:  cobra.RangeArgs(1, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -73,16 +96,32 @@ func NewRegisterRevenue() *cobra.Command {
 				return fmt.Errorf("invalid contract hex address %w", err)
 			}
 
+			proof, err := getOwnershipProofFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			var nonces []uint64
-			if err = json.Unmarshal([]byte("["+args[1]+"]"), &nonces); err != nil {
-				return fmt.Errorf("invalid nonces %w", err)
+			if proof == types.OWNERSHIP_PROOF_ADDRESS_DERIVATION {
+				if len(args) < 2 {
+					return fmt.Errorf("nonces are required to derive the contract address")
+				}
+
+				if err = json.Unmarshal([]byte("["+args[1]+"]"), &nonces); err != nil {
+					return fmt.Errorf("invalid nonces %w", err)
+				}
+				args = args[1:]
 			}
 
-			if len(args) == 3 {
-				withdrawer = args[2]
+			switch len(args) {
+			case 1:
+			case 2:
+				withdrawer = args[1]
 				if _, err := sdk.AccAddressFromBech32(withdrawer); err != nil {
 					return fmt.Errorf("invalid withdrawer bech32 address %w", err)
 				}
+			default:
+				return fmt.Errorf("too many arguments for ownership proof %s", proof)
 			}
 
 			// If withdraw address is the same as contract deployer, remove the
@@ -91,11 +130,30 @@ func NewRegisterRevenue() *cobra.Command {
 				withdrawer = ""
 			}
 
+			salt, err := cmd.Flags().GetString(FlagCreate2Salt)
+			if err != nil {
+				return err
+			}
+
+			initCodeHash, err := cmd.Flags().GetString(FlagCreate2InitCodeHash)
+			if err != nil {
+				return err
+			}
+
+			withdrawers, err := getWithdrawersFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRegisterRevenue{
-				ContractAddress:   contract,
-				DeployerAddress:   deployer.String(),
-				WithdrawerAddress: withdrawer,
-				Nonces:            nonces,
+				ContractAddress:     contract,
+				DeployerAddress:     deployer.String(),
+				WithdrawerAddress:   withdrawer,
+				Nonces:              nonces,
+				Withdrawers:         withdrawers,
+				OwnershipProof:      proof,
+				Create2Salt:         salt,
+				Create2InitCodeHash: initCodeHash,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -106,6 +164,10 @@ func NewRegisterRevenue() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagWithdrawers, "", "comma separated list of weighted withdrawers in the address:weight format")
+	cmd.Flags().String(FlagOwnershipProof, ownershipProofAddressDerivation, "ownership proof of the contract (address-derivation|eip173-owner|eip1967-admin)")
+	cmd.Flags().String(FlagCreate2Salt, "", "0x prefixed CREATE2 salt used by the factory to deploy the contract")
+	cmd.Flags().String(FlagCreate2InitCodeHash, "", "0x prefixed keccak256 hash of the init code used by the factory to deploy the contract")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -149,13 +211,13 @@ func NewCancelRevenue() *cobra.Command {
 }
 
 // NewUpdateRevenue returns a CLI command handler for updating the withdraw
-// address of a contract for fee distribution
+// address or the weighted withdrawers of a contract for fee distribution
 func NewUpdateRevenue() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update CONTRACT_HEX WITHDRAWER_BECH32",
+		Use:   "update CONTRACT_HEX [WITHDRAWER_BECH32]",
 		Short: "Update withdrawer address for a contract registered for fee distribution.",
-		Long:  "Update withdrawer address for a contract registered for fee distribution. \nOnly the contract deployer can update the withdrawer address.",
-		Args:  cobra.ExactArgs(2),
+		Long:  "Update withdrawer address for a contract registered for fee distribution. \nOnly the contract deployer can update the withdrawer address. \nUse the --withdrawers flag instead of the withdrawer address to split the fees between several accounts, e.g. --withdrawers evmos1...:6000,evmos1...:4000 (weights in basis points adding up to 10000).",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -169,17 +231,57 @@ func NewUpdateRevenue() *cobra.Command {
 				return fmt.Errorf("invalid contract hex address %w", err)
 			}
 
-			withdrawer := args[1]
-			if _, err := sdk.AccAddressFromBech32(withdrawer); err != nil {
-				return fmt.Errorf("invalid withdrawer bech32 address %w", err)
+			withdrawers, err := getWithdrawersFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			var withdrawer string
+			if len(args) == 2 {
+				withdrawer = args[1]
+				if _, err := sdk.AccAddressFromBech32(withdrawer); err != nil {
+					return fmt.Errorf("invalid withdrawer bech32 address %w", err)
+				}
+			} else if len(withdrawers) == 0 {
+				return fmt.Errorf("either a withdrawer address or the --%s flag must be provided", FlagWithdrawers)
 			}
 
 			msg := &types.MsgUpdateRevenue{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				Withdrawers:       withdrawers,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagWithdrawers, "", "comma separated list of weighted withdrawers in the address:weight format")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewWithdrawRevenue returns a CLI command handler for withdrawing the
+// revenue accrued by the sender
+func NewWithdrawRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw",
+		Short: "Withdraw the accrued revenue of the sender",
+		Long:  "Withdraw the revenue accrued by the sender as withdrawer of registered contracts, when the revenue module accrues the developer shares instead of distributing them on every transaction.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
+			msg := types.NewMsgWithdrawRevenue(cliCtx.GetFromAddress())
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -191,3 +293,55 @@ func NewUpdateRevenue() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// getWithdrawersFromFlag parses the weighted withdrawers provided in the
+// address:weight,address:weight format
+func getWithdrawersFromFlag(cmd *cobra.Command) ([]types.WithdrawerSplit, error) {
+	value, err := cmd.Flags().GetString(FlagWithdrawers)
+	if err != nil || strings.TrimSpace(value) == "" {
+		return nil, err
+	}
+
+	var withdrawers []types.WithdrawerSplit
+	for _, pair := range strings.Split(value, ",") {
+		address, weightStr, found := strings.Cut(strings.TrimSpace(pair), ":")
+		if !found {
+			return nil, fmt.Errorf("invalid withdrawer %q, expected address:weight", pair)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return nil, fmt.Errorf("invalid withdrawer bech32 address %w", err)
+		}
+
+		weight, err := strconv.ParseUint(weightStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for withdrawer %s: %w", address, err)
+		}
+
+		withdrawers = append(withdrawers, types.WithdrawerSplit{
+			WithdrawerAddress: address,
+			Weight:            uint32(weight),
+		})
+	}
+
+	return withdrawers, nil
+}
+
+// getOwnershipProofFromFlag parses the ownership proof flag
+func getOwnershipProofFromFlag(cmd *cobra.Command) (types.OwnershipProof, error) {
+	value, err := cmd.Flags().GetString(FlagOwnershipProof)
+	if err != nil {
+		return types.OWNERSHIP_PROOF_ADDRESS_DERIVATION, err
+	}
+
+	switch value {
+	case "", ownershipProofAddressDerivation:
+		return types.OWNERSHIP_PROOF_ADDRESS_DERIVATION, nil
+	case ownershipProofEIP173Owner:
+		return types.OWNERSHIP_PROOF_EIP173_OWNER, nil
+	case ownershipProofEIP1967Admin:
+		return types.OWNERSHIP_PROOF_EIP1967_ADMIN, nil
+	default:
+		return types.OWNERSHIP_PROOF_ADDRESS_DERIVATION, fmt.Errorf("invalid ownership proof %q", value)
+	}
+}
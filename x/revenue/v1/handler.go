@@ -39,6 +39,9 @@ func NewHandler(server types.MsgServer) sdk.Handler {
 		case *types.MsgCancelRevenue:
 			res, err := server.CancelRevenue(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawRevenue:
+			res, err := server.WithdrawRevenue(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgUpdateParams:
 			res, err := server.UpdateParams(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -28,7 +28,10 @@ import (
 	"github.com/evmos/evmos/v12/x/revenue/v1/types"
 )
 
-var _ evmtypes.EvmHooks = Hooks{}
+var (
+	_ evmtypes.EvmHooks         = Hooks{}
+	_ evmtypes.ContractGasHooks = Hooks{}
+)
 
 // Hooks wrapper struct for fees keeper
 type Hooks struct {
@@ -46,10 +49,20 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 	return h.k.PostTxProcessing(ctx, msg, receipt)
 }
 
+// RequiresContractGas implements ContractGasHooks. The gas consumed by the
+// contracts of the call tree is only needed when revenue is enabled and
+// attributed beyond the top-level contract.
+func (h Hooks) RequiresContractGas(ctx sdk.Context) bool {
+	params := h.k.GetParams(ctx)
+	return params.EnableRevenue && params.Attribution != types.REVENUE_ATTRIBUTION_TOP_LEVEL
+}
+
 // PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
 // interaction with a registered contract, the contract deployer (or, if set,
-// the withdraw address) receives a share from the transaction fees paid by the
-// transaction sender.
+// the withdraw address or weighted withdrawers) receives a share from the transaction fees paid by the
+// transaction sender. Depending on the attribution param, the share is either
+// paid for the contract called by the transaction or split across the
+// registered contracts executed in the call tree of the transaction.
 func (k Keeper) PostTxProcessing(
 	ctx sdk.Context,
 	msg core.Message,
@@ -61,53 +74,184 @@ func (k Keeper) PostTxProcessing(
 		return nil
 	}
 
+	// if no contract is registered to receive fees, do nothing
+	shares := k.revenueShares(ctx, params.Attribution, msg, receipt)
+	if len(shares) == 0 {
+		return nil
+	}
+
+	txFee := sdk.NewIntFromUint64(receipt.GasUsed).Mul(sdk.NewIntFromBigInt(msg.GasPrice()))
+	developerFee := (params.DeveloperShares).MulInt(txFee).TruncateInt()
+	gasDenom := k.evmKeeper.GetParams(ctx).GasDenom
+
+	totalWeight := sdk.ZeroInt()
+	for _, share := range shares {
+		totalWeight = totalWeight.Add(sdk.NewIntFromUint64(share.weight))
+	}
+
+	for _, share := range shares {
+		amount := developerFee
+		if len(shares) > 1 {
+			amount = developerFee.Mul(sdk.NewIntFromUint64(share.weight)).Quo(totalWeight)
+			// skip the shares rounded down to zero
+			if amount.IsZero() {
+				continue
+			}
+		}
+
+		fee := sdk.Coin{Denom: gasDenom, Amount: amount}
+		if err := k.distributeRevenue(ctx, msg, share.revenue, fee, params.AccrueRevenue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revenueShare defines the weight of a registered contract in the split of the
+// developer fees of a transaction
+type revenueShare struct {
+	revenue types.Revenue
+	weight  uint64
+}
+
+// revenueShares returns the registered contracts that receive a share of the
+// developer fees of a transaction. The call tree attributions fall back to the
+// contract called by the transaction if the call tree wasn't recorded.
+func (k Keeper) revenueShares(
+	ctx sdk.Context,
+	attribution types.RevenueAttribution,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) []revenueShare {
+	if attribution != types.REVENUE_ATTRIBUTION_TOP_LEVEL {
+		contractGas := k.evmKeeper.GetTxContractGasTransient(ctx, uint64(receipt.TransactionIndex))
+		if len(contractGas) > 0 {
+			return k.callTreeShares(ctx, attribution, contractGas)
+		}
+	}
+
 	contract := msg.To()
 	if contract == nil {
 		return nil
 	}
 
-	// if the contract is not registered to receive fees, do nothing
 	revenue, found := k.GetRevenue(ctx, *contract)
 	if !found {
 		return nil
 	}
 
-	withdrawer := revenue.GetWithdrawerAddr()
-	if len(withdrawer) == 0 {
-		withdrawer = revenue.GetDeployerAddr()
-	}
+	return []revenueShare{{revenue: revenue, weight: 1}}
+}
 
-	txFee := sdk.NewIntFromUint64(receipt.GasUsed).Mul(sdk.NewIntFromBigInt(msg.GasPrice()))
-	developerFee := (params.DeveloperShares).MulInt(txFee).TruncateInt()
-	gasDenom := k.evmKeeper.GetParams(ctx).GasDenom
-	fees := sdk.Coins{{Denom: gasDenom, Amount: developerFee}}
-
-	// distribute the fees to the contract deployer / withdraw address
-	err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx,
-		k.feeCollectorName,
-		withdrawer,
-		fees,
+// callTreeShares returns the registered contracts executed in the call tree of
+// a transaction, weighted by the gas consumed by their code or equally
+func (k Keeper) callTreeShares(
+	ctx sdk.Context,
+	attribution types.RevenueAttribution,
+	contractGas []evmtypes.ContractGas,
+) []revenueShare {
+	var (
+		shares   []revenueShare
+		totalGas uint64
 	)
-	if err != nil {
-		return errorsmod.Wrapf(
-			err,
-			"fee collector account failed to distribute developer fees (%s) to withdraw address %s. contract %s",
-			fees, withdrawer, contract,
-		)
+
+	for _, cg := range contractGas {
+		revenue, found := k.GetRevenue(ctx, cg.Contract)
+		if !found {
+			continue
+		}
+
+		weight := uint64(1)
+		if attribution == types.REVENUE_ATTRIBUTION_CALL_TREE_GAS {
+			weight = cg.Gas
+		}
+
+		totalGas += weight
+		shares = append(shares, revenueShare{revenue: revenue, weight: weight})
 	}
 
-	ctx.EventManager().EmitEvents(
-		sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeDistributeDevRevenue,
-				sdk.NewAttribute(sdk.AttributeKeySender, msg.From().String()),
-				sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
-				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, withdrawer.String()),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, developerFee.String()),
-			),
-		},
-	)
+	// split equally if the registered contracts didn't consume any gas
+	if totalGas == 0 {
+		for i := range shares {
+			shares[i].weight = 1
+		}
+	}
+
+	return shares
+}
+
+// distributeRevenue sends the given fee from the fee collector to the
+// withdrawers of the registered contract, split according to their weights.
+// Without weighted withdrawers, the full fee is sent to the withdraw address
+// or to the deployer if unset. In accrual mode, the fee is added to the pending
+// revenue of the withdrawers instead.
+func (k Keeper) distributeRevenue(
+	ctx sdk.Context,
+	msg core.Message,
+	revenue types.Revenue,
+	fee sdk.Coin,
+	accrue bool,
+) error {
+	splits := revenue.GetWithdrawerSplits()
+
+	for _, split := range splits {
+		amount := fee.Amount
+		if len(splits) > 1 {
+			amount = fee.Amount.MulRaw(int64(split.Weight)).QuoRaw(types.WithdrawerWeightTotal)
+			// skip the splits rounded down to zero
+			if amount.IsZero() {
+				continue
+			}
+		}
+
+		withdrawer := sdk.MustAccAddressFromBech32(split.WithdrawerAddress)
+
+		if accrue {
+			k.accrueRevenue(ctx, withdrawer, sdk.Coin{Denom: fee.Denom, Amount: amount})
+
+			ctx.EventManager().EmitEvents(
+				sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeAccrueDevRevenue,
+						sdk.NewAttribute(sdk.AttributeKeySender, msg.From().String()),
+						sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+						sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, withdrawer.String()),
+						sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+					),
+				},
+			)
+			continue
+		}
+
+		// distribute the fees to the contract deployer / withdraw address
+		fees := sdk.Coins{{Denom: fee.Denom, Amount: amount}}
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			k.feeCollectorName,
+			withdrawer,
+			fees,
+		)
+		if err != nil {
+			return errorsmod.Wrapf(
+				err,
+				"fee collector account failed to distribute developer fees (%s) to withdraw address %s. contract %s",
+				fees, withdrawer, revenue.ContractAddress,
+			)
+		}
+
+		ctx.EventManager().EmitEvents(
+			sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeDistributeDevRevenue,
+					sdk.NewAttribute(sdk.AttributeKeySender, msg.From().String()),
+					sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+					sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, withdrawer.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				),
+			},
+		)
+	}
 
 	return nil
 }
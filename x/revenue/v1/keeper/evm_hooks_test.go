@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	"github.com/evmos/evmos/v12/x/revenue/v1/types"
+)
+
+func (suite *KeeperTestSuite) TestPostTxProcessingAttribution() {
+	var (
+		router     = utiltx.GenerateAddress()
+		library    = utiltx.GenerateAddress()
+		unknown    = utiltx.GenerateAddress()
+		routerDev  = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		libraryDev = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	)
+
+	// the developer fees are 50% of a 100000 tx fee
+	callTree := []evmtypes.ContractGas{
+		{Contract: router, Gas: 1000},
+		{Contract: library, Gas: 3000},
+		{Contract: unknown, Gas: 4000},
+	}
+
+	testCases := []struct {
+		name          string
+		attribution   types.RevenueAttribution
+		malleate      func()
+		expRouterDev  int64
+		expLibraryDev int64
+	}{
+		{
+			"top level - only the called contract is paid",
+			types.REVENUE_ATTRIBUTION_TOP_LEVEL,
+			func() {
+				suite.app.EvmKeeper.SetTxContractGasTransient(suite.ctx, 0, callTree)
+			},
+			50000,
+			0,
+		},
+		{
+			"call tree gas - split weighted by gas",
+			types.REVENUE_ATTRIBUTION_CALL_TREE_GAS,
+			func() {
+				suite.app.EvmKeeper.SetTxContractGasTransient(suite.ctx, 0, callTree)
+			},
+			12500,
+			37500,
+		},
+		{
+			"call tree equal - split equally",
+			types.REVENUE_ATTRIBUTION_CALL_TREE_EQUAL,
+			func() {
+				suite.app.EvmKeeper.SetTxContractGasTransient(suite.ctx, 0, callTree)
+			},
+			25000,
+			25000,
+		},
+		{
+			"call tree gas - unregistered entry contract",
+			types.REVENUE_ATTRIBUTION_CALL_TREE_GAS,
+			func() {
+				suite.app.RevenueKeeper.DeleteRevenue(suite.ctx, types.NewRevenue(router, routerDev, nil))
+				suite.app.EvmKeeper.SetTxContractGasTransient(suite.ctx, 0, callTree)
+			},
+			0,
+			50000,
+		},
+		{
+			"call tree gas - call tree not recorded",
+			types.REVENUE_ATTRIBUTION_CALL_TREE_GAS,
+			func() {},
+			50000,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+			params.Attribution = tc.attribution
+			err := suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(router, routerDev, nil))
+			suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(library, libraryDev, nil))
+
+			err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 100000)))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			msg := ethtypes.NewMessage(
+				suite.address, &router, 0, big.NewInt(0), 200000,
+				big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false,
+			)
+			receipt := &ethtypes.Receipt{GasUsed: 100000, TransactionIndex: 0}
+
+			err = suite.app.RevenueKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.expRouterDev, suite.app.BankKeeper.GetBalance(suite.ctx, routerDev, suite.denom).Amount.Int64())
+			suite.Require().Equal(tc.expLibraryDev, suite.app.BankKeeper.GetBalance(suite.ctx, libraryDev, suite.denom).Amount.Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessingWithdrawerSplits() {
+	var (
+		contract    = utiltx.GenerateAddress()
+		deployer    = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		withdrawer1 = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		withdrawer2 = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		withdrawer3 = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	)
+
+	// the developer fees are 50% of a 100000 tx fee
+	testCases := []struct {
+		name        string
+		withdrawers []types.WithdrawerSplit
+		expBalances []int64
+	}{
+		{
+			"single withdrawer - full developer fee",
+			[]types.WithdrawerSplit{
+				{WithdrawerAddress: withdrawer1.String(), Weight: 10000},
+			},
+			[]int64{50000, 0, 0},
+		},
+		{
+			"weighted withdrawers",
+			[]types.WithdrawerSplit{
+				{WithdrawerAddress: withdrawer1.String(), Weight: 5000},
+				{WithdrawerAddress: withdrawer2.String(), Weight: 3000},
+				{WithdrawerAddress: withdrawer3.String(), Weight: 2000},
+			},
+			[]int64{25000, 15000, 10000},
+		},
+		{
+			"weighted withdrawers - rounded down",
+			[]types.WithdrawerSplit{
+				{WithdrawerAddress: withdrawer1.String(), Weight: 3333},
+				{WithdrawerAddress: withdrawer2.String(), Weight: 3333},
+				{WithdrawerAddress: withdrawer3.String(), Weight: 3334},
+			},
+			[]int64{16665, 16665, 16670},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			revenue := types.NewRevenue(contract, deployer, nil)
+			revenue.Withdrawers = tc.withdrawers
+			suite.app.RevenueKeeper.SetRevenue(suite.ctx, revenue)
+
+			err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 100000)))
+			suite.Require().NoError(err)
+
+			msg := ethtypes.NewMessage(
+				suite.address, &contract, 0, big.NewInt(0), 200000,
+				big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false,
+			)
+			receipt := &ethtypes.Receipt{GasUsed: 100000}
+
+			err = suite.app.RevenueKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+			suite.Require().NoError(err)
+
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, deployer, suite.denom).IsZero())
+			for i, withdrawer := range []sdk.AccAddress{withdrawer1, withdrawer2, withdrawer3} {
+				suite.Require().Equal(tc.expBalances[i], suite.app.BankKeeper.GetBalance(suite.ctx, withdrawer, suite.denom).Amount.Int64())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessingAccrual() {
+	var (
+		contract    = utiltx.GenerateAddress()
+		deployer    = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		withdrawer1 = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		withdrawer2 = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	)
+
+	suite.SetupTest()
+
+	params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+	params.AccrueRevenue = true
+	err := suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(err)
+
+	revenue := types.NewRevenue(contract, deployer, nil)
+	revenue.Withdrawers = []types.WithdrawerSplit{
+		{WithdrawerAddress: withdrawer1.String(), Weight: 6000},
+		{WithdrawerAddress: withdrawer2.String(), Weight: 4000},
+	}
+	suite.app.RevenueKeeper.SetRevenue(suite.ctx, revenue)
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	feeCollectorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.denom).Amount.Int64()
+
+	err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 200000)))
+	suite.Require().NoError(err)
+
+	msg := ethtypes.NewMessage(
+		suite.address, &contract, 0, big.NewInt(0), 200000,
+		big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false,
+	)
+	receipt := &ethtypes.Receipt{GasUsed: 100000}
+
+	// the developer fees are 50% of a 100000 tx fee, accrued twice
+	for i := 0; i < 2; i++ {
+		err = suite.app.RevenueKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+		suite.Require().NoError(err)
+	}
+
+	// nothing is transferred until the end of the block
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, withdrawer1, suite.denom).IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, suite.denom).IsZero())
+	suite.Require().Equal(feeCollectorBalance+200000, suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.denom).Amount.Int64())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 60000)), suite.app.RevenueKeeper.GetPendingRevenue(suite.ctx, withdrawer1))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 40000)), suite.app.RevenueKeeper.GetPendingRevenue(suite.ctx, withdrawer2))
+
+	suite.app.RevenueKeeper.EndBlock(suite.ctx)
+
+	suite.Require().Equal(int64(100000), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, suite.denom).Amount.Int64())
+	suite.Require().Equal(feeCollectorBalance+100000, suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.denom).Amount.Int64())
+
+	// a second sweep is a no-op
+	suite.app.RevenueKeeper.EndBlock(suite.ctx)
+	suite.Require().Equal(int64(100000), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, suite.denom).Amount.Int64())
+
+	res, err := suite.queryClient.PendingRevenue(sdk.WrapSDKContext(suite.ctx), &types.QueryPendingRevenueRequest{
+		WithdrawerAddress: withdrawer1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 60000)), res.Amount)
+}
+
+func (suite *KeeperTestSuite) TestRequiresContractGas() {
+	testCases := []struct {
+		name        string
+		enable      bool
+		attribution types.RevenueAttribution
+		expRequired bool
+	}{
+		{"disabled revenue", false, types.REVENUE_ATTRIBUTION_CALL_TREE_GAS, false},
+		{"top level attribution", true, types.REVENUE_ATTRIBUTION_TOP_LEVEL, false},
+		{"call tree gas attribution", true, types.REVENUE_ATTRIBUTION_CALL_TREE_GAS, true},
+		{"call tree equal attribution", true, types.REVENUE_ATTRIBUTION_CALL_TREE_EQUAL, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+			params.EnableRevenue = tc.enable
+			params.Attribution = tc.attribution
+			err := suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			required := suite.app.RevenueKeeper.Hooks().RequiresContractGas(suite.ctx)
+			suite.Require().Equal(tc.expRequired, required)
+		})
+	}
+}
@@ -161,8 +161,9 @@ func (k Keeper) DeployerRevenues( //nolint: dupl
 	}, nil
 }
 
-// WithdrawerRevenues returns all fees for a given withdraw address
-func (k Keeper) WithdrawerRevenues( //nolint: dupl
+// WithdrawerRevenues returns all fees for a given withdraw address, along with
+// the weight of the fees of each contract sent to the withdrawer
+func (k Keeper) WithdrawerRevenues(
 	c context.Context,
 	req *types.QueryWithdrawerRevenuesRequest,
 ) (*types.QueryWithdrawerRevenuesResponse, error) {
@@ -179,7 +180,7 @@ func (k Keeper) WithdrawerRevenues( //nolint: dupl
 		)
 	}
 
-	deployer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
 	if err != nil {
 		return nil, status.Errorf(
 			codes.InvalidArgument,
@@ -187,14 +188,28 @@ func (k Keeper) WithdrawerRevenues( //nolint: dupl
 		)
 	}
 
-	var contracts []string
+	var (
+		contracts []string
+		shares    []types.WithdrawerShare
+	)
 	store := prefix.NewStore(
 		ctx.KVStore(k.storeKey),
-		types.GetKeyPrefixWithdrawer(deployer),
+		types.GetKeyPrefixWithdrawer(withdrawer),
 	)
 
 	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
-		contracts = append(contracts, common.BytesToAddress(key).Hex())
+		contract := common.BytesToAddress(key)
+		contracts = append(contracts, contract.Hex())
+
+		var weight uint32
+		if revenue, found := k.GetRevenue(ctx, contract); found {
+			weight = revenue.GetWithdrawerWeight(withdrawer)
+		}
+
+		shares = append(shares, types.WithdrawerShare{
+			ContractAddress: contract.Hex(),
+			Weight:          weight,
+		})
 
 		return nil
 	})
@@ -204,5 +219,38 @@ func (k Keeper) WithdrawerRevenues( //nolint: dupl
 	return &types.QueryWithdrawerRevenuesResponse{
 		ContractAddresses: contracts,
 		Pagination:        pageRes,
+		Shares:            shares,
+	}, nil
+}
+
+// PendingRevenue returns the accrued revenue of a withdrawer that hasn't been
+// withdrawn yet
+func (k Keeper) PendingRevenue(
+	c context.Context,
+	req *types.QueryPendingRevenueRequest,
+) (*types.QueryPendingRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if strings.TrimSpace(req.WithdrawerAddress) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"withdraw address is empty",
+		)
+	}
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for withdraw addr %s, should be bech32 ('evmos...')", req.WithdrawerAddress,
+		)
+	}
+
+	return &types.QueryPendingRevenueResponse{
+		Amount: k.GetPendingRevenue(ctx, withdrawer),
 	}, nil
 }
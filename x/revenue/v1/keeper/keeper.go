@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/evmos/evmos/v12/x/revenue/v1/types"
@@ -37,6 +38,7 @@ type Keeper struct {
 	bankKeeper       types.BankKeeper
 	evmKeeper        types.EVMKeeper
 	feeCollectorName string
+	hooks            types.RevenueHooks
 }
 
 // NewKeeper creates new instances of the fees Keeper
@@ -58,6 +60,26 @@ func NewKeeper(
 	}
 }
 
+// SetHooks set the revenue registration hooks
+func (k *Keeper) SetHooks(rh types.RevenueHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set revenue hooks twice")
+	}
+
+	k.hooks = rh
+
+	return k
+}
+
+// AfterRevenueRegistered executes the revenue registration hooks, if set,
+// after a contract is registered for fee revenue
+func (k Keeper) AfterRevenueRegistered(ctx sdk.Context, deployer sdk.AccAddress, contract common.Address) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterRevenueRegistered(ctx, deployer, contract)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -25,7 +25,6 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/evmos/evmos/v12/x/revenue/v1/types"
 )
@@ -84,51 +83,36 @@ func (k Keeper) RegisterRevenue(
 		withdrawer = sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
 	}
 
-	derivedContract := common.BytesToAddress(deployer)
-
-	// the contract can be directly deployed by an EOA or created through one
-	// or more factory contracts. If it was deployed by an EOA account, then
-	// msg.Nonces contains the EOA nonce for the deployment transaction.
-	// If it was deployed by one or more factories, msg.Nonces contains the EOA
-	// nonce for the origin factory contract, then the nonce of the factory
-	// for the creation of the next factory/contract.
-	for _, nonce := range msg.Nonces {
-		ctx.GasMeter().ConsumeGas(
-			params.AddrDerivationCostCreate,
-			"revenue registration: address derivation CREATE opcode",
-		)
-
-		derivedContract = crypto.CreateAddress(derivedContract, nonce)
-	}
-
-	if contract != derivedContract {
-		return nil, errorsmod.Wrapf(
-			errortypes.ErrorInvalidSigner,
-			"not contract deployer or wrong nonce: expected %s instead of %s",
-			derivedContract, msg.ContractAddress,
-		)
+	if err := k.verifyContractOwnership(ctx, params, msg, contract, common.BytesToAddress(deployer)); err != nil {
+		return nil, err
 	}
 
 	// prevent storing the same address for deployer and withdrawer
 	revenue := types.NewRevenue(contract, deployer, withdrawer)
+	revenue.Withdrawers = msg.Withdrawers
 	k.SetRevenue(ctx, revenue)
 	k.SetDeployerMap(ctx, deployer, contract)
+	k.SetWithdrawerMaps(ctx, revenue)
 
 	// The effective withdrawer is the withdraw address that is stored after the
 	// revenue registration is completed. It defaults to the deployer address if
-	// the withdraw address in the msg is omitted. When omitted, the withdraw map
-	// dosn't need to be set.
+	// the withdraw address in the msg is omitted, and is empty when the fees are
+	// split between weighted withdrawers.
 	effectiveWithdrawer := msg.DeployerAddress
 
-	if len(withdrawer) != 0 {
-		k.SetWithdrawerMap(ctx, withdrawer, contract)
+	switch {
+	case len(revenue.Withdrawers) != 0:
+		effectiveWithdrawer = ""
+	case len(withdrawer) != 0:
 		effectiveWithdrawer = msg.WithdrawerAddress
 	}
 
+	withdrawers := types.FormatWithdrawerSplits(revenue.GetWithdrawerSplits())
+
 	k.Logger(ctx).Debug(
 		"registering contract for transaction fees",
 		"contract", msg.ContractAddress, "deployer", msg.DeployerAddress,
-		"withdraw", effectiveWithdrawer,
+		"withdraw", withdrawers,
 	)
 
 	ctx.EventManager().EmitEvents(
@@ -138,16 +122,22 @@ func (k Keeper) RegisterRevenue(
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
 				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
 				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, effectiveWithdrawer),
+				sdk.NewAttribute(types.AttributeKeyWithdrawers, withdrawers),
 			),
 		},
 	)
 
+	if err := k.AfterRevenueRegistered(ctx, deployer, contract); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgRegisterRevenueResponse{}, nil
 }
 
-// UpdateRevenue updates the withdraw address of a given Revenue. If the given
-// withdraw address is empty or the same as the deployer address, the withdraw
-// address is removed.
+// UpdateRevenue updates the withdraw address or the weighted withdrawers of a
+// given Revenue. If the given withdraw address is empty or the same as the
+// deployer address and no weighted withdrawers are given, the withdraw address
+// is removed.
 func (k Keeper) UpdateRevenue(
 	goCtx context.Context,
 	msg *types.MsgUpdateRevenue,
@@ -181,30 +171,25 @@ func (k Keeper) UpdateRevenue(
 		msg.WithdrawerAddress = ""
 	}
 
-	// revenue with the given withdraw address is already registered
-	if msg.WithdrawerAddress == revenue.WithdrawerAddress {
+	// revenue with the given withdrawers is already registered
+	if msg.WithdrawerAddress == revenue.WithdrawerAddress &&
+		equalWithdrawerSplits(msg.Withdrawers, revenue.Withdrawers) {
 		return nil, errorsmod.Wrapf(
 			types.ErrRevenueAlreadyRegistered,
-			"revenue with withdraw address %s", msg.WithdrawerAddress,
+			"revenue with withdraw address %s and withdrawers [%s]",
+			msg.WithdrawerAddress, types.FormatWithdrawerSplits(msg.Withdrawers),
 		)
 	}
 
-	// only delete withdrawer map if is not default
-	if revenue.WithdrawerAddress != "" {
-		k.DeleteWithdrawerMap(ctx, sdk.MustAccAddressFromBech32(revenue.WithdrawerAddress), contract)
-	}
+	// replace the withdrawer maps of the previous withdrawers, the default
+	// withdrawer is never mapped
+	k.DeleteWithdrawerMaps(ctx, revenue)
 
-	// only add withdrawer map if new entry is not default
-	if msg.WithdrawerAddress != "" {
-		k.SetWithdrawerMap(
-			ctx,
-			sdk.MustAccAddressFromBech32(msg.WithdrawerAddress),
-			contract,
-		)
-	}
 	// update revenue
 	revenue.WithdrawerAddress = msg.WithdrawerAddress
+	revenue.Withdrawers = msg.Withdrawers
 	k.SetRevenue(ctx, revenue)
+	k.SetWithdrawerMaps(ctx, revenue)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
@@ -213,6 +198,7 @@ func (k Keeper) UpdateRevenue(
 				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
 				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				sdk.NewAttribute(types.AttributeKeyWithdrawers, types.FormatWithdrawerSplits(revenue.GetWithdrawerSplits())),
 			),
 		},
 	)
@@ -256,14 +242,8 @@ func (k Keeper) CancelRevenue(
 		contract,
 	)
 
-	// delete entry from withdrawer map if not default
-	if fee.WithdrawerAddress != "" {
-		k.DeleteWithdrawerMap(
-			ctx,
-			fee.GetWithdrawerAddr(),
-			contract,
-		)
-	}
+	// delete entries from withdrawer map if not default
+	k.DeleteWithdrawerMaps(ctx, fee)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
@@ -294,3 +274,64 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// WithdrawRevenue sends the revenue accrued by a withdrawer from the module
+// account to the withdrawer
+func (k Keeper) WithdrawRevenue(
+	goCtx context.Context,
+	msg *types.MsgWithdrawRevenue,
+) (*types.MsgWithdrawRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// move the revenue accrued in the current block to the module account, so
+	// that it can be withdrawn right away
+	if err := k.SweepAccruedRevenue(ctx); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to sweep accrued revenue")
+	}
+
+	withdrawer := sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+	pending := k.GetPendingRevenue(ctx, withdrawer)
+	if pending.IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNoPendingRevenue,
+			"withdrawer %s", msg.WithdrawerAddress,
+		)
+	}
+
+	k.SetPendingRevenue(ctx, withdrawer, sdk.Coins{})
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawer, pending); err != nil {
+		return nil, errorsmod.Wrapf(
+			err,
+			"module account failed to withdraw revenue (%s) to withdraw address %s",
+			pending, withdrawer,
+		)
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeWithdrawRevenue,
+				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, pending.String()),
+			),
+		},
+	)
+
+	return &types.MsgWithdrawRevenueResponse{Amount: pending}, nil
+}
+
+// equalWithdrawerSplits returns true if both lists contain the same weighted
+// withdrawers in the same order
+func equalWithdrawerSplits(a, b []types.WithdrawerSplit) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
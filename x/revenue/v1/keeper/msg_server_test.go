@@ -5,12 +5,16 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/evmos/evmos/v12/testutil"
 	utiltx "github.com/evmos/evmos/v12/testutil/tx"
 	"github.com/evmos/evmos/v12/x/evm/statedb"
 	"github.com/evmos/evmos/v12/x/revenue/v1/types"
@@ -666,3 +670,290 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRevenueWithdrawerSplits() {
+	deployer := utiltx.GenerateAddress()
+	deployerAddr := sdk.AccAddress(deployer.Bytes())
+	withdrawer1 := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	withdrawer2 := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := crypto.CreateAddress(deployer, 1)
+	codeHash := common.Hex2Bytes("fa98cd094c09bb300de0037ba34e94f569b145ce8baa36ed863a08d7b7433f8d")
+
+	suite.SetupTest()
+	err := suite.app.EvmKeeper.SetAccount(suite.ctx, deployer, statedb.Account{
+		Balance:  big.NewInt(0),
+		CodeHash: crypto.Keccak256(nil),
+	})
+	suite.Require().NoError(err)
+	err = suite.app.EvmKeeper.SetAccount(suite.ctx, contract, statedb.Account{
+		Nonce:    1,
+		Balance:  big.NewInt(0),
+		CodeHash: codeHash,
+	})
+	suite.Require().NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	splits := []types.WithdrawerSplit{
+		{WithdrawerAddress: withdrawer1.String(), Weight: 6000},
+		{WithdrawerAddress: withdrawer2.String(), Weight: 4000},
+	}
+
+	// register with weighted withdrawers
+	msg := types.NewMsgRegisterRevenue(contract, deployerAddr, nil, []uint64{1})
+	msg.Withdrawers = splits
+	_, err = suite.app.RevenueKeeper.RegisterRevenue(ctx, msg)
+	suite.Require().NoError(err)
+
+	revenue, found := suite.app.RevenueKeeper.GetRevenue(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(splits, revenue.Withdrawers)
+	suite.Require().True(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer1, contract))
+	suite.Require().True(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer2, contract))
+
+	res, err := suite.queryClient.WithdrawerRevenues(ctx, &types.QueryWithdrawerRevenuesRequest{
+		WithdrawerAddress: withdrawer2.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{contract.Hex()}, res.ContractAddresses)
+	suite.Require().Equal([]types.WithdrawerShare{{ContractAddress: contract.Hex(), Weight: 4000}}, res.Shares)
+
+	// updating to the same withdrawers fails
+	_, err = suite.app.RevenueKeeper.UpdateRevenue(ctx, types.NewMsgUpdateRevenueWithdrawers(contract, deployerAddr, splits))
+	suite.Require().ErrorIs(err, types.ErrRevenueAlreadyRegistered)
+
+	// update to a single withdrawer removes the previous withdrawer maps
+	_, err = suite.app.RevenueKeeper.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract, deployerAddr, withdrawer2))
+	suite.Require().NoError(err)
+
+	revenue, found = suite.app.RevenueKeeper.GetRevenue(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Empty(revenue.Withdrawers)
+	suite.Require().Equal(withdrawer2.String(), revenue.WithdrawerAddress)
+	suite.Require().False(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer1, contract))
+	suite.Require().True(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer2, contract))
+
+	res, err = suite.queryClient.WithdrawerRevenues(ctx, &types.QueryWithdrawerRevenuesRequest{
+		WithdrawerAddress: withdrawer2.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.WithdrawerShare{{ContractAddress: contract.Hex(), Weight: 10000}}, res.Shares)
+
+	// update back to weighted withdrawers
+	_, err = suite.app.RevenueKeeper.UpdateRevenue(ctx, types.NewMsgUpdateRevenueWithdrawers(contract, deployerAddr, splits))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer1, contract))
+
+	// cancel removes all the withdrawer maps
+	_, err = suite.app.RevenueKeeper.CancelRevenue(ctx, types.NewMsgCancelRevenue(contract, deployerAddr))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer1, contract))
+	suite.Require().False(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer2, contract))
+}
+
+func (suite *KeeperTestSuite) TestWithdrawRevenue() {
+	withdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	fees := sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1000))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - no pending revenue",
+			func() {},
+			false,
+		},
+		{
+			"ok - revenue accrued in the current block",
+			func() {
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, fees)
+				suite.Require().NoError(err)
+
+				params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+				params.AccrueRevenue = true
+				err = suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				contract := utiltx.GenerateAddress()
+				suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(contract, suite.address.Bytes(), withdrawer))
+
+				// 50% of a 2000 tx fee
+				msg := ethtypes.NewMessage(
+					suite.address, &contract, 0, big.NewInt(0), 2000,
+					big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false,
+				)
+				err = suite.app.RevenueKeeper.PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{GasUsed: 2000})
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"ok - revenue held by the module account",
+			func() {
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, fees)
+				suite.Require().NoError(err)
+				suite.app.RevenueKeeper.SetPendingRevenue(suite.ctx, withdrawer, fees)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.app.RevenueKeeper.WithdrawRevenue(ctx, types.NewMsgWithdrawRevenue(withdrawer))
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(fees, res.Amount)
+				suite.Require().Equal(fees, suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawer))
+				suite.Require().True(suite.app.RevenueKeeper.GetPendingRevenue(suite.ctx, withdrawer).IsZero())
+			} else {
+				suite.Require().ErrorIs(err, types.ErrNoPendingRevenue, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterRevenueOwnershipProofs() {
+	deployer := utiltx.GenerateAddress()
+	otherOwner := utiltx.GenerateAddress()
+	factory := crypto.CreateAddress(deployer, 1)
+	salt := common.HexToHash("0x01")
+	initCodeHash := crypto.Keccak256Hash([]byte("init code"))
+	create2Contract := crypto.CreateAddress2(factory, salt, initCodeHash.Bytes())
+	contract := utiltx.GenerateAddress()
+	deployerAccount := statedb.Account{
+		Balance:  big.NewInt(0),
+		CodeHash: crypto.Keccak256(nil),
+	}
+
+	// setContract sets a contract whose code returns the given owner on any call
+	setContract := func(addr, owner common.Address) {
+		code := append(append([]byte{byte(vm.PUSH20)}, owner.Bytes()...),
+			byte(vm.PUSH1), 0, byte(vm.MSTORE),
+			byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+		)
+		codeHash := crypto.Keccak256(code)
+		suite.app.EvmKeeper.SetCode(suite.ctx, codeHash, code)
+		err := suite.app.EvmKeeper.SetAccount(suite.ctx, addr, statedb.Account{
+			Nonce:    1,
+			Balance:  big.NewInt(0),
+			CodeHash: codeHash,
+		})
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgRegisterRevenue
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"ok - CREATE2 factory deployed by EOA",
+			&types.MsgRegisterRevenue{
+				ContractAddress:     create2Contract.Hex(),
+				DeployerAddress:     sdk.AccAddress(deployer.Bytes()).String(),
+				Nonces:              []uint64{1},
+				Create2Salt:         salt.Hex(),
+				Create2InitCodeHash: initCodeHash.Hex(),
+			},
+			func() {
+				setContract(create2Contract, otherOwner)
+			},
+			true,
+		},
+		{
+			"fail - CREATE2 wrong salt",
+			&types.MsgRegisterRevenue{
+				ContractAddress:     create2Contract.Hex(),
+				DeployerAddress:     sdk.AccAddress(deployer.Bytes()).String(),
+				Nonces:              []uint64{1},
+				Create2Salt:         common.HexToHash("0x02").Hex(),
+				Create2InitCodeHash: initCodeHash.Hex(),
+			},
+			func() {
+				setContract(create2Contract, otherOwner)
+			},
+			false,
+		},
+		{
+			"ok - EIP-173 owner",
+			&types.MsgRegisterRevenue{
+				ContractAddress: contract.Hex(),
+				DeployerAddress: sdk.AccAddress(deployer.Bytes()).String(),
+				OwnershipProof:  types.OWNERSHIP_PROOF_EIP173_OWNER,
+			},
+			func() {
+				setContract(contract, deployer)
+			},
+			true,
+		},
+		{
+			"fail - EIP-173 owner is another account",
+			&types.MsgRegisterRevenue{
+				ContractAddress: contract.Hex(),
+				DeployerAddress: sdk.AccAddress(deployer.Bytes()).String(),
+				OwnershipProof:  types.OWNERSHIP_PROOF_EIP173_OWNER,
+			},
+			func() {
+				setContract(contract, otherOwner)
+			},
+			false,
+		},
+		{
+			"ok - EIP-1967 admin",
+			&types.MsgRegisterRevenue{
+				ContractAddress: contract.Hex(),
+				DeployerAddress: sdk.AccAddress(deployer.Bytes()).String(),
+				OwnershipProof:  types.OWNERSHIP_PROOF_EIP1967_ADMIN,
+			},
+			func() {
+				setContract(contract, otherOwner)
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, types.EIP1967AdminSlot, common.LeftPadBytes(deployer.Bytes(), 32))
+			},
+			true,
+		},
+		{
+			"fail - EIP-1967 admin not set",
+			&types.MsgRegisterRevenue{
+				ContractAddress: contract.Hex(),
+				DeployerAddress: sdk.AccAddress(deployer.Bytes()).String(),
+				OwnershipProof:  types.OWNERSHIP_PROOF_EIP1967_ADMIN,
+			},
+			func() {
+				setContract(contract, deployer)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			err := suite.app.EvmKeeper.SetAccount(suite.ctx, deployer, deployerAccount)
+			suite.Require().NoError(err)
+			tc.malleate()
+
+			suite.Require().NoError(tc.msg.ValidateBasic())
+			_, err = suite.app.RevenueKeeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), tc.msg)
+
+			contractAddr := common.HexToAddress(tc.msg.ContractAddress)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().True(suite.app.RevenueKeeper.IsRevenueRegistered(suite.ctx, contractAddr))
+				suite.Require().True(suite.app.RevenueKeeper.IsDeployerMapSet(suite.ctx, deployer.Bytes(), contractAddr))
+			} else {
+				suite.Require().ErrorIs(err, errortypes.ErrorInvalidSigner, tc.name)
+				suite.Require().False(suite.app.RevenueKeeper.IsRevenueRegistered(suite.ctx, contractAddr))
+			}
+		})
+	}
+}
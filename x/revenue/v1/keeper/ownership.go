@@ -0,0 +1,160 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	"github.com/evmos/evmos/v12/x/revenue/v1/types"
+)
+
+// verifyContractOwnership checks that the deployer of the registration message
+// owns the contract, according to the ownership proof of the message
+func (k Keeper) verifyContractOwnership(
+	ctx sdk.Context,
+	params types.Params,
+	msg *types.MsgRegisterRevenue,
+	contract, deployer common.Address,
+) error {
+	switch msg.OwnershipProof {
+	case types.OWNERSHIP_PROOF_EIP173_OWNER:
+		owner, err := k.getEIP173Owner(ctx, contract, deployer)
+		if err != nil {
+			return err
+		}
+
+		if owner != deployer {
+			return errorsmod.Wrapf(
+				errortypes.ErrorInvalidSigner,
+				"not contract owner: expected %s instead of %s", owner, deployer,
+			)
+		}
+	case types.OWNERSHIP_PROOF_EIP1967_ADMIN:
+		admin := common.BytesToAddress(k.evmKeeper.GetState(ctx, contract, types.EIP1967AdminSlot).Bytes())
+		if admin != deployer {
+			return errorsmod.Wrapf(
+				errortypes.ErrorInvalidSigner,
+				"not proxy admin: expected %s instead of %s", admin, deployer,
+			)
+		}
+	default:
+		derivedContract := deriveContractAddress(ctx, params, msg, deployer)
+		if contract != derivedContract {
+			return errorsmod.Wrapf(
+				errortypes.ErrorInvalidSigner,
+				"not contract deployer or wrong nonce: expected %s instead of %s",
+				derivedContract, msg.ContractAddress,
+			)
+		}
+	}
+
+	return nil
+}
+
+// deriveContractAddress derives the address of the contract created from the
+// deployer address through the nonces of the message and, if set, its CREATE2
+// salt and init code hash.
+func deriveContractAddress(
+	ctx sdk.Context,
+	params types.Params,
+	msg *types.MsgRegisterRevenue,
+	deployer common.Address,
+) common.Address {
+	derivedContract := deployer
+
+	// the contract can be directly deployed by an EOA or created through one
+	// or more factory contracts. If it was deployed by an EOA account, then
+	// msg.Nonces contains the EOA nonce for the deployment transaction.
+	// If it was deployed by one or more factories, msg.Nonces contains the EOA
+	// nonce for the origin factory contract, then the nonce of the factory
+	// for the creation of the next factory/contract.
+	for _, nonce := range msg.Nonces {
+		ctx.GasMeter().ConsumeGas(
+			params.AddrDerivationCostCreate,
+			"revenue registration: address derivation CREATE opcode",
+		)
+
+		derivedContract = crypto.CreateAddress(derivedContract, nonce)
+	}
+
+	if msg.Create2Salt == "" {
+		return derivedContract
+	}
+
+	// the last derived address is the factory that deployed the contract with
+	// the CREATE2 opcode. The parameters are checked on ValidateBasic.
+	ctx.GasMeter().ConsumeGas(
+		params.AddrDerivationCostCreate,
+		"revenue registration: address derivation CREATE2 opcode",
+	)
+
+	salt, _ := types.ParseHash(msg.Create2Salt)
+	initCodeHash, _ := types.ParseHash(msg.Create2InitCodeHash)
+
+	return crypto.CreateAddress2(derivedContract, salt, initCodeHash.Bytes())
+}
+
+// getEIP173Owner calls the EIP-173 owner() method of the contract without
+// committing any state changes. The gas used by the call is consumed from the
+// transaction gas meter.
+func (k Keeper) getEIP173Owner(ctx sdk.Context, contract, from common.Address) (common.Address, error) {
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		0,
+		big.NewInt(0),                // amount
+		types.OwnershipProofGasLimit, // gasLimit
+		big.NewInt(0),                // gasFeeCap
+		big.NewInt(0),                // gasTipCap
+		big.NewInt(0),                // gasPrice
+		types.EIP173OwnerSelector,
+		ethtypes.AccessList{}, // AccessList
+		true,                  // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), false)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "revenue registration: EIP-173 owner call")
+
+	if res.Failed() {
+		return common.Address{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"EIP-173 owner call failed on contract %s: %s", contract, res.VmError,
+		)
+	}
+
+	if len(res.Ret) != common.HashLength {
+		return common.Address{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"invalid EIP-173 owner return data on contract %s", contract,
+		)
+	}
+
+	return common.BytesToAddress(res.Ret), nil
+}
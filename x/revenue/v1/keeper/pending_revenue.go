@@ -0,0 +1,129 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v12/x/revenue/v1/types"
+)
+
+// GetPendingRevenues returns the accrued revenues of all withdrawers.
+func (k Keeper) GetPendingRevenues(ctx sdk.Context) []types.PendingRevenue {
+	pendingRevenues := []types.PendingRevenue{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPendingRevenue)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingRevenue
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+
+		pendingRevenues = append(pendingRevenues, pending)
+	}
+
+	return pendingRevenues
+}
+
+// GetPendingRevenue returns the accrued revenue of a withdrawer that hasn't
+// been withdrawn yet
+func (k Keeper) GetPendingRevenue(ctx sdk.Context, withdrawer sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRevenue)
+	bz := store.Get(withdrawer.Bytes())
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var pending types.PendingRevenue
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending.Amount
+}
+
+// SetPendingRevenue stores the accrued revenue of a withdrawer. The entry is
+// deleted if the amount is zero.
+func (k Keeper) SetPendingRevenue(ctx sdk.Context, withdrawer sdk.AccAddress, amount sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRevenue)
+	if amount.IsZero() {
+		store.Delete(withdrawer.Bytes())
+		return
+	}
+
+	pending := types.PendingRevenue{
+		WithdrawerAddress: withdrawer.String(),
+		Amount:            amount,
+	}
+	store.Set(withdrawer.Bytes(), k.cdc.MustMarshal(&pending))
+}
+
+// accrueRevenue adds the given fee to the pending revenue of a withdrawer and
+// to the revenue accrued in the current block, which is moved from the fee
+// collector to the module account at the end of the block.
+func (k Keeper) accrueRevenue(ctx sdk.Context, withdrawer sdk.AccAddress, fee sdk.Coin) {
+	pending := k.GetPendingRevenue(ctx, withdrawer)
+	k.SetPendingRevenue(ctx, withdrawer, pending.Add(fee))
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRevenue)
+	accrued := k.getAccruedRevenue(ctx).AmountOf(fee.Denom).Add(fee.Amount)
+	bz, err := accrued.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(fee.Denom), bz)
+}
+
+// getAccruedRevenue returns the revenue accrued in the current block that is
+// still held by the fee collector
+func (k Keeper) getAccruedRevenue(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRevenue)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	accrued := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		accrued = accrued.Add(sdk.Coin{Denom: string(iterator.Key()), Amount: amount})
+	}
+
+	return accrued
+}
+
+// SweepAccruedRevenue moves the revenue accrued since the last sweep from the
+// fee collector to the module account, where it is held until the withdrawers
+// withdraw it. Accruing only updates the store, so that the developer shares
+// of a block are transferred at once instead of on every transaction.
+func (k Keeper) SweepAccruedRevenue(ctx sdk.Context) error {
+	accrued := k.getAccruedRevenue(ctx)
+	if accrued.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, accrued); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRevenue)
+	for _, coin := range accrued {
+		store.Delete([]byte(coin.Denom))
+	}
+
+	return nil
+}
@@ -137,6 +137,44 @@ func (k Keeper) DeleteWithdrawerMap(
 	store.Delete(key)
 }
 
+// SetWithdrawerMaps stores the contract-by-withdrawer mappings for all the
+// withdrawers of a revenue that differ from the default (deployer) withdrawer
+func (k Keeper) SetWithdrawerMaps(ctx sdk.Context, revenue types.Revenue) {
+	contract := revenue.GetContractAddr()
+	for _, withdrawer := range mappedWithdrawers(revenue) {
+		k.SetWithdrawerMap(ctx, withdrawer, contract)
+	}
+}
+
+// DeleteWithdrawerMaps deletes the contract-by-withdrawer mappings for all the
+// withdrawers of a revenue
+func (k Keeper) DeleteWithdrawerMaps(ctx sdk.Context, revenue types.Revenue) {
+	contract := revenue.GetContractAddr()
+	for _, withdrawer := range mappedWithdrawers(revenue) {
+		k.DeleteWithdrawerMap(ctx, withdrawer, contract)
+	}
+}
+
+// mappedWithdrawers returns the withdrawers of a revenue that are indexed in
+// the contract-by-withdrawer mapping, i.e. the weighted withdrawers or the
+// withdraw address if set
+func mappedWithdrawers(revenue types.Revenue) []sdk.AccAddress {
+	if len(revenue.Withdrawers) == 0 {
+		withdrawer := revenue.GetWithdrawerAddr()
+		if len(withdrawer) == 0 {
+			return nil
+		}
+		return []sdk.AccAddress{withdrawer}
+	}
+
+	withdrawers := make([]sdk.AccAddress, len(revenue.Withdrawers))
+	for i, split := range revenue.Withdrawers {
+		withdrawers[i] = sdk.MustAccAddressFromBech32(split.WithdrawerAddress)
+	}
+
+	return withdrawers
+}
+
 // IsRevenueRegistered checks if a contract was registered for receiving
 // transaction fees
 func (k Keeper) IsRevenueRegistered(
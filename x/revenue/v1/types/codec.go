@@ -43,6 +43,7 @@ const (
 	registerRevenueName = "evmos/MsgRegisterRevenue"
 	updateRevenueName   = "evmos/MsgUpdateRevenue"
 	updateParamsName    = "evmos/MsgUpdateParams"
+	withdrawRevenueName = "evmos/MsgWithdrawRevenue"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -59,6 +60,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCancelRevenue{},
 		&MsgUpdateRevenue{},
 		&MsgUpdateParams{},
+		&MsgWithdrawRevenue{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -72,4 +74,5 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelRevenue{}, cancelRevenueName, nil)
 	cdc.RegisterConcrete(&MsgRegisterRevenue{}, registerRevenueName, nil)
 	cdc.RegisterConcrete(&MsgUpdateRevenue{}, updateRevenueName, nil)
+	cdc.RegisterConcrete(&MsgWithdrawRevenue{}, withdrawRevenueName, nil)
 }
@@ -6,7 +6,10 @@ const (
 	EventTypeCancelRevenue        = "cancel_revenue"
 	EventTypeUpdateRevenue        = "update_revenue"
 	EventTypeDistributeDevRevenue = "distribute_dev_revenue"
+	EventTypeAccrueDevRevenue     = "accrue_dev_revenue"
+	EventTypeWithdrawRevenue      = "withdraw_revenue"
 
 	AttributeKeyContract          = "contract"
 	AttributeKeyWithdrawerAddress = "withdrawer_address"
+	AttributeKeyWithdrawers       = "withdrawers"
 )
@@ -51,5 +51,18 @@ func (gs GenesisState) Validate() error {
 		seenContract[fs.ContractAddress] = true
 	}
 
+	seenWithdrawer := make(map[string]bool)
+	for _, pending := range gs.PendingRevenues {
+		if seenWithdrawer[pending.WithdrawerAddress] {
+			return fmt.Errorf("pending revenue withdrawer duplicated on genesis '%s'", pending.WithdrawerAddress)
+		}
+
+		if err := pending.Validate(); err != nil {
+			return err
+		}
+
+		seenWithdrawer[pending.WithdrawerAddress] = true
+	}
+
 	return gs.Params.Validate()
 }
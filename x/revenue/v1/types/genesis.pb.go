@@ -24,12 +24,53 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RevenueAttribution enumerates the ways of attributing the developer shares of
+// a transaction to the registered contracts
+type RevenueAttribution int32
+
+const (
+	// REVENUE_ATTRIBUTION_TOP_LEVEL attributes the developer shares to the
+	// contract called by the transaction
+	REVENUE_ATTRIBUTION_TOP_LEVEL RevenueAttribution = 0
+	// REVENUE_ATTRIBUTION_CALL_TREE_GAS splits the developer shares across the
+	// registered contracts executed in the call tree of the transaction, weighted
+	// by the gas consumed by their code
+	REVENUE_ATTRIBUTION_CALL_TREE_GAS RevenueAttribution = 1
+	// REVENUE_ATTRIBUTION_CALL_TREE_EQUAL splits the developer shares equally
+	// across the registered contracts executed in the call tree of the
+	// transaction
+	REVENUE_ATTRIBUTION_CALL_TREE_EQUAL RevenueAttribution = 2
+)
+
+var RevenueAttribution_name = map[int32]string{
+	0: "REVENUE_ATTRIBUTION_TOP_LEVEL",
+	1: "REVENUE_ATTRIBUTION_CALL_TREE_GAS",
+	2: "REVENUE_ATTRIBUTION_CALL_TREE_EQUAL",
+}
+
+var RevenueAttribution_value = map[string]int32{
+	"REVENUE_ATTRIBUTION_TOP_LEVEL":       0,
+	"REVENUE_ATTRIBUTION_CALL_TREE_GAS":   1,
+	"REVENUE_ATTRIBUTION_CALL_TREE_EQUAL": 2,
+}
+
+func (x RevenueAttribution) String() string {
+	return proto.EnumName(RevenueAttribution_name, int32(x))
+}
+
+func (RevenueAttribution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_649d64d9c3438055, []int{0}
+}
+
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
 	// params are the revenue module parameters
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// revenues is a slice of active registered contracts for fee distribution
 	Revenues []Revenue `protobuf:"bytes,2,rep,name=revenues,proto3" json:"revenues"`
+	// pending_revenues is a slice of the accrued revenues that haven't been
+	// withdrawn yet
+	PendingRevenues []PendingRevenue `protobuf:"bytes,3,rep,name=pending_revenues,json=pendingRevenues,proto3" json:"pending_revenues"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +120,13 @@ func (m *GenesisState) GetRevenues() []Revenue {
 	return nil
 }
 
+func (m *GenesisState) GetPendingRevenues() []PendingRevenue {
+	if m != nil {
+		return m.PendingRevenues
+	}
+	return nil
+}
+
 // Params defines the revenue module params
 type Params struct {
 	// enable_revenue defines a parameter to enable the revenue module
@@ -89,6 +137,13 @@ type Params struct {
 	// addr_derivation_cost_create defines the cost of address derivation for
 	// verifying the contract deployer at fee registration
 	AddrDerivationCostCreate uint64 `protobuf:"varint,3,opt,name=addr_derivation_cost_create,json=addrDerivationCostCreate,proto3" json:"addr_derivation_cost_create,omitempty"`
+	// attribution defines how the developer shares of a transaction are
+	// attributed to the registered contracts
+	Attribution RevenueAttribution `protobuf:"varint,4,opt,name=attribution,proto3,enum=evmos.revenue.v1.RevenueAttribution" json:"attribution,omitempty"`
+	// accrue_revenue defines a parameter to accrue the developer shares in the
+	// module account, to be withdrawn by the withdrawers, instead of sending
+	// them to the withdrawers on every transaction
+	AccrueRevenue bool `protobuf:"varint,5,opt,name=accrue_revenue,json=accrueRevenue,proto3" json:"accrue_revenue,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -138,7 +193,22 @@ func (m *Params) GetAddrDerivationCostCreate() uint64 {
 	return 0
 }
 
+func (m *Params) GetAttribution() RevenueAttribution {
+	if m != nil {
+		return m.Attribution
+	}
+	return REVENUE_ATTRIBUTION_TOP_LEVEL
+}
+
+func (m *Params) GetAccrueRevenue() bool {
+	if m != nil {
+		return m.AccrueRevenue
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("evmos.revenue.v1.RevenueAttribution", RevenueAttribution_name, RevenueAttribution_value)
 	proto.RegisterType((*GenesisState)(nil), "evmos.revenue.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.revenue.v1.Params")
 }
@@ -146,29 +216,39 @@ func init() {
 func init() { proto.RegisterFile("evmos/revenue/v1/genesis.proto", fileDescriptor_649d64d9c3438055) }
 
 var fileDescriptor_649d64d9c3438055 = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x6a, 0xc2, 0x40,
-	0x10, 0xc6, 0xb3, 0x55, 0xc4, 0xae, 0xfd, 0x23, 0xa1, 0x87, 0xd4, 0x42, 0x14, 0xa1, 0x25, 0x17,
-	0x37, 0x68, 0xa1, 0x97, 0xd2, 0x8b, 0x0a, 0x5e, 0x4b, 0x3c, 0xb5, 0x97, 0xb0, 0x26, 0x43, 0x0c,
-	0xd5, 0x6c, 0xd8, 0x5d, 0x43, 0x7b, 0xee, 0x0b, 0xf4, 0xb1, 0xec, 0xcd, 0x63, 0xe9, 0x41, 0x8a,
-	0xbe, 0x48, 0xc9, 0x6e, 0x2a, 0x52, 0x2f, 0xc9, 0x30, 0xdf, 0xf7, 0xfd, 0x66, 0xd8, 0xc1, 0x36,
-	0x64, 0x73, 0x26, 0x5c, 0x0e, 0x19, 0x24, 0x0b, 0x70, 0xb3, 0xae, 0x1b, 0x41, 0x02, 0x22, 0x16,
-	0x24, 0xe5, 0x4c, 0x32, 0xb3, 0xae, 0x74, 0x52, 0xe8, 0x24, 0xeb, 0x36, 0x0e, 0x13, 0x7f, 0xa2,
-	0x4a, 0x34, 0x2e, 0x22, 0x16, 0x31, 0x55, 0xba, 0x79, 0xa5, 0xbb, 0xed, 0x77, 0x84, 0x4f, 0x46,
-	0x9a, 0x3c, 0x96, 0x54, 0x82, 0x79, 0x87, 0x2b, 0x29, 0xe5, 0x74, 0x2e, 0x2c, 0xd4, 0x42, 0x4e,
-	0xad, 0x67, 0x91, 0xff, 0x93, 0xc8, 0xa3, 0xd2, 0xfb, 0xe5, 0xe5, 0xba, 0x69, 0x78, 0x85, 0xdb,
-	0xbc, 0xc7, 0xd5, 0xc2, 0x22, 0xac, 0xa3, 0x56, 0xc9, 0xa9, 0xf5, 0x2e, 0x0f, 0x93, 0x9e, 0x2e,
-	0x8b, 0xe8, 0x2e, 0xd0, 0xfe, 0x44, 0xb8, 0xa2, 0xa9, 0xe6, 0x35, 0x3e, 0x83, 0x84, 0x4e, 0x66,
-	0xe0, 0x17, 0xaa, 0xda, 0xa3, 0xea, 0x9d, 0xea, 0x6e, 0x41, 0x30, 0x9f, 0x70, 0x3d, 0x84, 0x0c,
-	0x66, 0x2c, 0x05, 0xee, 0x8b, 0x29, 0xe5, 0x6a, 0x2c, 0x72, 0x8e, 0xfb, 0x24, 0x67, 0x7f, 0xaf,
-	0x9b, 0x37, 0x51, 0x2c, 0xa7, 0x8b, 0x09, 0x09, 0xd8, 0xdc, 0x0d, 0x98, 0xc8, 0xdf, 0x46, 0xff,
-	0x3a, 0x22, 0x7c, 0x71, 0xe5, 0x5b, 0x0a, 0x82, 0x0c, 0x21, 0xf0, 0xce, 0x77, 0x9c, 0xb1, 0xc2,
-	0x98, 0x0f, 0xf8, 0x8a, 0x86, 0x21, 0xf7, 0x43, 0xe0, 0x71, 0x46, 0x65, 0xcc, 0x12, 0x3f, 0x60,
-	0x42, 0xfa, 0x01, 0x07, 0x2a, 0xc1, 0x2a, 0xb5, 0x90, 0x53, 0xf6, 0xac, 0xdc, 0x32, 0xdc, 0x39,
-	0x06, 0x4c, 0xc8, 0x81, 0xd2, 0xfb, 0xa3, 0xe5, 0xc6, 0x46, 0xab, 0x8d, 0x8d, 0x7e, 0x36, 0x36,
-	0xfa, 0xd8, 0xda, 0xc6, 0x6a, 0x6b, 0x1b, 0x5f, 0x5b, 0xdb, 0x78, 0xee, 0xec, 0x6d, 0xa4, 0x8f,
-	0xa5, 0xbf, 0x59, 0xb7, 0xe7, 0xbe, 0xee, 0x1f, 0x4e, 0x2d, 0x37, 0xa9, 0xa8, 0x0b, 0xdd, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x09, 0xb6, 0x09, 0xf2, 0x0b, 0x02, 0x00, 0x00,
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x8f, 0xdb, 0x52, 0x0d, 0x17, 0xb6, 0xc8, 0xe2, 0x10, 0x8a, 0xc8, 0xba, 0xc1, 0xa0, 0x42,
+	0x5a, 0xa2, 0x16, 0x89, 0x0b, 0xe2, 0xd0, 0x76, 0xa1, 0x9a, 0x54, 0xed, 0x4f, 0xda, 0x4e, 0x82,
+	0x8b, 0xe5, 0x26, 0x56, 0x17, 0xb1, 0xc6, 0x91, 0xed, 0x46, 0xf0, 0x06, 0x5c, 0x90, 0x78, 0x07,
+	0x5e, 0x82, 0x47, 0xd8, 0x71, 0x27, 0x84, 0x38, 0x4c, 0xa8, 0x7d, 0x11, 0x14, 0x3b, 0xeb, 0x3a,
+	0x3a, 0xb8, 0x24, 0x9f, 0xbe, 0xdf, 0x9f, 0x2f, 0xfe, 0xf9, 0x0b, 0xb4, 0x69, 0x3a, 0x61, 0xc2,
+	0xe5, 0x34, 0xa5, 0xf1, 0x94, 0xba, 0x69, 0xc3, 0x1d, 0xd3, 0x98, 0x8a, 0x48, 0x38, 0x09, 0x67,
+	0x92, 0x21, 0x53, 0xe1, 0x4e, 0x8e, 0x3b, 0x69, 0xa3, 0xba, 0xaa, 0xb8, 0x02, 0x95, 0xa2, 0xfa,
+	0x60, 0xcc, 0xc6, 0x4c, 0x95, 0x6e, 0x56, 0xe9, 0xee, 0xf6, 0x0f, 0x00, 0xef, 0x75, 0xb5, 0x73,
+	0x5f, 0x12, 0x49, 0xd1, 0x2b, 0x58, 0x4e, 0x08, 0x27, 0x13, 0x61, 0x81, 0x1a, 0xa8, 0x57, 0x9a,
+	0x96, 0xf3, 0xf7, 0x24, 0xe7, 0x48, 0xe1, 0xed, 0xd2, 0xf9, 0xe5, 0xa6, 0xe1, 0xe7, 0x6c, 0xf4,
+	0x1a, 0xae, 0xe5, 0x14, 0x61, 0x15, 0x6a, 0xc5, 0x7a, 0xa5, 0xf9, 0x70, 0x55, 0xe9, 0xeb, 0x32,
+	0x97, 0x2e, 0x04, 0xe8, 0x18, 0x9a, 0x09, 0x8d, 0xc3, 0x28, 0x1e, 0xe3, 0x85, 0x49, 0x51, 0x99,
+	0xd4, 0x6e, 0x19, 0xaf, 0x99, 0x37, 0xbd, 0x36, 0x92, 0x1b, 0x5d, 0xb1, 0xfd, 0xbd, 0x00, 0xcb,
+	0xfa, 0x43, 0xd1, 0x0e, 0x5c, 0xa7, 0x31, 0x19, 0x9d, 0xd1, 0x2b, 0x73, 0x75, 0xb4, 0x35, 0xff,
+	0xbe, 0xee, 0xe6, 0x12, 0xf4, 0x0e, 0x9a, 0x21, 0x4d, 0xe9, 0x19, 0x4b, 0x28, 0xc7, 0xe2, 0x94,
+	0x70, 0x75, 0x12, 0x50, 0xbf, 0xdb, 0x76, 0xb2, 0x11, 0xbf, 0x2e, 0x37, 0x9f, 0x8d, 0x23, 0x79,
+	0x3a, 0x1d, 0x39, 0x01, 0x9b, 0xb8, 0x01, 0x13, 0x59, 0xdc, 0xfa, 0xb5, 0x2b, 0xc2, 0x0f, 0xae,
+	0xfc, 0x94, 0x50, 0xe1, 0xec, 0xd1, 0xc0, 0xdf, 0x58, 0xf8, 0xf4, 0x95, 0x0d, 0x7a, 0x03, 0x1f,
+	0x91, 0x30, 0xe4, 0x38, 0xa4, 0x3c, 0x4a, 0x89, 0x8c, 0x58, 0x8c, 0x03, 0x26, 0x24, 0x0e, 0x38,
+	0x25, 0x92, 0x5a, 0xc5, 0x1a, 0xa8, 0x97, 0x7c, 0x2b, 0xa3, 0xec, 0x2d, 0x18, 0x1d, 0x26, 0x64,
+	0x47, 0xe1, 0xe8, 0x2d, 0xac, 0x10, 0x29, 0x79, 0x34, 0x9a, 0x66, 0x80, 0x55, 0xaa, 0x81, 0xfa,
+	0x7a, 0xf3, 0xe9, 0x3f, 0xe3, 0x6d, 0x5d, 0x73, 0xfd, 0x65, 0x61, 0x16, 0x04, 0x09, 0x02, 0x3e,
+	0xbd, 0x0e, 0xe2, 0x8e, 0x0e, 0x42, 0x77, 0x73, 0xf9, 0x8b, 0x2f, 0x00, 0xa2, 0x55, 0x2b, 0xb4,
+	0x05, 0x1f, 0xfb, 0xde, 0x89, 0x77, 0x30, 0xf4, 0x70, 0x6b, 0x30, 0xf0, 0xf7, 0xdb, 0xc3, 0xc1,
+	0xfe, 0xe1, 0x01, 0x1e, 0x1c, 0x1e, 0xe1, 0x9e, 0x77, 0xe2, 0xf5, 0x4c, 0x03, 0xed, 0xc0, 0xad,
+	0xdb, 0x28, 0x9d, 0x56, 0xaf, 0x87, 0x07, 0xbe, 0xe7, 0xe1, 0x6e, 0xab, 0x6f, 0x02, 0xf4, 0x1c,
+	0x3e, 0xf9, 0x3f, 0xcd, 0x3b, 0x1e, 0xb6, 0x7a, 0x66, 0xa1, 0x5a, 0xfa, 0xfc, 0xcd, 0x36, 0xda,
+	0xdd, 0xf3, 0x99, 0x0d, 0x2e, 0x66, 0x36, 0xf8, 0x3d, 0xb3, 0xc1, 0xd7, 0xb9, 0x6d, 0x5c, 0xcc,
+	0x6d, 0xe3, 0xe7, 0xdc, 0x36, 0xde, 0xef, 0x2e, 0x5d, 0x88, 0x5e, 0x7f, 0xfd, 0x4c, 0x1b, 0x4d,
+	0xf7, 0xe3, 0xf2, 0xaf, 0xa0, 0xee, 0x66, 0x54, 0x56, 0x3b, 0xff, 0xf2, 0xcf, 0x00, 0x9f, 0x82,
+	0xd9, 0xa1, 0x5d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -191,6 +271,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingRevenues) > 0 {
+		for iNdEx := len(m.PendingRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Revenues) > 0 {
 		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -238,6 +332,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AccrueRevenue {
+		i--
+		if m.AccrueRevenue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attribution != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Attribution))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.AddrDerivationCostCreate != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.AddrDerivationCostCreate))
 		i--
@@ -291,6 +400,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingRevenues) > 0 {
+		for _, e := range m.PendingRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -308,6 +423,12 @@ func (m *Params) Size() (n int) {
 	if m.AddrDerivationCostCreate != 0 {
 		n += 1 + sovGenesis(uint64(m.AddrDerivationCostCreate))
 	}
+	if m.Attribution != 0 {
+		n += 1 + sovGenesis(uint64(m.Attribution))
+	}
+	if m.AccrueRevenue {
+		n += 2
+	}
 	return n
 }
 
@@ -413,6 +534,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRevenues = append(m.PendingRevenues, PendingRevenue{})
+			if err := m.PendingRevenues[len(m.PendingRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -536,6 +691,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribution", wireType)
+			}
+			m.Attribution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attribution |= RevenueAttribution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccrueRevenue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccrueRevenue = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -160,6 +160,48 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with pending revenues",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingRevenues: []types.PendingRevenue{
+					{
+						WithdrawerAddress: suite.address1,
+						Amount:            sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated pending revenue",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingRevenues: []types.PendingRevenue{
+					{
+						WithdrawerAddress: suite.address1,
+						Amount:            sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+					{
+						WithdrawerAddress: suite.address1,
+						Amount:            sdk.NewCoins(sdk.NewInt64Coin("aevmos", 200)),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - empty pending revenue",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingRevenues: []types.PendingRevenue{
+					{
+						WithdrawerAddress: suite.address1,
+					},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
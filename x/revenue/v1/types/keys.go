@@ -35,6 +35,8 @@ const (
 	prefixRevenue = iota + 1
 	prefixDeployer
 	prefixWithdrawer
+	prefixPendingRevenue
+	prefixAccruedRevenue
 )
 
 // KVStore key prefixes
@@ -42,6 +44,11 @@ var (
 	KeyPrefixRevenue    = []byte{prefixRevenue}
 	KeyPrefixDeployer   = []byte{prefixDeployer}
 	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
+	// KeyPrefixPendingRevenue stores the accrued revenue of each withdrawer
+	KeyPrefixPendingRevenue = []byte{prefixPendingRevenue}
+	// KeyPrefixAccruedRevenue stores the revenue accrued in the current block,
+	// which is still held by the fee collector
+	KeyPrefixAccruedRevenue = []byte{prefixAccruedRevenue}
 )
 
 // GetKeyPrefixDeployer returns the KVStore key prefix for storing
@@ -29,12 +29,14 @@ var (
 	_ sdk.Msg = &MsgCancelRevenue{}
 	_ sdk.Msg = &MsgUpdateRevenue{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgWithdrawRevenue{}
 )
 
 const (
 	TypeMsgRegisterRevenue = "register_revenue"
 	TypeMsgCancelRevenue   = "cancel_revenue"
 	TypeMsgUpdateRevenue   = "update_revenue"
+	TypeMsgWithdrawRevenue = "withdraw_revenue"
 )
 
 // NewMsgRegisterRevenue creates new instance of MsgRegisterRevenue
@@ -79,6 +81,29 @@ func (msg MsgRegisterRevenue) ValidateBasic() error {
 		}
 	}
 
+	if err := validateMsgWithdrawers(msg.WithdrawerAddress, msg.Withdrawers); err != nil {
+		return err
+	}
+
+	switch msg.OwnershipProof {
+	case OWNERSHIP_PROOF_ADDRESS_DERIVATION:
+		return msg.validateAddressDerivation()
+	case OWNERSHIP_PROOF_EIP173_OWNER, OWNERSHIP_PROOF_EIP1967_ADMIN:
+		if len(msg.Nonces) > 0 || msg.Create2Salt != "" || msg.Create2InitCodeHash != "" {
+			return errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"nonces and CREATE2 parameters must be empty for ownership proof %s", msg.OwnershipProof,
+			)
+		}
+		return nil
+	default:
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid ownership proof %d", msg.OwnershipProof)
+	}
+}
+
+// validateAddressDerivation checks the nonces and the optional CREATE2
+// parameters used to derive the contract address from the deployer address
+func (msg MsgRegisterRevenue) validateAddressDerivation() error {
 	if len(msg.Nonces) < 1 {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid nonces - empty array")
 	}
@@ -87,6 +112,18 @@ func (msg MsgRegisterRevenue) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid nonces - array length must be less than 20")
 	}
 
+	if msg.Create2Salt == "" && msg.Create2InitCodeHash == "" {
+		return nil
+	}
+
+	if _, err := ParseHash(msg.Create2Salt); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid CREATE2 salt %s: %s", msg.Create2Salt, err)
+	}
+
+	if _, err := ParseHash(msg.Create2InitCodeHash); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid CREATE2 init code hash %s: %s", msg.Create2InitCodeHash, err)
+	}
+
 	return nil
 }
 
@@ -171,6 +208,10 @@ func (msg MsgUpdateRevenue) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
+	if len(msg.Withdrawers) > 0 {
+		return validateMsgWithdrawers(msg.WithdrawerAddress, msg.Withdrawers)
+	}
+
 	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
 		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
 	}
@@ -178,6 +219,20 @@ func (msg MsgUpdateRevenue) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgUpdateRevenueWithdrawers creates new instance of MsgUpdateRevenue that
+// sets weighted withdrawers for the revenue
+func NewMsgUpdateRevenueWithdrawers(
+	contract common.Address,
+	deployer sdk.AccAddress,
+	withdrawers []WithdrawerSplit,
+) *MsgUpdateRevenue {
+	return &MsgUpdateRevenue{
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+		Withdrawers:     withdrawers,
+	}
+}
+
 // GetSignBytes encodes the message for signing
 func (msg *MsgUpdateRevenue) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
@@ -189,6 +244,60 @@ func (msg MsgUpdateRevenue) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// NewMsgWithdrawRevenue creates new instance of MsgWithdrawRevenue
+func NewMsgWithdrawRevenue(withdrawer sdk.AccAddress) *MsgWithdrawRevenue {
+	return &MsgWithdrawRevenue{
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgWithdrawRevenue) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgWithdrawRevenue) Type() string { return TypeMsgWithdrawRevenue }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgWithdrawRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawRevenue) GetSigners() []sdk.AccAddress {
+	from := sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+	return []sdk.AccAddress{from}
+}
+
+// validateMsgWithdrawers checks that a single withdraw address and weighted
+// withdrawers aren't both provided, and that the weighted withdrawers are valid
+func validateMsgWithdrawers(withdrawer string, withdrawers []WithdrawerSplit) error {
+	if len(withdrawers) == 0 {
+		return nil
+	}
+
+	if withdrawer != "" {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"withdraw address %s cannot be set together with weighted withdrawers", withdrawer,
+		)
+	}
+
+	if err := ValidateWithdrawerSplits(withdrawers); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.Authority)
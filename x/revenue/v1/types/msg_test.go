@@ -292,3 +292,187 @@ func (suite *MsgsTestSuite) TestMsgUpdateRevenueNew() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgWithdrawersValidateBasic() {
+	splits := []types.WithdrawerSplit{
+		{WithdrawerAddress: suite.deployerStr, Weight: 4000},
+		{WithdrawerAddress: suite.withdrawerStr, Weight: 6000},
+	}
+	testCases := []struct {
+		msg         string
+		withdraw    string
+		withdrawers []types.WithdrawerSplit
+		expectPass  bool
+	}{
+		{
+			"weighted withdrawers - pass",
+			"",
+			splits,
+			true,
+		},
+		{
+			"cannot be set together with weighted withdrawers",
+			suite.withdrawerStr,
+			splits,
+			false,
+		},
+		{
+			"withdrawer weights must add up to 10000",
+			"",
+			[]types.WithdrawerSplit{{WithdrawerAddress: suite.withdrawerStr, Weight: 9999}},
+			false,
+		},
+		{
+			"duplicate withdraw address",
+			"",
+			[]types.WithdrawerSplit{
+				{WithdrawerAddress: suite.withdrawerStr, Weight: 5000},
+				{WithdrawerAddress: suite.withdrawerStr, Weight: 5000},
+			},
+			false,
+		},
+		{
+			"too many withdrawers",
+			"",
+			make([]types.WithdrawerSplit, types.MaxWithdrawerSplits+1),
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msgs := []sdk.Msg{
+			&types.MsgRegisterRevenue{
+				ContractAddress:   suite.contract.String(),
+				DeployerAddress:   suite.deployerStr,
+				WithdrawerAddress: tc.withdraw,
+				Nonces:            []uint64{1},
+				Withdrawers:       tc.withdrawers,
+			},
+			&types.MsgUpdateRevenue{
+				ContractAddress:   suite.contract.String(),
+				DeployerAddress:   suite.deployerStr,
+				WithdrawerAddress: tc.withdraw,
+				Withdrawers:       tc.withdrawers,
+			},
+		}
+
+		for _, msg := range msgs {
+			err := msg.ValidateBasic()
+
+			if tc.expectPass {
+				suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+			} else {
+				suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+				suite.Require().Contains(err.Error(), tc.msg)
+			}
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgWithdrawRevenue() {
+	msg := types.NewMsgWithdrawRevenue(suite.deployer)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgWithdrawRevenue, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{suite.deployer}, msg.GetSigners())
+	suite.Require().NoError(msg.ValidateBasic())
+
+	msg.WithdrawerAddress = "withdraw"
+	suite.Require().Error(msg.ValidateBasic())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterRevenueOwnershipProof() {
+	hash := common.HexToHash("0x01").Hex()
+	testCases := []struct {
+		msg          string
+		proof        types.OwnershipProof
+		nonces       []uint64
+		salt         string
+		initCodeHash string
+		expectPass   bool
+	}{
+		{
+			"address derivation with CREATE2 - pass",
+			types.OWNERSHIP_PROOF_ADDRESS_DERIVATION,
+			[]uint64{1},
+			hash,
+			hash,
+			true,
+		},
+		{
+			"invalid CREATE2 salt",
+			types.OWNERSHIP_PROOF_ADDRESS_DERIVATION,
+			[]uint64{1},
+			"0x01",
+			hash,
+			false,
+		},
+		{
+			"invalid CREATE2 init code hash",
+			types.OWNERSHIP_PROOF_ADDRESS_DERIVATION,
+			[]uint64{1},
+			hash,
+			"",
+			false,
+		},
+		{
+			"invalid nonces - empty array",
+			types.OWNERSHIP_PROOF_ADDRESS_DERIVATION,
+			nil,
+			hash,
+			hash,
+			false,
+		},
+		{
+			"EIP-173 owner - pass",
+			types.OWNERSHIP_PROOF_EIP173_OWNER,
+			nil,
+			"",
+			"",
+			true,
+		},
+		{
+			"EIP-1967 admin - pass",
+			types.OWNERSHIP_PROOF_EIP1967_ADMIN,
+			nil,
+			"",
+			"",
+			true,
+		},
+		{
+			"nonces and CREATE2 parameters must be empty",
+			types.OWNERSHIP_PROOF_EIP173_OWNER,
+			[]uint64{1},
+			"",
+			"",
+			false,
+		},
+		{
+			"invalid ownership proof",
+			types.OwnershipProof(3),
+			[]uint64{1},
+			"",
+			"",
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgRegisterRevenue{
+			ContractAddress:     suite.contract.String(),
+			DeployerAddress:     suite.deployerStr,
+			Nonces:              tc.nonces,
+			OwnershipProof:      tc.proof,
+			Create2Salt:         tc.salt,
+			Create2InitCodeHash: tc.initCodeHash,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// OwnershipProofGasLimit is the gas limit of the EIP-173 owner() call used to
+// prove the ownership of a contract
+const OwnershipProofGasLimit = uint64(100_000)
+
+var (
+	// EIP173OwnerSelector is the method selector of the EIP-173 owner() method
+	EIP173OwnerSelector = crypto.Keccak256([]byte("owner()"))[:4]
+	// EIP1967AdminSlot is the storage slot of the admin of an EIP-1967 proxy,
+	// i.e. bytes32(uint256(keccak256('eip1967.proxy.admin')) - 1)
+	EIP1967AdminSlot = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+)
+
+// ParseHash decodes a 0x prefixed, hex encoded 32 byte value such as a CREATE2
+// salt or init code hash
+func ParseHash(value string) (common.Hash, error) {
+	bz, err := hexutil.Decode(value)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if len(bz) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid length %d, expected %d bytes", len(bz), common.HashLength)
+	}
+
+	return common.BytesToHash(bz), nil
+}
@@ -29,6 +29,8 @@ var (
 	// DefaultAddrDerivationCostCreate Cost for executing `crypto.CreateAddress` must be at least 36 gas for the
 	// contained keccak256(word) operation
 	DefaultAddrDerivationCostCreate = uint64(50)
+	DefaultAttribution              = REVENUE_ATTRIBUTION_TOP_LEVEL
+	DefaultAccrueRevenue            = false
 )
 
 var (
@@ -43,11 +45,15 @@ func NewParams(
 	enableRevenue bool,
 	developerShares sdk.Dec,
 	addrDerivationCostCreate uint64,
+	attribution RevenueAttribution,
+	accrueRevenue bool,
 ) Params {
 	return Params{
 		EnableRevenue:            enableRevenue,
 		DeveloperShares:          developerShares,
 		AddrDerivationCostCreate: addrDerivationCostCreate,
+		Attribution:              attribution,
+		AccrueRevenue:            accrueRevenue,
 	}
 }
 
@@ -56,6 +62,8 @@ func DefaultParams() Params {
 		EnableRevenue:            DefaultEnableRevenue,
 		DeveloperShares:          DefaultDeveloperShares,
 		AddrDerivationCostCreate: DefaultAddrDerivationCostCreate,
+		Attribution:              DefaultAttribution,
+		AccrueRevenue:            DefaultAccrueRevenue,
 	}
 }
 
@@ -99,6 +107,19 @@ func validateShares(i interface{}) error {
 	return nil
 }
 
+func validateAttribution(i interface{}) error {
+	v, ok := i.(RevenueAttribution)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := RevenueAttribution_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid revenue attribution: %d", v)
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := validateBool(p.EnableRevenue); err != nil {
 		return err
@@ -106,5 +127,11 @@ func (p Params) Validate() error {
 	if err := validateShares(p.DeveloperShares); err != nil {
 		return err
 	}
-	return validateUint64(p.AddrDerivationCostCreate)
+	if err := validateUint64(p.AddrDerivationCostCreate); err != nil {
+		return err
+	}
+	if err := validateAttribution(p.Attribution); err != nil {
+		return err
+	}
+	return validateBool(p.AccrueRevenue)
 }
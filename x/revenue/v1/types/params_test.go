@@ -19,17 +19,17 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid: enabled",
-			NewParams(true, devShares, derivCostCreate),
+			NewParams(true, devShares, derivCostCreate, DefaultAttribution, DefaultAccrueRevenue),
 			false,
 		},
 		{
 			"valid: disabled",
-			NewParams(false, devShares, derivCostCreate),
+			NewParams(false, devShares, derivCostCreate, DefaultAttribution, DefaultAccrueRevenue),
 			false,
 		},
 		{
 			"valid: 100% devs",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), derivCostCreate},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), derivCostCreate, DefaultAttribution, DefaultAccrueRevenue},
 			false,
 		},
 		{
@@ -39,17 +39,32 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"invalid: share > 1",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), derivCostCreate},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), derivCostCreate, DefaultAttribution, DefaultAccrueRevenue},
 			true,
 		},
 		{
 			"invalid: share < 0",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), derivCostCreate},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), derivCostCreate, DefaultAttribution, DefaultAccrueRevenue},
 			true,
 		},
+		{
+			"valid: call tree attribution",
+			NewParams(true, devShares, derivCostCreate, REVENUE_ATTRIBUTION_CALL_TREE_GAS, DefaultAccrueRevenue),
+			false,
+		},
+		{
+			"invalid: unknown attribution",
+			NewParams(true, devShares, derivCostCreate, RevenueAttribution(3), DefaultAccrueRevenue),
+			true,
+		},
+		{
+			"valid: accrue revenue",
+			NewParams(true, devShares, derivCostCreate, DefaultAttribution, true),
+			false,
+		},
 		{
 			"invalid: wrong address derivation cost",
-			NewParams(true, devShares, 50),
+			NewParams(true, devShares, 50, DefaultAttribution, DefaultAccrueRevenue),
 			false,
 		},
 	}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -481,6 +483,9 @@ type QueryWithdrawerRevenuesResponse struct {
 	ContractAddresses []string `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// shares is the slice of fee shares of the withdrawer, in the same order as
+	// contract_addresses
+	Shares []WithdrawerShare `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares"`
 }
 
 func (m *QueryWithdrawerRevenuesResponse) Reset()         { *m = QueryWithdrawerRevenuesResponse{} }
@@ -530,6 +535,163 @@ func (m *QueryWithdrawerRevenuesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryWithdrawerRevenuesResponse) GetShares() []WithdrawerShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// WithdrawerShare defines the portion of the transaction fees of a registered
+// contract that is sent to a withdrawer
+type WithdrawerShare struct {
+	// contract_address is the hex address of the registered contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// weight is the portion of the fees sent to the withdrawer, in basis points
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WithdrawerShare) Reset()         { *m = WithdrawerShare{} }
+func (m *WithdrawerShare) String() string { return proto.CompactTextString(m) }
+func (*WithdrawerShare) ProtoMessage()    {}
+func (*WithdrawerShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{10}
+}
+func (m *WithdrawerShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawerShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawerShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawerShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawerShare.Merge(m, src)
+}
+func (m *WithdrawerShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawerShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawerShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawerShare proto.InternalMessageInfo
+
+func (m *WithdrawerShare) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *WithdrawerShare) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// QueryPendingRevenueRequest is the request type for the Query/PendingRevenue
+// RPC method.
+type QueryPendingRevenueRequest struct {
+	// withdrawer_address in bech32 format
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *QueryPendingRevenueRequest) Reset()         { *m = QueryPendingRevenueRequest{} }
+func (m *QueryPendingRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRevenueRequest) ProtoMessage()    {}
+func (*QueryPendingRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{11}
+}
+func (m *QueryPendingRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRevenueRequest.Merge(m, src)
+}
+func (m *QueryPendingRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRevenueRequest) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// QueryPendingRevenueResponse is the response type for the
+// Query/PendingRevenue RPC method.
+type QueryPendingRevenueResponse struct {
+	// amount is the accrued revenue of the withdrawer
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryPendingRevenueResponse) Reset()         { *m = QueryPendingRevenueResponse{} }
+func (m *QueryPendingRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRevenueResponse) ProtoMessage()    {}
+func (*QueryPendingRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{12}
+}
+func (m *QueryPendingRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRevenueResponse.Merge(m, src)
+}
+func (m *QueryPendingRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRevenueResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryRevenuesRequest)(nil), "evmos.revenue.v1.QueryRevenuesRequest")
 	proto.RegisterType((*QueryRevenuesResponse)(nil), "evmos.revenue.v1.QueryRevenuesResponse")
@@ -541,54 +703,67 @@ func init() {
 	proto.RegisterType((*QueryDeployerRevenuesResponse)(nil), "evmos.revenue.v1.QueryDeployerRevenuesResponse")
 	proto.RegisterType((*QueryWithdrawerRevenuesRequest)(nil), "evmos.revenue.v1.QueryWithdrawerRevenuesRequest")
 	proto.RegisterType((*QueryWithdrawerRevenuesResponse)(nil), "evmos.revenue.v1.QueryWithdrawerRevenuesResponse")
+	proto.RegisterType((*WithdrawerShare)(nil), "evmos.revenue.v1.WithdrawerShare")
+	proto.RegisterType((*QueryPendingRevenueRequest)(nil), "evmos.revenue.v1.QueryPendingRevenueRequest")
+	proto.RegisterType((*QueryPendingRevenueResponse)(nil), "evmos.revenue.v1.QueryPendingRevenueResponse")
 }
 
 func init() { proto.RegisterFile("evmos/revenue/v1/query.proto", fileDescriptor_5e4b17cda6e8e927) }
 
 var fileDescriptor_5e4b17cda6e8e927 = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xcf, 0x54, 0xfb, 0xef, 0xf5, 0x60, 0x3a, 0x56, 0x88, 0x4b, 0xdc, 0x96, 0xc5, 0xfe, 0x13,
-	0xb2, 0xe3, 0x46, 0x54, 0xc4, 0x8b, 0x16, 0xb1, 0x27, 0xa1, 0xdd, 0x8b, 0xe0, 0x41, 0x99, 0x24,
-	0xc3, 0x76, 0xa1, 0xdd, 0xd9, 0xee, 0x6c, 0xb6, 0x16, 0x29, 0x82, 0x5f, 0x40, 0xc5, 0x43, 0xf1,
-	0xe0, 0x17, 0xf0, 0xe8, 0xa7, 0xe8, 0xb1, 0xe0, 0xc5, 0x93, 0x48, 0xe2, 0x57, 0xf0, 0x2e, 0x99,
-	0x99, 0x4d, 0x93, 0x5d, 0xb7, 0xb1, 0x52, 0xf0, 0x12, 0x96, 0xf7, 0xef, 0xf7, 0x7b, 0xbf, 0x79,
-	0xef, 0x05, 0xaa, 0x2c, 0xd9, 0xe1, 0x82, 0x44, 0x2c, 0x61, 0x41, 0x9b, 0x91, 0xc4, 0x21, 0xbb,
-	0x6d, 0x16, 0xed, 0xdb, 0x61, 0xc4, 0x63, 0x8e, 0xcb, 0xd2, 0x6b, 0x6b, 0xaf, 0x9d, 0x38, 0xc6,
-	0x8d, 0x26, 0x17, 0xbd, 0x84, 0x06, 0x15, 0x4c, 0x85, 0x92, 0xc4, 0x69, 0xb0, 0x98, 0x3a, 0x24,
-	0xa4, 0x9e, 0x1f, 0xd0, 0xd8, 0xe7, 0x81, 0xca, 0x36, 0xcc, 0x5c, 0x6d, 0x8f, 0x05, 0x4c, 0xf8,
-	0xa2, 0xd0, 0x9f, 0x02, 0x29, 0xff, 0x9c, 0xc7, 0x3d, 0x2e, 0x3f, 0x49, 0xef, 0x4b, 0x5b, 0xab,
-	0x1e, 0xe7, 0xde, 0x36, 0x23, 0x34, 0xf4, 0x09, 0x0d, 0x02, 0x1e, 0x4b, 0x48, 0x5d, 0xd3, 0x7a,
-	0x0e, 0x73, 0x9b, 0x3d, 0x56, 0xae, 0xaa, 0x24, 0x5c, 0xb6, 0xdb, 0x66, 0x22, 0xc6, 0x8f, 0x01,
-	0x4e, 0xf8, 0x55, 0xd0, 0x02, 0x5a, 0x99, 0xa9, 0x2f, 0xd9, 0xaa, 0x19, 0xbb, 0xd7, 0x8c, 0xad,
-	0xfa, 0xd6, 0xcd, 0xd8, 0x1b, 0xd4, 0x63, 0x3a, 0xd7, 0x1d, 0xc8, 0xb4, 0x3e, 0x21, 0xb8, 0x92,
-	0x01, 0x10, 0x21, 0x0f, 0x04, 0xc3, 0xf7, 0x61, 0x4a, 0xd3, 0x17, 0x15, 0xb4, 0x70, 0x61, 0x65,
-	0xa6, 0x7e, 0xd5, 0xce, 0xca, 0x67, 0xeb, 0xac, 0xb5, 0x8b, 0x47, 0xdf, 0xe7, 0x4b, 0x6e, 0x3f,
-	0x01, 0xaf, 0x0f, 0xd1, 0x1b, 0x93, 0xf4, 0x96, 0x47, 0xd2, 0x53, 0xc8, 0x43, 0xfc, 0x1e, 0xc0,
-	0xe5, 0x41, 0x7a, 0x69, 0xfb, 0xab, 0x50, 0x6e, 0xf2, 0x20, 0x8e, 0x68, 0x33, 0x7e, 0x41, 0x5b,
-	0xad, 0x88, 0x09, 0x21, 0x45, 0x98, 0x76, 0x2f, 0xa5, 0xf6, 0x87, 0xca, 0x6c, 0x6d, 0x0e, 0x2b,
-	0xd8, 0xef, 0xef, 0x1e, 0x4c, 0x6a, 0xba, 0x5a, 0xbe, 0x91, 0xed, 0xa5, 0xf1, 0xd6, 0x1c, 0x60,
-	0x59, 0x72, 0x83, 0x46, 0x74, 0x27, 0x7d, 0x12, 0xeb, 0x89, 0xa6, 0x9a, 0x5a, 0x35, 0xce, 0x1d,
-	0x98, 0x08, 0xa5, 0x45, 0xc3, 0x54, 0xf2, 0x30, 0x2a, 0x43, 0xa3, 0xe8, 0x68, 0xeb, 0x3d, 0x82,
-	0xaa, 0xac, 0xf7, 0x88, 0x85, 0xdb, 0x7c, 0x9f, 0x45, 0xd9, 0x11, 0x58, 0x85, 0x72, 0x4b, 0xbb,
-	0xb2, 0x1a, 0xa4, 0x76, 0xad, 0x41, 0x66, 0x5a, 0xc6, 0xfe, 0x79, 0x5a, 0x0e, 0x11, 0x5c, 0x2b,
-	0xe0, 0xa4, 0xbb, 0xad, 0x01, 0xce, 0x3e, 0x8c, 0x9e, 0x9f, 0x69, 0x77, 0x36, 0xf3, 0x34, 0xe7,
-	0x39, 0x27, 0x87, 0x08, 0x4c, 0xc9, 0xec, 0xa9, 0x1f, 0x6f, 0xb5, 0x22, 0xba, 0x97, 0xd7, 0xab,
-	0x06, 0x78, 0xaf, 0xef, 0xcc, 0x28, 0x36, 0x7b, 0xe2, 0x39, 0x6f, 0xcd, 0x3e, 0x22, 0x98, 0x2f,
-	0x64, 0xf6, 0x7f, 0x55, 0xab, 0xff, 0x1a, 0x87, 0x71, 0xc9, 0x0d, 0xbf, 0x86, 0xa9, 0x94, 0x15,
-	0x5e, 0xca, 0x4f, 0xe8, 0x9f, 0x6e, 0x90, 0xb1, 0x3c, 0x32, 0x4e, 0x41, 0x5a, 0xd6, 0x9b, 0xaf,
-	0x3f, 0x3f, 0x8c, 0x55, 0xb1, 0x41, 0x8a, 0x2e, 0xa4, 0xc0, 0x6f, 0x11, 0x4c, 0xea, 0x44, 0xbc,
-	0x78, 0x7a, 0xe1, 0x14, 0x7f, 0x69, 0x54, 0x98, 0x86, 0xbf, 0x2d, 0xe1, 0x09, 0xae, 0x15, 0xc3,
-	0x93, 0x57, 0x59, 0xfd, 0x0f, 0xf0, 0x1e, 0x4c, 0xa8, 0xc5, 0xc4, 0xd7, 0x0b, 0x80, 0x86, 0xf6,
-	0xdf, 0x58, 0x1c, 0x11, 0xa5, 0xd9, 0x2c, 0x48, 0x36, 0x06, 0xae, 0xe4, 0xd9, 0xa8, 0xcd, 0xc7,
-	0x9f, 0x11, 0x94, 0xb3, 0x0b, 0x86, 0xed, 0x82, 0xea, 0x05, 0xd7, 0xc1, 0x20, 0x7f, 0x1d, 0x7f,
-	0x16, 0x95, 0xb2, 0x07, 0xe7, 0x00, 0x7f, 0x41, 0x80, 0xf3, 0x93, 0x8d, 0x6f, 0x16, 0xc0, 0x17,
-	0xae, 0xa7, 0xe1, 0x9c, 0x21, 0x43, 0x53, 0xbe, 0x2b, 0x29, 0x3b, 0x98, 0x9c, 0x46, 0x39, 0xbf,
-	0xf3, 0x07, 0x6b, 0xeb, 0x47, 0x1d, 0x13, 0x1d, 0x77, 0x4c, 0xf4, 0xa3, 0x63, 0xa2, 0x77, 0x5d,
-	0xb3, 0x74, 0xdc, 0x35, 0x4b, 0xdf, 0xba, 0x66, 0xe9, 0x59, 0xcd, 0xf3, 0xe3, 0xad, 0x76, 0xc3,
-	0x6e, 0xf2, 0x1d, 0x5d, 0x54, 0xfd, 0x26, 0x4e, 0x9d, 0xbc, 0x1c, 0x04, 0x88, 0xf7, 0x43, 0x26,
-	0x1a, 0x13, 0xf2, 0x5f, 0xfa, 0xd6, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x83, 0x77, 0x43,
-	0x77, 0x08, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x4f, 0x1b, 0x47,
+	0x18, 0xf6, 0x40, 0x6b, 0xe0, 0x45, 0x2d, 0x66, 0xa0, 0x95, 0xbb, 0x75, 0x17, 0xba, 0x2a, 0x5f,
+	0x55, 0xbd, 0x83, 0x5d, 0x95, 0xb6, 0xaa, 0xd4, 0x0f, 0x5a, 0xc1, 0xa1, 0xaa, 0x04, 0xdb, 0x48,
+	0x91, 0x72, 0x08, 0x5a, 0xdb, 0xa3, 0xf5, 0x2a, 0x78, 0xc7, 0xec, 0xac, 0xed, 0xa0, 0x08, 0x45,
+	0xe2, 0x0f, 0x24, 0x51, 0x0e, 0x9c, 0x72, 0xcc, 0x05, 0x29, 0x97, 0xfc, 0x0a, 0x8e, 0x48, 0xb9,
+	0x44, 0x39, 0x24, 0x11, 0xe4, 0x87, 0x44, 0x9e, 0x99, 0x35, 0xf6, 0xae, 0x17, 0xe3, 0x08, 0x29,
+	0x17, 0x30, 0xf3, 0x7e, 0x3c, 0xcf, 0xfb, 0xbc, 0x1f, 0x18, 0x72, 0xb4, 0x59, 0x63, 0x9c, 0xf8,
+	0xb4, 0x49, 0xbd, 0x06, 0x25, 0xcd, 0x02, 0xd9, 0x6b, 0x50, 0x7f, 0xdf, 0xac, 0xfb, 0x2c, 0x60,
+	0x38, 0x23, 0xac, 0xa6, 0xb2, 0x9a, 0xcd, 0x82, 0xf6, 0x7d, 0x99, 0xf1, 0x76, 0x40, 0xc9, 0xe6,
+	0x54, 0xba, 0x92, 0x66, 0xa1, 0x44, 0x03, 0xbb, 0x40, 0xea, 0xb6, 0xe3, 0x7a, 0x76, 0xe0, 0x32,
+	0x4f, 0x46, 0x6b, 0x7a, 0xb7, 0x6f, 0xe8, 0x55, 0x66, 0x6e, 0xc7, 0x1e, 0xc3, 0x76, 0xa8, 0x47,
+	0xb9, 0xcb, 0x13, 0xed, 0x21, 0x11, 0x69, 0x9f, 0x75, 0x98, 0xc3, 0xc4, 0x47, 0xd2, 0xfe, 0xa4,
+	0x5e, 0x73, 0x0e, 0x63, 0xce, 0x2e, 0x25, 0x76, 0xdd, 0x25, 0xb6, 0xe7, 0xb1, 0x40, 0x50, 0x52,
+	0x39, 0x8d, 0xdb, 0x30, 0xbb, 0xdd, 0x66, 0x6d, 0xc9, 0x4c, 0xdc, 0xa2, 0x7b, 0x0d, 0xca, 0x03,
+	0xbc, 0x01, 0x70, 0xc1, 0x3f, 0x8b, 0xe6, 0xd1, 0xf2, 0x64, 0x71, 0xd1, 0x94, 0x05, 0x98, 0xed,
+	0x02, 0x4c, 0xa9, 0x8b, 0x2a, 0xc3, 0xdc, 0xb2, 0x1d, 0xaa, 0x62, 0xad, 0xae, 0x48, 0xe3, 0x09,
+	0x82, 0x2f, 0x22, 0x00, 0xbc, 0xce, 0x3c, 0x4e, 0xf1, 0x6f, 0x30, 0xae, 0xe8, 0xf3, 0x2c, 0x9a,
+	0x1f, 0x5d, 0x9e, 0x2c, 0x7e, 0x65, 0x46, 0xe5, 0x35, 0x55, 0xd4, 0xfa, 0x27, 0x27, 0xaf, 0xe7,
+	0x52, 0x56, 0x27, 0x00, 0x6f, 0xf6, 0xd0, 0x1b, 0x11, 0xf4, 0x96, 0x06, 0xd2, 0x93, 0xc8, 0x3d,
+	0xfc, 0xfe, 0x84, 0x99, 0x6e, 0x7a, 0x61, 0xf9, 0x2b, 0x90, 0x29, 0x33, 0x2f, 0xf0, 0xed, 0x72,
+	0xb0, 0x63, 0x57, 0x2a, 0x3e, 0xe5, 0x5c, 0x88, 0x30, 0x61, 0x4d, 0x85, 0xef, 0x7f, 0xc9, 0x67,
+	0x63, 0xbb, 0x57, 0xc1, 0x4e, 0x7d, 0xbf, 0xc2, 0x98, 0xa2, 0xab, 0xe4, 0x1b, 0x58, 0x5e, 0xe8,
+	0x6f, 0xcc, 0x02, 0x16, 0x29, 0xb7, 0x6c, 0xdf, 0xae, 0x85, 0x2d, 0x31, 0xfe, 0x83, 0x99, 0x9e,
+	0x57, 0x85, 0xb3, 0x06, 0xe9, 0xba, 0x78, 0x51, 0x30, 0xd9, 0x38, 0x8c, 0x8c, 0x50, 0x28, 0xca,
+	0xdb, 0x78, 0x84, 0x20, 0x27, 0xf2, 0xfd, 0x43, 0xeb, 0xbb, 0x6c, 0x9f, 0xfa, 0xd1, 0x11, 0x58,
+	0x81, 0x4c, 0x45, 0x99, 0xa2, 0x1a, 0x84, 0xef, 0x4a, 0x03, 0xbc, 0xd1, 0xa7, 0x1d, 0x1f, 0x32,
+	0x2d, 0x47, 0x08, 0xbe, 0x49, 0xe0, 0xa4, 0xaa, 0xcd, 0x03, 0x8e, 0x36, 0x46, 0xcd, 0xcf, 0x84,
+	0x35, 0x1d, 0x69, 0xcd, 0x75, 0xce, 0xc9, 0x11, 0x02, 0x5d, 0x30, 0xbb, 0xe9, 0x06, 0xd5, 0x8a,
+	0x6f, 0xb7, 0xe2, 0x7a, 0xe5, 0x01, 0xb7, 0x3a, 0xc6, 0x88, 0x62, 0xd3, 0x17, 0x96, 0xeb, 0xd6,
+	0xec, 0x15, 0x82, 0xb9, 0x44, 0x66, 0x1f, 0x57, 0x35, 0xfc, 0x07, 0xa4, 0x79, 0xd5, 0xf6, 0x29,
+	0xcf, 0x8e, 0x8a, 0x0d, 0xff, 0x36, 0x3e, 0x9b, 0x17, 0xac, 0xff, 0x6f, 0x7b, 0x86, 0x43, 0x2a,
+	0xc3, 0x8c, 0x1b, 0x30, 0x15, 0x71, 0x18, 0x62, 0x35, 0xf1, 0x97, 0x90, 0x6e, 0x51, 0xd7, 0xa9,
+	0x06, 0xa2, 0x86, 0xcf, 0x2c, 0xf5, 0x97, 0xf1, 0x2f, 0x68, 0x72, 0x93, 0xa8, 0x57, 0x71, 0x3d,
+	0x27, 0xb2, 0xfb, 0xc3, 0xf5, 0xd1, 0x38, 0x44, 0xf0, 0x75, 0xdf, 0x6c, 0x4a, 0xfb, 0x32, 0xa4,
+	0xed, 0x1a, 0x6b, 0x78, 0x41, 0xe7, 0xca, 0x75, 0x0b, 0x19, 0x4a, 0xf8, 0x37, 0x73, 0xbd, 0xf5,
+	0xd5, 0x76, 0xed, 0xc7, 0x6f, 0xe6, 0x96, 0x1d, 0x37, 0xa8, 0x36, 0x4a, 0x66, 0x99, 0xd5, 0x88,
+	0x74, 0x56, 0xbf, 0xf2, 0xbc, 0x72, 0x87, 0x04, 0xfb, 0x75, 0xca, 0x45, 0x00, 0xb7, 0x54, 0xea,
+	0xe2, 0xd3, 0x31, 0xf8, 0x54, 0x90, 0xc0, 0xf7, 0x61, 0x3c, 0x6c, 0x3f, 0x5e, 0x8c, 0xcb, 0xdd,
+	0xef, 0xd8, 0x6b, 0x4b, 0x03, 0xfd, 0x64, 0x2d, 0x86, 0x71, 0xf8, 0xe2, 0xdd, 0xe3, 0x91, 0x1c,
+	0xd6, 0x48, 0xd2, 0xbf, 0x22, 0x8e, 0x1f, 0x20, 0x18, 0x53, 0x81, 0x78, 0xe1, 0xf2, 0xc4, 0x21,
+	0xfe, 0xe2, 0x20, 0x37, 0x05, 0xff, 0x93, 0x80, 0x27, 0x38, 0x9f, 0x0c, 0x4f, 0xee, 0x45, 0x87,
+	0xe3, 0x00, 0xb7, 0x20, 0x2d, 0x2f, 0x20, 0xfe, 0x2e, 0x01, 0xa8, 0xe7, 0xd0, 0x6a, 0x0b, 0x03,
+	0xbc, 0x14, 0x9b, 0x79, 0xc1, 0x46, 0xc3, 0xd9, 0x38, 0x1b, 0x79, 0x62, 0xf1, 0x31, 0x82, 0x4c,
+	0xf4, 0x92, 0x61, 0x33, 0x21, 0x7b, 0xc2, 0x19, 0xd6, 0xc8, 0x95, 0xfd, 0x87, 0x51, 0x29, 0x7a,
+	0xd9, 0x0f, 0xf0, 0x73, 0x04, 0x38, 0x7e, 0x42, 0xf0, 0x6a, 0x02, 0x7c, 0xe2, 0x1d, 0xd4, 0x0a,
+	0x43, 0x44, 0x28, 0xca, 0x3f, 0x0b, 0xca, 0x05, 0x4c, 0x2e, 0xa3, 0x1c, 0x5f, 0xca, 0x03, 0xfc,
+	0x0c, 0xc1, 0xe7, 0xbd, 0x7b, 0x87, 0x7f, 0x48, 0xea, 0x5e, 0xbf, 0x65, 0xd7, 0xf2, 0x57, 0xf4,
+	0x56, 0x44, 0x7f, 0x17, 0x44, 0x7f, 0xc1, 0x6b, 0x7d, 0x7a, 0x2e, 0x23, 0x76, 0xc2, 0xa7, 0x7e,
+	0x7c, 0xd7, 0x37, 0x4f, 0xce, 0x74, 0x74, 0x7a, 0xa6, 0xa3, 0xb7, 0x67, 0x3a, 0x7a, 0x78, 0xae,
+	0xa7, 0x4e, 0xcf, 0xf5, 0xd4, 0xcb, 0x73, 0x3d, 0x75, 0x2b, 0xdf, 0xb5, 0xf3, 0x32, 0xb7, 0xfc,
+	0xd9, 0x2c, 0x14, 0xc9, 0xdd, 0x6e, 0x1c, 0xb1, 0xfe, 0xa5, 0xb4, 0xf8, 0xfa, 0xf6, 0xe3, 0xfb,
+	0x01, 0x00, 0xac, 0xd1, 0x22, 0xa9, 0xb0, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -615,6 +790,9 @@ type QueryClient interface {
 	// WithdrawerRevenues retrieves all revenues with a given withdrawer
 	// address
 	WithdrawerRevenues(ctx context.Context, in *QueryWithdrawerRevenuesRequest, opts ...grpc.CallOption) (*QueryWithdrawerRevenuesResponse, error)
+	// PendingRevenue retrieves the accrued revenue of a withdrawer that hasn't
+	// been withdrawn yet
+	PendingRevenue(ctx context.Context, in *QueryPendingRevenueRequest, opts ...grpc.CallOption) (*QueryPendingRevenueResponse, error)
 }
 
 type queryClient struct {
@@ -670,6 +848,15 @@ func (c *queryClient) WithdrawerRevenues(ctx context.Context, in *QueryWithdrawe
 	return out, nil
 }
 
+func (c *queryClient) PendingRevenue(ctx context.Context, in *QueryPendingRevenueRequest, opts ...grpc.CallOption) (*QueryPendingRevenueResponse, error) {
+	out := new(QueryPendingRevenueResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/PendingRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Revenues retrieves all registered revenues
@@ -684,6 +871,9 @@ type QueryServer interface {
 	// WithdrawerRevenues retrieves all revenues with a given withdrawer
 	// address
 	WithdrawerRevenues(context.Context, *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error)
+	// PendingRevenue retrieves the accrued revenue of a withdrawer that hasn't
+	// been withdrawn yet
+	PendingRevenue(context.Context, *QueryPendingRevenueRequest) (*QueryPendingRevenueResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -705,6 +895,9 @@ func (*UnimplementedQueryServer) DeployerRevenues(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) WithdrawerRevenues(ctx context.Context, req *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawerRevenues not implemented")
 }
+func (*UnimplementedQueryServer) PendingRevenue(ctx context.Context, req *QueryPendingRevenueRequest) (*QueryPendingRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRevenue not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -800,6 +993,24 @@ func _Query_WithdrawerRevenues_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/PendingRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRevenue(ctx, req.(*QueryPendingRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.revenue.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -824,6 +1035,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawerRevenues",
 			Handler:    _Query_WithdrawerRevenues_Handler,
 		},
+		{
+			MethodName: "PendingRevenue",
+			Handler:    _Query_PendingRevenue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/revenue/v1/query.proto",
@@ -1180,6 +1395,20 @@ func (m *QueryWithdrawerRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1204,6 +1433,108 @@ func (m *QueryWithdrawerRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *WithdrawerShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawerShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawerShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1360,6 +1691,56 @@ func (m *QueryWithdrawerRevenuesResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawerShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovQuery(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *QueryPendingRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2324,6 +2705,307 @@ func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, WithdrawerShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawerShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawerShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawerShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -17,11 +17,23 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	evmostypes "github.com/evmos/evmos/v12/types"
 )
 
+const (
+	// MaxWithdrawerSplits is the maximum number of weighted withdrawers of a
+	// revenue
+	MaxWithdrawerSplits = 10
+	// WithdrawerWeightTotal is the sum of the weights of the withdrawers of a
+	// revenue, in basis points
+	WithdrawerWeightTotal = 10000
+)
+
 // NewRevenue returns an instance of Revenue. If the provided withdrawer
 // address is empty, it sets the value to an empty string.
 func NewRevenue(contract common.Address, deployer, withdrawer sdk.AccAddress) Revenue {
@@ -58,6 +70,35 @@ func (fs Revenue) GetWithdrawerAddr() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(fs.WithdrawerAddress)
 }
 
+// GetWithdrawerSplits returns the weighted accounts receiving the fees of the
+// revenue. If no weighted withdrawers are defined, it returns the withdraw
+// address, or the deployer address if unset, with the full weight.
+func (fs Revenue) GetWithdrawerSplits() []WithdrawerSplit {
+	if len(fs.Withdrawers) > 0 {
+		return fs.Withdrawers
+	}
+
+	withdrawer := fs.WithdrawerAddress
+	if withdrawer == "" {
+		withdrawer = fs.DeployerAddress
+	}
+
+	return []WithdrawerSplit{{WithdrawerAddress: withdrawer, Weight: WithdrawerWeightTotal}}
+}
+
+// GetWithdrawerWeight returns the weight, in basis points, of the fees of the
+// revenue that are sent to the given account
+func (fs Revenue) GetWithdrawerWeight(withdrawer sdk.AccAddress) uint32 {
+	var weight uint32
+	for _, split := range fs.GetWithdrawerSplits() {
+		if split.WithdrawerAddress == withdrawer.String() {
+			weight += split.Weight
+		}
+	}
+
+	return weight
+}
+
 // Validate performs a stateless validation of a Revenue
 func (fs Revenue) Validate() error {
 	if err := evmostypes.ValidateNonZeroAddress(fs.ContractAddress); err != nil {
@@ -74,5 +115,71 @@ func (fs Revenue) Validate() error {
 		}
 	}
 
+	if len(fs.Withdrawers) == 0 {
+		return nil
+	}
+
+	if fs.WithdrawerAddress != "" {
+		return fmt.Errorf("withdraw address and weighted withdrawers cannot be both set")
+	}
+
+	return ValidateWithdrawerSplits(fs.Withdrawers)
+}
+
+// FormatWithdrawerSplits returns the weighted withdrawers as a comma separated
+// list of address:weight pairs
+func FormatWithdrawerSplits(splits []WithdrawerSplit) string {
+	pairs := make([]string, len(splits))
+	for i, split := range splits {
+		pairs[i] = fmt.Sprintf("%s:%d", split.WithdrawerAddress, split.Weight)
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// ValidateWithdrawerSplits checks that the weighted withdrawers have valid and
+// unique addresses, non-zero weights that add up to WithdrawerWeightTotal and
+// don't exceed MaxWithdrawerSplits entries
+func ValidateWithdrawerSplits(splits []WithdrawerSplit) error {
+	if len(splits) > MaxWithdrawerSplits {
+		return fmt.Errorf("too many withdrawers: %d > %d", len(splits), MaxWithdrawerSplits)
+	}
+
+	seen := make(map[string]bool, len(splits))
+	var total uint32
+
+	for _, split := range splits {
+		if _, err := sdk.AccAddressFromBech32(split.WithdrawerAddress); err != nil {
+			return fmt.Errorf("invalid withdraw address %s: %w", split.WithdrawerAddress, err)
+		}
+
+		if seen[split.WithdrawerAddress] {
+			return fmt.Errorf("duplicate withdraw address %s", split.WithdrawerAddress)
+		}
+		seen[split.WithdrawerAddress] = true
+
+		if split.Weight == 0 || split.Weight > WithdrawerWeightTotal {
+			return fmt.Errorf("invalid weight %d for withdraw address %s", split.Weight, split.WithdrawerAddress)
+		}
+		total += split.Weight
+	}
+
+	if total != WithdrawerWeightTotal {
+		return fmt.Errorf("withdrawer weights must add up to %d, got %d", WithdrawerWeightTotal, total)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of a PendingRevenue
+func (pr PendingRevenue) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(pr.WithdrawerAddress); err != nil {
+		return err
+	}
+
+	if !pr.Amount.IsValid() || pr.Amount.IsZero() {
+		return fmt.Errorf("invalid pending revenue amount %s for withdrawer %s", pr.Amount, pr.WithdrawerAddress)
+	}
+
 	return nil
 }
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -33,6 +36,10 @@ type Revenue struct {
 	// withdrawer_address is the bech32 address of account receiving the transaction fees it defaults to
 	// deployer_address
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers is the list of weighted accounts receiving the transaction
+	// fees. When set, it takes precedence over withdrawer_address, which must be
+	// left empty
+	Withdrawers []WithdrawerSplit `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *Revenue) Reset()         { *m = Revenue{} }
@@ -89,27 +96,161 @@ func (m *Revenue) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *Revenue) GetWithdrawers() []WithdrawerSplit {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
+// WithdrawerSplit defines an account that receives a portion of the
+// transaction fees of a registered contract
+type WithdrawerSplit struct {
+	// withdrawer_address is the bech32 address of the account receiving the fees
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// weight is the portion of the fees sent to the withdrawer, in basis points.
+	// The weights of all the withdrawers of a revenue must add up to 10000
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WithdrawerSplit) Reset()         { *m = WithdrawerSplit{} }
+func (m *WithdrawerSplit) String() string { return proto.CompactTextString(m) }
+func (*WithdrawerSplit) ProtoMessage()    {}
+func (*WithdrawerSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33d087165192e447, []int{1}
+}
+func (m *WithdrawerSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawerSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawerSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawerSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawerSplit.Merge(m, src)
+}
+func (m *WithdrawerSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawerSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawerSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawerSplit proto.InternalMessageInfo
+
+func (m *WithdrawerSplit) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+func (m *WithdrawerSplit) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// PendingRevenue defines the accrued revenue of a withdrawer that can be
+// withdrawn from the module account
+type PendingRevenue struct {
+	// withdrawer_address is the bech32 address of the account that accrued the
+	// revenue
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// amount is the accrued revenue
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *PendingRevenue) Reset()         { *m = PendingRevenue{} }
+func (m *PendingRevenue) String() string { return proto.CompactTextString(m) }
+func (*PendingRevenue) ProtoMessage()    {}
+func (*PendingRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33d087165192e447, []int{2}
+}
+func (m *PendingRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRevenue.Merge(m, src)
+}
+func (m *PendingRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRevenue proto.InternalMessageInfo
+
+func (m *PendingRevenue) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+func (m *PendingRevenue) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Revenue)(nil), "evmos.revenue.v1.Revenue")
+	proto.RegisterType((*WithdrawerSplit)(nil), "evmos.revenue.v1.WithdrawerSplit")
+	proto.RegisterType((*PendingRevenue)(nil), "evmos.revenue.v1.PendingRevenue")
 }
 
 func init() { proto.RegisterFile("evmos/revenue/v1/revenue.proto", fileDescriptor_33d087165192e447) }
 
 var fileDescriptor_33d087165192e447 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x2f, 0x4a, 0x2d, 0x4b, 0xcd, 0x2b, 0x4d, 0xd5, 0x2f, 0x33, 0x84, 0x31, 0xf5, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0xc0, 0xf2, 0x7a, 0x30, 0xc1, 0x32, 0x43, 0xa5, 0x3e, 0x46,
-	0x2e, 0xf6, 0x20, 0x08, 0x57, 0x48, 0x93, 0x4b, 0x20, 0x39, 0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9,
-	0x24, 0x3e, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x88,
-	0x1f, 0x26, 0xee, 0x08, 0x11, 0x06, 0x29, 0x4d, 0x49, 0x2d, 0xc8, 0xc9, 0xaf, 0x4c, 0x2d, 0x82,
-	0x2b, 0x65, 0x82, 0x28, 0x85, 0x89, 0xc3, 0x94, 0xea, 0x72, 0x09, 0x95, 0x67, 0x96, 0x64, 0xa4,
-	0x14, 0x25, 0x96, 0x23, 0x29, 0x66, 0x06, 0x2b, 0x16, 0x44, 0xc8, 0x40, 0x95, 0x3b, 0xb9, 0x9f,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc4, 0x9f, 0x10, 0xb2, 0xcc, 0xd0, 0x48, 0xbf, 0x02, 0xd9,
-	0xcf, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x2f, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x81, 0x2c, 0xea, 0xe7, 0x14, 0x01, 0x00, 0x00,
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0xc6, 0x3b, 0x17, 0xc2, 0xcd, 0x1d, 0x72, 0x2f, 0x5c, 0x62, 0x0c, 0xb2, 0x18, 0x90, 0x15,
+	0x2e, 0x98, 0x5a, 0x7c, 0x02, 0x71, 0x61, 0xdc, 0x99, 0xba, 0xd0, 0xb8, 0x31, 0xfd, 0x33, 0x29,
+	0x8d, 0x30, 0xd3, 0x74, 0x86, 0x22, 0x6f, 0xe1, 0x43, 0xb8, 0xf2, 0x49, 0x58, 0xb2, 0xd4, 0x8d,
+	0x1a, 0x78, 0x11, 0x33, 0x33, 0x1d, 0x68, 0x48, 0x58, 0xb8, 0x69, 0x4f, 0xbf, 0xf9, 0x9d, 0x2f,
+	0x73, 0xfa, 0x1d, 0x88, 0x48, 0x36, 0x61, 0xdc, 0x4e, 0x49, 0x46, 0xe8, 0x94, 0xd8, 0x99, 0x63,
+	0x4a, 0x9c, 0xa4, 0x4c, 0xb0, 0x46, 0x5d, 0x9d, 0x63, 0x23, 0x66, 0x4e, 0x0b, 0x05, 0x8c, 0xcb,
+	0x16, 0xdf, 0xe3, 0x92, 0xf7, 0x89, 0xf0, 0x1c, 0x3b, 0x60, 0x31, 0xd5, 0x1d, 0xad, 0x83, 0x88,
+	0x45, 0x4c, 0x95, 0xb6, 0xac, 0xb4, 0xda, 0x7d, 0x07, 0xf0, 0xb7, 0xab, 0x4d, 0x1a, 0x27, 0xb0,
+	0x1e, 0x30, 0x2a, 0x52, 0x2f, 0x10, 0x0f, 0x5e, 0x18, 0xa6, 0x84, 0xf3, 0x26, 0xe8, 0x80, 0xde,
+	0x1f, 0xb7, 0x66, 0xf4, 0x73, 0x2d, 0x4b, 0x34, 0x24, 0xc9, 0x98, 0xcd, 0x49, 0xba, 0x41, 0x7f,
+	0x69, 0xd4, 0xe8, 0x06, 0xed, 0xc3, 0xc6, 0x2c, 0x16, 0xa3, 0x30, 0xf5, 0x66, 0x05, 0xb8, 0xa4,
+	0xe0, 0xff, 0xdb, 0x13, 0x83, 0x5f, 0xc1, 0xea, 0x56, 0xe4, 0xcd, 0x72, 0xa7, 0xd4, 0xab, 0x0e,
+	0x8e, 0xf1, 0xee, 0xb8, 0xf8, 0x76, 0x03, 0xdd, 0x24, 0xe3, 0x58, 0x0c, 0xcb, 0x8b, 0x8f, 0xb6,
+	0xe5, 0x16, 0x7b, 0xbb, 0x77, 0xb0, 0xb6, 0x43, 0xed, 0xb9, 0x0c, 0xd8, 0x77, 0x99, 0x43, 0x58,
+	0x99, 0x91, 0x38, 0x1a, 0x09, 0x35, 0xdc, 0x5f, 0x37, 0xff, 0xea, 0xbe, 0x00, 0xf8, 0xef, 0x9a,
+	0xd0, 0x30, 0xa6, 0x91, 0xf9, 0x79, 0x3f, 0x74, 0x0e, 0x60, 0xc5, 0x9b, 0xb0, 0x29, 0x95, 0xce,
+	0x72, 0xc2, 0x23, 0xac, 0xe3, 0xc3, 0x32, 0x3e, 0x9c, 0xc7, 0x87, 0x2f, 0x58, 0x4c, 0x87, 0xa7,
+	0x72, 0xb2, 0xd7, 0xcf, 0x76, 0x2f, 0x8a, 0xc5, 0x68, 0xea, 0xe3, 0x80, 0x4d, 0xec, 0x3c, 0x6b,
+	0xfd, 0xea, 0xf3, 0xf0, 0xd1, 0x16, 0xf3, 0x84, 0x70, 0xd5, 0xc0, 0xdd, 0xdc, 0x7a, 0x78, 0xb9,
+	0x58, 0x21, 0xb0, 0x5c, 0x21, 0xf0, 0xb5, 0x42, 0xe0, 0x79, 0x8d, 0xac, 0xe5, 0x1a, 0x59, 0x6f,
+	0x6b, 0x64, 0xdd, 0xf7, 0x0b, 0x5e, 0x7a, 0xd3, 0xf4, 0x33, 0x73, 0x06, 0xf6, 0x53, 0x71, 0xeb,
+	0x94, 0xad, 0x5f, 0x51, 0xcb, 0x72, 0xf6, 0x3d, 0x00, 0x37, 0x45, 0x75, 0x8f, 0x96, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Revenue) Marshal() (dAtA []byte, err error) {
@@ -132,6 +273,20 @@ func (m *Revenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -156,6 +311,85 @@ func (m *Revenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WithdrawerSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawerSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawerSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintRevenue(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRevenue(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRevenue(v)
 	base := offset
@@ -185,6 +419,47 @@ func (m *Revenue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRevenue(uint64(l))
 	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawerSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovRevenue(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *PendingRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -319,6 +594,257 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, WithdrawerSplit{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawerSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawerSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawerSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRevenue(dAtA[iNdEx:])
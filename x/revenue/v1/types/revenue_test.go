@@ -87,54 +87,125 @@ func (suite *RevenueTestSuite) TestFee() {
 		{
 			"Create revenue- pass",
 			types.Revenue{
-				utiltx.GenerateAddress().String(),
-				suite.address1.String(),
-				suite.address2.String(),
+				ContractAddress:   utiltx.GenerateAddress().String(),
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: suite.address2.String(),
 			},
 			true,
 		},
 		{
 			"Create revenue- invalid contract address (not hex)",
 			types.Revenue{
-				"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
-				suite.address1.String(),
-				suite.address2.String(),
+				ContractAddress:   "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: suite.address2.String(),
 			},
 			false,
 		},
 		{
 			"Create revenue- invalid contract address (invalid length 1)",
 			types.Revenue{
-				"0x5dCA2483280D9727c80b5518faC4556617fb19",
-				suite.address1.String(),
-				suite.address2.String(),
+				ContractAddress:   "0x5dCA2483280D9727c80b5518faC4556617fb19",
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: suite.address2.String(),
 			},
 			false,
 		},
 		{
 			"Create revenue- invalid contract address (invalid length 2)",
 			types.Revenue{
-				"0x5dCA2483280D9727c80b5518faC4556617fb194FFF",
-				suite.address1.String(),
-				suite.address2.String(),
+				ContractAddress:   "0x5dCA2483280D9727c80b5518faC4556617fb194FFF",
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: suite.address2.String(),
 			},
 			false,
 		},
 		{
 			"Create revenue- invalid deployer address",
 			types.Revenue{
-				utiltx.GenerateAddress().String(),
-				"evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z",
-				suite.address2.String(),
+				ContractAddress:   utiltx.GenerateAddress().String(),
+				DeployerAddress:   "evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z",
+				WithdrawerAddress: suite.address2.String(),
 			},
 			false,
 		},
 		{
 			"Create revenue- invalid withdraw address",
 			types.Revenue{
-				utiltx.GenerateAddress().String(),
-				suite.address1.String(),
-				"evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z",
+				ContractAddress:   utiltx.GenerateAddress().String(),
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: "evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z",
+			},
+			false,
+		},
+		{
+			"Create revenue- weighted withdrawers",
+			types.Revenue{
+				ContractAddress: utiltx.GenerateAddress().String(),
+				DeployerAddress: suite.address1.String(),
+				Withdrawers: []types.WithdrawerSplit{
+					{WithdrawerAddress: suite.address1.String(), Weight: 2500},
+					{WithdrawerAddress: suite.address2.String(), Weight: 7500},
+				},
+			},
+			true,
+		},
+		{
+			"Create revenue- withdraw address and weighted withdrawers",
+			types.Revenue{
+				ContractAddress:   utiltx.GenerateAddress().String(),
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: suite.address2.String(),
+				Withdrawers: []types.WithdrawerSplit{
+					{WithdrawerAddress: suite.address2.String(), Weight: 10000},
+				},
+			},
+			false,
+		},
+		{
+			"Create revenue- weights don't add up to 10000",
+			types.Revenue{
+				ContractAddress: utiltx.GenerateAddress().String(),
+				DeployerAddress: suite.address1.String(),
+				Withdrawers: []types.WithdrawerSplit{
+					{WithdrawerAddress: suite.address1.String(), Weight: 2500},
+					{WithdrawerAddress: suite.address2.String(), Weight: 2500},
+				},
+			},
+			false,
+		},
+		{
+			"Create revenue- duplicate weighted withdrawer",
+			types.Revenue{
+				ContractAddress: utiltx.GenerateAddress().String(),
+				DeployerAddress: suite.address1.String(),
+				Withdrawers: []types.WithdrawerSplit{
+					{WithdrawerAddress: suite.address2.String(), Weight: 5000},
+					{WithdrawerAddress: suite.address2.String(), Weight: 5000},
+				},
+			},
+			false,
+		},
+		{
+			"Create revenue- zero weight withdrawer",
+			types.Revenue{
+				ContractAddress: utiltx.GenerateAddress().String(),
+				DeployerAddress: suite.address1.String(),
+				Withdrawers: []types.WithdrawerSplit{
+					{WithdrawerAddress: suite.address1.String(), Weight: 10000},
+					{WithdrawerAddress: suite.address2.String(), Weight: 0},
+				},
+			},
+			false,
+		},
+		{
+			"Create revenue- invalid weighted withdraw address",
+			types.Revenue{
+				ContractAddress: utiltx.GenerateAddress().String(),
+				DeployerAddress: suite.address1.String(),
+				Withdrawers: []types.WithdrawerSplit{
+					{WithdrawerAddress: "evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z", Weight: 10000},
+				},
 			},
 			false,
 		},
@@ -154,20 +225,49 @@ func (suite *RevenueTestSuite) TestFee() {
 func (suite *RevenueTestSuite) TestRevenueGetters() {
 	contract := utiltx.GenerateAddress()
 	fs := types.Revenue{
-		contract.String(),
-		suite.address1.String(),
-		suite.address2.String(),
+		ContractAddress:   contract.String(),
+		DeployerAddress:   suite.address1.String(),
+		WithdrawerAddress: suite.address2.String(),
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
 	suite.Equal(fs.GetWithdrawerAddr(), suite.address2)
 
 	fs = types.Revenue{
-		contract.String(),
-		suite.address1.String(),
-		"",
+		ContractAddress:   contract.String(),
+		DeployerAddress:   suite.address1.String(),
+		WithdrawerAddress: "",
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
 	suite.Equal(len(fs.GetWithdrawerAddr()), 0)
 }
+
+func (suite *RevenueTestSuite) TestRevenueWithdrawerSplits() {
+	contract := utiltx.GenerateAddress()
+	fs := types.Revenue{
+		ContractAddress: contract.String(),
+		DeployerAddress: suite.address1.String(),
+	}
+	suite.Equal([]types.WithdrawerSplit{{WithdrawerAddress: suite.address1.String(), Weight: 10000}}, fs.GetWithdrawerSplits())
+	suite.Equal(uint32(10000), fs.GetWithdrawerWeight(suite.address1))
+	suite.Equal(uint32(0), fs.GetWithdrawerWeight(suite.address2))
+
+	fs.WithdrawerAddress = suite.address2.String()
+	suite.Equal([]types.WithdrawerSplit{{WithdrawerAddress: suite.address2.String(), Weight: 10000}}, fs.GetWithdrawerSplits())
+	suite.Equal(uint32(0), fs.GetWithdrawerWeight(suite.address1))
+
+	splits := []types.WithdrawerSplit{
+		{WithdrawerAddress: suite.address1.String(), Weight: 3000},
+		{WithdrawerAddress: suite.address2.String(), Weight: 7000},
+	}
+	fs.WithdrawerAddress = ""
+	fs.Withdrawers = splits
+	suite.Equal(splits, fs.GetWithdrawerSplits())
+	suite.Equal(uint32(3000), fs.GetWithdrawerWeight(suite.address1))
+	suite.Equal(uint32(7000), fs.GetWithdrawerWeight(suite.address2))
+	suite.Equal(
+		suite.address1.String()+":3000,"+suite.address2.String()+":7000",
+		types.FormatWithdrawerSplits(fs.GetWithdrawerSplits()),
+	)
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -31,6 +33,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OwnershipProof enumerates the ways of proving the ownership of a contract at
+// revenue registration
+type OwnershipProof int32
+
+const (
+	// OWNERSHIP_PROOF_ADDRESS_DERIVATION proves the ownership by deriving the
+	// contract address from the deployer address with the CREATE and CREATE2
+	// opcodes
+	OWNERSHIP_PROOF_ADDRESS_DERIVATION OwnershipProof = 0
+	// OWNERSHIP_PROOF_EIP173_OWNER proves the ownership by calling the EIP-173
+	// owner() method of the contract, which must return the deployer address
+	OWNERSHIP_PROOF_EIP173_OWNER OwnershipProof = 1
+	// OWNERSHIP_PROOF_EIP1967_ADMIN proves the ownership by reading the EIP-1967
+	// admin slot of the proxy contract, which must hold the deployer address
+	OWNERSHIP_PROOF_EIP1967_ADMIN OwnershipProof = 2
+)
+
+var OwnershipProof_name = map[int32]string{
+	0: "OWNERSHIP_PROOF_ADDRESS_DERIVATION",
+	1: "OWNERSHIP_PROOF_EIP173_OWNER",
+	2: "OWNERSHIP_PROOF_EIP1967_ADMIN",
+}
+
+var OwnershipProof_value = map[string]int32{
+	"OWNERSHIP_PROOF_ADDRESS_DERIVATION": 0,
+	"OWNERSHIP_PROOF_EIP173_OWNER":       1,
+	"OWNERSHIP_PROOF_EIP1967_ADMIN":      2,
+}
+
+func (x OwnershipProof) String() string {
+	return proto.EnumName(OwnershipProof_name, int32(x))
+}
+
+func (OwnershipProof) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2a6d23524264890a, []int{0}
+}
+
 // MsgRegisterRevenue defines a message that registers a Revenue
 type MsgRegisterRevenue struct {
 	// contract_address in hex format
@@ -42,8 +81,23 @@ type MsgRegisterRevenue struct {
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
 	// nonces is an array of nonces from the address path, where the last nonce is the nonce
 	// that determines the contract's address - it can be an EOA nonce or a
-	// factory contract nonce
+	// factory contract nonce. If create2_salt is set, the last nonce determines
+	// the address of the CREATE2 factory instead. It must be empty for ownership
+	// proofs
 	Nonces []uint64 `protobuf:"varint,4,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
+	// withdrawers is the list of weighted accounts receiving the transaction
+	// fees. It cannot be set together with withdrawer_address
+	Withdrawers []WithdrawerSplit `protobuf:"bytes,5,rep,name=withdrawers,proto3" json:"withdrawers"`
+	// ownership_proof defines how the deployer proves the ownership of the
+	// contract. It defaults to the derivation of the contract address from the
+	// deployer address and nonces
+	OwnershipProof OwnershipProof `protobuf:"varint,6,opt,name=ownership_proof,json=ownershipProof,proto3,enum=evmos.revenue.v1.OwnershipProof" json:"ownership_proof,omitempty"`
+	// create2_salt is the hex encoded 32 byte salt used by the factory derived
+	// from the nonces to deploy the contract with the CREATE2 opcode
+	Create2Salt string `protobuf:"bytes,7,opt,name=create2_salt,json=create2Salt,proto3" json:"create2_salt,omitempty"`
+	// create2_init_code_hash is the hex encoded keccak256 hash of the init code
+	// used by the factory to deploy the contract with the CREATE2 opcode
+	Create2InitCodeHash string `protobuf:"bytes,8,opt,name=create2_init_code_hash,json=create2InitCodeHash,proto3" json:"create2_init_code_hash,omitempty"`
 }
 
 func (m *MsgRegisterRevenue) Reset()         { *m = MsgRegisterRevenue{} }
@@ -107,6 +161,34 @@ func (m *MsgRegisterRevenue) GetNonces() []uint64 {
 	return nil
 }
 
+func (m *MsgRegisterRevenue) GetWithdrawers() []WithdrawerSplit {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
+func (m *MsgRegisterRevenue) GetOwnershipProof() OwnershipProof {
+	if m != nil {
+		return m.OwnershipProof
+	}
+	return OWNERSHIP_PROOF_ADDRESS_DERIVATION
+}
+
+func (m *MsgRegisterRevenue) GetCreate2Salt() string {
+	if m != nil {
+		return m.Create2Salt
+	}
+	return ""
+}
+
+func (m *MsgRegisterRevenue) GetCreate2InitCodeHash() string {
+	if m != nil {
+		return m.Create2InitCodeHash
+	}
+	return ""
+}
+
 // MsgRegisterRevenueResponse defines the MsgRegisterRevenue response type
 type MsgRegisterRevenueResponse struct {
 }
@@ -154,6 +236,9 @@ type MsgUpdateRevenue struct {
 	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
 	// withdrawer_address is the bech32 address of account receiving the transaction fees
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers is the list of weighted accounts receiving the transaction
+	// fees. It cannot be set together with withdrawer_address
+	Withdrawers []WithdrawerSplit `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *MsgUpdateRevenue) Reset()         { *m = MsgUpdateRevenue{} }
@@ -210,6 +295,13 @@ func (m *MsgUpdateRevenue) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgUpdateRevenue) GetWithdrawers() []WithdrawerSplit {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
 // MsgUpdateRevenueResponse defines the MsgUpdateRevenue response type
 type MsgUpdateRevenueResponse struct {
 }
@@ -340,6 +432,100 @@ func (m *MsgCancelRevenueResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelRevenueResponse proto.InternalMessageInfo
 
+// MsgWithdrawRevenue defines a message that withdraws the revenue accrued by a
+// withdrawer
+type MsgWithdrawRevenue struct {
+	// withdrawer_address is the bech32 address of the account that accrued the
+	// revenue
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *MsgWithdrawRevenue) Reset()         { *m = MsgWithdrawRevenue{} }
+func (m *MsgWithdrawRevenue) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRevenue) ProtoMessage()    {}
+func (*MsgWithdrawRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a6d23524264890a, []int{6}
+}
+func (m *MsgWithdrawRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRevenue.Merge(m, src)
+}
+func (m *MsgWithdrawRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRevenue proto.InternalMessageInfo
+
+func (m *MsgWithdrawRevenue) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// MsgWithdrawRevenueResponse defines the MsgWithdrawRevenue response type
+type MsgWithdrawRevenueResponse struct {
+	// amount is the withdrawn revenue
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawRevenueResponse) Reset()         { *m = MsgWithdrawRevenueResponse{} }
+func (m *MsgWithdrawRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRevenueResponse) ProtoMessage()    {}
+func (*MsgWithdrawRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a6d23524264890a, []int{7}
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRevenueResponse.Merge(m, src)
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRevenueResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawRevenueResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // MsgUpdateParams defines a Msg for updating the x/revenue module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -353,7 +539,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2a6d23524264890a, []int{6}
+	return fileDescriptor_2a6d23524264890a, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +591,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2a6d23524264890a, []int{7}
+	return fileDescriptor_2a6d23524264890a, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,12 +621,15 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("evmos.revenue.v1.OwnershipProof", OwnershipProof_name, OwnershipProof_value)
 	proto.RegisterType((*MsgRegisterRevenue)(nil), "evmos.revenue.v1.MsgRegisterRevenue")
 	proto.RegisterType((*MsgRegisterRevenueResponse)(nil), "evmos.revenue.v1.MsgRegisterRevenueResponse")
 	proto.RegisterType((*MsgUpdateRevenue)(nil), "evmos.revenue.v1.MsgUpdateRevenue")
 	proto.RegisterType((*MsgUpdateRevenueResponse)(nil), "evmos.revenue.v1.MsgUpdateRevenueResponse")
 	proto.RegisterType((*MsgCancelRevenue)(nil), "evmos.revenue.v1.MsgCancelRevenue")
 	proto.RegisterType((*MsgCancelRevenueResponse)(nil), "evmos.revenue.v1.MsgCancelRevenueResponse")
+	proto.RegisterType((*MsgWithdrawRevenue)(nil), "evmos.revenue.v1.MsgWithdrawRevenue")
+	proto.RegisterType((*MsgWithdrawRevenueResponse)(nil), "evmos.revenue.v1.MsgWithdrawRevenueResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.revenue.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.revenue.v1.MsgUpdateParamsResponse")
 }
@@ -448,43 +637,64 @@ func init() {
 func init() { proto.RegisterFile("evmos/revenue/v1/tx.proto", fileDescriptor_2a6d23524264890a) }
 
 var fileDescriptor_2a6d23524264890a = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xc0, 0x33, 0x4d, 0x08, 0x74, 0xfc, 0x93, 0xb8, 0x14, 0x9b, 0x2c, 0x65, 0x5b, 0x57, 0x8b,
-	0x6d, 0x35, 0xbb, 0x24, 0x42, 0x0f, 0xbd, 0x19, 0x0f, 0x9e, 0x02, 0xb2, 0xe2, 0x45, 0x84, 0x30,
-	0xdd, 0x0c, 0x93, 0x85, 0x64, 0x66, 0x99, 0x99, 0xa4, 0xcd, 0xb5, 0x67, 0x0f, 0x8a, 0x1e, 0xbc,
-	0x08, 0x7e, 0x04, 0x0f, 0x82, 0x5f, 0xa1, 0xc7, 0xa2, 0x17, 0x4f, 0x22, 0x89, 0xa0, 0x1f, 0x43,
-	0x32, 0x33, 0xbb, 0x71, 0x93, 0xd8, 0x7a, 0x11, 0xbc, 0x84, 0xcc, 0x7b, 0xbf, 0xf7, 0xde, 0x6f,
-	0xc2, 0x9b, 0xc0, 0x2a, 0x1e, 0xf6, 0x99, 0xf0, 0x39, 0x1e, 0x62, 0x3a, 0xc0, 0xfe, 0xb0, 0xee,
-	0xcb, 0x63, 0x2f, 0xe6, 0x4c, 0x32, 0xab, 0xac, 0x52, 0x9e, 0x49, 0x79, 0xc3, 0xba, 0xbd, 0x1e,
-	0x32, 0x31, 0xa5, 0xfb, 0x82, 0x4c, 0xc9, 0xbe, 0x20, 0x1a, 0xb5, 0xab, 0x3a, 0xd1, 0x56, 0x27,
-	0x5f, 0x1f, 0x4c, 0xca, 0x59, 0x18, 0x40, 0x30, 0xc5, 0x22, 0x4a, 0xf2, 0x6b, 0x84, 0x11, 0xa6,
-	0xeb, 0xa6, 0xdf, 0x4c, 0x74, 0x83, 0x30, 0x46, 0x7a, 0xd8, 0x47, 0x71, 0xe4, 0x23, 0x4a, 0x99,
-	0x44, 0x32, 0x62, 0xd4, 0xd4, 0xb8, 0x1f, 0x01, 0xb4, 0x5a, 0x82, 0x04, 0x98, 0x44, 0x42, 0x62,
-	0x1e, 0xe8, 0xde, 0xd6, 0x2e, 0x2c, 0x87, 0x8c, 0x4a, 0x8e, 0x42, 0xd9, 0x46, 0x9d, 0x0e, 0xc7,
-	0x42, 0x54, 0xc0, 0x16, 0xd8, 0x59, 0x0d, 0x4a, 0x49, 0xfc, 0xbe, 0x0e, 0x4f, 0xd1, 0x0e, 0x8e,
-	0x7b, 0x6c, 0x84, 0x79, 0x8a, 0xae, 0x68, 0x34, 0x89, 0x27, 0x68, 0x0d, 0x5a, 0x47, 0x91, 0xec,
-	0x76, 0x38, 0x3a, 0xfa, 0x0d, 0xce, 0x2b, 0xf8, 0xda, 0x2c, 0x93, 0xe0, 0xd7, 0x61, 0x91, 0x32,
-	0x1a, 0x62, 0x51, 0x29, 0x6c, 0xe5, 0x77, 0x0a, 0x81, 0x39, 0x1d, 0x14, 0x7e, 0xbe, 0xdb, 0xcc,
-	0xb9, 0x1b, 0xd0, 0x5e, 0x14, 0x0f, 0xb0, 0x88, 0x19, 0x15, 0xd8, 0x7d, 0x0b, 0x60, 0xb9, 0x25,
-	0xc8, 0x93, 0xb8, 0x83, 0x24, 0xfe, 0x9f, 0x6e, 0x65, 0xec, 0x6d, 0x58, 0x99, 0xd7, 0x4b, 0xdd,
-	0xa9, 0x52, 0x7f, 0x80, 0x68, 0x88, 0x7b, 0xff, 0x54, 0x3d, 0xe3, 0x92, 0x99, 0x97, 0xba, 0xbc,
-	0x04, 0xb0, 0x94, 0x8a, 0x3e, 0x42, 0x1c, 0xf5, 0x85, 0xb5, 0x0f, 0x57, 0xd1, 0x40, 0x76, 0x19,
-	0x8f, 0xe4, 0x48, 0x4b, 0x34, 0x2b, 0x9f, 0x3e, 0xd4, 0xd6, 0xcc, 0xb2, 0x9a, 0xe6, 0x8f, 0x25,
-	0x8f, 0x28, 0x09, 0x66, 0xa8, 0xb5, 0x0f, 0x8b, 0xb1, 0xea, 0xa0, 0x74, 0x2e, 0x35, 0x2a, 0xde,
-	0xfc, 0xb3, 0xf0, 0xf4, 0x84, 0x66, 0xe1, 0xf4, 0xeb, 0x66, 0x2e, 0x30, 0xf4, 0xc1, 0xd5, 0x93,
-	0x1f, 0xef, 0xf7, 0x66, 0x7d, 0xdc, 0x2a, 0x5c, 0x9f, 0x53, 0x4a, 0x74, 0x1b, 0x6f, 0x0a, 0x30,
-	0xdf, 0x12, 0xc4, 0x7a, 0x0d, 0x60, 0x69, 0x7e, 0xa7, 0x6f, 0x2d, 0x8e, 0x5b, 0x5c, 0x20, 0xfb,
-	0xee, 0xdf, 0x50, 0xe9, 0xcf, 0x53, 0x3b, 0xf9, 0xfc, 0xfd, 0xd5, 0xca, 0x6d, 0x77, 0xdb, 0x5f,
-	0xf2, 0xf8, 0x7d, 0x6e, 0xaa, 0xda, 0x26, 0x6c, 0x3d, 0x07, 0xf0, 0x4a, 0x76, 0x25, 0xdd, 0xa5,
-	0xe3, 0x32, 0x8c, 0xbd, 0x77, 0x31, 0x93, 0x0a, 0xdd, 0x51, 0x42, 0xdb, 0xee, 0xcd, 0xa5, 0x42,
-	0x03, 0x55, 0x93, 0xd1, 0xc9, 0xae, 0xd9, 0x72, 0x9d, 0x0c, 0xf3, 0x07, 0x9d, 0xe5, 0xeb, 0x73,
-	0xbe, 0x4e, 0xa8, 0x6a, 0x52, 0x9d, 0x67, 0xf0, 0x72, 0x66, 0xcf, 0x6e, 0x9c, 0x73, 0x6f, 0x8d,
-	0xd8, 0xbb, 0x17, 0x22, 0x89, 0x4a, 0xf3, 0xe1, 0xe9, 0xd8, 0x01, 0x67, 0x63, 0x07, 0x7c, 0x1b,
-	0x3b, 0xe0, 0xc5, 0xc4, 0xc9, 0x9d, 0x4d, 0x9c, 0xdc, 0x97, 0x89, 0x93, 0x7b, 0x5a, 0x23, 0x91,
-	0xec, 0x0e, 0x0e, 0xbd, 0x90, 0xf5, 0x8d, 0xa6, 0xfe, 0x1c, 0xd6, 0x1b, 0xfe, 0x71, 0x46, 0x79,
-	0x14, 0x63, 0x71, 0x58, 0x54, 0xff, 0x9c, 0xf7, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x20, 0xe5,
-	0x3a, 0x50, 0xf0, 0x05, 0x00, 0x00,
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x26, 0x1b, 0xd8, 0xe9, 0xd2, 0x86, 0x61, 0xb5, 0xeb, 0x5a, 0xc5, 0x4d, 0x0d,
+	0x0b, 0xd9, 0xb2, 0xb1, 0x49, 0x2a, 0x75, 0xc5, 0xde, 0xfa, 0x0b, 0xd6, 0x87, 0x36, 0x91, 0x03,
+	0xac, 0x84, 0x90, 0xac, 0xa9, 0x33, 0x38, 0x16, 0xc9, 0x8c, 0xe5, 0x99, 0xa4, 0xdb, 0x23, 0xcb,
+	0x85, 0x03, 0x07, 0x10, 0xfc, 0x01, 0x48, 0x88, 0x0b, 0x17, 0x38, 0xf0, 0x47, 0xec, 0x71, 0x05,
+	0x17, 0x4e, 0x80, 0x5a, 0x24, 0xf6, 0xcf, 0x40, 0x1e, 0x8f, 0xdd, 0xda, 0xc9, 0xd2, 0x08, 0x09,
+	0x89, 0x4b, 0x9b, 0x79, 0xef, 0x33, 0x6f, 0xbe, 0xf3, 0xde, 0xbc, 0x97, 0x80, 0x15, 0x3c, 0x19,
+	0x51, 0x66, 0x45, 0x78, 0x82, 0xc9, 0x18, 0x5b, 0x93, 0x96, 0xc5, 0x1f, 0x9a, 0x61, 0x44, 0x39,
+	0x85, 0x35, 0xe1, 0x32, 0xa5, 0xcb, 0x9c, 0xb4, 0x34, 0xdd, 0xa3, 0x2c, 0xa6, 0x8f, 0x10, 0x8b,
+	0xd1, 0x23, 0xcc, 0x51, 0xcb, 0xf2, 0x68, 0x40, 0x92, 0x1d, 0xda, 0x4d, 0xe9, 0x1f, 0x31, 0x3f,
+	0x8e, 0x34, 0x62, 0xbe, 0x74, 0xac, 0x24, 0x0e, 0x57, 0xac, 0xac, 0x64, 0x21, 0x5d, 0xfa, 0x94,
+	0x00, 0x1f, 0x13, 0xcc, 0x82, 0x67, 0xfb, 0x53, 0x41, 0x89, 0xff, 0xba, 0x4f, 0x7d, 0x9a, 0xc4,
+	0x8d, 0x3f, 0x49, 0xeb, 0xaa, 0x4f, 0xa9, 0x3f, 0xc4, 0x16, 0x0a, 0x03, 0x0b, 0x11, 0x42, 0x39,
+	0xe2, 0x01, 0x25, 0x32, 0xa6, 0xf1, 0x5d, 0x19, 0xc0, 0x03, 0xe6, 0x3b, 0xd8, 0x0f, 0x18, 0xc7,
+	0x91, 0x93, 0x04, 0x84, 0xb7, 0x41, 0xcd, 0xa3, 0x84, 0x47, 0xc8, 0xe3, 0x2e, 0xea, 0xf7, 0x23,
+	0xcc, 0x98, 0xaa, 0xd4, 0x95, 0xc6, 0x55, 0x67, 0x39, 0xb5, 0x6f, 0x27, 0xe6, 0x18, 0xed, 0xe3,
+	0x70, 0x48, 0x4f, 0x70, 0x94, 0xa1, 0x0b, 0x09, 0x9a, 0xda, 0x53, 0xb4, 0x09, 0xe0, 0x71, 0xc0,
+	0x07, 0xfd, 0x08, 0x1d, 0x5f, 0x80, 0xcb, 0x02, 0x7e, 0xf1, 0xdc, 0x93, 0xe2, 0x37, 0x40, 0x95,
+	0x50, 0xe2, 0x61, 0xa6, 0x56, 0xea, 0xe5, 0x46, 0xc5, 0x91, 0x2b, 0x68, 0x83, 0xc5, 0x73, 0x98,
+	0xa9, 0x57, 0xea, 0xe5, 0xc6, 0x62, 0x7b, 0xdd, 0x2c, 0xd6, 0xc8, 0x7c, 0x90, 0x41, 0xbd, 0x70,
+	0x18, 0xf0, 0x9d, 0xca, 0xe3, 0xdf, 0xd6, 0x4a, 0xce, 0xc5, 0xbd, 0xd0, 0x06, 0xcb, 0xf4, 0x98,
+	0xe0, 0x88, 0x0d, 0x82, 0x30, 0x2e, 0x09, 0xfd, 0x48, 0xad, 0xd6, 0x95, 0xc6, 0x52, 0xbb, 0x3e,
+	0x1d, 0xae, 0x93, 0x82, 0xdd, 0x98, 0x73, 0x96, 0x68, 0x6e, 0x0d, 0xd7, 0xc1, 0x35, 0x2f, 0xc2,
+	0x88, 0xe3, 0xb6, 0xcb, 0xd0, 0x90, 0xab, 0xcf, 0x89, 0x6b, 0x2d, 0x4a, 0x5b, 0x0f, 0x0d, 0x39,
+	0xdc, 0x04, 0x37, 0x52, 0x24, 0x20, 0x01, 0x77, 0x3d, 0xda, 0xc7, 0xee, 0x00, 0xb1, 0x81, 0xfa,
+	0xbc, 0x80, 0x5f, 0x92, 0x5e, 0x9b, 0x04, 0x7c, 0x97, 0xf6, 0xf1, 0x7d, 0xc4, 0x06, 0xf7, 0x2a,
+	0x4f, 0xbf, 0x59, 0x2b, 0x19, 0xab, 0x40, 0x9b, 0x2e, 0x93, 0x83, 0x59, 0x48, 0x09, 0xc3, 0xc6,
+	0x53, 0x05, 0xd4, 0x0e, 0x98, 0xff, 0x5e, 0xd8, 0x47, 0x1c, 0xff, 0xaf, 0x6a, 0x58, 0xa8, 0x55,
+	0xe5, 0xdf, 0xd7, 0x4a, 0x26, 0x42, 0x03, 0x6a, 0xf1, 0xa6, 0x59, 0x1a, 0x88, 0xc8, 0xc2, 0x2e,
+	0x22, 0x1e, 0x1e, 0xfe, 0xa7, 0x59, 0xc8, 0x69, 0xc9, 0x9d, 0x97, 0x69, 0xb1, 0x45, 0x5f, 0xa5,
+	0xd7, 0x4a, 0xd5, 0xcc, 0xce, 0x9e, 0xf2, 0x8c, 0xec, 0xc9, 0x63, 0x3e, 0x51, 0x80, 0x36, 0x1d,
+	0x2b, 0x3d, 0x09, 0x7a, 0xa0, 0x8a, 0x46, 0x74, 0x4c, 0xb8, 0xaa, 0x88, 0xec, 0xae, 0x98, 0x72,
+	0xaa, 0xc4, 0xb3, 0xc9, 0x94, 0xb3, 0xc9, 0xdc, 0xa5, 0x01, 0xd9, 0x79, 0x33, 0xce, 0xea, 0xf7,
+	0xbf, 0xaf, 0x35, 0xfc, 0x80, 0x0f, 0xc6, 0x47, 0xa6, 0x47, 0x47, 0x72, 0x04, 0xc9, 0x7f, 0x4d,
+	0xd6, 0xff, 0xd8, 0xe2, 0x27, 0x21, 0x66, 0x62, 0x03, 0x73, 0x64, 0x68, 0xe3, 0x4b, 0x05, 0x2c,
+	0x67, 0x79, 0xef, 0xa2, 0x08, 0x8d, 0x18, 0xdc, 0x02, 0x57, 0xd1, 0x98, 0x0f, 0x68, 0x14, 0xf0,
+	0x93, 0xe4, 0x0e, 0x3b, 0xea, 0xcf, 0x3f, 0x35, 0xaf, 0xcb, 0xe3, 0xe5, 0x25, 0x7a, 0x3c, 0x0a,
+	0x88, 0xef, 0x9c, 0xa3, 0x70, 0x0b, 0x54, 0x43, 0x11, 0x41, 0x64, 0x77, 0xb1, 0xad, 0x4e, 0x3f,
+	0x87, 0xe4, 0x04, 0xf9, 0x0a, 0x24, 0x7d, 0x6f, 0xe9, 0xd1, 0x5f, 0x3f, 0x6e, 0x9c, 0xc7, 0x31,
+	0x56, 0xc0, 0xcd, 0x82, 0xa4, 0x34, 0x27, 0x1b, 0x9f, 0x2a, 0x60, 0x29, 0xdf, 0xaf, 0xf0, 0x35,
+	0x60, 0x74, 0x1e, 0x1c, 0xee, 0x3b, 0xbd, 0xfb, 0x76, 0xd7, 0xed, 0x3a, 0x9d, 0xce, 0xdb, 0xee,
+	0xf6, 0xde, 0x9e, 0xb3, 0xdf, 0xeb, 0xb9, 0x7b, 0xfb, 0x8e, 0xfd, 0xfe, 0xf6, 0xbb, 0x76, 0xe7,
+	0xb0, 0x56, 0x82, 0x75, 0xb0, 0x5a, 0xe4, 0xf6, 0xed, 0x6e, 0xeb, 0xee, 0xa6, 0x2b, 0xcc, 0x35,
+	0x05, 0xae, 0x83, 0x97, 0x67, 0x11, 0x6f, 0x6d, 0xdd, 0x75, 0xb7, 0xf7, 0x0e, 0xec, 0xc3, 0xda,
+	0x82, 0x56, 0xf9, 0xec, 0x5b, 0xbd, 0xd4, 0xfe, 0xe1, 0x0a, 0x28, 0x1f, 0x30, 0x1f, 0x7e, 0xad,
+	0x80, 0xe5, 0xe2, 0x84, 0x7d, 0x75, 0xfa, 0xd2, 0xd3, 0x0d, 0xae, 0xdd, 0x99, 0x87, 0xca, 0xde,
+	0x5c, 0xf3, 0xd1, 0x2f, 0x7f, 0x7e, 0xb5, 0xf0, 0xba, 0x71, 0xcb, 0x9a, 0xf1, 0x55, 0x66, 0x45,
+	0x72, 0x97, 0x2b, 0xcd, 0xf0, 0x73, 0x05, 0xbc, 0x90, 0x1f, 0x19, 0xc6, 0xcc, 0xe3, 0x72, 0x8c,
+	0xb6, 0x71, 0x39, 0x93, 0x09, 0x7a, 0x43, 0x08, 0xba, 0x65, 0xbc, 0x32, 0x53, 0xd0, 0x58, 0xec,
+	0xc9, 0xc9, 0xc9, 0xf7, 0xee, 0x6c, 0x39, 0x39, 0x46, 0xdb, 0xb8, 0x9c, 0x99, 0x53, 0x8e, 0x27,
+	0xf6, 0x64, 0x72, 0xe2, 0xa2, 0x15, 0xdb, 0x77, 0x76, 0xd1, 0x0a, 0x94, 0x76, 0x67, 0x1e, 0x6a,
+	0xce, 0xa2, 0xa5, 0x33, 0x21, 0x93, 0xf5, 0x21, 0xb8, 0x96, 0x6b, 0xc2, 0xf5, 0x7f, 0x28, 0x47,
+	0x82, 0x68, 0xb7, 0x2f, 0x45, 0x52, 0x31, 0x3b, 0xef, 0x3c, 0x3e, 0xd5, 0x95, 0x27, 0xa7, 0xba,
+	0xf2, 0xc7, 0xa9, 0xae, 0x7c, 0x71, 0xa6, 0x97, 0x9e, 0x9c, 0xe9, 0xa5, 0x5f, 0xcf, 0xf4, 0xd2,
+	0x07, 0xcd, 0x0b, 0x23, 0x23, 0x11, 0x9a, 0xfc, 0x9d, 0xb4, 0xda, 0xd6, 0xc3, 0x9c, 0xe8, 0x78,
+	0x7a, 0x1c, 0x55, 0xc5, 0xcf, 0x8b, 0xcd, 0xbf, 0x07, 0x00, 0x61, 0xc0, 0x94, 0x14, 0x55, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -501,11 +711,14 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// RegisterRevenue registers a new contract for receiving transaction fees
 	RegisterRevenue(ctx context.Context, in *MsgRegisterRevenue, opts ...grpc.CallOption) (*MsgRegisterRevenueResponse, error)
-	// UpdateRevenue updates the withdrawer address of a revenue
+	// UpdateRevenue updates the withdrawer address or the weighted withdrawers of
+	// a revenue
 	UpdateRevenue(ctx context.Context, in *MsgUpdateRevenue, opts ...grpc.CallOption) (*MsgUpdateRevenueResponse, error)
 	// CancelRevenue cancels a contract's fee registration and further receival
 	// of transaction fees
 	CancelRevenue(ctx context.Context, in *MsgCancelRevenue, opts ...grpc.CallOption) (*MsgCancelRevenueResponse, error)
+	// WithdrawRevenue withdraws the revenue accrued by a withdrawer
+	WithdrawRevenue(ctx context.Context, in *MsgWithdrawRevenue, opts ...grpc.CallOption) (*MsgWithdrawRevenueResponse, error)
 	// UpdateParams defined a governance operation for updating the x/revenue module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -546,6 +759,15 @@ func (c *msgClient) CancelRevenue(ctx context.Context, in *MsgCancelRevenue, opt
 	return out, nil
 }
 
+func (c *msgClient) WithdrawRevenue(ctx context.Context, in *MsgWithdrawRevenue, opts ...grpc.CallOption) (*MsgWithdrawRevenueResponse, error) {
+	out := new(MsgWithdrawRevenueResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Msg/WithdrawRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Msg/UpdateParams", in, out, opts...)
@@ -559,11 +781,14 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// RegisterRevenue registers a new contract for receiving transaction fees
 	RegisterRevenue(context.Context, *MsgRegisterRevenue) (*MsgRegisterRevenueResponse, error)
-	// UpdateRevenue updates the withdrawer address of a revenue
+	// UpdateRevenue updates the withdrawer address or the weighted withdrawers of
+	// a revenue
 	UpdateRevenue(context.Context, *MsgUpdateRevenue) (*MsgUpdateRevenueResponse, error)
 	// CancelRevenue cancels a contract's fee registration and further receival
 	// of transaction fees
 	CancelRevenue(context.Context, *MsgCancelRevenue) (*MsgCancelRevenueResponse, error)
+	// WithdrawRevenue withdraws the revenue accrued by a withdrawer
+	WithdrawRevenue(context.Context, *MsgWithdrawRevenue) (*MsgWithdrawRevenueResponse, error)
 	// UpdateParams defined a governance operation for updating the x/revenue module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -582,6 +807,9 @@ func (*UnimplementedMsgServer) UpdateRevenue(ctx context.Context, req *MsgUpdate
 func (*UnimplementedMsgServer) CancelRevenue(ctx context.Context, req *MsgCancelRevenue) (*MsgCancelRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRevenue not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawRevenue(ctx context.Context, req *MsgWithdrawRevenue) (*MsgWithdrawRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRevenue not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -644,6 +872,24 @@ func _Msg_CancelRevenue_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Msg/WithdrawRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRevenue(ctx, req.(*MsgWithdrawRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -678,6 +924,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRevenue",
 			Handler:    _Msg_CancelRevenue_Handler,
 		},
+		{
+			MethodName: "WithdrawRevenue",
+			Handler:    _Msg_WithdrawRevenue_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -707,6 +957,39 @@ func (m *MsgRegisterRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Create2InitCodeHash) > 0 {
+		i -= len(m.Create2InitCodeHash)
+		copy(dAtA[i:], m.Create2InitCodeHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Create2InitCodeHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Create2Salt) > 0 {
+		i -= len(m.Create2Salt)
+		copy(dAtA[i:], m.Create2Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Create2Salt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OwnershipProof != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OwnershipProof))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Nonces) > 0 {
 		dAtA2 := make([]byte, len(m.Nonces)*10)
 		var j1 int
@@ -792,6 +1075,20 @@ func (m *MsgUpdateRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -899,6 +1196,73 @@ func (m *MsgCancelRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -998,6 +1362,23 @@ func (m *MsgRegisterRevenue) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.OwnershipProof != 0 {
+		n += 1 + sovTx(uint64(m.OwnershipProof))
+	}
+	l = len(m.Create2Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Create2InitCodeHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1028,6 +1409,12 @@ func (m *MsgUpdateRevenue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1066,6 +1453,34 @@ func (m *MsgCancelRevenueResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1297,6 +1712,123 @@ func (m *MsgRegisterRevenue) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, WithdrawerSplit{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipProof", wireType)
+			}
+			m.OwnershipProof = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnershipProof |= OwnershipProof(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create2Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Create2Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create2InitCodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Create2InitCodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1493,6 +2025,40 @@ func (m *MsgUpdateRevenue) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, WithdrawerSplit{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1728,6 +2294,172 @@ func (m *MsgCancelRevenueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
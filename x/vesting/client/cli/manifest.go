@@ -0,0 +1,392 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/evmos/v12/x/vesting/types"
+)
+
+// manifestCSVHeader is the expected header of a CSV manifest
+var manifestCSVHeader = []string{"address", "amount", "cliff_seconds", "template"}
+
+// Manifest defines a batch of clawback vesting grants that share the same
+// start time and a set of vesting templates.
+type Manifest struct {
+	StartTime int64                      `json:"start_time"`
+	Templates map[string]VestingTemplate `json:"templates"`
+	Grants    []Grant                    `json:"grants"`
+}
+
+// VestingTemplate defines a vesting schedule of equal periods and an optional
+// lockup, during which the vested coins can't be transferred.
+type VestingTemplate struct {
+	Periods      int64 `json:"periods"`
+	PeriodLength int64 `json:"period_length_seconds"`
+	LockupLength int64 `json:"lockup_seconds"`
+}
+
+// Grant defines a clawback vesting grant of a manifest. The coins of the
+// vesting periods that end before the cliff vest at the cliff.
+type Grant struct {
+	Address  string `json:"address"`
+	Amount   string `json:"amount"`
+	Cliff    int64  `json:"cliff_seconds"`
+	Template string `json:"template"`
+}
+
+// MonthlyLocked defines the total coins of a manifest that are locked or
+// unvested at a given time.
+type MonthlyLocked struct {
+	Month  int
+	Time   time.Time
+	Locked sdk.Coins
+}
+
+// ReadManifestFile reads a JSON or CSV manifest. The grants of a CSV manifest
+// are read from its rows, while the start time and the templates are read
+// from the JSON templates file.
+func ReadManifestFile(path, templatesPath string) (Manifest, error) {
+	var manifest Manifest
+
+	if !strings.EqualFold(filepath.Ext(path), ".csv") {
+		if err := readJSONFile(path, &manifest); err != nil {
+			return Manifest{}, err
+		}
+		return manifest, nil
+	}
+
+	if templatesPath == "" {
+		return Manifest{}, errors.New("a templates file is required for CSV manifests")
+	}
+
+	if err := readJSONFile(templatesPath, &manifest); err != nil {
+		return Manifest{}, err
+	}
+
+	grants, err := readCSVGrants(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	manifest.Grants = grants
+	return manifest, nil
+}
+
+// readJSONFile reads the file at path and unmarshals it into out
+func readJSONFile(path string, out interface{}) error {
+	contents, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(contents, out)
+}
+
+// readCSVGrants reads the grants from the rows of a CSV file
+func readCSVGrants(path string) ([]Grant, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = len(manifestCSVHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	for i, column := range manifestCSVHeader {
+		if strings.TrimSpace(header[i]) != column {
+			return nil, fmt.Errorf("invalid CSV header, expected %s", strings.Join(manifestCSVHeader, ","))
+		}
+	}
+
+	var grants []Grant
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		cliff, err := strconv.ParseInt(record[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cliff for grant to %s: %w", record[0], err)
+		}
+
+		grants = append(grants, Grant{
+			Address:  record[0],
+			Amount:   record[1],
+			Cliff:    cliff,
+			Template: record[3],
+		})
+	}
+
+	return grants, nil
+}
+
+// Validate performs a stateless validation of the template
+func (t VestingTemplate) Validate() error {
+	if t.Periods < 1 {
+		return fmt.Errorf("number of periods must be positive, got %d", t.Periods)
+	}
+
+	if t.PeriodLength < 1 {
+		return fmt.Errorf("period length must be positive, got %d", t.PeriodLength)
+	}
+
+	if t.LockupLength < 0 {
+		return fmt.Errorf("lockup length can't be negative, got %d", t.LockupLength)
+	}
+
+	return nil
+}
+
+// LockupPeriods returns the lockup schedule of the template for the given
+// amount. An empty schedule is returned if the template has no lockup.
+func (t VestingTemplate) LockupPeriods(amount sdk.Coins) sdkvesting.Periods {
+	if t.LockupLength == 0 {
+		return nil
+	}
+
+	return sdkvesting.Periods{{Length: t.LockupLength, Amount: amount}}
+}
+
+// VestingPeriods returns the vesting schedule of the template for the given
+// amount and cliff. The amount is split equally between the periods, with the
+// remainder vesting in the last period. The periods that end before or at the
+// cliff are merged into a single period that ends at the cliff.
+func (t VestingTemplate) VestingPeriods(amount sdk.Coins, cliff int64) sdkvesting.Periods {
+	periodAmount := sdk.Coins{}
+	remainder := sdk.Coins{}
+	for _, coin := range amount {
+		quotient := coin.Amount.QuoRaw(t.Periods)
+		periodAmount = periodAmount.Add(sdk.NewCoin(coin.Denom, quotient))
+		remainder = remainder.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(quotient.MulRaw(t.Periods))))
+	}
+
+	var (
+		periods   sdkvesting.Periods
+		accrued   sdk.Coins
+		lastEvent int64
+	)
+
+	for i := int64(1); i <= t.Periods; i++ {
+		eventTime := i * t.PeriodLength
+		eventAmount := periodAmount
+		if i == t.Periods {
+			eventAmount = eventAmount.Add(remainder...)
+		}
+
+		if eventTime <= cliff {
+			accrued = accrued.Add(eventAmount...)
+			continue
+		}
+
+		if !accrued.IsZero() {
+			periods = append(periods, sdkvesting.Period{Length: cliff - lastEvent, Amount: accrued})
+			lastEvent = cliff
+			accrued = nil
+		}
+
+		periods = append(periods, sdkvesting.Period{Length: eventTime - lastEvent, Amount: eventAmount})
+		lastEvent = eventTime
+	}
+
+	if !accrued.IsZero() {
+		periods = append(periods, sdkvesting.Period{Length: cliff - lastEvent, Amount: accrued})
+	}
+
+	return periods
+}
+
+// BuildMsgs validates the manifest and returns a MsgCreateClawbackVestingAccount
+// for each of its grants, funded by the given address.
+func (m Manifest) BuildMsgs(funder sdk.AccAddress, merge bool) ([]*types.MsgCreateClawbackVestingAccount, error) {
+	if m.StartTime <= 0 {
+		return nil, fmt.Errorf("start time must be positive, got %d", m.StartTime)
+	}
+
+	if len(m.Grants) == 0 {
+		return nil, errors.New("manifest has no grants")
+	}
+
+	for name, template := range m.Templates {
+		if err := template.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid template %s: %w", name, err)
+		}
+	}
+
+	startTime := time.Unix(m.StartTime, 0)
+	seen := make(map[string]bool, len(m.Grants))
+	msgs := make([]*types.MsgCreateClawbackVestingAccount, 0, len(m.Grants))
+
+	for i, grant := range m.Grants {
+		to, err := sdk.AccAddressFromBech32(strings.TrimSpace(grant.Address))
+		if err != nil {
+			return nil, fmt.Errorf("invalid address in grant %d: %w", i, err)
+		}
+
+		if seen[to.String()] {
+			return nil, fmt.Errorf("duplicate grant %d to %s", i, to)
+		}
+		seen[to.String()] = true
+
+		amount, err := sdk.ParseCoinsNormalized(strings.TrimSpace(grant.Amount))
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount in grant %d to %s: %w", i, to, err)
+		}
+
+		template, found := m.Templates[strings.TrimSpace(grant.Template)]
+		if !found {
+			return nil, fmt.Errorf("unknown template %q in grant %d to %s", grant.Template, i, to)
+		}
+
+		if err := validateGrant(grant, template, amount); err != nil {
+			return nil, fmt.Errorf("invalid grant %d to %s: %w", i, to, err)
+		}
+
+		msg := types.NewMsgCreateClawbackVestingAccount(
+			funder,
+			to,
+			startTime,
+			template.LockupPeriods(amount),
+			template.VestingPeriods(amount, grant.Cliff),
+			merge,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid grant %d to %s: %w", i, to, err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// validateGrant checks that the grant amount and cliff fit the template
+func validateGrant(grant Grant, template VestingTemplate, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return errors.New("amount can't be zero")
+	}
+
+	for _, coin := range amount {
+		if coin.Amount.LT(sdk.NewInt(template.Periods)) {
+			return fmt.Errorf("amount %s is smaller than the number of periods %d", coin, template.Periods)
+		}
+	}
+
+	if grant.Cliff < 0 || grant.Cliff > template.Periods*template.PeriodLength {
+		return fmt.Errorf("cliff must be between 0 and the vesting length, got %d", grant.Cliff)
+	}
+
+	return nil
+}
+
+// MonthlyLockedAmounts returns the total coins of the grants that are still
+// locked or unvested at the start time and at the end of every following
+// month, until all the coins are vested and unlocked.
+func MonthlyLockedAmounts(msgs []*types.MsgCreateClawbackVestingAccount) []MonthlyLocked {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	accounts := make([]*types.ClawbackVestingAccount, 0, len(msgs))
+	startTime := msgs[0].StartTime
+	endTime := int64(0)
+
+	for _, msg := range msgs {
+		amount := msg.VestingPeriods.TotalAmount()
+
+		// default to an instant unlock schedule, as the msg server does
+		lockupPeriods := msg.LockupPeriods
+		if len(lockupPeriods) == 0 {
+			lockupPeriods = sdkvesting.Periods{{Length: 0, Amount: amount}}
+		}
+
+		baseAcc := authtypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32(msg.ToAddress))
+		funder := sdk.MustAccAddressFromBech32(msg.FromAddress)
+		va := types.NewClawbackVestingAccount(baseAcc, funder, amount, msg.StartTime, lockupPeriods, msg.VestingPeriods)
+
+		accounts = append(accounts, va)
+		if msg.StartTime.Before(startTime) {
+			startTime = msg.StartTime
+		}
+		endTime = types.Max64(endTime, va.EndTime)
+	}
+
+	var report []MonthlyLocked
+	for month := 0; ; month++ {
+		readTime := startTime.AddDate(0, month, 0)
+
+		locked := sdk.Coins{}
+		for _, va := range accounts {
+			locked = locked.Add(va.GetVestingCoins(readTime)...)
+		}
+
+		report = append(report, MonthlyLocked{Month: month, Time: readTime, Locked: locked})
+
+		if readTime.Unix() >= endTime {
+			break
+		}
+	}
+
+	return report
+}
+
+// FormatLockedReport returns a human readable table of the monthly locked
+// amounts of a manifest.
+func FormatLockedReport(msgs []*types.MsgCreateClawbackVestingAccount, report []MonthlyLocked) string {
+	total := sdk.Coins{}
+	for _, msg := range msgs {
+		total = total.Add(msg.VestingPeriods.TotalAmount()...)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "grants: %d\ntotal: %s\n\n", len(msgs), total)
+	fmt.Fprintf(&sb, "%-6s %-10s %s\n", "month", "date", "locked")
+	for _, row := range report {
+		locked := row.Locked.String()
+		if row.Locked.IsZero() {
+			locked = "0"
+		}
+		fmt.Fprintf(&sb, "%-6d %-10s %s\n", row.Month, row.Time.UTC().Format("2006-01-02"), locked)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVestingTemplateVestingPeriods(t *testing.T) {
+	template := VestingTemplate{Periods: 4, PeriodLength: 100}
+	amount := sdk.NewCoins(sdk.NewInt64Coin("test", 1003))
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("test", amt)) }
+
+	testCases := []struct {
+		name       string
+		cliff      int64
+		expPeriods sdkvesting.Periods
+	}{
+		{
+			"no cliff",
+			0,
+			sdkvesting.Periods{
+				{Length: 100, Amount: coins(250)},
+				{Length: 100, Amount: coins(250)},
+				{Length: 100, Amount: coins(250)},
+				{Length: 100, Amount: coins(253)},
+			},
+		},
+		{
+			"cliff between periods",
+			250,
+			sdkvesting.Periods{
+				{Length: 250, Amount: coins(500)},
+				{Length: 50, Amount: coins(250)},
+				{Length: 100, Amount: coins(253)},
+			},
+		},
+		{
+			"cliff at the end of a period",
+			200,
+			sdkvesting.Periods{
+				{Length: 200, Amount: coins(500)},
+				{Length: 100, Amount: coins(250)},
+				{Length: 100, Amount: coins(253)},
+			},
+		},
+		{
+			"cliff at the end of the vesting",
+			400,
+			sdkvesting.Periods{
+				{Length: 400, Amount: coins(1003)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			periods := template.VestingPeriods(amount, tc.cliff)
+			require.Equal(t, tc.expPeriods, periods)
+			require.Equal(t, amount, periods.TotalAmount())
+		})
+	}
+}
+
+func TestReadManifestFile(t *testing.T) {
+	dir := t.TempDir()
+	funder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	writeFile := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+		return path
+	}
+
+	templates := `"start_time": 1672531200,
+  "templates": {
+    "employee": {"periods": 4, "period_length_seconds": 100, "lockup_seconds": 1000},
+    "investor": {"periods": 2, "period_length_seconds": 200}
+  }`
+
+	jsonManifest := writeFile("manifest.json", fmt.Sprintf(`{
+  %s,
+  "grants": [
+    {"address": "%s", "amount": "1000test", "cliff_seconds": 100, "template": "employee"},
+    {"address": "%s", "amount": "500test", "cliff_seconds": 0, "template": "investor"}
+  ]
+}`, templates, addr1, addr2))
+	templatesFile := writeFile("templates.json", fmt.Sprintf("{%s}", templates))
+	csvManifest := writeFile("manifest.csv", fmt.Sprintf(`address,amount,cliff_seconds,template
+%s,1000test,100,employee
+%s,500test,0,investor
+`, addr1, addr2))
+	duplicateManifest := writeFile("duplicate.csv", fmt.Sprintf(`address,amount,cliff_seconds,template
+%s,1000test,100,employee
+%s,500test,0,investor
+`, addr1, addr1))
+	unknownTemplateManifest := writeFile("unknown.csv", fmt.Sprintf(`address,amount,cliff_seconds,template
+%s,1000test,100,advisor
+`, addr1))
+	invalidHeaderManifest := writeFile("header.csv", fmt.Sprintf(`addr,amount,cliff,template
+%s,1000test,100,employee
+`, addr1))
+	longCliffManifest := writeFile("cliff.csv", fmt.Sprintf(`address,amount,cliff_seconds,template
+%s,1000test,500,employee
+`, addr1))
+
+	testCases := []struct {
+		name      string
+		path      string
+		templates string
+		expPass   bool
+	}{
+		{"fail - file not found", filepath.Join(dir, "missing.json"), "", false},
+		{"fail - CSV manifest without templates", csvManifest, "", false},
+		{"fail - invalid CSV header", invalidHeaderManifest, templatesFile, false},
+		{"fail - duplicate address", duplicateManifest, templatesFile, false},
+		{"fail - unknown template", unknownTemplateManifest, templatesFile, false},
+		{"fail - cliff longer than the vesting", longCliffManifest, templatesFile, false},
+		{"pass - JSON manifest", jsonManifest, "", true},
+		{"pass - CSV manifest", csvManifest, templatesFile, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest, err := ReadManifestFile(tc.path, tc.templates)
+			if err == nil {
+				_, err = manifest.BuildMsgs(funder, false)
+			}
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			msgs, err := manifest.BuildMsgs(funder, false)
+			require.NoError(t, err)
+			require.Len(t, msgs, 2)
+
+			require.Equal(t, funder.String(), msgs[0].FromAddress)
+			require.Equal(t, addr1.String(), msgs[0].ToAddress)
+			require.Equal(t, time.Unix(1672531200, 0), msgs[0].StartTime)
+			require.Equal(t, sdkvesting.Periods{{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 1000))}}, msgs[0].LockupPeriods)
+			require.Len(t, msgs[0].VestingPeriods, 4)
+
+			require.Equal(t, addr2.String(), msgs[1].ToAddress)
+			require.Empty(t, msgs[1].LockupPeriods)
+			require.Len(t, msgs[1].VestingPeriods, 2)
+		})
+	}
+}
+
+func TestMonthlyLockedAmounts(t *testing.T) {
+	day := int64(24 * 60 * 60)
+	manifest := Manifest{
+		StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		Templates: map[string]VestingTemplate{
+			"investor": {Periods: 2, PeriodLength: 60 * day},
+		},
+		Grants: []Grant{
+			{Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), Amount: "600test", Template: "investor"},
+			{Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), Amount: "400test", Template: "investor"},
+		},
+	}
+
+	msgs, err := manifest.BuildMsgs(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), false)
+	require.NoError(t, err)
+
+	report := MonthlyLockedAmounts(msgs)
+
+	// vesting events on March 2nd and May 1st
+	expLocked := []int64{1000, 1000, 1000, 500, 0}
+	require.Len(t, report, len(expLocked))
+	for i, row := range report {
+		require.Equal(t, i, row.Month)
+		require.True(t, row.Locked.AmountOf("test").Equal(sdk.NewInt(expLocked[i])), "month %d", i)
+	}
+
+	require.Contains(t, FormatLockedReport(msgs, report), "grants: 2\ntotal: 1000test")
+}
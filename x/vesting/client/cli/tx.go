@@ -26,6 +26,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/evmos/evmos/v12/x/vesting/types"
@@ -33,13 +35,17 @@ import (
 
 // Transaction command flags
 const (
-	FlagDelayed  = "delayed"
-	FlagDest     = "dest"
-	FlagLockup   = "lockup"
-	FlagMerge    = "merge"
-	FlagVesting  = "vesting"
-	FlagClawback = "clawback"
-	FlagFunder   = "funder"
+	FlagDelayed      = "delayed"
+	FlagDest         = "dest"
+	FlagLockup       = "lockup"
+	FlagMerge        = "merge"
+	FlagVesting      = "vesting"
+	FlagClawback     = "clawback"
+	FlagFunder       = "funder"
+	FlagAccelerate   = "accelerate"
+	FlagExtendLockup = "extend-lockup"
+	FlagTemplates    = "templates"
+	FlagReport       = "report"
 )
 
 // NewTxCmd returns a root CLI command handler for certain modules/vesting
@@ -55,9 +61,11 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewMsgCreateClawbackVestingAccountCmd(),
+		NewBatchCreateClawbackVestingAccountsCmd(),
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewMsgUpdateVestingScheduleCmd(),
 	)
 
 	return txCmd
@@ -147,6 +155,87 @@ with a start time and an array of coins strings and durations relative to the st
 	return cmd
 }
 
+// NewBatchCreateClawbackVestingAccountsCmd returns a CLI command handler for
+// creating a transaction with a MsgCreateClawbackVestingAccount for every
+// grant of a manifest.
+func NewBatchCreateClawbackVestingAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-accounts MANIFEST_FILE",
+		Short: "Create clawback vesting accounts for all the grants of a JSON or CSV manifest in a single transaction.",
+		Long: `Each grant of the manifest defines the address of the vesting account, the granted amount,
+a cliff in seconds and the name of a vesting template.
+A template splits the amount into equal vesting periods and can lock the vested coins for a given length after the start time.
+The coins of the periods that end before the cliff vest at the cliff.
+All the grants start at the start time of the manifest and are funded by the --from address.
+
+A CSV manifest has the header "address,amount,cliff_seconds,template" and requires a JSON templates file (--templates)
+that defines the start time and the templates.
+Use --report to print the total locked amounts per month without generating or broadcasting the transaction.`,
+		Example: `Sample JSON manifest file contents:
+{
+  "start_time": 1625204910,
+  "templates": {
+    "employee": {
+      "periods": 48,
+      "period_length_seconds": 2592000,
+      "lockup_seconds": 0
+    }
+  },
+  "grants": [
+    {
+      "address": "evmos1...",
+      "amount": "1000000aevmos",
+      "cliff_seconds": 31536000,
+      "template": "employee"
+    }
+  ]
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			templatesFile, _ := cmd.Flags().GetString(FlagTemplates)
+			manifest, err := ReadManifestFile(args[0], templatesFile)
+			if err != nil {
+				return err
+			}
+
+			report, _ := cmd.Flags().GetBool(FlagReport)
+			funder := clientCtx.GetFromAddress()
+			if report && funder.Empty() {
+				// the funder doesn't affect the report, so --from is optional
+				funder = authtypes.NewModuleAddress(types.ModuleName)
+			}
+
+			merge, _ := cmd.Flags().GetBool(FlagMerge)
+			createMsgs, err := manifest.BuildMsgs(funder, merge)
+			if err != nil {
+				return err
+			}
+
+			if report {
+				return clientCtx.PrintString(FormatLockedReport(createMsgs, MonthlyLockedAmounts(createMsgs)))
+			}
+
+			msgs := make([]sdk.Msg, len(createMsgs))
+			for i, msg := range createMsgs {
+				msgs[i] = msg
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().Bool(FlagMerge, false, "Merge the grants with existing ClawbackVestingAccounts, if any")
+	cmd.Flags().String(FlagTemplates, "", "path to the JSON file containing the start time and the templates of a CSV manifest")
+	cmd.Flags().Bool(FlagReport, false, "print the total locked amounts per month instead of generating the transaction")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewMsgClawbackCmd returns a CLI command handler for creating a
 // MsgClawback transaction.
 func NewMsgClawbackCmd() *cobra.Command {
@@ -261,3 +350,65 @@ func NewMsgConvertVestingAccountCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMsgUpdateVestingScheduleCmd returns a CLI command handler for creating a
+// MsgUpdateVestingSchedule transaction.
+func NewMsgUpdateVestingScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-vesting-schedule VESTING_ACCOUNT_ADDRESS",
+		Short: "Modify the remaining vesting and lockup schedules of an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the original funder address (--from).
+Unvested coins can either vest immediately (--accelerate) or according to a new vesting periods file (--vesting).
+The periods of the file are relative to the block time of the update, and must add up to the unvested coins of the account.
+The start_time of the file is ignored.
+All future unlocking events can be delayed by a given duration (--extend-lockup).
+Vesting and unlocking events that already passed are not modified.`,
+		Example: fmt.Sprintf(
+			"%s tx %s update-vesting-schedule evmos1... --%s --%s 720h --from funder",
+			version.AppName, types.ModuleName, FlagAccelerate, FlagExtendLockup,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var vestingPeriods sdkvesting.Periods
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			accelerate, _ := cmd.Flags().GetBool(FlagAccelerate)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if vestingFile != "" {
+				_, vestingPeriods, err = ReadScheduleFile(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			lockupExtension, err := cmd.Flags().GetDuration(FlagExtendLockup)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateVestingSchedule(
+				clientCtx.GetFromAddress(), addr, accelerate, vestingPeriods, int64(lockupExtension.Seconds()),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAccelerate, false, "vest all the unvested coins immediately")
+	cmd.Flags().String(FlagVesting, "", "path to file containing the new vesting periods of the unvested coins")
+	cmd.Flags().Duration(FlagExtendLockup, 0, "duration by which all future unlocking events are delayed")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -28,8 +28,8 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
-// Balances returns the locked, unvested and vested amount of tokens for a
-// clawback vesting account
+// Balances returns the locked, unvested, vested and spendable amount of tokens
+// for a clawback vesting account
 func (k Keeper) Balances(
 	goCtx context.Context,
 	req *types.QueryBalancesRequest,
@@ -66,10 +66,12 @@ func (k Keeper) Balances(
 	locked := clawbackAccount.GetLockedOnly(ctx.BlockTime())
 	unvested := clawbackAccount.GetUnvestedOnly(ctx.BlockTime())
 	vested := clawbackAccount.GetVestedOnly(ctx.BlockTime())
+	spendable := k.bankKeeper.SpendableCoins(ctx, addr)
 
 	return &types.QueryBalancesResponse{
-		Locked:   locked,
-		Unvested: unvested,
-		Vested:   vested,
+		Locked:    locked,
+		Unvested:  unvested,
+		Vested:    vested,
+		Spendable: spendable,
 	}, nil
 }
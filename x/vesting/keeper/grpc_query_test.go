@@ -85,9 +85,10 @@ func (suite *KeeperTestSuite) TestBalances() {
 					Address: addr.String(),
 				}
 				expRes = &types.QueryBalancesResponse{
-					Locked:   balances,
-					Unvested: balances,
-					Vested:   nil,
+					Locked:    balances,
+					Unvested:  balances,
+					Vested:    nil,
+					Spendable: nil,
 				}
 			},
 			true,
@@ -325,6 +325,91 @@ func (k Keeper) ConvertVestingAccount(
 	return &types.MsgConvertVestingAccountResponse{}, nil
 }
 
+// UpdateVestingSchedule modifies the remaining vesting and lockup schedules
+// of a ClawbackVestingAccount. Unvested coins can be vested immediately or
+// according to new vesting periods, and future unlocking events can be
+// delayed.
+func (k Keeper) UpdateVestingSchedule(
+	goCtx context.Context,
+	msg *types.MsgUpdateVestingSchedule,
+) (*types.MsgUpdateVestingScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: error checked during msg validation
+	addr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	// Check if vesting account exists
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "account %s does not exist", msg.VestingAddress)
+	}
+
+	// Check if account is a clawback vesting account
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account not subject to clawback: %s", msg.VestingAddress)
+	}
+
+	// Check if account funder is same as in msg
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting schedule can only be updated by original funder %s", va.FunderAddress)
+	}
+
+	updatedAcc := *va
+	updateTime := ctx.BlockTime().Unix()
+
+	vestingPeriods := msg.VestingPeriods
+	if msg.AccelerateVesting {
+		unvested := va.GetUnvestedOnly(ctx.BlockTime())
+		if unvested.IsZero() {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientVestedCoins, "no unvested coins left in account: %s", msg.VestingAddress)
+		}
+
+		// vest all the unvested coins at the current block time
+		vestingPeriods = sdkvesting.Periods{{Length: 0, Amount: unvested}}
+	}
+
+	var err error
+	if len(vestingPeriods) > 0 {
+		updatedAcc, err = updatedAcc.ReplaceVestingPeriods(updateTime, vestingPeriods)
+		if err != nil {
+			return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	if msg.LockupExtension > 0 {
+		updatedAcc, err = updatedAcc.ExtendLockup(updateTime, msg.LockupExtension)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrVestingLockup, err.Error())
+		}
+	}
+
+	// the vesting coins changed, so the tracked delegations need to be split
+	// again between delegated vesting and delegated free coins
+	k.resetDelegations(ctx, &updatedAcc)
+
+	if err := updatedAcc.Validate(); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	k.accountKeeper.SetAccount(ctx, &updatedAcc)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUpdateVestingSchedule,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyAccelerate, strconv.FormatBool(msg.AccelerateVesting)),
+				sdk.NewAttribute(types.AttributeKeyLockupExtension, strconv.FormatInt(msg.LockupExtension, 10)),
+				sdk.NewAttribute(types.AttributeKeyEndTime, strconv.FormatInt(updatedAcc.EndTime, 10)),
+			),
+		},
+	)
+
+	return &types.MsgUpdateVestingScheduleResponse{}, nil
+}
+
 // addGrant merges a new clawback vesting grant into an existing
 // ClawbackVestingAccount.
 func (k Keeper) addGrant(
@@ -334,12 +419,6 @@ func (k Keeper) addGrant(
 	grantLockupPeriods, grantVestingPeriods sdkvesting.Periods,
 	grantCoins sdk.Coins,
 ) error {
-	// how much is really delegated?
-	bondedAmt := k.stakingKeeper.GetDelegatorBonded(ctx, va.GetAddress())
-	unbondingAmt := k.stakingKeeper.GetDelegatorUnbonding(ctx, va.GetAddress())
-	delegatedAmt := bondedAmt.Add(unbondingAmt)
-	delegated := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), delegatedAmt))
-
 	// modify schedules for the new grant
 	newLockupStart, newLockupEnd, newLockupPeriods := types.DisjunctPeriods(va.GetStartTime(), grantStartTime, va.LockupPeriods, grantLockupPeriods)
 	newVestingStart, newVestingEnd, newVestingPeriods := types.DisjunctPeriods(va.GetStartTime(), grantStartTime,
@@ -359,11 +438,24 @@ func (k Keeper) addGrant(
 	va.VestingPeriods = newVestingPeriods
 	va.OriginalVesting = va.OriginalVesting.Add(grantCoins...)
 
+	k.resetDelegations(ctx, va)
+	return nil
+}
+
+// resetDelegations splits the coins delegated by a ClawbackVestingAccount
+// into delegated vesting and delegated free coins, according to its current
+// vesting schedule.
+func (k Keeper) resetDelegations(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	// how much is really delegated?
+	bondedAmt := k.stakingKeeper.GetDelegatorBonded(ctx, va.GetAddress())
+	unbondingAmt := k.stakingKeeper.GetDelegatorUnbonding(ctx, va.GetAddress())
+	delegatedAmt := bondedAmt.Add(unbondingAmt)
+	delegated := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), delegatedAmt))
+
 	// cap DV at the current unvested amount, DF rounds out to current delegated
 	unvested := va.GetVestingCoins(ctx.BlockTime())
 	va.DelegatedVesting = delegated.Min(unvested)
 	va.DelegatedFree = delegated.Sub(va.DelegatedVesting...)
-	return nil
 }
 
 // transferClawback transfers unvested tokens in a ClawbackVestingAccount to
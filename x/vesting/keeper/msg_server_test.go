@@ -11,6 +11,7 @@ import (
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/evmos/evmos/v12/testutil"
 	utiltx "github.com/evmos/evmos/v12/testutil/tx"
@@ -440,6 +441,221 @@ func (suite *KeeperTestSuite) TestMsgUpdateVestingFunder() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgUpdateVestingSchedule() {
+	threeQuarters := sdk.NewCoins(sdk.NewInt64Coin("test", 750))
+
+	testCases := []struct {
+		name              string
+		malleate          func()
+		funder            sdk.AccAddress
+		vestingAcc        sdk.AccAddress
+		accelerate        bool
+		vestingPeriods    sdkvesting.Periods
+		lockupExtension   int64
+		expVestingPeriods sdkvesting.Periods
+		expLockupPeriods  sdkvesting.Periods
+		expectedPass      bool
+	}{
+		{
+			"fail - non-existent vesting account",
+			func() {},
+			addr,
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			true,
+			nil,
+			0,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - wrong account type",
+			func() {
+				baseAccount := authtypes.NewBaseAccountWithAddress(addr4)
+				acc := sdkvesting.NewBaseVestingAccount(baseAccount, balances, 500000)
+				s.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			addr,
+			addr4,
+			true,
+			nil,
+			0,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - wrong funder",
+			func() {},
+			addr3,
+			addr2,
+			true,
+			nil,
+			0,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - vesting periods don't add up to the unvested coins",
+			func() {},
+			addr,
+			addr2,
+			false,
+			sdkvesting.Periods{{Length: 1000, Amount: quarter}},
+			0,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - nothing left to accelerate",
+			func() {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10000 * time.Second))
+			},
+			addr,
+			addr2,
+			true,
+			nil,
+			0,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - accelerate vesting",
+			func() {},
+			addr,
+			addr2,
+			true,
+			nil,
+			0,
+			sdkvesting.Periods{
+				{Length: 2000, Amount: quarter},
+				{Length: 1000, Amount: threeQuarters},
+			},
+			lockupPeriods,
+			true,
+		},
+		{
+			"pass - replace future vesting periods",
+			func() {},
+			addr,
+			addr2,
+			false,
+			sdkvesting.Periods{
+				{Length: 500, Amount: quarter},
+				{Length: 10000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 500))},
+			},
+			0,
+			sdkvesting.Periods{
+				{Length: 2000, Amount: quarter},
+				{Length: 1500, Amount: quarter},
+				{Length: 10000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 500))},
+			},
+			lockupPeriods,
+			true,
+		},
+		{
+			"pass - extend lockup",
+			func() {},
+			addr,
+			addr2,
+			false,
+			nil,
+			4000,
+			vestingPeriods,
+			sdkvesting.Periods{{Length: 9000, Amount: balances}},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			startTime := suite.ctx.BlockTime().Add(-3000 * time.Second)
+
+			// Set funder
+			funder := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, funder)
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, balances)
+			suite.Require().NoError(err)
+
+			// Create Clawback Vesting Account with the first vesting period passed
+			createMsg := types.NewMsgCreateClawbackVestingAccount(addr, addr2, startTime, lockupPeriods, vestingPeriods, false)
+			_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), createMsg)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			msg := types.NewMsgUpdateVestingSchedule(tc.funder, tc.vestingAcc, tc.accelerate, tc.vestingPeriods, tc.lockupExtension)
+			res, err := suite.app.VestingKeeper.UpdateVestingSchedule(sdk.WrapSDKContext(suite.ctx), msg)
+
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&types.MsgUpdateVestingScheduleResponse{}, res)
+
+				vestingAcc := suite.app.AccountKeeper.GetAccount(suite.ctx, tc.vestingAcc)
+				va, ok := vestingAcc.(*types.ClawbackVestingAccount)
+				suite.Require().True(ok, "vesting account could not be casted to ClawbackVestingAccount")
+
+				suite.Require().Equal(tc.expVestingPeriods, va.VestingPeriods)
+				suite.Require().Equal(tc.expLockupPeriods, va.LockupPeriods)
+				suite.Require().Equal(balances, va.OriginalVesting)
+				suite.Require().NoError(va.Validate())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateVestingScheduleDelegations() {
+	suite.SetupTest()
+
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	total := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	quarter := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 250))
+	// instant unlock, so that the vesting coins only depend on the vesting schedule
+	lockup := sdkvesting.Periods{{Length: 0, Amount: total}}
+	vesting := sdkvesting.Periods{
+		{Length: 2000, Amount: quarter},
+		{Length: 2000, Amount: quarter},
+		{Length: 2000, Amount: quarter},
+		{Length: 2000, Amount: quarter},
+	}
+
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, total)
+	suite.Require().NoError(err)
+
+	startTime := suite.ctx.BlockTime().Add(-3000 * time.Second)
+	createMsg := types.NewMsgCreateClawbackVestingAccount(addr, addr2, startTime, lockup, vesting, false)
+	_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), createMsg)
+	suite.Require().NoError(err)
+
+	// delegate unvested coins
+	_, err = suite.app.StakingKeeper.Delegate(suite.ctx, addr2, sdk.NewInt(600), stakingtypes.Unbonded, suite.validator, true)
+	suite.Require().NoError(err)
+
+	va := suite.app.AccountKeeper.GetAccount(suite.ctx, addr2).(*types.ClawbackVestingAccount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 600)), va.DelegatedVesting)
+	suite.Require().True(va.DelegatedFree.IsZero())
+
+	msg := types.NewMsgUpdateVestingSchedule(addr, addr2, true, nil, 0)
+	_, err = suite.app.VestingKeeper.UpdateVestingSchedule(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	// all the delegated coins are now vested
+	va = suite.app.AccountKeeper.GetAccount(suite.ctx, addr2).(*types.ClawbackVestingAccount)
+	suite.Require().True(va.GetUnvestedOnly(suite.ctx.BlockTime()).IsZero())
+	suite.Require().True(va.DelegatedVesting.IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 600)), va.DelegatedFree)
+
+	// the remaining coins are spendable
+	spendable := suite.app.BankKeeper.SpendableCoins(suite.ctx, addr2)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 400)), spendable)
+}
+
 func (suite *KeeperTestSuite) TestClawbackVestingAccountStore() {
 	suite.SetupTest()
 
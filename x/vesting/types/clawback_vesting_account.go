@@ -18,6 +18,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -220,6 +221,76 @@ func (va ClawbackVestingAccount) ComputeClawback(
 	return va, totalUnvested
 }
 
+// ReplaceVestingPeriods returns an account with all future vesting events
+// replaced by the given periods. The periods are relative to the update time,
+// or to the start time if vesting hasn't begun, and must add up to the
+// unvested coins at the update time. Passed vesting events are preserved.
+func (va ClawbackVestingAccount) ReplaceVestingPeriods(
+	updateTime int64,
+	periods sdkvesting.Periods,
+) (ClawbackVestingAccount, error) {
+	unvested := va.GetUnvestedOnly(time.Unix(updateTime, 0))
+	if !coinEq(periods.TotalAmount(), unvested) {
+		return va, fmt.Errorf(
+			"vesting periods must add up to the unvested coins %s, got %s",
+			unvested, periods.TotalAmount(),
+		)
+	}
+
+	passedPeriods, lastEvent := ReadPastPeriods(va.GetStartTime(), va.EndTime, va.VestingPeriods, updateTime)
+
+	// copy the periods to avoid mutating the account or the inputs
+	newVestingPeriods := make(sdkvesting.Periods, len(passedPeriods), len(passedPeriods)+len(periods))
+	copy(newVestingPeriods, passedPeriods)
+
+	if len(periods) > 0 {
+		futurePeriods := make(sdkvesting.Periods, len(periods))
+		copy(futurePeriods, periods)
+		// the first future period is relative to the update time
+		futurePeriods[0].Length += Max64(updateTime, lastEvent) - lastEvent
+		newVestingPeriods = append(newVestingPeriods, futurePeriods...)
+	}
+
+	// copy the embedded account, since the end time is updated
+	baseVestingAcc := *va.BaseVestingAccount
+	va.BaseVestingAccount = &baseVestingAcc
+
+	va.VestingPeriods = newVestingPeriods
+	va.EndTime = va.GetStartTime() + Max64(va.LockupPeriods.TotalLength(), newVestingPeriods.TotalLength())
+
+	return va, nil
+}
+
+// ExtendLockup returns an account with all unlocking events after the update
+// time delayed by the given length in seconds. Passed unlocking events are
+// preserved.
+func (va ClawbackVestingAccount) ExtendLockup(updateTime, length int64) (ClawbackVestingAccount, error) {
+	if length <= 0 {
+		return va, fmt.Errorf("lockup extension must be positive, got %d", length)
+	}
+
+	passedPeriods, _ := ReadPastPeriods(va.GetStartTime(), va.EndTime, va.LockupPeriods, updateTime)
+	if len(passedPeriods) == len(va.LockupPeriods) {
+		return va, errors.New("all lockup periods have already passed")
+	}
+
+	// copy the periods to avoid mutating the account
+	newLockupPeriods := make(sdkvesting.Periods, len(va.LockupPeriods))
+	copy(newLockupPeriods, va.LockupPeriods)
+
+	// delaying the next unlocking event delays all the subsequent ones
+	newLockupPeriods[len(passedPeriods)].Length += length
+
+	// copy the embedded account, since the end time is updated
+	baseVestingAcc := *va.BaseVestingAccount
+	va.BaseVestingAccount = &baseVestingAcc
+
+	va.LockupPeriods = newLockupPeriods
+	va.EndTime = va.GetStartTime() + Max64(newLockupPeriods.TotalLength(), va.VestingPeriods.TotalLength())
+
+	return va, nil
+}
+
 // HasLockedCoins returns true if the blocktime has not passed all clawback
 // account's lockup periods
 func (va ClawbackVestingAccount) HasLockedCoins(blockTime time.Time) bool {
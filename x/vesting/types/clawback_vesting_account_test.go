@@ -538,3 +538,137 @@ func (suite *VestingAccountTestSuite) TestComputeClawback() {
 		})
 	}
 }
+
+func (suite *VestingAccountTestSuite) TestReplaceVestingPeriods() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := tmtime.Now()
+
+	testCases := []struct {
+		name              string
+		time              int64
+		periods           sdkvesting.Periods
+		expVestingPeriods sdkvesting.Periods
+		expEndTime        int64
+		expErr            bool
+	}{
+		{
+			"fail - periods don't add up to the unvested coins",
+			now.Add(13 * time.Hour).Unix(),
+			sdkvesting.Periods{{Length: 0, Amount: sdk.NewCoins(fee(400), stake(50))}},
+			nil,
+			0,
+			true,
+		},
+		{
+			"pass - replace all periods before the start time",
+			now.Add(-time.Hour).Unix(),
+			sdkvesting.Periods{{Length: 3600, Amount: origCoins}},
+			sdkvesting.Periods{{Length: 3600, Amount: origCoins}},
+			now.Add(16 * time.Hour).Unix(),
+			false,
+		},
+		{
+			"pass - accelerate after the first vesting period",
+			now.Add(13 * time.Hour).Unix(),
+			sdkvesting.Periods{{Length: 0, Amount: sdk.NewCoins(fee(500), stake(50))}},
+			sdkvesting.Periods{
+				vestingPeriods[0],
+				{Length: int64(1 * 60 * 60), Amount: sdk.NewCoins(fee(500), stake(50))},
+			},
+			now.Add(16 * time.Hour).Unix(),
+			false,
+		},
+		{
+			"pass - extend vesting after the first vesting period",
+			now.Add(13 * time.Hour).Unix(),
+			sdkvesting.Periods{{Length: int64(24 * 60 * 60), Amount: sdk.NewCoins(fee(500), stake(50))}},
+			sdkvesting.Periods{
+				vestingPeriods[0],
+				{Length: int64(25 * 60 * 60), Amount: sdk.NewCoins(fee(500), stake(50))},
+			},
+			now.Add(37 * time.Hour).Unix(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+			va2, err := va.ReplaceVestingPeriods(tc.time, tc.periods)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expVestingPeriods, va2.VestingPeriods)
+			suite.Require().Equal(tc.expEndTime, va2.EndTime)
+			suite.Require().True(va2.VestingPeriods.TotalAmount().IsEqual(origCoins))
+			suite.Require().NoError(va2.Validate())
+			// the original account is not modified
+			suite.Require().Equal(vestingPeriods, va.VestingPeriods)
+			suite.Require().Equal(now.Add(24*time.Hour).Unix(), va.EndTime)
+		})
+	}
+}
+
+func (suite *VestingAccountTestSuite) TestExtendLockup() {
+	now := tmtime.Now()
+
+	testCases := []struct {
+		name             string
+		time             int64
+		length           int64
+		expLockupPeriods sdkvesting.Periods
+		expEndTime       int64
+		expErr           bool
+	}{
+		{
+			"fail - non-positive length",
+			now.Unix(),
+			0,
+			nil,
+			0,
+			true,
+		},
+		{
+			"fail - lockup already passed",
+			now.Add(17 * time.Hour).Unix(),
+			3600,
+			nil,
+			0,
+			true,
+		},
+		{
+			"pass - extend lockup beyond the vesting end",
+			now.Add(time.Hour).Unix(),
+			int64(12 * 60 * 60),
+			sdkvesting.Periods{{Length: int64(28 * 60 * 60), Amount: origCoins}},
+			now.Add(28 * time.Hour).Unix(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+			va2, err := va.ExtendLockup(tc.time, tc.length)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expLockupPeriods, va2.LockupPeriods)
+			suite.Require().Equal(tc.expEndTime, va2.EndTime)
+			suite.Require().Equal(origCoins, va2.GetLockedOnly(time.Unix(tc.time, 0)))
+			suite.Require().NoError(va2.Validate())
+		})
+	}
+}
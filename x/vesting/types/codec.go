@@ -41,6 +41,7 @@ const (
 	createClawbackVestingAccount = "evmos/MsgCreateClawbackVestingAccount"
 	updateVestingFunder          = "evmos/MsgUpdateVestingFunder"
 	convertVestingAccount        = "evmos/MsgConvertVestingAccount"
+	updateVestingSchedule        = "evmos/MsgUpdateVestingSchedule"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -78,6 +79,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateClawbackVestingAccount{},
 		&MsgUpdateVestingFunder{},
 		&MsgConvertVestingAccount{},
+		&MsgUpdateVestingSchedule{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -91,4 +93,5 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateClawbackVestingAccount{}, createClawbackVestingAccount, nil)
 	cdc.RegisterConcrete(&MsgUpdateVestingFunder{}, updateVestingFunder, nil)
 	cdc.RegisterConcrete(&MsgConvertVestingAccount{}, convertVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgUpdateVestingSchedule{}, updateVestingSchedule, nil)
 }
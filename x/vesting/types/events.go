@@ -21,12 +21,16 @@ const (
 	EventTypeCreateClawbackVestingAccount = "create_clawback_vesting_account"
 	EventTypeClawback                     = "clawback"
 	EventTypeUpdateVestingFunder          = "update_vesting_funder"
+	EventTypeUpdateVestingSchedule        = "update_vesting_schedule"
 
-	AttributeKeyCoins       = "coins"
-	AttributeKeyStartTime   = "start_time"
-	AttributeKeyMerge       = "merge"
-	AttributeKeyAccount     = "account"
-	AttributeKeyFunder      = "funder"
-	AttributeKeyNewFunder   = "new_funder"
-	AttributeKeyDestination = "destination"
+	AttributeKeyCoins           = "coins"
+	AttributeKeyStartTime       = "start_time"
+	AttributeKeyMerge           = "merge"
+	AttributeKeyAccount         = "account"
+	AttributeKeyFunder          = "funder"
+	AttributeKeyNewFunder       = "new_funder"
+	AttributeKeyDestination     = "destination"
+	AttributeKeyAccelerate      = "accelerate"
+	AttributeKeyLockupExtension = "lockup_extension"
+	AttributeKeyEndTime         = "end_time"
 )
@@ -30,6 +30,7 @@ var (
 	_ sdk.Msg = &MsgClawback{}
 	_ sdk.Msg = &MsgConvertVestingAccount{}
 	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgUpdateVestingSchedule{}
 )
 
 const (
@@ -37,6 +38,7 @@ const (
 	TypeMsgClawback                     = "clawback"
 	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
 	TypeMsgConvertVestingAccount        = "convert_vesting_account"
+	TypeMsgUpdateVestingSchedule        = "update_vesting_schedule"
 )
 
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
@@ -252,3 +254,74 @@ func (msg MsgConvertVestingAccount) GetSigners() []sdk.AccAddress {
 	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
 	return []sdk.AccAddress{vesting}
 }
+
+// NewMsgUpdateVestingSchedule creates new instance of MsgUpdateVestingSchedule
+func NewMsgUpdateVestingSchedule(
+	funder, vesting sdk.AccAddress,
+	accelerateVesting bool,
+	vestingPeriods sdkvesting.Periods,
+	lockupExtension int64,
+) *MsgUpdateVestingSchedule {
+	return &MsgUpdateVestingSchedule{
+		FunderAddress:     funder.String(),
+		VestingAddress:    vesting.String(),
+		AccelerateVesting: accelerateVesting,
+		VestingPeriods:    vestingPeriods,
+		LockupExtension:   lockupExtension,
+	}
+}
+
+// Route returns the message route for a MsgUpdateVestingSchedule.
+func (msg MsgUpdateVestingSchedule) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateVestingSchedule.
+func (msg MsgUpdateVestingSchedule) Type() string { return TypeMsgUpdateVestingSchedule }
+
+// ValidateBasic runs stateless checks on the MsgUpdateVestingSchedule message
+func (msg MsgUpdateVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GetFunderAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.GetVestingAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting account address")
+	}
+
+	if msg.AccelerateVesting && len(msg.VestingPeriods) > 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting acceleration and vesting periods are mutually exclusive")
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 0 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must not be negative", period.Length, i)
+		}
+		if !period.Amount.IsValid() {
+			return errortypes.ErrInvalidCoins.Wrap(period.Amount.String())
+		}
+		if !period.Amount.IsAllPositive() {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid amount in vesting periods, amounts must be positive")
+		}
+	}
+
+	if msg.LockupExtension < 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "lockup extension must not be negative, got %d", msg.LockupExtension)
+	}
+
+	// If no modification is requested, the message is invalid.
+	if !msg.AccelerateVesting && len(msg.VestingPeriods) == 0 && msg.LockupExtension == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting acceleration, vesting periods or lockup extension must be present")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUpdateVestingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateVestingSchedule) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
@@ -410,3 +410,103 @@ func (suite *MsgsTestSuite) TestMsgConvertVestingAccount() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgUpdateVestingScheduleGetters() {
+	msgInvalid := types.MsgUpdateVestingSchedule{}
+	msg := types.NewMsgUpdateVestingSchedule(
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		true,
+		nil,
+		0,
+	)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgUpdateVestingSchedule, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateVestingSchedule() {
+	var (
+		funder     = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		vestingAcc = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		periods    = sdkvesting.Periods{{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))}}
+	)
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgUpdateVestingSchedule
+		expectPass bool
+	}{
+		{
+			name:       "msg update vesting schedule - accelerate vesting",
+			msg:        types.NewMsgUpdateVestingSchedule(funder, vestingAcc, true, nil, 0),
+			expectPass: true,
+		},
+		{
+			name:       "msg update vesting schedule - new vesting periods and lockup extension",
+			msg:        types.NewMsgUpdateVestingSchedule(funder, vestingAcc, false, periods, 3600),
+			expectPass: true,
+		},
+		{
+			name: "msg update vesting schedule - invalid funder address",
+			msg: &types.MsgUpdateVestingSchedule{
+				FunderAddress:     "invalid_address",
+				VestingAddress:    vestingAcc.String(),
+				AccelerateVesting: true,
+			},
+			expectPass: false,
+		},
+		{
+			name: "msg update vesting schedule - invalid vesting address",
+			msg: &types.MsgUpdateVestingSchedule{
+				FunderAddress:     funder.String(),
+				VestingAddress:    "invalid_address",
+				AccelerateVesting: true,
+			},
+			expectPass: false,
+		},
+		{
+			name:       "msg update vesting schedule - no modification",
+			msg:        types.NewMsgUpdateVestingSchedule(funder, vestingAcc, false, nil, 0),
+			expectPass: false,
+		},
+		{
+			name:       "msg update vesting schedule - accelerate vesting with vesting periods",
+			msg:        types.NewMsgUpdateVestingSchedule(funder, vestingAcc, true, periods, 0),
+			expectPass: false,
+		},
+		{
+			name: "msg update vesting schedule - negative period length",
+			msg: types.NewMsgUpdateVestingSchedule(
+				funder, vestingAcc, false,
+				sdkvesting.Periods{{Length: -1, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))}},
+				0,
+			),
+			expectPass: false,
+		},
+		{
+			name: "msg update vesting schedule - empty period amount",
+			msg: types.NewMsgUpdateVestingSchedule(
+				funder, vestingAcc, false,
+				sdkvesting.Periods{{Length: 100, Amount: sdk.NewCoins()}},
+				0,
+			),
+			expectPass: false,
+		},
+		{
+			name:       "msg update vesting schedule - negative lockup extension",
+			msg:        types.NewMsgUpdateVestingSchedule(funder, vestingAcc, true, nil, -1),
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.name)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.name)
+		}
+	}
+}
@@ -86,6 +86,10 @@ type QueryBalancesResponse struct {
 	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
 	// vested defines the current amount of vested tokens
 	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// spendable defines the current amount of tokens that can be transferred
+	// from the account balance, i.e. the balance minus the locked tokens that
+	// are not delegated
+	Spendable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spendable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spendable"`
 }
 
 func (m *QueryBalancesResponse) Reset()         { *m = QueryBalancesResponse{} }
@@ -142,6 +146,13 @@ func (m *QueryBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+func (m *QueryBalancesResponse) GetSpendable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spendable
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalancesRequest)(nil), "evmos.vesting.v1.QueryBalancesRequest")
 	proto.RegisterType((*QueryBalancesResponse)(nil), "evmos.vesting.v1.QueryBalancesResponse")
@@ -150,31 +161,32 @@ func init() {
 func init() { proto.RegisterFile("evmos/vesting/v1/query.proto", fileDescriptor_ff0457b141ab5d28) }
 
 var fileDescriptor_ff0457b141ab5d28 = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0xed, 0x40, 0x1e, 0x8f, 0x37, 0x6f, 0xf3, 0xd2, 0xf0, 0x92, 0x4a, 0x48, 0x21, 0xc4, 0x20,
-	0x31, 0x3a, 0x43, 0xf1, 0x0f, 0xd0, 0x1f, 0x90, 0xa5, 0xbb, 0x69, 0x3b, 0xa9, 0x0d, 0x30, 0xb7,
-	0x30, 0xd3, 0x46, 0x62, 0xdc, 0xb8, 0x73, 0x67, 0xc2, 0x5f, 0xf8, 0x25, 0xc4, 0x15, 0x89, 0x1b,
-	0x57, 0x6a, 0xc0, 0x0f, 0x31, 0x6d, 0x07, 0x62, 0xd0, 0xc4, 0x8d, 0xae, 0x7a, 0x3b, 0xf7, 0x9e,
-	0x73, 0xef, 0xb9, 0xe7, 0xe2, 0x1a, 0x4f, 0x46, 0x20, 0x69, 0xc2, 0xa5, 0x0a, 0x45, 0x40, 0x13,
-	0x87, 0x8e, 0x63, 0x3e, 0x99, 0x92, 0x68, 0x02, 0x0a, 0xcc, 0x7f, 0x59, 0x96, 0xe8, 0x2c, 0x49,
-	0x9c, 0xaa, 0xed, 0x81, 0x4c, 0x01, 0x2e, 0x93, 0x9c, 0x26, 0x8e, 0xcb, 0x15, 0x73, 0xa8, 0x07,
-	0xa1, 0xc8, 0x11, 0xd5, 0x4a, 0x00, 0x01, 0x64, 0x21, 0x4d, 0x23, 0xfd, 0x5a, 0x0b, 0x00, 0x82,
-	0x21, 0xa7, 0x2c, 0x0a, 0x29, 0x13, 0x02, 0x14, 0x53, 0x21, 0x08, 0x99, 0x67, 0x9b, 0x1d, 0x5c,
-	0x39, 0x4d, 0x9b, 0xf6, 0xd8, 0x90, 0x09, 0x8f, 0xcb, 0x3e, 0x1f, 0xc7, 0x5c, 0x2a, 0xd3, 0xc2,
-	0xbf, 0x99, 0xef, 0x4f, 0xb8, 0x94, 0x16, 0x6a, 0xa0, 0xf6, 0x9f, 0xfe, 0xfa, 0xb7, 0x79, 0x5f,
-	0xc0, 0xff, 0xb7, 0x20, 0x32, 0x02, 0x21, 0xb9, 0xe9, 0xe1, 0xd2, 0x10, 0xbc, 0x01, 0xf7, 0x2d,
-	0xd4, 0x28, 0xb6, 0xff, 0x76, 0x77, 0x48, 0x3e, 0x30, 0x49, 0x07, 0x26, 0x7a, 0x60, 0x72, 0x0c,
-	0xa1, 0xe8, 0x75, 0xe6, 0x4f, 0x75, 0xe3, 0xee, 0xb9, 0xde, 0x0e, 0x42, 0x75, 0x1e, 0xbb, 0xc4,
-	0x83, 0x11, 0xd5, 0xea, 0xf2, 0xcf, 0xa1, 0xf4, 0x07, 0x54, 0x4d, 0x23, 0x2e, 0x33, 0x80, 0xec,
-	0x6b, 0x6a, 0x33, 0xc0, 0xe5, 0x58, 0xa4, 0x4b, 0xe1, 0xbe, 0x55, 0xf8, 0xfe, 0x36, 0x1b, 0xf2,
-	0x54, 0x8d, 0x6e, 0x53, 0xfc, 0x01, 0x35, 0x39, 0x75, 0x77, 0x86, 0xf0, 0xaf, 0x6c, 0x99, 0xe6,
-	0x0d, 0xc2, 0xe5, 0xf5, 0x46, 0xcd, 0x16, 0xd9, 0x36, 0x9f, 0x7c, 0xe6, 0x52, 0x75, 0xef, 0xcb,
-	0xba, 0xdc, 0x9a, 0xe6, 0xc1, 0xf5, 0xc3, 0xeb, 0xac, 0xd0, 0x32, 0x77, 0xe9, 0x87, 0x9b, 0x73,
-	0x75, 0x2d, 0xbd, 0xd4, 0x0e, 0x5f, 0xf5, 0x4e, 0xe6, 0x4b, 0x1b, 0x2d, 0x96, 0x36, 0x7a, 0x59,
-	0xda, 0xe8, 0x76, 0x65, 0x1b, 0x8b, 0x95, 0x6d, 0x3c, 0xae, 0x6c, 0xe3, 0x6c, 0xff, 0x9d, 0xc2,
-	0x9c, 0x49, 0xf3, 0x39, 0x5d, 0x7a, 0xb1, 0x61, 0xcd, 0x94, 0xba, 0xa5, 0xec, 0xc2, 0x8e, 0xde,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x43, 0xf3, 0x97, 0xe7, 0x02, 0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x6e, 0xda, 0x40,
+	0x10, 0xf5, 0x42, 0x4b, 0x61, 0x7b, 0xa9, 0x2c, 0x2a, 0xb9, 0x08, 0x19, 0x84, 0x2a, 0x8a, 0xaa,
+	0x76, 0x17, 0xd3, 0x3f, 0xa0, 0xfd, 0x81, 0x72, 0xec, 0x6d, 0x6d, 0x8f, 0x5c, 0x0b, 0xb3, 0x6b,
+	0xd8, 0xb5, 0x15, 0x14, 0xe5, 0x92, 0x5b, 0x6e, 0x91, 0xf8, 0x8b, 0xdc, 0xf3, 0x0f, 0x1c, 0x91,
+	0x72, 0xc9, 0x29, 0x89, 0x20, 0x1f, 0x12, 0xd9, 0x5e, 0x48, 0x44, 0x22, 0xe5, 0x42, 0x4e, 0x1e,
+	0x7b, 0xe6, 0xbd, 0x37, 0xcf, 0xf3, 0x70, 0x13, 0xd2, 0x89, 0x90, 0x34, 0x05, 0xa9, 0x42, 0x1e,
+	0xd0, 0xd4, 0xa1, 0xd3, 0x04, 0x66, 0x73, 0x12, 0xcf, 0x84, 0x12, 0xe6, 0xa7, 0xbc, 0x4b, 0x74,
+	0x97, 0xa4, 0x4e, 0xc3, 0xf6, 0x84, 0xcc, 0x00, 0x2e, 0x93, 0x40, 0x53, 0xc7, 0x05, 0xc5, 0x1c,
+	0xea, 0x89, 0x90, 0x17, 0x88, 0x46, 0x3d, 0x10, 0x81, 0xc8, 0x4b, 0x9a, 0x55, 0xfa, 0x6b, 0x33,
+	0x10, 0x22, 0x88, 0x80, 0xb2, 0x38, 0xa4, 0x8c, 0x73, 0xa1, 0x98, 0x0a, 0x05, 0x97, 0x45, 0xb7,
+	0xd3, 0xc7, 0xf5, 0xbf, 0x99, 0xe8, 0x90, 0x45, 0x8c, 0x7b, 0x20, 0x47, 0x30, 0x4d, 0x40, 0x2a,
+	0xd3, 0xc2, 0x1f, 0x98, 0xef, 0xcf, 0x40, 0x4a, 0x0b, 0xb5, 0x51, 0xaf, 0x36, 0xda, 0xbe, 0x76,
+	0x2e, 0xcb, 0xf8, 0xf3, 0x1e, 0x44, 0xc6, 0x82, 0x4b, 0x30, 0x3d, 0x5c, 0x89, 0x84, 0x37, 0x06,
+	0xdf, 0x42, 0xed, 0x72, 0xef, 0xe3, 0xe0, 0x0b, 0x29, 0x16, 0x26, 0xd9, 0xc2, 0x44, 0x2f, 0x4c,
+	0x7e, 0x8b, 0x90, 0x0f, 0xfb, 0xcb, 0x9b, 0x96, 0x71, 0x71, 0xdb, 0xea, 0x05, 0xa1, 0xfa, 0x9f,
+	0xb8, 0xc4, 0x13, 0x13, 0xaa, 0xdd, 0x15, 0x8f, 0x9f, 0xd2, 0x1f, 0x53, 0x35, 0x8f, 0x41, 0xe6,
+	0x00, 0x39, 0xd2, 0xd4, 0x66, 0x80, 0xab, 0x09, 0xcf, 0x7e, 0x0a, 0xf8, 0x56, 0xe9, 0xf0, 0x32,
+	0x3b, 0xf2, 0xcc, 0x8d, 0x96, 0x29, 0xbf, 0x81, 0x1b, 0x2d, 0x12, 0xe2, 0x9a, 0x8c, 0x81, 0xfb,
+	0xcc, 0x8d, 0xc0, 0x7a, 0x77, 0x78, 0x9d, 0x47, 0xf6, 0xc1, 0x02, 0xe1, 0xf7, 0xf9, 0xdd, 0xcc,
+	0x33, 0x84, 0xab, 0xdb, 0xe3, 0x99, 0x5d, 0xb2, 0x9f, 0x33, 0xf2, 0x52, 0x20, 0x1a, 0xdf, 0x5e,
+	0x9d, 0x2b, 0x52, 0xd0, 0xf9, 0x71, 0x7a, 0x75, 0xbf, 0x28, 0x75, 0xcd, 0xaf, 0xf4, 0x59, 0xbc,
+	0x5d, 0x3d, 0x4b, 0x8f, 0x75, 0x98, 0x4e, 0x86, 0x7f, 0x96, 0x6b, 0x1b, 0xad, 0xd6, 0x36, 0xba,
+	0x5b, 0xdb, 0xe8, 0x7c, 0x63, 0x1b, 0xab, 0x8d, 0x6d, 0x5c, 0x6f, 0x6c, 0xe3, 0xdf, 0xf7, 0x27,
+	0x26, 0x0b, 0x26, 0xcd, 0xe7, 0x0c, 0xe8, 0xd1, 0x8e, 0x35, 0x37, 0xeb, 0x56, 0xf2, 0x30, 0xff,
+	0x7a, 0x18, 0x00, 0x8c, 0x03, 0x75, 0xcd, 0x52, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -309,6 +321,20 @@ func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Spendable) > 0 {
+		for iNdEx := len(m.Spendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Vested) > 0 {
 		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -402,6 +428,12 @@ func (m *QueryBalancesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Spendable) > 0 {
+		for _, e := range m.Spendable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -624,6 +656,40 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spendable = append(m.Spendable, types.Coin{})
+			if err := m.Spendable[len(m.Spendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -94,6 +94,18 @@ func ReadPastPeriodCount(
 	return passedPeriods
 }
 
+// ReadPastPeriods returns the periods that passed before read time, along
+// with the time of the last passed event, or the start time if no event
+// passed yet.
+func ReadPastPeriods(
+	startTime, endTime int64,
+	periods sdkvesting.Periods,
+	readTime int64,
+) (sdkvesting.Periods, int64) {
+	passedPeriods := periods[:ReadPastPeriodCount(startTime, endTime, periods, readTime)]
+	return passedPeriods, startTime + passedPeriods.TotalLength()
+}
+
 // DisjunctPeriods returns the union of two vesting period schedules. The
 // returned schedule is the union of the vesting events, with simultaneous
 // events combined into a single event. Input schedules P and Q are defined by
@@ -463,6 +463,132 @@ func (m *MsgConvertVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVestingAccountResponse proto.InternalMessageInfo
 
+// MsgUpdateVestingSchedule defines a message that modifies the remaining
+// vesting and lockup schedules of a ClawbackVestingAccount. Vesting events
+// and unlocking events that already passed are not modified.
+type MsgUpdateVestingSchedule struct {
+	// funder_address is the address which funded the account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount being updated
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// accelerate_vesting vests all the unvested coins at the block time of the
+	// update. It can't be combined with vesting_periods.
+	AccelerateVesting bool `protobuf:"varint,3,opt,name=accelerate_vesting,json=accelerateVesting,proto3" json:"accelerate_vesting,omitempty"`
+	// vesting_periods replaces the future vesting events. The periods are
+	// relative to the block time of the update and must add up to the unvested
+	// coins of the account.
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// lockup_extension defines the length in seconds by which all the future
+	// unlocking events are delayed
+	LockupExtension int64 `protobuf:"varint,5,opt,name=lockup_extension,json=lockupExtension,proto3" json:"lockup_extension,omitempty"`
+}
+
+func (m *MsgUpdateVestingSchedule) Reset()         { *m = MsgUpdateVestingSchedule{} }
+func (m *MsgUpdateVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateVestingSchedule) ProtoMessage()    {}
+func (*MsgUpdateVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{8}
+}
+func (m *MsgUpdateVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateVestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateVestingSchedule.Merge(m, src)
+}
+func (m *MsgUpdateVestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateVestingSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateVestingSchedule) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateVestingSchedule) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateVestingSchedule) GetAccelerateVesting() bool {
+	if m != nil {
+		return m.AccelerateVesting
+	}
+	return false
+}
+
+func (m *MsgUpdateVestingSchedule) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+func (m *MsgUpdateVestingSchedule) GetLockupExtension() int64 {
+	if m != nil {
+		return m.LockupExtension
+	}
+	return 0
+}
+
+// MsgUpdateVestingScheduleResponse defines the MsgUpdateVestingSchedule
+// response type.
+type MsgUpdateVestingScheduleResponse struct {
+}
+
+func (m *MsgUpdateVestingScheduleResponse) Reset()         { *m = MsgUpdateVestingScheduleResponse{} }
+func (m *MsgUpdateVestingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateVestingScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{9}
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateVestingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateVestingScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateVestingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateVestingScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateVestingScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v1.MsgCreateClawbackVestingAccount")
 	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v1.MsgCreateClawbackVestingAccountResponse")
@@ -472,57 +598,66 @@ func init() {
 	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v1.MsgUpdateVestingFunderResponse")
 	proto.RegisterType((*MsgConvertVestingAccount)(nil), "evmos.vesting.v1.MsgConvertVestingAccount")
 	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "evmos.vesting.v1.MsgConvertVestingAccountResponse")
+	proto.RegisterType((*MsgUpdateVestingSchedule)(nil), "evmos.vesting.v1.MsgUpdateVestingSchedule")
+	proto.RegisterType((*MsgUpdateVestingScheduleResponse)(nil), "evmos.vesting.v1.MsgUpdateVestingScheduleResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v1/tx.proto", fileDescriptor_d5db113bc0c7240c) }
 
 var fileDescriptor_d5db113bc0c7240c = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0x3b, 0x14, 0x08, 0xbc, 0x42, 0x21, 0x0b, 0xfc, 0xd2, 0x5f, 0x03, 0xbb, 0xa5, 0x91,
-	0x50, 0x09, 0xee, 0xda, 0x4a, 0x4c, 0x30, 0x5e, 0xa0, 0x86, 0x1b, 0x89, 0x69, 0xd4, 0x83, 0x97,
-	0x66, 0xbb, 0x3b, 0x2c, 0x0d, 0x74, 0x67, 0xb3, 0x33, 0xdb, 0xe2, 0xcd, 0x78, 0x32, 0x9e, 0x48,
-	0x4c, 0x3c, 0x9b, 0x18, 0x2f, 0x26, 0xfe, 0x1f, 0xc4, 0x13, 0x89, 0x17, 0x0f, 0x46, 0x0c, 0x78,
-	0xf0, 0xcf, 0x30, 0x3b, 0x33, 0xbb, 0xc2, 0x66, 0x04, 0xbc, 0x78, 0xea, 0xce, 0x7b, 0xdf, 0xf7,
-	0xde, 0x67, 0xdf, 0x7b, 0x3b, 0x85, 0xff, 0x71, 0xbf, 0x47, 0xa8, 0xd5, 0xc7, 0x94, 0x75, 0x7d,
-	0xcf, 0xea, 0xd7, 0x2d, 0x76, 0x60, 0x06, 0x21, 0x61, 0x44, 0x9b, 0xe6, 0x2e, 0x53, 0xba, 0xcc,
-	0x7e, 0xbd, 0x7c, 0xc3, 0x21, 0xf4, 0xa2, 0xba, 0x83, 0x99, 0x5d, 0x4f, 0xce, 0x22, 0xae, 0x3c,
-	0xeb, 0x11, 0x8f, 0xf0, 0x47, 0x2b, 0x7e, 0x92, 0xd6, 0x79, 0x8f, 0x10, 0x6f, 0x1f, 0x5b, 0x76,
-	0xd0, 0xb5, 0x6c, 0xdf, 0x27, 0xcc, 0x66, 0x5d, 0xe2, 0x53, 0xe9, 0x35, 0xa4, 0x97, 0x9f, 0x3a,
-	0xd1, 0x8e, 0xc5, 0xba, 0x3d, 0x4c, 0x99, 0xdd, 0x0b, 0x84, 0xa0, 0xfa, 0x35, 0x0f, 0xc6, 0x36,
-	0xf5, 0x9a, 0x21, 0xb6, 0x19, 0x6e, 0xee, 0xdb, 0x83, 0x8e, 0xed, 0xec, 0x3d, 0x11, 0x75, 0x37,
-	0x1c, 0x87, 0x44, 0x3e, 0xd3, 0x16, 0x61, 0x62, 0x27, 0x24, 0xbd, 0xb6, 0xed, 0xba, 0x21, 0xa6,
-	0xb4, 0x84, 0x2a, 0xa8, 0x36, 0xde, 0x2a, 0xc4, 0xb6, 0x0d, 0x61, 0xd2, 0x16, 0x00, 0x18, 0x49,
-	0x05, 0x43, 0x5c, 0x30, 0xce, 0x48, 0xe2, 0x6e, 0x02, 0x50, 0x66, 0x87, 0xac, 0x1d, 0x97, 0x2f,
-	0xe5, 0x2b, 0xa8, 0x56, 0x68, 0x94, 0x4d, 0xc1, 0x66, 0x26, 0x6c, 0xe6, 0xa3, 0x84, 0x6d, 0x73,
-	0xec, 0xe8, 0x9b, 0x91, 0x3b, 0x3c, 0x31, 0x50, 0x6b, 0x9c, 0xc7, 0xc5, 0x1e, 0xed, 0x25, 0x82,
-	0xe2, 0x3e, 0x71, 0xf6, 0xa2, 0xa0, 0x1d, 0xe0, 0xb0, 0x4b, 0x5c, 0x5a, 0x1a, 0xae, 0xe4, 0x6b,
-	0x85, 0x86, 0x6e, 0x8a, 0xfe, 0x9d, 0x6b, 0x29, 0xef, 0x9f, 0xf9, 0x90, 0xcb, 0x36, 0x37, 0xe2,
-	0x6c, 0x1f, 0x4e, 0x8c, 0x75, 0xaf, 0xcb, 0x76, 0xa3, 0x8e, 0xe9, 0x90, 0x9e, 0x25, 0x3b, 0x2e,
-	0x7e, 0x6e, 0x51, 0x77, 0xcf, 0x3a, 0xb0, 0xec, 0x88, 0xed, 0xa6, 0x33, 0x60, 0xcf, 0x02, 0x4c,
-	0x65, 0x06, 0xda, 0x9a, 0x14, 0x85, 0xe5, 0x51, 0x7b, 0x85, 0x60, 0x4a, 0x0a, 0x53, 0x96, 0x91,
-	0x7f, 0xc5, 0x52, 0x94, 0xe6, 0x04, 0x66, 0x16, 0x46, 0x7a, 0x38, 0xf4, 0x70, 0x69, 0xb4, 0x82,
-	0x6a, 0x63, 0x2d, 0x71, 0xb8, 0x37, 0xfc, 0xf3, 0xad, 0x91, 0xab, 0xde, 0x84, 0xe5, 0x2b, 0xa6,
-	0xdb, 0xc2, 0x34, 0x20, 0x3e, 0xc5, 0xd5, 0xe7, 0x08, 0x0a, 0xb1, 0x56, 0xaa, 0xb4, 0x25, 0x28,
-	0xee, 0x44, 0xbe, 0x8b, 0xc3, 0xcc, 0xdc, 0x27, 0x85, 0x35, 0x19, 0xed, 0x32, 0x4c, 0xd9, 0x22,
-	0x53, 0x66, 0xfc, 0x45, 0x69, 0x4e, 0x84, 0x8b, 0x30, 0xe1, 0x62, 0xfa, 0x5b, 0x95, 0x17, 0x5b,
-	0x14, 0xdb, 0xa4, 0xa4, 0x3a, 0x07, 0x33, 0xe7, 0x08, 0x52, 0xb2, 0x37, 0x08, 0xfe, 0xdb, 0xa6,
-	0xde, 0xe3, 0xc0, 0xb5, 0x19, 0x96, 0xf4, 0x5b, 0x1c, 0xe2, 0xba, 0x90, 0xab, 0xa0, 0xf9, 0x78,
-	0xd0, 0xce, 0x48, 0x05, 0xe7, 0xb4, 0x8f, 0x07, 0x5b, 0xd9, 0x57, 0x4a, 0x86, 0x7b, 0x11, 0x36,
-	0xe9, 0x7c, 0xc2, 0x5b, 0x01, 0x5d, 0xcd, 0x95, 0xa2, 0x37, 0xa1, 0x14, 0xbf, 0x11, 0xf1, 0xfb,
-	0x38, 0x64, 0x99, 0xcf, 0x4a, 0x51, 0x06, 0x29, 0xcb, 0x54, 0xa1, 0xf2, 0xa7, 0x24, 0x49, 0xa1,
-	0xc6, 0xbb, 0x11, 0xc8, 0x6f, 0x53, 0x4f, 0xfb, 0x84, 0x60, 0xfe, 0xd2, 0x8f, 0xb9, 0x6e, 0x66,
-	0xaf, 0x1f, 0xf3, 0x8a, 0x0d, 0x29, 0xaf, 0xff, 0x75, 0x48, 0xfa, 0xfe, 0xf7, 0x5f, 0x7c, 0xfe,
-	0xf1, 0x7a, 0xe8, 0xae, 0xb6, 0x66, 0x29, 0xee, 0x43, 0xcb, 0xe1, 0x29, 0xda, 0x8e, 0xcc, 0xd1,
-	0x4e, 0xdb, 0x21, 0x59, 0x07, 0x30, 0x96, 0xae, 0xe3, 0x82, 0x1a, 0x42, 0xba, 0xcb, 0x4b, 0x97,
-	0xba, 0x53, 0x9e, 0x25, 0xce, 0x63, 0x68, 0x0b, 0x6a, 0x9e, 0xa4, 0xd8, 0x7b, 0x04, 0x33, 0xaa,
-	0x75, 0xab, 0x29, 0xab, 0x28, 0x94, 0xe5, 0xdb, 0xd7, 0x55, 0xa6, 0x68, 0x0d, 0x8e, 0xb6, 0xaa,
-	0xad, 0x28, 0xd1, 0x22, 0x1e, 0x99, 0x76, 0x48, 0x6c, 0xb2, 0xf6, 0x11, 0xc1, 0x9c, 0x7a, 0xb9,
-	0x56, 0xd4, 0xfd, 0x50, 0x69, 0xcb, 0x8d, 0xeb, 0x6b, 0x53, 0xda, 0x35, 0x4e, 0x6b, 0x6a, 0xab,
-	0xea, 0x46, 0x8a, 0xd8, 0xec, 0x40, 0x37, 0x1f, 0x1c, 0x9d, 0xea, 0xe8, 0xf8, 0x54, 0x47, 0xdf,
-	0x4f, 0x75, 0x74, 0x78, 0xa6, 0xe7, 0x8e, 0xcf, 0xf4, 0xdc, 0x97, 0x33, 0x3d, 0xf7, 0x74, 0xe5,
-	0xdc, 0x7d, 0x28, 0x32, 0xca, 0xbc, 0xf5, 0x86, 0x75, 0x70, 0xf1, 0x22, 0xec, 0x8c, 0xf2, 0x7f,
-	0x8c, 0x3b, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x14, 0x81, 0x50, 0xdb, 0x64, 0x07, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x33, 0x09, 0xa0, 0x30, 0x81, 0xc0, 0x1a, 0x58, 0x65, 0x2d, 0x70, 0x42, 0xb4, 0x88,
+	0x10, 0x81, 0xbd, 0xc9, 0xa2, 0x95, 0x58, 0xed, 0x05, 0xb2, 0xcb, 0x0d, 0x69, 0x95, 0xdd, 0xf6,
+	0xd0, 0x4b, 0xe4, 0xd8, 0x83, 0x89, 0x48, 0x3c, 0x96, 0x67, 0x9c, 0xa4, 0xb7, 0xaa, 0xa7, 0xaa,
+	0x27, 0xa4, 0x4a, 0x3d, 0xf7, 0xd2, 0x4b, 0xa5, 0x7e, 0x0f, 0xd4, 0x13, 0x12, 0x87, 0xf6, 0x50,
+	0x95, 0x0a, 0x7a, 0xe8, 0xc7, 0xa8, 0x3c, 0x33, 0x36, 0xe0, 0x4e, 0x21, 0x5c, 0xda, 0x13, 0x9e,
+	0xf7, 0xfe, 0xf3, 0xde, 0xcf, 0xef, 0xf9, 0x3d, 0x02, 0x7f, 0x41, 0xfd, 0x1e, 0x26, 0x46, 0x1f,
+	0x11, 0xda, 0x71, 0x1d, 0xa3, 0x5f, 0x33, 0xe8, 0x50, 0xf7, 0x7c, 0x4c, 0xb1, 0x32, 0xcb, 0x5c,
+	0xba, 0x70, 0xe9, 0xfd, 0x9a, 0xfa, 0xab, 0x85, 0xc9, 0x75, 0x75, 0x1b, 0x51, 0xb3, 0x16, 0x9d,
+	0xf9, 0x3d, 0x75, 0xde, 0xc1, 0x0e, 0x66, 0x8f, 0x46, 0xf8, 0x24, 0xac, 0x8b, 0x0e, 0xc6, 0x4e,
+	0x17, 0x19, 0xa6, 0xd7, 0x31, 0x4c, 0xd7, 0xc5, 0xd4, 0xa4, 0x1d, 0xec, 0x12, 0xe1, 0x2d, 0x0a,
+	0x2f, 0x3b, 0xb5, 0x83, 0x7d, 0x83, 0x76, 0x7a, 0x88, 0x50, 0xb3, 0xe7, 0x71, 0x41, 0xf9, 0x7d,
+	0x06, 0x16, 0xf7, 0x88, 0xd3, 0xf0, 0x91, 0x49, 0x51, 0xa3, 0x6b, 0x0e, 0xda, 0xa6, 0x75, 0x78,
+	0x9f, 0xe7, 0xdd, 0xb6, 0x2c, 0x1c, 0xb8, 0x54, 0x59, 0x86, 0x53, 0xfb, 0x3e, 0xee, 0xb5, 0x4c,
+	0xdb, 0xf6, 0x11, 0x21, 0x05, 0x50, 0x02, 0x95, 0xc9, 0x66, 0x2e, 0xb4, 0x6d, 0x73, 0x93, 0xb2,
+	0x04, 0x21, 0xc5, 0xb1, 0x20, 0xcd, 0x04, 0x93, 0x14, 0x47, 0xee, 0x06, 0x84, 0x84, 0x9a, 0x3e,
+	0x6d, 0x85, 0xe9, 0x0b, 0x99, 0x12, 0xa8, 0xe4, 0xea, 0xaa, 0xce, 0xd9, 0xf4, 0x88, 0x4d, 0xff,
+	0x3f, 0x62, 0xdb, 0xc9, 0x1e, 0x7f, 0x28, 0xa6, 0x8e, 0xce, 0x8a, 0xa0, 0x39, 0xc9, 0xee, 0x85,
+	0x1e, 0xe5, 0x09, 0x80, 0xf9, 0x2e, 0xb6, 0x0e, 0x03, 0xaf, 0xe5, 0x21, 0xbf, 0x83, 0x6d, 0x52,
+	0x18, 0x2b, 0x65, 0x2a, 0xb9, 0xba, 0xa6, 0xf3, 0xfa, 0x5d, 0x29, 0x29, 0xab, 0x9f, 0xfe, 0x2f,
+	0x93, 0xed, 0x6c, 0x87, 0xd1, 0x5e, 0x9d, 0x15, 0xb7, 0x9c, 0x0e, 0x3d, 0x08, 0xda, 0xba, 0x85,
+	0x7b, 0x86, 0xa8, 0x38, 0xff, 0xb3, 0x41, 0xec, 0x43, 0x63, 0x68, 0x98, 0x01, 0x3d, 0x88, 0x7b,
+	0x40, 0x1f, 0x7a, 0x88, 0x88, 0x08, 0xa4, 0x39, 0xcd, 0x13, 0x8b, 0xa3, 0xf2, 0x14, 0xc0, 0x19,
+	0x21, 0x8c, 0x59, 0xc6, 0xbf, 0x17, 0x4b, 0x5e, 0x98, 0x23, 0x98, 0x79, 0x38, 0xde, 0x43, 0xbe,
+	0x83, 0x0a, 0x13, 0x25, 0x50, 0xc9, 0x36, 0xf9, 0xe1, 0xcf, 0xb1, 0xcf, 0x2f, 0x8a, 0xa9, 0xf2,
+	0x1a, 0x5c, 0xbd, 0xa5, 0xbb, 0x4d, 0x44, 0x3c, 0xec, 0x12, 0x54, 0x7e, 0x04, 0x60, 0x2e, 0xd4,
+	0x0a, 0x95, 0xb2, 0x02, 0xf3, 0xfb, 0x81, 0x6b, 0x23, 0x3f, 0xd1, 0xf7, 0x69, 0x6e, 0x8d, 0x5a,
+	0xbb, 0x0a, 0x67, 0x4c, 0x1e, 0x29, 0xd1, 0xfe, 0xbc, 0x30, 0x47, 0xc2, 0x65, 0x38, 0x65, 0x23,
+	0x72, 0xa9, 0xca, 0xf0, 0xaf, 0x28, 0xb4, 0x09, 0x49, 0x79, 0x01, 0xce, 0x5d, 0x21, 0x88, 0xc9,
+	0x9e, 0x03, 0xf8, 0xf3, 0x1e, 0x71, 0xee, 0x79, 0xb6, 0x49, 0x91, 0xa0, 0xdf, 0x65, 0x10, 0xa3,
+	0x42, 0xae, 0x43, 0xc5, 0x45, 0x83, 0x56, 0x42, 0xca, 0x39, 0x67, 0x5d, 0x34, 0xd8, 0x4d, 0xbe,
+	0x52, 0xd4, 0xdc, 0xeb, 0xb0, 0x51, 0xe5, 0x23, 0xde, 0x12, 0xd4, 0xe4, 0x5c, 0x31, 0x7a, 0x03,
+	0x16, 0xc2, 0x37, 0xc2, 0x6e, 0x1f, 0xf9, 0x34, 0x31, 0x56, 0x92, 0x34, 0x40, 0x9a, 0xa6, 0x0c,
+	0x4b, 0xdf, 0x0a, 0x12, 0x27, 0x7a, 0x9b, 0x86, 0x85, 0x24, 0xcb, 0x7f, 0xd6, 0x01, 0xb2, 0x83,
+	0x2e, 0xba, 0x43, 0x2b, 0x93, 0x40, 0x69, 0x19, 0x90, 0xb2, 0x01, 0x15, 0xd3, 0xb2, 0x50, 0x17,
+	0xf9, 0x26, 0x45, 0x2d, 0xe1, 0x64, 0x35, 0xca, 0x36, 0x7f, 0xba, 0xf4, 0x08, 0x0c, 0xe9, 0xb4,
+	0x8c, 0xfd, 0xa8, 0x69, 0x59, 0x83, 0xb3, 0x62, 0x89, 0xa0, 0x21, 0x45, 0x2e, 0xe9, 0x60, 0xb7,
+	0x30, 0x5e, 0x02, 0x95, 0x4c, 0x73, 0x86, 0xdb, 0xff, 0x89, 0xcc, 0x62, 0x84, 0x78, 0xf5, 0xa5,
+	0x85, 0x8d, 0xaa, 0x5f, 0x3f, 0x9d, 0x80, 0x99, 0x3d, 0xe2, 0x28, 0x6f, 0x00, 0x5c, 0xbc, 0x71,
+	0x95, 0xd6, 0xf4, 0xe4, 0xf2, 0xd7, 0x6f, 0x99, 0x4f, 0x75, 0xeb, 0xce, 0x57, 0xe2, 0x8f, 0xe2,
+	0xaf, 0xc7, 0xa7, 0x9f, 0x9e, 0xa5, 0xff, 0x50, 0x36, 0x0d, 0xc9, 0x7f, 0x23, 0xc3, 0x62, 0x21,
+	0x5a, 0x96, 0x88, 0xd1, 0x8a, 0x7b, 0x2f, 0x58, 0x07, 0x30, 0x1b, 0x2f, 0x83, 0x25, 0x39, 0x84,
+	0x70, 0xab, 0x2b, 0x37, 0xba, 0x63, 0x9e, 0x15, 0xc6, 0x53, 0x54, 0x96, 0xe4, 0x3c, 0x51, 0xb2,
+	0x97, 0x00, 0xce, 0xc9, 0x86, 0xbd, 0x22, 0xcd, 0x22, 0x51, 0xaa, 0xbf, 0x8d, 0xaa, 0x8c, 0xd1,
+	0xea, 0x0c, 0x6d, 0x5d, 0xa9, 0x4a, 0xd1, 0x02, 0x76, 0x33, 0xae, 0x10, 0x1f, 0x1b, 0xe5, 0x35,
+	0x80, 0x0b, 0xf2, 0xd1, 0xae, 0xca, 0xeb, 0x21, 0xd3, 0xaa, 0xf5, 0xd1, 0xb5, 0x31, 0xed, 0x26,
+	0xa3, 0xd5, 0x95, 0x75, 0x79, 0x21, 0xf9, 0xdd, 0xaf, 0x1a, 0x1a, 0xf2, 0xca, 0x17, 0x44, 0xf5,
+	0xf6, 0x7a, 0x45, 0x5a, 0xb5, 0x3e, 0xba, 0x76, 0x44, 0xde, 0x44, 0x75, 0x89, 0xb8, 0xbd, 0xf3,
+	0xf7, 0xf1, 0xb9, 0x06, 0x4e, 0xce, 0x35, 0xf0, 0xf1, 0x5c, 0x03, 0x47, 0x17, 0x5a, 0xea, 0xe4,
+	0x42, 0x4b, 0xbd, 0xbb, 0xd0, 0x52, 0x0f, 0xaa, 0x57, 0xf6, 0x01, 0x8f, 0x28, 0xe2, 0xd6, 0xea,
+	0xc6, 0xf0, 0xfa, 0x22, 0x68, 0x4f, 0xb0, 0xdf, 0x17, 0xbf, 0x7f, 0x19, 0x00, 0x75, 0xae, 0x86,
+	0x82, 0x92, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -547,6 +682,9 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// UpdateVestingSchedule modifies the remaining vesting and lockup schedules
+	// of an existing ClawbackVestingAccount.
+	UpdateVestingSchedule(ctx context.Context, in *MsgUpdateVestingSchedule, opts ...grpc.CallOption) (*MsgUpdateVestingScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -593,6 +731,15 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) UpdateVestingSchedule(ctx context.Context, in *MsgUpdateVestingSchedule, opts ...grpc.CallOption) (*MsgUpdateVestingScheduleResponse, error) {
+	out := new(MsgUpdateVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Msg/UpdateVestingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to
@@ -605,6 +752,9 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// UpdateVestingSchedule modifies the remaining vesting and lockup schedules
+	// of an existing ClawbackVestingAccount.
+	UpdateVestingSchedule(context.Context, *MsgUpdateVestingSchedule) (*MsgUpdateVestingScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -623,6 +773,9 @@ func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) UpdateVestingSchedule(ctx context.Context, req *MsgUpdateVestingSchedule) (*MsgUpdateVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVestingSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -700,6 +853,24 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Msg/UpdateVestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVestingSchedule(ctx, req.(*MsgUpdateVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -720,6 +891,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "UpdateVestingSchedule",
+			Handler:    _Msg_UpdateVestingSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v1/tx.proto",
@@ -1018,6 +1193,95 @@ func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateVestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockupExtension != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockupExtension))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AccelerateVesting {
+		i--
+		if m.AccelerateVesting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVestingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1154,6 +1418,44 @@ func (m *MsgConvertVestingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateVestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccelerateVesting {
+		n += 2
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LockupExtension != 0 {
+		n += 1 + sovTx(uint64(m.LockupExtension))
+	}
+	return n
+}
+
+func (m *MsgUpdateVestingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1969,6 +2271,243 @@ func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateVestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccelerateVesting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccelerateVesting = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupExtension", wireType)
+			}
+			m.LockupExtension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockupExtension |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0